@@ -62,6 +62,15 @@ const (
 	// IngesterServiceMergeSpanProfileProcedure is the fully-qualified name of the IngesterService's
 	// MergeSpanProfile RPC.
 	IngesterServiceMergeSpanProfileProcedure = "/ingester.v1.IngesterService/MergeSpanProfile"
+	// IngesterServiceMergeProfilesTopFunctionsProcedure is the fully-qualified name of the
+	// IngesterService's MergeProfilesTopFunctions RPC.
+	IngesterServiceMergeProfilesTopFunctionsProcedure = "/ingester.v1.IngesterService/MergeProfilesTopFunctions"
+	// IngesterServiceMergeProfilesValuesProcedure is the fully-qualified name of the IngesterService's
+	// MergeProfilesValues RPC.
+	IngesterServiceMergeProfilesValuesProcedure = "/ingester.v1.IngesterService/MergeProfilesValues"
+	// IngesterServiceGetProfileByIDProcedure is the fully-qualified name of the IngesterService's
+	// GetProfileByID RPC.
+	IngesterServiceGetProfileByIDProcedure = "/ingester.v1.IngesterService/GetProfileByID"
 	// IngesterServiceBlockMetadataProcedure is the fully-qualified name of the IngesterService's
 	// BlockMetadata RPC.
 	IngesterServiceBlockMetadataProcedure = "/ingester.v1.IngesterService/BlockMetadata"
@@ -75,20 +84,23 @@ const (
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	ingesterServiceServiceDescriptor                        = v1.File_ingester_v1_ingester_proto.Services().ByName("IngesterService")
-	ingesterServicePushMethodDescriptor                     = ingesterServiceServiceDescriptor.Methods().ByName("Push")
-	ingesterServiceLabelValuesMethodDescriptor              = ingesterServiceServiceDescriptor.Methods().ByName("LabelValues")
-	ingesterServiceLabelNamesMethodDescriptor               = ingesterServiceServiceDescriptor.Methods().ByName("LabelNames")
-	ingesterServiceProfileTypesMethodDescriptor             = ingesterServiceServiceDescriptor.Methods().ByName("ProfileTypes")
-	ingesterServiceSeriesMethodDescriptor                   = ingesterServiceServiceDescriptor.Methods().ByName("Series")
-	ingesterServiceFlushMethodDescriptor                    = ingesterServiceServiceDescriptor.Methods().ByName("Flush")
-	ingesterServiceMergeProfilesStacktracesMethodDescriptor = ingesterServiceServiceDescriptor.Methods().ByName("MergeProfilesStacktraces")
-	ingesterServiceMergeProfilesLabelsMethodDescriptor      = ingesterServiceServiceDescriptor.Methods().ByName("MergeProfilesLabels")
-	ingesterServiceMergeProfilesPprofMethodDescriptor       = ingesterServiceServiceDescriptor.Methods().ByName("MergeProfilesPprof")
-	ingesterServiceMergeSpanProfileMethodDescriptor         = ingesterServiceServiceDescriptor.Methods().ByName("MergeSpanProfile")
-	ingesterServiceBlockMetadataMethodDescriptor            = ingesterServiceServiceDescriptor.Methods().ByName("BlockMetadata")
-	ingesterServiceGetProfileStatsMethodDescriptor          = ingesterServiceServiceDescriptor.Methods().ByName("GetProfileStats")
-	ingesterServiceGetBlockStatsMethodDescriptor            = ingesterServiceServiceDescriptor.Methods().ByName("GetBlockStats")
+	ingesterServiceServiceDescriptor                         = v1.File_ingester_v1_ingester_proto.Services().ByName("IngesterService")
+	ingesterServicePushMethodDescriptor                      = ingesterServiceServiceDescriptor.Methods().ByName("Push")
+	ingesterServiceLabelValuesMethodDescriptor               = ingesterServiceServiceDescriptor.Methods().ByName("LabelValues")
+	ingesterServiceLabelNamesMethodDescriptor                = ingesterServiceServiceDescriptor.Methods().ByName("LabelNames")
+	ingesterServiceProfileTypesMethodDescriptor              = ingesterServiceServiceDescriptor.Methods().ByName("ProfileTypes")
+	ingesterServiceSeriesMethodDescriptor                    = ingesterServiceServiceDescriptor.Methods().ByName("Series")
+	ingesterServiceFlushMethodDescriptor                     = ingesterServiceServiceDescriptor.Methods().ByName("Flush")
+	ingesterServiceMergeProfilesStacktracesMethodDescriptor  = ingesterServiceServiceDescriptor.Methods().ByName("MergeProfilesStacktraces")
+	ingesterServiceMergeProfilesLabelsMethodDescriptor       = ingesterServiceServiceDescriptor.Methods().ByName("MergeProfilesLabels")
+	ingesterServiceMergeProfilesPprofMethodDescriptor        = ingesterServiceServiceDescriptor.Methods().ByName("MergeProfilesPprof")
+	ingesterServiceMergeSpanProfileMethodDescriptor          = ingesterServiceServiceDescriptor.Methods().ByName("MergeSpanProfile")
+	ingesterServiceMergeProfilesTopFunctionsMethodDescriptor = ingesterServiceServiceDescriptor.Methods().ByName("MergeProfilesTopFunctions")
+	ingesterServiceMergeProfilesValuesMethodDescriptor       = ingesterServiceServiceDescriptor.Methods().ByName("MergeProfilesValues")
+	ingesterServiceGetProfileByIDMethodDescriptor            = ingesterServiceServiceDescriptor.Methods().ByName("GetProfileByID")
+	ingesterServiceBlockMetadataMethodDescriptor             = ingesterServiceServiceDescriptor.Methods().ByName("BlockMetadata")
+	ingesterServiceGetProfileStatsMethodDescriptor           = ingesterServiceServiceDescriptor.Methods().ByName("GetProfileStats")
+	ingesterServiceGetBlockStatsMethodDescriptor             = ingesterServiceServiceDescriptor.Methods().ByName("GetBlockStats")
 )
 
 // IngesterServiceClient is a client for the ingester.v1.IngesterService service.
@@ -105,6 +117,9 @@ type IngesterServiceClient interface {
 	MergeProfilesLabels(context.Context) *connect.BidiStreamForClient[v1.MergeProfilesLabelsRequest, v1.MergeProfilesLabelsResponse]
 	MergeProfilesPprof(context.Context) *connect.BidiStreamForClient[v1.MergeProfilesPprofRequest, v1.MergeProfilesPprofResponse]
 	MergeSpanProfile(context.Context) *connect.BidiStreamForClient[v1.MergeSpanProfileRequest, v1.MergeSpanProfileResponse]
+	MergeProfilesTopFunctions(context.Context) *connect.BidiStreamForClient[v1.MergeProfilesTopFunctionsRequest, v1.MergeProfilesTopFunctionsResponse]
+	MergeProfilesValues(context.Context) *connect.BidiStreamForClient[v1.MergeProfilesValuesRequest, v1.MergeProfilesValuesResponse]
+	GetProfileByID(context.Context, *connect.Request[v1.GetProfileByIDRequest]) (*connect.Response[v1.GetProfileByIDResponse], error)
 	BlockMetadata(context.Context, *connect.Request[v1.BlockMetadataRequest]) (*connect.Response[v1.BlockMetadataResponse], error)
 	// GetProfileStats returns profile stats for the current tenant.
 	GetProfileStats(context.Context, *connect.Request[v12.GetProfileStatsRequest]) (*connect.Response[v12.GetProfileStatsResponse], error)
@@ -181,6 +196,24 @@ func NewIngesterServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(ingesterServiceMergeSpanProfileMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		mergeProfilesTopFunctions: connect.NewClient[v1.MergeProfilesTopFunctionsRequest, v1.MergeProfilesTopFunctionsResponse](
+			httpClient,
+			baseURL+IngesterServiceMergeProfilesTopFunctionsProcedure,
+			connect.WithSchema(ingesterServiceMergeProfilesTopFunctionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		mergeProfilesValues: connect.NewClient[v1.MergeProfilesValuesRequest, v1.MergeProfilesValuesResponse](
+			httpClient,
+			baseURL+IngesterServiceMergeProfilesValuesProcedure,
+			connect.WithSchema(ingesterServiceMergeProfilesValuesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getProfileByID: connect.NewClient[v1.GetProfileByIDRequest, v1.GetProfileByIDResponse](
+			httpClient,
+			baseURL+IngesterServiceGetProfileByIDProcedure,
+			connect.WithSchema(ingesterServiceGetProfileByIDMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		blockMetadata: connect.NewClient[v1.BlockMetadataRequest, v1.BlockMetadataResponse](
 			httpClient,
 			baseURL+IngesterServiceBlockMetadataProcedure,
@@ -204,19 +237,22 @@ func NewIngesterServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 
 // ingesterServiceClient implements IngesterServiceClient.
 type ingesterServiceClient struct {
-	push                     *connect.Client[v11.PushRequest, v11.PushResponse]
-	labelValues              *connect.Client[v12.LabelValuesRequest, v12.LabelValuesResponse]
-	labelNames               *connect.Client[v12.LabelNamesRequest, v12.LabelNamesResponse]
-	profileTypes             *connect.Client[v1.ProfileTypesRequest, v1.ProfileTypesResponse]
-	series                   *connect.Client[v1.SeriesRequest, v1.SeriesResponse]
-	flush                    *connect.Client[v1.FlushRequest, v1.FlushResponse]
-	mergeProfilesStacktraces *connect.Client[v1.MergeProfilesStacktracesRequest, v1.MergeProfilesStacktracesResponse]
-	mergeProfilesLabels      *connect.Client[v1.MergeProfilesLabelsRequest, v1.MergeProfilesLabelsResponse]
-	mergeProfilesPprof       *connect.Client[v1.MergeProfilesPprofRequest, v1.MergeProfilesPprofResponse]
-	mergeSpanProfile         *connect.Client[v1.MergeSpanProfileRequest, v1.MergeSpanProfileResponse]
-	blockMetadata            *connect.Client[v1.BlockMetadataRequest, v1.BlockMetadataResponse]
-	getProfileStats          *connect.Client[v12.GetProfileStatsRequest, v12.GetProfileStatsResponse]
-	getBlockStats            *connect.Client[v1.GetBlockStatsRequest, v1.GetBlockStatsResponse]
+	push                      *connect.Client[v11.PushRequest, v11.PushResponse]
+	labelValues               *connect.Client[v12.LabelValuesRequest, v12.LabelValuesResponse]
+	labelNames                *connect.Client[v12.LabelNamesRequest, v12.LabelNamesResponse]
+	profileTypes              *connect.Client[v1.ProfileTypesRequest, v1.ProfileTypesResponse]
+	series                    *connect.Client[v1.SeriesRequest, v1.SeriesResponse]
+	flush                     *connect.Client[v1.FlushRequest, v1.FlushResponse]
+	mergeProfilesStacktraces  *connect.Client[v1.MergeProfilesStacktracesRequest, v1.MergeProfilesStacktracesResponse]
+	mergeProfilesLabels       *connect.Client[v1.MergeProfilesLabelsRequest, v1.MergeProfilesLabelsResponse]
+	mergeProfilesPprof        *connect.Client[v1.MergeProfilesPprofRequest, v1.MergeProfilesPprofResponse]
+	mergeSpanProfile          *connect.Client[v1.MergeSpanProfileRequest, v1.MergeSpanProfileResponse]
+	mergeProfilesTopFunctions *connect.Client[v1.MergeProfilesTopFunctionsRequest, v1.MergeProfilesTopFunctionsResponse]
+	mergeProfilesValues       *connect.Client[v1.MergeProfilesValuesRequest, v1.MergeProfilesValuesResponse]
+	getProfileByID            *connect.Client[v1.GetProfileByIDRequest, v1.GetProfileByIDResponse]
+	blockMetadata             *connect.Client[v1.BlockMetadataRequest, v1.BlockMetadataResponse]
+	getProfileStats           *connect.Client[v12.GetProfileStatsRequest, v12.GetProfileStatsResponse]
+	getBlockStats             *connect.Client[v1.GetBlockStatsRequest, v1.GetBlockStatsResponse]
 }
 
 // Push calls ingester.v1.IngesterService.Push.
@@ -269,6 +305,21 @@ func (c *ingesterServiceClient) MergeSpanProfile(ctx context.Context) *connect.B
 	return c.mergeSpanProfile.CallBidiStream(ctx)
 }
 
+// MergeProfilesTopFunctions calls ingester.v1.IngesterService.MergeProfilesTopFunctions.
+func (c *ingesterServiceClient) MergeProfilesTopFunctions(ctx context.Context) *connect.BidiStreamForClient[v1.MergeProfilesTopFunctionsRequest, v1.MergeProfilesTopFunctionsResponse] {
+	return c.mergeProfilesTopFunctions.CallBidiStream(ctx)
+}
+
+// MergeProfilesValues calls ingester.v1.IngesterService.MergeProfilesValues.
+func (c *ingesterServiceClient) MergeProfilesValues(ctx context.Context) *connect.BidiStreamForClient[v1.MergeProfilesValuesRequest, v1.MergeProfilesValuesResponse] {
+	return c.mergeProfilesValues.CallBidiStream(ctx)
+}
+
+// GetProfileByID calls ingester.v1.IngesterService.GetProfileByID.
+func (c *ingesterServiceClient) GetProfileByID(ctx context.Context, req *connect.Request[v1.GetProfileByIDRequest]) (*connect.Response[v1.GetProfileByIDResponse], error) {
+	return c.getProfileByID.CallUnary(ctx, req)
+}
+
 // BlockMetadata calls ingester.v1.IngesterService.BlockMetadata.
 func (c *ingesterServiceClient) BlockMetadata(ctx context.Context, req *connect.Request[v1.BlockMetadataRequest]) (*connect.Response[v1.BlockMetadataResponse], error) {
 	return c.blockMetadata.CallUnary(ctx, req)
@@ -298,6 +349,9 @@ type IngesterServiceHandler interface {
 	MergeProfilesLabels(context.Context, *connect.BidiStream[v1.MergeProfilesLabelsRequest, v1.MergeProfilesLabelsResponse]) error
 	MergeProfilesPprof(context.Context, *connect.BidiStream[v1.MergeProfilesPprofRequest, v1.MergeProfilesPprofResponse]) error
 	MergeSpanProfile(context.Context, *connect.BidiStream[v1.MergeSpanProfileRequest, v1.MergeSpanProfileResponse]) error
+	MergeProfilesTopFunctions(context.Context, *connect.BidiStream[v1.MergeProfilesTopFunctionsRequest, v1.MergeProfilesTopFunctionsResponse]) error
+	MergeProfilesValues(context.Context, *connect.BidiStream[v1.MergeProfilesValuesRequest, v1.MergeProfilesValuesResponse]) error
+	GetProfileByID(context.Context, *connect.Request[v1.GetProfileByIDRequest]) (*connect.Response[v1.GetProfileByIDResponse], error)
 	BlockMetadata(context.Context, *connect.Request[v1.BlockMetadataRequest]) (*connect.Response[v1.BlockMetadataResponse], error)
 	// GetProfileStats returns profile stats for the current tenant.
 	GetProfileStats(context.Context, *connect.Request[v12.GetProfileStatsRequest]) (*connect.Response[v12.GetProfileStatsResponse], error)
@@ -370,6 +424,24 @@ func NewIngesterServiceHandler(svc IngesterServiceHandler, opts ...connect.Handl
 		connect.WithSchema(ingesterServiceMergeSpanProfileMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	ingesterServiceMergeProfilesTopFunctionsHandler := connect.NewBidiStreamHandler(
+		IngesterServiceMergeProfilesTopFunctionsProcedure,
+		svc.MergeProfilesTopFunctions,
+		connect.WithSchema(ingesterServiceMergeProfilesTopFunctionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	ingesterServiceMergeProfilesValuesHandler := connect.NewBidiStreamHandler(
+		IngesterServiceMergeProfilesValuesProcedure,
+		svc.MergeProfilesValues,
+		connect.WithSchema(ingesterServiceMergeProfilesValuesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	ingesterServiceGetProfileByIDHandler := connect.NewUnaryHandler(
+		IngesterServiceGetProfileByIDProcedure,
+		svc.GetProfileByID,
+		connect.WithSchema(ingesterServiceGetProfileByIDMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	ingesterServiceBlockMetadataHandler := connect.NewUnaryHandler(
 		IngesterServiceBlockMetadataProcedure,
 		svc.BlockMetadata,
@@ -410,6 +482,12 @@ func NewIngesterServiceHandler(svc IngesterServiceHandler, opts ...connect.Handl
 			ingesterServiceMergeProfilesPprofHandler.ServeHTTP(w, r)
 		case IngesterServiceMergeSpanProfileProcedure:
 			ingesterServiceMergeSpanProfileHandler.ServeHTTP(w, r)
+		case IngesterServiceMergeProfilesTopFunctionsProcedure:
+			ingesterServiceMergeProfilesTopFunctionsHandler.ServeHTTP(w, r)
+		case IngesterServiceMergeProfilesValuesProcedure:
+			ingesterServiceMergeProfilesValuesHandler.ServeHTTP(w, r)
+		case IngesterServiceGetProfileByIDProcedure:
+			ingesterServiceGetProfileByIDHandler.ServeHTTP(w, r)
 		case IngesterServiceBlockMetadataProcedure:
 			ingesterServiceBlockMetadataHandler.ServeHTTP(w, r)
 		case IngesterServiceGetProfileStatsProcedure:
@@ -465,6 +543,18 @@ func (UnimplementedIngesterServiceHandler) MergeSpanProfile(context.Context, *co
 	return connect.NewError(connect.CodeUnimplemented, errors.New("ingester.v1.IngesterService.MergeSpanProfile is not implemented"))
 }
 
+func (UnimplementedIngesterServiceHandler) MergeProfilesTopFunctions(context.Context, *connect.BidiStream[v1.MergeProfilesTopFunctionsRequest, v1.MergeProfilesTopFunctionsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("ingester.v1.IngesterService.MergeProfilesTopFunctions is not implemented"))
+}
+
+func (UnimplementedIngesterServiceHandler) MergeProfilesValues(context.Context, *connect.BidiStream[v1.MergeProfilesValuesRequest, v1.MergeProfilesValuesResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("ingester.v1.IngesterService.MergeProfilesValues is not implemented"))
+}
+
+func (UnimplementedIngesterServiceHandler) GetProfileByID(context.Context, *connect.Request[v1.GetProfileByIDRequest]) (*connect.Response[v1.GetProfileByIDResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ingester.v1.IngesterService.GetProfileByID is not implemented"))
+}
+
 func (UnimplementedIngesterServiceHandler) BlockMetadata(context.Context, *connect.Request[v1.BlockMetadataRequest]) (*connect.Response[v1.BlockMetadataResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ingester.v1.IngesterService.BlockMetadata is not implemented"))
 }
@@ -69,6 +69,21 @@ func RegisterIngesterServiceHandler(mux *mux.Router, svc IngesterServiceHandler,
 		svc.MergeSpanProfile,
 		opts...,
 	))
+	mux.Handle("/ingester.v1.IngesterService/MergeProfilesTopFunctions", connect.NewBidiStreamHandler(
+		"/ingester.v1.IngesterService/MergeProfilesTopFunctions",
+		svc.MergeProfilesTopFunctions,
+		opts...,
+	))
+	mux.Handle("/ingester.v1.IngesterService/MergeProfilesValues", connect.NewBidiStreamHandler(
+		"/ingester.v1.IngesterService/MergeProfilesValues",
+		svc.MergeProfilesValues,
+		opts...,
+	))
+	mux.Handle("/ingester.v1.IngesterService/GetProfileByID", connect.NewUnaryHandler(
+		"/ingester.v1.IngesterService/GetProfileByID",
+		svc.GetProfileByID,
+		opts...,
+	))
 	mux.Handle("/ingester.v1.IngesterService/BlockMetadata", connect.NewUnaryHandler(
 		"/ingester.v1.IngesterService/BlockMetadata",
 		svc.BlockMetadata,
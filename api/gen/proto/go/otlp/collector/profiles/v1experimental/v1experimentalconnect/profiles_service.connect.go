@@ -0,0 +1,135 @@
+// Copyright 2023, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// This file is a copy of opentelemetry/proto/collector/profiles/v1experimental/profiles_service.proto
+// from https://github.com/open-telemetry/opentelemetry-proto.
+
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: otlp/collector/profiles/v1experimental/profiles_service.proto
+
+package v1experimentalconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1experimental "github.com/grafana/pyroscope/api/gen/proto/go/otlp/collector/profiles/v1experimental"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ProfilesServiceName is the fully-qualified name of the ProfilesService service.
+	ProfilesServiceName = "opentelemetry.proto.collector.profiles.v1experimental.ProfilesService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ProfilesServiceExportProcedure is the fully-qualified name of the ProfilesService's Export RPC.
+	ProfilesServiceExportProcedure = "/opentelemetry.proto.collector.profiles.v1experimental.ProfilesService/Export"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	profilesServiceServiceDescriptor      = v1experimental.File_otlp_collector_profiles_v1experimental_profiles_service_proto.Services().ByName("ProfilesService")
+	profilesServiceExportMethodDescriptor = profilesServiceServiceDescriptor.Methods().ByName("Export")
+)
+
+// ProfilesServiceClient is a client for the
+// opentelemetry.proto.collector.profiles.v1experimental.ProfilesService service.
+type ProfilesServiceClient interface {
+	// For performance reasons, it is recommended to keep this RPC
+	// alive for the entire life of the application.
+	Export(context.Context, *connect.Request[v1experimental.ExportProfilesServiceRequest]) (*connect.Response[v1experimental.ExportProfilesServiceResponse], error)
+}
+
+// NewProfilesServiceClient constructs a client for the
+// opentelemetry.proto.collector.profiles.v1experimental.ProfilesService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewProfilesServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ProfilesServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &profilesServiceClient{
+		export: connect.NewClient[v1experimental.ExportProfilesServiceRequest, v1experimental.ExportProfilesServiceResponse](
+			httpClient,
+			baseURL+ProfilesServiceExportProcedure,
+			connect.WithSchema(profilesServiceExportMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// profilesServiceClient implements ProfilesServiceClient.
+type profilesServiceClient struct {
+	export *connect.Client[v1experimental.ExportProfilesServiceRequest, v1experimental.ExportProfilesServiceResponse]
+}
+
+// Export calls opentelemetry.proto.collector.profiles.v1experimental.ProfilesService.Export.
+func (c *profilesServiceClient) Export(ctx context.Context, req *connect.Request[v1experimental.ExportProfilesServiceRequest]) (*connect.Response[v1experimental.ExportProfilesServiceResponse], error) {
+	return c.export.CallUnary(ctx, req)
+}
+
+// ProfilesServiceHandler is an implementation of the
+// opentelemetry.proto.collector.profiles.v1experimental.ProfilesService service.
+type ProfilesServiceHandler interface {
+	// For performance reasons, it is recommended to keep this RPC
+	// alive for the entire life of the application.
+	Export(context.Context, *connect.Request[v1experimental.ExportProfilesServiceRequest]) (*connect.Response[v1experimental.ExportProfilesServiceResponse], error)
+}
+
+// NewProfilesServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewProfilesServiceHandler(svc ProfilesServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	profilesServiceExportHandler := connect.NewUnaryHandler(
+		ProfilesServiceExportProcedure,
+		svc.Export,
+		connect.WithSchema(profilesServiceExportMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/opentelemetry.proto.collector.profiles.v1experimental.ProfilesService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ProfilesServiceExportProcedure:
+			profilesServiceExportHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedProfilesServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedProfilesServiceHandler struct{}
+
+func (UnimplementedProfilesServiceHandler) Export(context.Context, *connect.Request[v1experimental.ExportProfilesServiceRequest]) (*connect.Response[v1experimental.ExportProfilesServiceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("opentelemetry.proto.collector.profiles.v1experimental.ProfilesService.Export is not implemented"))
+}
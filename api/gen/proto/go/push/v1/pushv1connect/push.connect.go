@@ -35,17 +35,23 @@ const (
 const (
 	// PusherServicePushProcedure is the fully-qualified name of the PusherService's Push RPC.
 	PusherServicePushProcedure = "/push.v1.PusherService/Push"
+	// PusherServiceValidateProcedure is the fully-qualified name of the PusherService's Validate RPC.
+	PusherServiceValidateProcedure = "/push.v1.PusherService/Validate"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	pusherServiceServiceDescriptor    = v1.File_push_v1_push_proto.Services().ByName("PusherService")
-	pusherServicePushMethodDescriptor = pusherServiceServiceDescriptor.Methods().ByName("Push")
+	pusherServiceServiceDescriptor        = v1.File_push_v1_push_proto.Services().ByName("PusherService")
+	pusherServicePushMethodDescriptor     = pusherServiceServiceDescriptor.Methods().ByName("Push")
+	pusherServiceValidateMethodDescriptor = pusherServiceServiceDescriptor.Methods().ByName("Validate")
 )
 
 // PusherServiceClient is a client for the push.v1.PusherService service.
 type PusherServiceClient interface {
 	Push(context.Context, *connect.Request[v1.PushRequest]) (*connect.Response[v1.PushResponse], error)
+	// Validate runs the push request through the ingestion pipeline without
+	// writing the profiles, and reports the series they would be written as.
+	Validate(context.Context, *connect.Request[v1.PushRequest]) (*connect.Response[v1.ValidateResponse], error)
 }
 
 // NewPusherServiceClient constructs a client for the push.v1.PusherService service. By default, it
@@ -64,12 +70,19 @@ func NewPusherServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(pusherServicePushMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		validate: connect.NewClient[v1.PushRequest, v1.ValidateResponse](
+			httpClient,
+			baseURL+PusherServiceValidateProcedure,
+			connect.WithSchema(pusherServiceValidateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // pusherServiceClient implements PusherServiceClient.
 type pusherServiceClient struct {
-	push *connect.Client[v1.PushRequest, v1.PushResponse]
+	push     *connect.Client[v1.PushRequest, v1.PushResponse]
+	validate *connect.Client[v1.PushRequest, v1.ValidateResponse]
 }
 
 // Push calls push.v1.PusherService.Push.
@@ -77,9 +90,17 @@ func (c *pusherServiceClient) Push(ctx context.Context, req *connect.Request[v1.
 	return c.push.CallUnary(ctx, req)
 }
 
+// Validate calls push.v1.PusherService.Validate.
+func (c *pusherServiceClient) Validate(ctx context.Context, req *connect.Request[v1.PushRequest]) (*connect.Response[v1.ValidateResponse], error) {
+	return c.validate.CallUnary(ctx, req)
+}
+
 // PusherServiceHandler is an implementation of the push.v1.PusherService service.
 type PusherServiceHandler interface {
 	Push(context.Context, *connect.Request[v1.PushRequest]) (*connect.Response[v1.PushResponse], error)
+	// Validate runs the push request through the ingestion pipeline without
+	// writing the profiles, and reports the series they would be written as.
+	Validate(context.Context, *connect.Request[v1.PushRequest]) (*connect.Response[v1.ValidateResponse], error)
 }
 
 // NewPusherServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -94,10 +115,18 @@ func NewPusherServiceHandler(svc PusherServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(pusherServicePushMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	pusherServiceValidateHandler := connect.NewUnaryHandler(
+		PusherServiceValidateProcedure,
+		svc.Validate,
+		connect.WithSchema(pusherServiceValidateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/push.v1.PusherService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case PusherServicePushProcedure:
 			pusherServicePushHandler.ServeHTTP(w, r)
+		case PusherServiceValidateProcedure:
+			pusherServiceValidateHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -110,3 +139,7 @@ type UnimplementedPusherServiceHandler struct{}
 func (UnimplementedPusherServiceHandler) Push(context.Context, *connect.Request[v1.PushRequest]) (*connect.Response[v1.PushResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("push.v1.PusherService.Push is not implemented"))
 }
+
+func (UnimplementedPusherServiceHandler) Validate(context.Context, *connect.Request[v1.PushRequest]) (*connect.Response[v1.ValidateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("push.v1.PusherService.Validate is not implemented"))
+}
@@ -24,4 +24,9 @@ func RegisterPusherServiceHandler(mux *mux.Router, svc PusherServiceHandler, opt
 		svc.Push,
 		opts...,
 	))
+	mux.Handle("/push.v1.PusherService/Validate", connect.NewUnaryHandler(
+		"/push.v1.PusherService/Validate",
+		svc.Validate,
+		opts...,
+	))
 }
@@ -58,6 +58,21 @@ const (
 	// QuerierServiceSelectSeriesProcedure is the fully-qualified name of the QuerierService's
 	// SelectSeries RPC.
 	QuerierServiceSelectSeriesProcedure = "/querier.v1.QuerierService/SelectSeries"
+	// QuerierServiceSelectTopFunctionsProcedure is the fully-qualified name of the QuerierService's
+	// SelectTopFunctions RPC.
+	QuerierServiceSelectTopFunctionsProcedure = "/querier.v1.QuerierService/SelectTopFunctions"
+	// QuerierServiceSelectLineProfileProcedure is the fully-qualified name of the QuerierService's
+	// SelectLineProfile RPC.
+	QuerierServiceSelectLineProfileProcedure = "/querier.v1.QuerierService/SelectLineProfile"
+	// QuerierServiceSelectHeatmapProcedure is the fully-qualified name of the QuerierService's
+	// SelectHeatmap RPC.
+	QuerierServiceSelectHeatmapProcedure = "/querier.v1.QuerierService/SelectHeatmap"
+	// QuerierServiceSelectProfilesProcedure is the fully-qualified name of the QuerierService's
+	// SelectProfiles RPC.
+	QuerierServiceSelectProfilesProcedure = "/querier.v1.QuerierService/SelectProfiles"
+	// QuerierServiceGetProfileByIDProcedure is the fully-qualified name of the QuerierService's
+	// GetProfileByID RPC.
+	QuerierServiceGetProfileByIDProcedure = "/querier.v1.QuerierService/GetProfileByID"
 	// QuerierServiceDiffProcedure is the fully-qualified name of the QuerierService's Diff RPC.
 	QuerierServiceDiffProcedure = "/querier.v1.QuerierService/Diff"
 	// QuerierServiceGetProfileStatsProcedure is the fully-qualified name of the QuerierService's
@@ -79,6 +94,11 @@ var (
 	querierServiceSelectMergeSpanProfileMethodDescriptor = querierServiceServiceDescriptor.Methods().ByName("SelectMergeSpanProfile")
 	querierServiceSelectMergeProfileMethodDescriptor     = querierServiceServiceDescriptor.Methods().ByName("SelectMergeProfile")
 	querierServiceSelectSeriesMethodDescriptor           = querierServiceServiceDescriptor.Methods().ByName("SelectSeries")
+	querierServiceSelectTopFunctionsMethodDescriptor     = querierServiceServiceDescriptor.Methods().ByName("SelectTopFunctions")
+	querierServiceSelectLineProfileMethodDescriptor      = querierServiceServiceDescriptor.Methods().ByName("SelectLineProfile")
+	querierServiceSelectHeatmapMethodDescriptor          = querierServiceServiceDescriptor.Methods().ByName("SelectHeatmap")
+	querierServiceSelectProfilesMethodDescriptor         = querierServiceServiceDescriptor.Methods().ByName("SelectProfiles")
+	querierServiceGetProfileByIDMethodDescriptor         = querierServiceServiceDescriptor.Methods().ByName("GetProfileByID")
 	querierServiceDiffMethodDescriptor                   = querierServiceServiceDescriptor.Methods().ByName("Diff")
 	querierServiceGetProfileStatsMethodDescriptor        = querierServiceServiceDescriptor.Methods().ByName("GetProfileStats")
 	querierServiceAnalyzeQueryMethodDescriptor           = querierServiceServiceDescriptor.Methods().ByName("AnalyzeQuery")
@@ -102,6 +122,16 @@ type QuerierServiceClient interface {
 	SelectMergeProfile(context.Context, *connect.Request[v1.SelectMergeProfileRequest]) (*connect.Response[v12.Profile], error)
 	// SelectSeries returns a time series for the total sum of the requested profiles.
 	SelectSeries(context.Context, *connect.Request[v1.SelectSeriesRequest]) (*connect.Response[v1.SelectSeriesResponse], error)
+	// SelectTopFunctions returns the self and total values of the functions found in the matching profiles.
+	SelectTopFunctions(context.Context, *connect.Request[v1.SelectTopFunctionsRequest]) (*connect.Response[v1.SelectTopFunctionsResponse], error)
+	// SelectLineProfile returns the self and total values of the source lines of a function or a file found in the matching profiles.
+	SelectLineProfile(context.Context, *connect.Request[v1.SelectLineProfileRequest]) (*connect.Response[v1.SelectLineProfileResponse], error)
+	// SelectHeatmap returns the distribution of the total values of the matching profiles over time.
+	SelectHeatmap(context.Context, *connect.Request[v1.SelectHeatmapRequest]) (*connect.Response[v1.SelectHeatmapResponse], error)
+	// SelectProfiles lists the individual profiles matching the selector.
+	SelectProfiles(context.Context, *connect.Request[v1.SelectProfilesRequest]) (*connect.Response[v1.SelectProfilesResponse], error)
+	// GetProfileByID returns the profile with the given ID.
+	GetProfileByID(context.Context, *connect.Request[v1.GetProfileByIDRequest]) (*connect.Response[v12.Profile], error)
 	// Diff returns a diff of two profiles
 	Diff(context.Context, *connect.Request[v1.DiffRequest]) (*connect.Response[v1.DiffResponse], error)
 	// GetProfileStats returns profile stats for the current tenant.
@@ -167,6 +197,36 @@ func NewQuerierServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(querierServiceSelectSeriesMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		selectTopFunctions: connect.NewClient[v1.SelectTopFunctionsRequest, v1.SelectTopFunctionsResponse](
+			httpClient,
+			baseURL+QuerierServiceSelectTopFunctionsProcedure,
+			connect.WithSchema(querierServiceSelectTopFunctionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		selectLineProfile: connect.NewClient[v1.SelectLineProfileRequest, v1.SelectLineProfileResponse](
+			httpClient,
+			baseURL+QuerierServiceSelectLineProfileProcedure,
+			connect.WithSchema(querierServiceSelectLineProfileMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		selectHeatmap: connect.NewClient[v1.SelectHeatmapRequest, v1.SelectHeatmapResponse](
+			httpClient,
+			baseURL+QuerierServiceSelectHeatmapProcedure,
+			connect.WithSchema(querierServiceSelectHeatmapMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		selectProfiles: connect.NewClient[v1.SelectProfilesRequest, v1.SelectProfilesResponse](
+			httpClient,
+			baseURL+QuerierServiceSelectProfilesProcedure,
+			connect.WithSchema(querierServiceSelectProfilesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getProfileByID: connect.NewClient[v1.GetProfileByIDRequest, v12.Profile](
+			httpClient,
+			baseURL+QuerierServiceGetProfileByIDProcedure,
+			connect.WithSchema(querierServiceGetProfileByIDMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		diff: connect.NewClient[v1.DiffRequest, v1.DiffResponse](
 			httpClient,
 			baseURL+QuerierServiceDiffProcedure,
@@ -198,6 +258,11 @@ type querierServiceClient struct {
 	selectMergeSpanProfile *connect.Client[v1.SelectMergeSpanProfileRequest, v1.SelectMergeSpanProfileResponse]
 	selectMergeProfile     *connect.Client[v1.SelectMergeProfileRequest, v12.Profile]
 	selectSeries           *connect.Client[v1.SelectSeriesRequest, v1.SelectSeriesResponse]
+	selectTopFunctions     *connect.Client[v1.SelectTopFunctionsRequest, v1.SelectTopFunctionsResponse]
+	selectLineProfile      *connect.Client[v1.SelectLineProfileRequest, v1.SelectLineProfileResponse]
+	selectHeatmap          *connect.Client[v1.SelectHeatmapRequest, v1.SelectHeatmapResponse]
+	selectProfiles         *connect.Client[v1.SelectProfilesRequest, v1.SelectProfilesResponse]
+	getProfileByID         *connect.Client[v1.GetProfileByIDRequest, v12.Profile]
 	diff                   *connect.Client[v1.DiffRequest, v1.DiffResponse]
 	getProfileStats        *connect.Client[v11.GetProfileStatsRequest, v11.GetProfileStatsResponse]
 	analyzeQuery           *connect.Client[v1.AnalyzeQueryRequest, v1.AnalyzeQueryResponse]
@@ -243,6 +308,31 @@ func (c *querierServiceClient) SelectSeries(ctx context.Context, req *connect.Re
 	return c.selectSeries.CallUnary(ctx, req)
 }
 
+// SelectTopFunctions calls querier.v1.QuerierService.SelectTopFunctions.
+func (c *querierServiceClient) SelectTopFunctions(ctx context.Context, req *connect.Request[v1.SelectTopFunctionsRequest]) (*connect.Response[v1.SelectTopFunctionsResponse], error) {
+	return c.selectTopFunctions.CallUnary(ctx, req)
+}
+
+// SelectLineProfile calls querier.v1.QuerierService.SelectLineProfile.
+func (c *querierServiceClient) SelectLineProfile(ctx context.Context, req *connect.Request[v1.SelectLineProfileRequest]) (*connect.Response[v1.SelectLineProfileResponse], error) {
+	return c.selectLineProfile.CallUnary(ctx, req)
+}
+
+// SelectHeatmap calls querier.v1.QuerierService.SelectHeatmap.
+func (c *querierServiceClient) SelectHeatmap(ctx context.Context, req *connect.Request[v1.SelectHeatmapRequest]) (*connect.Response[v1.SelectHeatmapResponse], error) {
+	return c.selectHeatmap.CallUnary(ctx, req)
+}
+
+// SelectProfiles calls querier.v1.QuerierService.SelectProfiles.
+func (c *querierServiceClient) SelectProfiles(ctx context.Context, req *connect.Request[v1.SelectProfilesRequest]) (*connect.Response[v1.SelectProfilesResponse], error) {
+	return c.selectProfiles.CallUnary(ctx, req)
+}
+
+// GetProfileByID calls querier.v1.QuerierService.GetProfileByID.
+func (c *querierServiceClient) GetProfileByID(ctx context.Context, req *connect.Request[v1.GetProfileByIDRequest]) (*connect.Response[v12.Profile], error) {
+	return c.getProfileByID.CallUnary(ctx, req)
+}
+
 // Diff calls querier.v1.QuerierService.Diff.
 func (c *querierServiceClient) Diff(ctx context.Context, req *connect.Request[v1.DiffRequest]) (*connect.Response[v1.DiffResponse], error) {
 	return c.diff.CallUnary(ctx, req)
@@ -276,6 +366,16 @@ type QuerierServiceHandler interface {
 	SelectMergeProfile(context.Context, *connect.Request[v1.SelectMergeProfileRequest]) (*connect.Response[v12.Profile], error)
 	// SelectSeries returns a time series for the total sum of the requested profiles.
 	SelectSeries(context.Context, *connect.Request[v1.SelectSeriesRequest]) (*connect.Response[v1.SelectSeriesResponse], error)
+	// SelectTopFunctions returns the self and total values of the functions found in the matching profiles.
+	SelectTopFunctions(context.Context, *connect.Request[v1.SelectTopFunctionsRequest]) (*connect.Response[v1.SelectTopFunctionsResponse], error)
+	// SelectLineProfile returns the self and total values of the source lines of a function or a file found in the matching profiles.
+	SelectLineProfile(context.Context, *connect.Request[v1.SelectLineProfileRequest]) (*connect.Response[v1.SelectLineProfileResponse], error)
+	// SelectHeatmap returns the distribution of the total values of the matching profiles over time.
+	SelectHeatmap(context.Context, *connect.Request[v1.SelectHeatmapRequest]) (*connect.Response[v1.SelectHeatmapResponse], error)
+	// SelectProfiles lists the individual profiles matching the selector.
+	SelectProfiles(context.Context, *connect.Request[v1.SelectProfilesRequest]) (*connect.Response[v1.SelectProfilesResponse], error)
+	// GetProfileByID returns the profile with the given ID.
+	GetProfileByID(context.Context, *connect.Request[v1.GetProfileByIDRequest]) (*connect.Response[v12.Profile], error)
 	// Diff returns a diff of two profiles
 	Diff(context.Context, *connect.Request[v1.DiffRequest]) (*connect.Response[v1.DiffResponse], error)
 	// GetProfileStats returns profile stats for the current tenant.
@@ -337,6 +437,36 @@ func NewQuerierServiceHandler(svc QuerierServiceHandler, opts ...connect.Handler
 		connect.WithSchema(querierServiceSelectSeriesMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	querierServiceSelectTopFunctionsHandler := connect.NewUnaryHandler(
+		QuerierServiceSelectTopFunctionsProcedure,
+		svc.SelectTopFunctions,
+		connect.WithSchema(querierServiceSelectTopFunctionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	querierServiceSelectLineProfileHandler := connect.NewUnaryHandler(
+		QuerierServiceSelectLineProfileProcedure,
+		svc.SelectLineProfile,
+		connect.WithSchema(querierServiceSelectLineProfileMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	querierServiceSelectHeatmapHandler := connect.NewUnaryHandler(
+		QuerierServiceSelectHeatmapProcedure,
+		svc.SelectHeatmap,
+		connect.WithSchema(querierServiceSelectHeatmapMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	querierServiceSelectProfilesHandler := connect.NewUnaryHandler(
+		QuerierServiceSelectProfilesProcedure,
+		svc.SelectProfiles,
+		connect.WithSchema(querierServiceSelectProfilesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	querierServiceGetProfileByIDHandler := connect.NewUnaryHandler(
+		QuerierServiceGetProfileByIDProcedure,
+		svc.GetProfileByID,
+		connect.WithSchema(querierServiceGetProfileByIDMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	querierServiceDiffHandler := connect.NewUnaryHandler(
 		QuerierServiceDiffProcedure,
 		svc.Diff,
@@ -373,6 +503,16 @@ func NewQuerierServiceHandler(svc QuerierServiceHandler, opts ...connect.Handler
 			querierServiceSelectMergeProfileHandler.ServeHTTP(w, r)
 		case QuerierServiceSelectSeriesProcedure:
 			querierServiceSelectSeriesHandler.ServeHTTP(w, r)
+		case QuerierServiceSelectTopFunctionsProcedure:
+			querierServiceSelectTopFunctionsHandler.ServeHTTP(w, r)
+		case QuerierServiceSelectLineProfileProcedure:
+			querierServiceSelectLineProfileHandler.ServeHTTP(w, r)
+		case QuerierServiceSelectHeatmapProcedure:
+			querierServiceSelectHeatmapHandler.ServeHTTP(w, r)
+		case QuerierServiceSelectProfilesProcedure:
+			querierServiceSelectProfilesHandler.ServeHTTP(w, r)
+		case QuerierServiceGetProfileByIDProcedure:
+			querierServiceGetProfileByIDHandler.ServeHTTP(w, r)
 		case QuerierServiceDiffProcedure:
 			querierServiceDiffHandler.ServeHTTP(w, r)
 		case QuerierServiceGetProfileStatsProcedure:
@@ -420,6 +560,26 @@ func (UnimplementedQuerierServiceHandler) SelectSeries(context.Context, *connect
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("querier.v1.QuerierService.SelectSeries is not implemented"))
 }
 
+func (UnimplementedQuerierServiceHandler) SelectTopFunctions(context.Context, *connect.Request[v1.SelectTopFunctionsRequest]) (*connect.Response[v1.SelectTopFunctionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("querier.v1.QuerierService.SelectTopFunctions is not implemented"))
+}
+
+func (UnimplementedQuerierServiceHandler) SelectLineProfile(context.Context, *connect.Request[v1.SelectLineProfileRequest]) (*connect.Response[v1.SelectLineProfileResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("querier.v1.QuerierService.SelectLineProfile is not implemented"))
+}
+
+func (UnimplementedQuerierServiceHandler) SelectHeatmap(context.Context, *connect.Request[v1.SelectHeatmapRequest]) (*connect.Response[v1.SelectHeatmapResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("querier.v1.QuerierService.SelectHeatmap is not implemented"))
+}
+
+func (UnimplementedQuerierServiceHandler) SelectProfiles(context.Context, *connect.Request[v1.SelectProfilesRequest]) (*connect.Response[v1.SelectProfilesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("querier.v1.QuerierService.SelectProfiles is not implemented"))
+}
+
+func (UnimplementedQuerierServiceHandler) GetProfileByID(context.Context, *connect.Request[v1.GetProfileByIDRequest]) (*connect.Response[v12.Profile], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("querier.v1.QuerierService.GetProfileByID is not implemented"))
+}
+
 func (UnimplementedQuerierServiceHandler) Diff(context.Context, *connect.Request[v1.DiffRequest]) (*connect.Response[v1.DiffResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("querier.v1.QuerierService.Diff is not implemented"))
 }
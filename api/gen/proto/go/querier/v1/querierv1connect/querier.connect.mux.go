@@ -59,6 +59,31 @@ func RegisterQuerierServiceHandler(mux *mux.Router, svc QuerierServiceHandler, o
 		svc.SelectSeries,
 		opts...,
 	))
+	mux.Handle("/querier.v1.QuerierService/SelectTopFunctions", connect.NewUnaryHandler(
+		"/querier.v1.QuerierService/SelectTopFunctions",
+		svc.SelectTopFunctions,
+		opts...,
+	))
+	mux.Handle("/querier.v1.QuerierService/SelectLineProfile", connect.NewUnaryHandler(
+		"/querier.v1.QuerierService/SelectLineProfile",
+		svc.SelectLineProfile,
+		opts...,
+	))
+	mux.Handle("/querier.v1.QuerierService/SelectHeatmap", connect.NewUnaryHandler(
+		"/querier.v1.QuerierService/SelectHeatmap",
+		svc.SelectHeatmap,
+		opts...,
+	))
+	mux.Handle("/querier.v1.QuerierService/SelectProfiles", connect.NewUnaryHandler(
+		"/querier.v1.QuerierService/SelectProfiles",
+		svc.SelectProfiles,
+		opts...,
+	))
+	mux.Handle("/querier.v1.QuerierService/GetProfileByID", connect.NewUnaryHandler(
+		"/querier.v1.QuerierService/GetProfileByID",
+		svc.GetProfileByID,
+		opts...,
+	))
 	mux.Handle("/querier.v1.QuerierService/Diff", connect.NewUnaryHandler(
 		"/querier.v1.QuerierService/Diff",
 		svc.Diff,
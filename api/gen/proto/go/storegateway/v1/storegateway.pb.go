@@ -35,7 +35,7 @@ var file_storegateway_v1_storegateway_proto_rawDesc = []byte{
 	0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x70, 0x75, 0x73, 0x68,
 	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14,
 	0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x32, 0xc1, 0x07, 0x0a, 0x13, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x47, 0x61,
+	0x72, 0x6f, 0x74, 0x6f, 0x32, 0x91, 0x0a, 0x0a, 0x13, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x47, 0x61,
 	0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7d, 0x0a, 0x18,
 	0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x53, 0x74, 0x61,
 	0x63, 0x6b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x73, 0x12, 0x2c, 0x2e, 0x69, 0x6e, 0x67, 0x65, 0x73,
@@ -65,6 +65,27 @@ var file_storegateway_v1_storegateway_proto_rawDesc = []byte{
 	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x69, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
 	0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x53, 0x70, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
 	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12,
+	0x80, 0x01, 0x0a, 0x19, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x54, 0x6f, 0x70, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x2e,
+	0x69, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x72, 0x67,
+	0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x54, 0x6f, 0x70, 0x46, 0x75, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x69,
+	0x6e, 0x67, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x54, 0x6f, 0x70, 0x46, 0x75, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01,
+	0x30, 0x01, 0x12, 0x6e, 0x0a, 0x13, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x69, 0x6e, 0x67, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x28, 0x2e, 0x69, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01,
+	0x30, 0x01, 0x12, 0x5b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x42, 0x79, 0x49, 0x44, 0x12, 0x22, 0x2e, 0x69, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x79, 0x49,
+	0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x69, 0x6e, 0x67, 0x65, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
 	0x55, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12,
 	0x20, 0x2e, 0x69, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
 	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
@@ -113,50 +134,62 @@ var file_storegateway_v1_storegateway_proto_rawDesc = []byte{
 }
 
 var file_storegateway_v1_storegateway_proto_goTypes = []interface{}{
-	(*v1.MergeProfilesStacktracesRequest)(nil),  // 0: ingester.v1.MergeProfilesStacktracesRequest
-	(*v1.MergeProfilesLabelsRequest)(nil),       // 1: ingester.v1.MergeProfilesLabelsRequest
-	(*v1.MergeProfilesPprofRequest)(nil),        // 2: ingester.v1.MergeProfilesPprofRequest
-	(*v1.MergeSpanProfileRequest)(nil),          // 3: ingester.v1.MergeSpanProfileRequest
-	(*v1.ProfileTypesRequest)(nil),              // 4: ingester.v1.ProfileTypesRequest
-	(*v11.LabelValuesRequest)(nil),              // 5: types.v1.LabelValuesRequest
-	(*v11.LabelNamesRequest)(nil),               // 6: types.v1.LabelNamesRequest
-	(*v1.SeriesRequest)(nil),                    // 7: ingester.v1.SeriesRequest
-	(*v1.BlockMetadataRequest)(nil),             // 8: ingester.v1.BlockMetadataRequest
-	(*v1.GetBlockStatsRequest)(nil),             // 9: ingester.v1.GetBlockStatsRequest
-	(*v1.MergeProfilesStacktracesResponse)(nil), // 10: ingester.v1.MergeProfilesStacktracesResponse
-	(*v1.MergeProfilesLabelsResponse)(nil),      // 11: ingester.v1.MergeProfilesLabelsResponse
-	(*v1.MergeProfilesPprofResponse)(nil),       // 12: ingester.v1.MergeProfilesPprofResponse
-	(*v1.MergeSpanProfileResponse)(nil),         // 13: ingester.v1.MergeSpanProfileResponse
-	(*v1.ProfileTypesResponse)(nil),             // 14: ingester.v1.ProfileTypesResponse
-	(*v11.LabelValuesResponse)(nil),             // 15: types.v1.LabelValuesResponse
-	(*v11.LabelNamesResponse)(nil),              // 16: types.v1.LabelNamesResponse
-	(*v1.SeriesResponse)(nil),                   // 17: ingester.v1.SeriesResponse
-	(*v1.BlockMetadataResponse)(nil),            // 18: ingester.v1.BlockMetadataResponse
-	(*v1.GetBlockStatsResponse)(nil),            // 19: ingester.v1.GetBlockStatsResponse
+	(*v1.MergeProfilesStacktracesRequest)(nil),   // 0: ingester.v1.MergeProfilesStacktracesRequest
+	(*v1.MergeProfilesLabelsRequest)(nil),        // 1: ingester.v1.MergeProfilesLabelsRequest
+	(*v1.MergeProfilesPprofRequest)(nil),         // 2: ingester.v1.MergeProfilesPprofRequest
+	(*v1.MergeSpanProfileRequest)(nil),           // 3: ingester.v1.MergeSpanProfileRequest
+	(*v1.MergeProfilesTopFunctionsRequest)(nil),  // 4: ingester.v1.MergeProfilesTopFunctionsRequest
+	(*v1.MergeProfilesValuesRequest)(nil),        // 5: ingester.v1.MergeProfilesValuesRequest
+	(*v1.GetProfileByIDRequest)(nil),             // 6: ingester.v1.GetProfileByIDRequest
+	(*v1.ProfileTypesRequest)(nil),               // 7: ingester.v1.ProfileTypesRequest
+	(*v11.LabelValuesRequest)(nil),               // 8: types.v1.LabelValuesRequest
+	(*v11.LabelNamesRequest)(nil),                // 9: types.v1.LabelNamesRequest
+	(*v1.SeriesRequest)(nil),                     // 10: ingester.v1.SeriesRequest
+	(*v1.BlockMetadataRequest)(nil),              // 11: ingester.v1.BlockMetadataRequest
+	(*v1.GetBlockStatsRequest)(nil),              // 12: ingester.v1.GetBlockStatsRequest
+	(*v1.MergeProfilesStacktracesResponse)(nil),  // 13: ingester.v1.MergeProfilesStacktracesResponse
+	(*v1.MergeProfilesLabelsResponse)(nil),       // 14: ingester.v1.MergeProfilesLabelsResponse
+	(*v1.MergeProfilesPprofResponse)(nil),        // 15: ingester.v1.MergeProfilesPprofResponse
+	(*v1.MergeSpanProfileResponse)(nil),          // 16: ingester.v1.MergeSpanProfileResponse
+	(*v1.MergeProfilesTopFunctionsResponse)(nil), // 17: ingester.v1.MergeProfilesTopFunctionsResponse
+	(*v1.MergeProfilesValuesResponse)(nil),       // 18: ingester.v1.MergeProfilesValuesResponse
+	(*v1.GetProfileByIDResponse)(nil),            // 19: ingester.v1.GetProfileByIDResponse
+	(*v1.ProfileTypesResponse)(nil),              // 20: ingester.v1.ProfileTypesResponse
+	(*v11.LabelValuesResponse)(nil),              // 21: types.v1.LabelValuesResponse
+	(*v11.LabelNamesResponse)(nil),               // 22: types.v1.LabelNamesResponse
+	(*v1.SeriesResponse)(nil),                    // 23: ingester.v1.SeriesResponse
+	(*v1.BlockMetadataResponse)(nil),             // 24: ingester.v1.BlockMetadataResponse
+	(*v1.GetBlockStatsResponse)(nil),             // 25: ingester.v1.GetBlockStatsResponse
 }
 var file_storegateway_v1_storegateway_proto_depIdxs = []int32{
 	0,  // 0: storegateway.v1.StoreGatewayService.MergeProfilesStacktraces:input_type -> ingester.v1.MergeProfilesStacktracesRequest
 	1,  // 1: storegateway.v1.StoreGatewayService.MergeProfilesLabels:input_type -> ingester.v1.MergeProfilesLabelsRequest
 	2,  // 2: storegateway.v1.StoreGatewayService.MergeProfilesPprof:input_type -> ingester.v1.MergeProfilesPprofRequest
 	3,  // 3: storegateway.v1.StoreGatewayService.MergeSpanProfile:input_type -> ingester.v1.MergeSpanProfileRequest
-	4,  // 4: storegateway.v1.StoreGatewayService.ProfileTypes:input_type -> ingester.v1.ProfileTypesRequest
-	5,  // 5: storegateway.v1.StoreGatewayService.LabelValues:input_type -> types.v1.LabelValuesRequest
-	6,  // 6: storegateway.v1.StoreGatewayService.LabelNames:input_type -> types.v1.LabelNamesRequest
-	7,  // 7: storegateway.v1.StoreGatewayService.Series:input_type -> ingester.v1.SeriesRequest
-	8,  // 8: storegateway.v1.StoreGatewayService.BlockMetadata:input_type -> ingester.v1.BlockMetadataRequest
-	9,  // 9: storegateway.v1.StoreGatewayService.GetBlockStats:input_type -> ingester.v1.GetBlockStatsRequest
-	10, // 10: storegateway.v1.StoreGatewayService.MergeProfilesStacktraces:output_type -> ingester.v1.MergeProfilesStacktracesResponse
-	11, // 11: storegateway.v1.StoreGatewayService.MergeProfilesLabels:output_type -> ingester.v1.MergeProfilesLabelsResponse
-	12, // 12: storegateway.v1.StoreGatewayService.MergeProfilesPprof:output_type -> ingester.v1.MergeProfilesPprofResponse
-	13, // 13: storegateway.v1.StoreGatewayService.MergeSpanProfile:output_type -> ingester.v1.MergeSpanProfileResponse
-	14, // 14: storegateway.v1.StoreGatewayService.ProfileTypes:output_type -> ingester.v1.ProfileTypesResponse
-	15, // 15: storegateway.v1.StoreGatewayService.LabelValues:output_type -> types.v1.LabelValuesResponse
-	16, // 16: storegateway.v1.StoreGatewayService.LabelNames:output_type -> types.v1.LabelNamesResponse
-	17, // 17: storegateway.v1.StoreGatewayService.Series:output_type -> ingester.v1.SeriesResponse
-	18, // 18: storegateway.v1.StoreGatewayService.BlockMetadata:output_type -> ingester.v1.BlockMetadataResponse
-	19, // 19: storegateway.v1.StoreGatewayService.GetBlockStats:output_type -> ingester.v1.GetBlockStatsResponse
-	10, // [10:20] is the sub-list for method output_type
-	0,  // [0:10] is the sub-list for method input_type
+	4,  // 4: storegateway.v1.StoreGatewayService.MergeProfilesTopFunctions:input_type -> ingester.v1.MergeProfilesTopFunctionsRequest
+	5,  // 5: storegateway.v1.StoreGatewayService.MergeProfilesValues:input_type -> ingester.v1.MergeProfilesValuesRequest
+	6,  // 6: storegateway.v1.StoreGatewayService.GetProfileByID:input_type -> ingester.v1.GetProfileByIDRequest
+	7,  // 7: storegateway.v1.StoreGatewayService.ProfileTypes:input_type -> ingester.v1.ProfileTypesRequest
+	8,  // 8: storegateway.v1.StoreGatewayService.LabelValues:input_type -> types.v1.LabelValuesRequest
+	9,  // 9: storegateway.v1.StoreGatewayService.LabelNames:input_type -> types.v1.LabelNamesRequest
+	10, // 10: storegateway.v1.StoreGatewayService.Series:input_type -> ingester.v1.SeriesRequest
+	11, // 11: storegateway.v1.StoreGatewayService.BlockMetadata:input_type -> ingester.v1.BlockMetadataRequest
+	12, // 12: storegateway.v1.StoreGatewayService.GetBlockStats:input_type -> ingester.v1.GetBlockStatsRequest
+	13, // 13: storegateway.v1.StoreGatewayService.MergeProfilesStacktraces:output_type -> ingester.v1.MergeProfilesStacktracesResponse
+	14, // 14: storegateway.v1.StoreGatewayService.MergeProfilesLabels:output_type -> ingester.v1.MergeProfilesLabelsResponse
+	15, // 15: storegateway.v1.StoreGatewayService.MergeProfilesPprof:output_type -> ingester.v1.MergeProfilesPprofResponse
+	16, // 16: storegateway.v1.StoreGatewayService.MergeSpanProfile:output_type -> ingester.v1.MergeSpanProfileResponse
+	17, // 17: storegateway.v1.StoreGatewayService.MergeProfilesTopFunctions:output_type -> ingester.v1.MergeProfilesTopFunctionsResponse
+	18, // 18: storegateway.v1.StoreGatewayService.MergeProfilesValues:output_type -> ingester.v1.MergeProfilesValuesResponse
+	19, // 19: storegateway.v1.StoreGatewayService.GetProfileByID:output_type -> ingester.v1.GetProfileByIDResponse
+	20, // 20: storegateway.v1.StoreGatewayService.ProfileTypes:output_type -> ingester.v1.ProfileTypesResponse
+	21, // 21: storegateway.v1.StoreGatewayService.LabelValues:output_type -> types.v1.LabelValuesResponse
+	22, // 22: storegateway.v1.StoreGatewayService.LabelNames:output_type -> types.v1.LabelNamesResponse
+	23, // 23: storegateway.v1.StoreGatewayService.Series:output_type -> ingester.v1.SeriesResponse
+	24, // 24: storegateway.v1.StoreGatewayService.BlockMetadata:output_type -> ingester.v1.BlockMetadataResponse
+	25, // 25: storegateway.v1.StoreGatewayService.GetBlockStats:output_type -> ingester.v1.GetBlockStatsResponse
+	13, // [13:26] is the sub-list for method output_type
+	0,  // [0:13] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -34,6 +34,9 @@ type StoreGatewayServiceClient interface {
 	MergeProfilesLabels(ctx context.Context, opts ...grpc.CallOption) (StoreGatewayService_MergeProfilesLabelsClient, error)
 	MergeProfilesPprof(ctx context.Context, opts ...grpc.CallOption) (StoreGatewayService_MergeProfilesPprofClient, error)
 	MergeSpanProfile(ctx context.Context, opts ...grpc.CallOption) (StoreGatewayService_MergeSpanProfileClient, error)
+	MergeProfilesTopFunctions(ctx context.Context, opts ...grpc.CallOption) (StoreGatewayService_MergeProfilesTopFunctionsClient, error)
+	MergeProfilesValues(ctx context.Context, opts ...grpc.CallOption) (StoreGatewayService_MergeProfilesValuesClient, error)
+	GetProfileByID(ctx context.Context, in *v1.GetProfileByIDRequest, opts ...grpc.CallOption) (*v1.GetProfileByIDResponse, error)
 	// Deprecated: ProfileType call is deprecated in the store components
 	// TODO: Remove this call in release v1.4
 	ProfileTypes(ctx context.Context, in *v1.ProfileTypesRequest, opts ...grpc.CallOption) (*v1.ProfileTypesResponse, error)
@@ -176,6 +179,77 @@ func (x *storeGatewayServiceMergeSpanProfileClient) Recv() (*v1.MergeSpanProfile
 	return m, nil
 }
 
+func (c *storeGatewayServiceClient) MergeProfilesTopFunctions(ctx context.Context, opts ...grpc.CallOption) (StoreGatewayService_MergeProfilesTopFunctionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StoreGatewayService_ServiceDesc.Streams[4], "/storegateway.v1.StoreGatewayService/MergeProfilesTopFunctions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &storeGatewayServiceMergeProfilesTopFunctionsClient{stream}
+	return x, nil
+}
+
+type StoreGatewayService_MergeProfilesTopFunctionsClient interface {
+	Send(*v1.MergeProfilesTopFunctionsRequest) error
+	Recv() (*v1.MergeProfilesTopFunctionsResponse, error)
+	grpc.ClientStream
+}
+
+type storeGatewayServiceMergeProfilesTopFunctionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *storeGatewayServiceMergeProfilesTopFunctionsClient) Send(m *v1.MergeProfilesTopFunctionsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *storeGatewayServiceMergeProfilesTopFunctionsClient) Recv() (*v1.MergeProfilesTopFunctionsResponse, error) {
+	m := new(v1.MergeProfilesTopFunctionsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *storeGatewayServiceClient) MergeProfilesValues(ctx context.Context, opts ...grpc.CallOption) (StoreGatewayService_MergeProfilesValuesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StoreGatewayService_ServiceDesc.Streams[5], "/storegateway.v1.StoreGatewayService/MergeProfilesValues", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &storeGatewayServiceMergeProfilesValuesClient{stream}
+	return x, nil
+}
+
+type StoreGatewayService_MergeProfilesValuesClient interface {
+	Send(*v1.MergeProfilesValuesRequest) error
+	Recv() (*v1.MergeProfilesValuesResponse, error)
+	grpc.ClientStream
+}
+
+type storeGatewayServiceMergeProfilesValuesClient struct {
+	grpc.ClientStream
+}
+
+func (x *storeGatewayServiceMergeProfilesValuesClient) Send(m *v1.MergeProfilesValuesRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *storeGatewayServiceMergeProfilesValuesClient) Recv() (*v1.MergeProfilesValuesResponse, error) {
+	m := new(v1.MergeProfilesValuesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *storeGatewayServiceClient) GetProfileByID(ctx context.Context, in *v1.GetProfileByIDRequest, opts ...grpc.CallOption) (*v1.GetProfileByIDResponse, error) {
+	out := new(v1.GetProfileByIDResponse)
+	err := c.cc.Invoke(ctx, "/storegateway.v1.StoreGatewayService/GetProfileByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storeGatewayServiceClient) ProfileTypes(ctx context.Context, in *v1.ProfileTypesRequest, opts ...grpc.CallOption) (*v1.ProfileTypesResponse, error) {
 	out := new(v1.ProfileTypesResponse)
 	err := c.cc.Invoke(ctx, "/storegateway.v1.StoreGatewayService/ProfileTypes", in, out, opts...)
@@ -238,6 +312,9 @@ type StoreGatewayServiceServer interface {
 	MergeProfilesLabels(StoreGatewayService_MergeProfilesLabelsServer) error
 	MergeProfilesPprof(StoreGatewayService_MergeProfilesPprofServer) error
 	MergeSpanProfile(StoreGatewayService_MergeSpanProfileServer) error
+	MergeProfilesTopFunctions(StoreGatewayService_MergeProfilesTopFunctionsServer) error
+	MergeProfilesValues(StoreGatewayService_MergeProfilesValuesServer) error
+	GetProfileByID(context.Context, *v1.GetProfileByIDRequest) (*v1.GetProfileByIDResponse, error)
 	// Deprecated: ProfileType call is deprecated in the store components
 	// TODO: Remove this call in release v1.4
 	ProfileTypes(context.Context, *v1.ProfileTypesRequest) (*v1.ProfileTypesResponse, error)
@@ -265,6 +342,15 @@ func (UnimplementedStoreGatewayServiceServer) MergeProfilesPprof(StoreGatewaySer
 func (UnimplementedStoreGatewayServiceServer) MergeSpanProfile(StoreGatewayService_MergeSpanProfileServer) error {
 	return status.Errorf(codes.Unimplemented, "method MergeSpanProfile not implemented")
 }
+func (UnimplementedStoreGatewayServiceServer) MergeProfilesTopFunctions(StoreGatewayService_MergeProfilesTopFunctionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method MergeProfilesTopFunctions not implemented")
+}
+func (UnimplementedStoreGatewayServiceServer) MergeProfilesValues(StoreGatewayService_MergeProfilesValuesServer) error {
+	return status.Errorf(codes.Unimplemented, "method MergeProfilesValues not implemented")
+}
+func (UnimplementedStoreGatewayServiceServer) GetProfileByID(context.Context, *v1.GetProfileByIDRequest) (*v1.GetProfileByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfileByID not implemented")
+}
 func (UnimplementedStoreGatewayServiceServer) ProfileTypes(context.Context, *v1.ProfileTypesRequest) (*v1.ProfileTypesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProfileTypes not implemented")
 }
@@ -400,6 +486,76 @@ func (x *storeGatewayServiceMergeSpanProfileServer) Recv() (*v1.MergeSpanProfile
 	return m, nil
 }
 
+func _StoreGatewayService_MergeProfilesTopFunctions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StoreGatewayServiceServer).MergeProfilesTopFunctions(&storeGatewayServiceMergeProfilesTopFunctionsServer{stream})
+}
+
+type StoreGatewayService_MergeProfilesTopFunctionsServer interface {
+	Send(*v1.MergeProfilesTopFunctionsResponse) error
+	Recv() (*v1.MergeProfilesTopFunctionsRequest, error)
+	grpc.ServerStream
+}
+
+type storeGatewayServiceMergeProfilesTopFunctionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *storeGatewayServiceMergeProfilesTopFunctionsServer) Send(m *v1.MergeProfilesTopFunctionsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *storeGatewayServiceMergeProfilesTopFunctionsServer) Recv() (*v1.MergeProfilesTopFunctionsRequest, error) {
+	m := new(v1.MergeProfilesTopFunctionsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _StoreGatewayService_MergeProfilesValues_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StoreGatewayServiceServer).MergeProfilesValues(&storeGatewayServiceMergeProfilesValuesServer{stream})
+}
+
+type StoreGatewayService_MergeProfilesValuesServer interface {
+	Send(*v1.MergeProfilesValuesResponse) error
+	Recv() (*v1.MergeProfilesValuesRequest, error)
+	grpc.ServerStream
+}
+
+type storeGatewayServiceMergeProfilesValuesServer struct {
+	grpc.ServerStream
+}
+
+func (x *storeGatewayServiceMergeProfilesValuesServer) Send(m *v1.MergeProfilesValuesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *storeGatewayServiceMergeProfilesValuesServer) Recv() (*v1.MergeProfilesValuesRequest, error) {
+	m := new(v1.MergeProfilesValuesRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _StoreGatewayService_GetProfileByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.GetProfileByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoreGatewayServiceServer).GetProfileByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/storegateway.v1.StoreGatewayService/GetProfileByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoreGatewayServiceServer).GetProfileByID(ctx, req.(*v1.GetProfileByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StoreGatewayService_ProfileTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v1.ProfileTypesRequest)
 	if err := dec(in); err != nil {
@@ -515,6 +671,10 @@ var StoreGatewayService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "storegateway.v1.StoreGatewayService",
 	HandlerType: (*StoreGatewayServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetProfileByID",
+			Handler:    _StoreGatewayService_GetProfileByID_Handler,
+		},
 		{
 			MethodName: "ProfileTypes",
 			Handler:    _StoreGatewayService_ProfileTypes_Handler,
@@ -565,6 +725,18 @@ var StoreGatewayService_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "MergeProfilesTopFunctions",
+			Handler:       _StoreGatewayService_MergeProfilesTopFunctions_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "MergeProfilesValues",
+			Handler:       _StoreGatewayService_MergeProfilesValues_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "storegateway/v1/storegateway.proto",
 }
@@ -47,6 +47,15 @@ const (
 	// StoreGatewayServiceMergeSpanProfileProcedure is the fully-qualified name of the
 	// StoreGatewayService's MergeSpanProfile RPC.
 	StoreGatewayServiceMergeSpanProfileProcedure = "/storegateway.v1.StoreGatewayService/MergeSpanProfile"
+	// StoreGatewayServiceMergeProfilesTopFunctionsProcedure is the fully-qualified name of the
+	// StoreGatewayService's MergeProfilesTopFunctions RPC.
+	StoreGatewayServiceMergeProfilesTopFunctionsProcedure = "/storegateway.v1.StoreGatewayService/MergeProfilesTopFunctions"
+	// StoreGatewayServiceMergeProfilesValuesProcedure is the fully-qualified name of the
+	// StoreGatewayService's MergeProfilesValues RPC.
+	StoreGatewayServiceMergeProfilesValuesProcedure = "/storegateway.v1.StoreGatewayService/MergeProfilesValues"
+	// StoreGatewayServiceGetProfileByIDProcedure is the fully-qualified name of the
+	// StoreGatewayService's GetProfileByID RPC.
+	StoreGatewayServiceGetProfileByIDProcedure = "/storegateway.v1.StoreGatewayService/GetProfileByID"
 	// StoreGatewayServiceProfileTypesProcedure is the fully-qualified name of the StoreGatewayService's
 	// ProfileTypes RPC.
 	StoreGatewayServiceProfileTypesProcedure = "/storegateway.v1.StoreGatewayService/ProfileTypes"
@@ -69,17 +78,20 @@ const (
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	storeGatewayServiceServiceDescriptor                        = v1.File_storegateway_v1_storegateway_proto.Services().ByName("StoreGatewayService")
-	storeGatewayServiceMergeProfilesStacktracesMethodDescriptor = storeGatewayServiceServiceDescriptor.Methods().ByName("MergeProfilesStacktraces")
-	storeGatewayServiceMergeProfilesLabelsMethodDescriptor      = storeGatewayServiceServiceDescriptor.Methods().ByName("MergeProfilesLabels")
-	storeGatewayServiceMergeProfilesPprofMethodDescriptor       = storeGatewayServiceServiceDescriptor.Methods().ByName("MergeProfilesPprof")
-	storeGatewayServiceMergeSpanProfileMethodDescriptor         = storeGatewayServiceServiceDescriptor.Methods().ByName("MergeSpanProfile")
-	storeGatewayServiceProfileTypesMethodDescriptor             = storeGatewayServiceServiceDescriptor.Methods().ByName("ProfileTypes")
-	storeGatewayServiceLabelValuesMethodDescriptor              = storeGatewayServiceServiceDescriptor.Methods().ByName("LabelValues")
-	storeGatewayServiceLabelNamesMethodDescriptor               = storeGatewayServiceServiceDescriptor.Methods().ByName("LabelNames")
-	storeGatewayServiceSeriesMethodDescriptor                   = storeGatewayServiceServiceDescriptor.Methods().ByName("Series")
-	storeGatewayServiceBlockMetadataMethodDescriptor            = storeGatewayServiceServiceDescriptor.Methods().ByName("BlockMetadata")
-	storeGatewayServiceGetBlockStatsMethodDescriptor            = storeGatewayServiceServiceDescriptor.Methods().ByName("GetBlockStats")
+	storeGatewayServiceServiceDescriptor                         = v1.File_storegateway_v1_storegateway_proto.Services().ByName("StoreGatewayService")
+	storeGatewayServiceMergeProfilesStacktracesMethodDescriptor  = storeGatewayServiceServiceDescriptor.Methods().ByName("MergeProfilesStacktraces")
+	storeGatewayServiceMergeProfilesLabelsMethodDescriptor       = storeGatewayServiceServiceDescriptor.Methods().ByName("MergeProfilesLabels")
+	storeGatewayServiceMergeProfilesPprofMethodDescriptor        = storeGatewayServiceServiceDescriptor.Methods().ByName("MergeProfilesPprof")
+	storeGatewayServiceMergeSpanProfileMethodDescriptor          = storeGatewayServiceServiceDescriptor.Methods().ByName("MergeSpanProfile")
+	storeGatewayServiceMergeProfilesTopFunctionsMethodDescriptor = storeGatewayServiceServiceDescriptor.Methods().ByName("MergeProfilesTopFunctions")
+	storeGatewayServiceMergeProfilesValuesMethodDescriptor       = storeGatewayServiceServiceDescriptor.Methods().ByName("MergeProfilesValues")
+	storeGatewayServiceGetProfileByIDMethodDescriptor            = storeGatewayServiceServiceDescriptor.Methods().ByName("GetProfileByID")
+	storeGatewayServiceProfileTypesMethodDescriptor              = storeGatewayServiceServiceDescriptor.Methods().ByName("ProfileTypes")
+	storeGatewayServiceLabelValuesMethodDescriptor               = storeGatewayServiceServiceDescriptor.Methods().ByName("LabelValues")
+	storeGatewayServiceLabelNamesMethodDescriptor                = storeGatewayServiceServiceDescriptor.Methods().ByName("LabelNames")
+	storeGatewayServiceSeriesMethodDescriptor                    = storeGatewayServiceServiceDescriptor.Methods().ByName("Series")
+	storeGatewayServiceBlockMetadataMethodDescriptor             = storeGatewayServiceServiceDescriptor.Methods().ByName("BlockMetadata")
+	storeGatewayServiceGetBlockStatsMethodDescriptor             = storeGatewayServiceServiceDescriptor.Methods().ByName("GetBlockStats")
 )
 
 // StoreGatewayServiceClient is a client for the storegateway.v1.StoreGatewayService service.
@@ -88,6 +100,9 @@ type StoreGatewayServiceClient interface {
 	MergeProfilesLabels(context.Context) *connect.BidiStreamForClient[v11.MergeProfilesLabelsRequest, v11.MergeProfilesLabelsResponse]
 	MergeProfilesPprof(context.Context) *connect.BidiStreamForClient[v11.MergeProfilesPprofRequest, v11.MergeProfilesPprofResponse]
 	MergeSpanProfile(context.Context) *connect.BidiStreamForClient[v11.MergeSpanProfileRequest, v11.MergeSpanProfileResponse]
+	MergeProfilesTopFunctions(context.Context) *connect.BidiStreamForClient[v11.MergeProfilesTopFunctionsRequest, v11.MergeProfilesTopFunctionsResponse]
+	MergeProfilesValues(context.Context) *connect.BidiStreamForClient[v11.MergeProfilesValuesRequest, v11.MergeProfilesValuesResponse]
+	GetProfileByID(context.Context, *connect.Request[v11.GetProfileByIDRequest]) (*connect.Response[v11.GetProfileByIDResponse], error)
 	// Deprecated: ProfileType call is deprecated in the store components
 	// TODO: Remove this call in release v1.4
 	ProfileTypes(context.Context, *connect.Request[v11.ProfileTypesRequest]) (*connect.Response[v11.ProfileTypesResponse], error)
@@ -132,6 +147,24 @@ func NewStoreGatewayServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(storeGatewayServiceMergeSpanProfileMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		mergeProfilesTopFunctions: connect.NewClient[v11.MergeProfilesTopFunctionsRequest, v11.MergeProfilesTopFunctionsResponse](
+			httpClient,
+			baseURL+StoreGatewayServiceMergeProfilesTopFunctionsProcedure,
+			connect.WithSchema(storeGatewayServiceMergeProfilesTopFunctionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		mergeProfilesValues: connect.NewClient[v11.MergeProfilesValuesRequest, v11.MergeProfilesValuesResponse](
+			httpClient,
+			baseURL+StoreGatewayServiceMergeProfilesValuesProcedure,
+			connect.WithSchema(storeGatewayServiceMergeProfilesValuesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getProfileByID: connect.NewClient[v11.GetProfileByIDRequest, v11.GetProfileByIDResponse](
+			httpClient,
+			baseURL+StoreGatewayServiceGetProfileByIDProcedure,
+			connect.WithSchema(storeGatewayServiceGetProfileByIDMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		profileTypes: connect.NewClient[v11.ProfileTypesRequest, v11.ProfileTypesResponse](
 			httpClient,
 			baseURL+StoreGatewayServiceProfileTypesProcedure,
@@ -173,16 +206,19 @@ func NewStoreGatewayServiceClient(httpClient connect.HTTPClient, baseURL string,
 
 // storeGatewayServiceClient implements StoreGatewayServiceClient.
 type storeGatewayServiceClient struct {
-	mergeProfilesStacktraces *connect.Client[v11.MergeProfilesStacktracesRequest, v11.MergeProfilesStacktracesResponse]
-	mergeProfilesLabels      *connect.Client[v11.MergeProfilesLabelsRequest, v11.MergeProfilesLabelsResponse]
-	mergeProfilesPprof       *connect.Client[v11.MergeProfilesPprofRequest, v11.MergeProfilesPprofResponse]
-	mergeSpanProfile         *connect.Client[v11.MergeSpanProfileRequest, v11.MergeSpanProfileResponse]
-	profileTypes             *connect.Client[v11.ProfileTypesRequest, v11.ProfileTypesResponse]
-	labelValues              *connect.Client[v12.LabelValuesRequest, v12.LabelValuesResponse]
-	labelNames               *connect.Client[v12.LabelNamesRequest, v12.LabelNamesResponse]
-	series                   *connect.Client[v11.SeriesRequest, v11.SeriesResponse]
-	blockMetadata            *connect.Client[v11.BlockMetadataRequest, v11.BlockMetadataResponse]
-	getBlockStats            *connect.Client[v11.GetBlockStatsRequest, v11.GetBlockStatsResponse]
+	mergeProfilesStacktraces  *connect.Client[v11.MergeProfilesStacktracesRequest, v11.MergeProfilesStacktracesResponse]
+	mergeProfilesLabels       *connect.Client[v11.MergeProfilesLabelsRequest, v11.MergeProfilesLabelsResponse]
+	mergeProfilesPprof        *connect.Client[v11.MergeProfilesPprofRequest, v11.MergeProfilesPprofResponse]
+	mergeSpanProfile          *connect.Client[v11.MergeSpanProfileRequest, v11.MergeSpanProfileResponse]
+	mergeProfilesTopFunctions *connect.Client[v11.MergeProfilesTopFunctionsRequest, v11.MergeProfilesTopFunctionsResponse]
+	mergeProfilesValues       *connect.Client[v11.MergeProfilesValuesRequest, v11.MergeProfilesValuesResponse]
+	getProfileByID            *connect.Client[v11.GetProfileByIDRequest, v11.GetProfileByIDResponse]
+	profileTypes              *connect.Client[v11.ProfileTypesRequest, v11.ProfileTypesResponse]
+	labelValues               *connect.Client[v12.LabelValuesRequest, v12.LabelValuesResponse]
+	labelNames                *connect.Client[v12.LabelNamesRequest, v12.LabelNamesResponse]
+	series                    *connect.Client[v11.SeriesRequest, v11.SeriesResponse]
+	blockMetadata             *connect.Client[v11.BlockMetadataRequest, v11.BlockMetadataResponse]
+	getBlockStats             *connect.Client[v11.GetBlockStatsRequest, v11.GetBlockStatsResponse]
 }
 
 // MergeProfilesStacktraces calls storegateway.v1.StoreGatewayService.MergeProfilesStacktraces.
@@ -205,6 +241,21 @@ func (c *storeGatewayServiceClient) MergeSpanProfile(ctx context.Context) *conne
 	return c.mergeSpanProfile.CallBidiStream(ctx)
 }
 
+// MergeProfilesTopFunctions calls storegateway.v1.StoreGatewayService.MergeProfilesTopFunctions.
+func (c *storeGatewayServiceClient) MergeProfilesTopFunctions(ctx context.Context) *connect.BidiStreamForClient[v11.MergeProfilesTopFunctionsRequest, v11.MergeProfilesTopFunctionsResponse] {
+	return c.mergeProfilesTopFunctions.CallBidiStream(ctx)
+}
+
+// MergeProfilesValues calls storegateway.v1.StoreGatewayService.MergeProfilesValues.
+func (c *storeGatewayServiceClient) MergeProfilesValues(ctx context.Context) *connect.BidiStreamForClient[v11.MergeProfilesValuesRequest, v11.MergeProfilesValuesResponse] {
+	return c.mergeProfilesValues.CallBidiStream(ctx)
+}
+
+// GetProfileByID calls storegateway.v1.StoreGatewayService.GetProfileByID.
+func (c *storeGatewayServiceClient) GetProfileByID(ctx context.Context, req *connect.Request[v11.GetProfileByIDRequest]) (*connect.Response[v11.GetProfileByIDResponse], error) {
+	return c.getProfileByID.CallUnary(ctx, req)
+}
+
 // ProfileTypes calls storegateway.v1.StoreGatewayService.ProfileTypes.
 func (c *storeGatewayServiceClient) ProfileTypes(ctx context.Context, req *connect.Request[v11.ProfileTypesRequest]) (*connect.Response[v11.ProfileTypesResponse], error) {
 	return c.profileTypes.CallUnary(ctx, req)
@@ -242,6 +293,9 @@ type StoreGatewayServiceHandler interface {
 	MergeProfilesLabels(context.Context, *connect.BidiStream[v11.MergeProfilesLabelsRequest, v11.MergeProfilesLabelsResponse]) error
 	MergeProfilesPprof(context.Context, *connect.BidiStream[v11.MergeProfilesPprofRequest, v11.MergeProfilesPprofResponse]) error
 	MergeSpanProfile(context.Context, *connect.BidiStream[v11.MergeSpanProfileRequest, v11.MergeSpanProfileResponse]) error
+	MergeProfilesTopFunctions(context.Context, *connect.BidiStream[v11.MergeProfilesTopFunctionsRequest, v11.MergeProfilesTopFunctionsResponse]) error
+	MergeProfilesValues(context.Context, *connect.BidiStream[v11.MergeProfilesValuesRequest, v11.MergeProfilesValuesResponse]) error
+	GetProfileByID(context.Context, *connect.Request[v11.GetProfileByIDRequest]) (*connect.Response[v11.GetProfileByIDResponse], error)
 	// Deprecated: ProfileType call is deprecated in the store components
 	// TODO: Remove this call in release v1.4
 	ProfileTypes(context.Context, *connect.Request[v11.ProfileTypesRequest]) (*connect.Response[v11.ProfileTypesResponse], error)
@@ -282,6 +336,24 @@ func NewStoreGatewayServiceHandler(svc StoreGatewayServiceHandler, opts ...conne
 		connect.WithSchema(storeGatewayServiceMergeSpanProfileMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	storeGatewayServiceMergeProfilesTopFunctionsHandler := connect.NewBidiStreamHandler(
+		StoreGatewayServiceMergeProfilesTopFunctionsProcedure,
+		svc.MergeProfilesTopFunctions,
+		connect.WithSchema(storeGatewayServiceMergeProfilesTopFunctionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeGatewayServiceMergeProfilesValuesHandler := connect.NewBidiStreamHandler(
+		StoreGatewayServiceMergeProfilesValuesProcedure,
+		svc.MergeProfilesValues,
+		connect.WithSchema(storeGatewayServiceMergeProfilesValuesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	storeGatewayServiceGetProfileByIDHandler := connect.NewUnaryHandler(
+		StoreGatewayServiceGetProfileByIDProcedure,
+		svc.GetProfileByID,
+		connect.WithSchema(storeGatewayServiceGetProfileByIDMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	storeGatewayServiceProfileTypesHandler := connect.NewUnaryHandler(
 		StoreGatewayServiceProfileTypesProcedure,
 		svc.ProfileTypes,
@@ -328,6 +400,12 @@ func NewStoreGatewayServiceHandler(svc StoreGatewayServiceHandler, opts ...conne
 			storeGatewayServiceMergeProfilesPprofHandler.ServeHTTP(w, r)
 		case StoreGatewayServiceMergeSpanProfileProcedure:
 			storeGatewayServiceMergeSpanProfileHandler.ServeHTTP(w, r)
+		case StoreGatewayServiceMergeProfilesTopFunctionsProcedure:
+			storeGatewayServiceMergeProfilesTopFunctionsHandler.ServeHTTP(w, r)
+		case StoreGatewayServiceMergeProfilesValuesProcedure:
+			storeGatewayServiceMergeProfilesValuesHandler.ServeHTTP(w, r)
+		case StoreGatewayServiceGetProfileByIDProcedure:
+			storeGatewayServiceGetProfileByIDHandler.ServeHTTP(w, r)
 		case StoreGatewayServiceProfileTypesProcedure:
 			storeGatewayServiceProfileTypesHandler.ServeHTTP(w, r)
 		case StoreGatewayServiceLabelValuesProcedure:
@@ -365,6 +443,18 @@ func (UnimplementedStoreGatewayServiceHandler) MergeSpanProfile(context.Context,
 	return connect.NewError(connect.CodeUnimplemented, errors.New("storegateway.v1.StoreGatewayService.MergeSpanProfile is not implemented"))
 }
 
+func (UnimplementedStoreGatewayServiceHandler) MergeProfilesTopFunctions(context.Context, *connect.BidiStream[v11.MergeProfilesTopFunctionsRequest, v11.MergeProfilesTopFunctionsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("storegateway.v1.StoreGatewayService.MergeProfilesTopFunctions is not implemented"))
+}
+
+func (UnimplementedStoreGatewayServiceHandler) MergeProfilesValues(context.Context, *connect.BidiStream[v11.MergeProfilesValuesRequest, v11.MergeProfilesValuesResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("storegateway.v1.StoreGatewayService.MergeProfilesValues is not implemented"))
+}
+
+func (UnimplementedStoreGatewayServiceHandler) GetProfileByID(context.Context, *connect.Request[v11.GetProfileByIDRequest]) (*connect.Response[v11.GetProfileByIDResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("storegateway.v1.StoreGatewayService.GetProfileByID is not implemented"))
+}
+
 func (UnimplementedStoreGatewayServiceHandler) ProfileTypes(context.Context, *connect.Request[v11.ProfileTypesRequest]) (*connect.Response[v11.ProfileTypesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("storegateway.v1.StoreGatewayService.ProfileTypes is not implemented"))
 }
@@ -39,6 +39,21 @@ func RegisterStoreGatewayServiceHandler(mux *mux.Router, svc StoreGatewayService
 		svc.MergeSpanProfile,
 		opts...,
 	))
+	mux.Handle("/storegateway.v1.StoreGatewayService/MergeProfilesTopFunctions", connect.NewBidiStreamHandler(
+		"/storegateway.v1.StoreGatewayService/MergeProfilesTopFunctions",
+		svc.MergeProfilesTopFunctions,
+		opts...,
+	))
+	mux.Handle("/storegateway.v1.StoreGatewayService/MergeProfilesValues", connect.NewBidiStreamHandler(
+		"/storegateway.v1.StoreGatewayService/MergeProfilesValues",
+		svc.MergeProfilesValues,
+		opts...,
+	))
+	mux.Handle("/storegateway.v1.StoreGatewayService/GetProfileByID", connect.NewUnaryHandler(
+		"/storegateway.v1.StoreGatewayService/GetProfileByID",
+		svc.GetProfileByID,
+		opts...,
+	))
 	mux.Handle("/storegateway.v1.StoreGatewayService/ProfileTypes", connect.NewUnaryHandler(
 		"/storegateway.v1.StoreGatewayService/ProfileTypes",
 		svc.ProfileTypes,
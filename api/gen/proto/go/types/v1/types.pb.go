@@ -25,6 +25,15 @@ type TimeSeriesAggregationType int32
 const (
 	TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_SUM     TimeSeriesAggregationType = 0
 	TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_AVERAGE TimeSeriesAggregationType = 1
+	// Minimum and maximum of the profile values within a step.
+	TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_MIN TimeSeriesAggregationType = 2
+	TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_MAX TimeSeriesAggregationType = 3
+	// Number of profiles within a step.
+	TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_COUNT TimeSeriesAggregationType = 4
+	// Percentiles of the profile values within a step.
+	TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_P50 TimeSeriesAggregationType = 5
+	TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_P90 TimeSeriesAggregationType = 6
+	TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_P99 TimeSeriesAggregationType = 7
 )
 
 // Enum value maps for TimeSeriesAggregationType.
@@ -32,10 +41,22 @@ var (
 	TimeSeriesAggregationType_name = map[int32]string{
 		0: "TIME_SERIES_AGGREGATION_TYPE_SUM",
 		1: "TIME_SERIES_AGGREGATION_TYPE_AVERAGE",
+		2: "TIME_SERIES_AGGREGATION_TYPE_MIN",
+		3: "TIME_SERIES_AGGREGATION_TYPE_MAX",
+		4: "TIME_SERIES_AGGREGATION_TYPE_COUNT",
+		5: "TIME_SERIES_AGGREGATION_TYPE_P50",
+		6: "TIME_SERIES_AGGREGATION_TYPE_P90",
+		7: "TIME_SERIES_AGGREGATION_TYPE_P99",
 	}
 	TimeSeriesAggregationType_value = map[string]int32{
 		"TIME_SERIES_AGGREGATION_TYPE_SUM":     0,
 		"TIME_SERIES_AGGREGATION_TYPE_AVERAGE": 1,
+		"TIME_SERIES_AGGREGATION_TYPE_MIN":     2,
+		"TIME_SERIES_AGGREGATION_TYPE_MAX":     3,
+		"TIME_SERIES_AGGREGATION_TYPE_COUNT":   4,
+		"TIME_SERIES_AGGREGATION_TYPE_P50":     5,
+		"TIME_SERIES_AGGREGATION_TYPE_P90":     6,
+		"TIME_SERIES_AGGREGATION_TYPE_P99":     7,
 	}
 )
 
@@ -66,6 +87,112 @@ func (TimeSeriesAggregationType) EnumDescriptor() ([]byte, []int) {
 	return file_types_v1_types_proto_rawDescGZIP(), []int{0}
 }
 
+// SeriesOrder specifies how series are ranked, when
+// the number of series returned is limited.
+type SeriesOrder int32
+
+const (
+	// Sum of the series point values.
+	SeriesOrder_SERIES_ORDER_TOTAL SeriesOrder = 0
+	// Maximum of the series point values.
+	SeriesOrder_SERIES_ORDER_MAX SeriesOrder = 1
+	// Value of the last series point.
+	SeriesOrder_SERIES_ORDER_LAST SeriesOrder = 2
+)
+
+// Enum value maps for SeriesOrder.
+var (
+	SeriesOrder_name = map[int32]string{
+		0: "SERIES_ORDER_TOTAL",
+		1: "SERIES_ORDER_MAX",
+		2: "SERIES_ORDER_LAST",
+	}
+	SeriesOrder_value = map[string]int32{
+		"SERIES_ORDER_TOTAL": 0,
+		"SERIES_ORDER_MAX":   1,
+		"SERIES_ORDER_LAST":  2,
+	}
+)
+
+func (x SeriesOrder) Enum() *SeriesOrder {
+	p := new(SeriesOrder)
+	*p = x
+	return p
+}
+
+func (x SeriesOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SeriesOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_types_v1_types_proto_enumTypes[1].Descriptor()
+}
+
+func (SeriesOrder) Type() protoreflect.EnumType {
+	return &file_types_v1_types_proto_enumTypes[1]
+}
+
+func (x SeriesOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SeriesOrder.Descriptor instead.
+func (SeriesOrder) EnumDescriptor() ([]byte, []int) {
+	return file_types_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
+type TopFunctionsGrouping int32
+
+const (
+	// Functions are grouped by name.
+	TopFunctionsGrouping_TOP_FUNCTIONS_GROUPING_FUNCTION TopFunctionsGrouping = 0
+	// Functions are grouped by name and file name.
+	TopFunctionsGrouping_TOP_FUNCTIONS_GROUPING_FILE TopFunctionsGrouping = 1
+	// Functions are grouped by name, file name, and line number.
+	TopFunctionsGrouping_TOP_FUNCTIONS_GROUPING_LINE TopFunctionsGrouping = 2
+)
+
+// Enum value maps for TopFunctionsGrouping.
+var (
+	TopFunctionsGrouping_name = map[int32]string{
+		0: "TOP_FUNCTIONS_GROUPING_FUNCTION",
+		1: "TOP_FUNCTIONS_GROUPING_FILE",
+		2: "TOP_FUNCTIONS_GROUPING_LINE",
+	}
+	TopFunctionsGrouping_value = map[string]int32{
+		"TOP_FUNCTIONS_GROUPING_FUNCTION": 0,
+		"TOP_FUNCTIONS_GROUPING_FILE":     1,
+		"TOP_FUNCTIONS_GROUPING_LINE":     2,
+	}
+)
+
+func (x TopFunctionsGrouping) Enum() *TopFunctionsGrouping {
+	p := new(TopFunctionsGrouping)
+	*p = x
+	return p
+}
+
+func (x TopFunctionsGrouping) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TopFunctionsGrouping) Descriptor() protoreflect.EnumDescriptor {
+	return file_types_v1_types_proto_enumTypes[2].Descriptor()
+}
+
+func (TopFunctionsGrouping) Type() protoreflect.EnumType {
+	return &file_types_v1_types_proto_enumTypes[2]
+}
+
+func (x TopFunctionsGrouping) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TopFunctionsGrouping.Descriptor instead.
+func (TopFunctionsGrouping) EnumDescriptor() ([]byte, []int) {
+	return file_types_v1_types_proto_rawDescGZIP(), []int{2}
+}
+
 type LabelPair struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -750,6 +877,14 @@ type StackTraceSelector struct {
 	// Stack trace selector for profiles purposed for Go PGO.
 	// If set, call_site is ignored.
 	GoPgo *GoPGO `protobuf:"bytes,2,opt,name=go_pgo,json=goPgo,proto3" json:"go_pgo,omitempty"`
+	// Selects the samples attributed to the function, found at any
+	// depth of the stack trace. If set, call_site is ignored.
+	// Only applicable to time series queries.
+	Function *FunctionSelector `protobuf:"bytes,3,opt,name=function,proto3" json:"function,omitempty"`
+	// Transformations of the stack trace frames, applied
+	// before any other selector. Not applicable to time
+	// series queries.
+	FrameFilter *FrameFilter `protobuf:"bytes,4,opt,name=frame_filter,json=frameFilter,proto3" json:"frame_filter,omitempty"`
 }
 
 func (x *StackTraceSelector) Reset() {
@@ -798,6 +933,178 @@ func (x *StackTraceSelector) GetGoPgo() *GoPGO {
 	return nil
 }
 
+func (x *StackTraceSelector) GetFunction() *FunctionSelector {
+	if x != nil {
+		return x.Function
+	}
+	return nil
+}
+
+func (x *StackTraceSelector) GetFrameFilter() *FrameFilter {
+	if x != nil {
+		return x.FrameFilter
+	}
+	return nil
+}
+
+// FrameFilter specifies pprof-style transformations of the stack traces.
+// Each option is a regular expression matched against the function names
+// of the stack trace frames; a frame matches if any of its functions does.
+// Empty options are ignored.
+type FrameFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only stack traces having a matching frame are kept.
+	Focus string `protobuf:"bytes,1,opt,name=focus,proto3" json:"focus,omitempty"`
+	// Stack traces having a matching frame are dropped.
+	Ignore string `protobuf:"bytes,2,opt,name=ignore,proto3" json:"ignore,omitempty"`
+	// Matching frames are removed from the stack traces.
+	Hide string `protobuf:"bytes,3,opt,name=hide,proto3" json:"hide,omitempty"`
+	// Frames above the matching frame closest to the root are
+	// removed. Stack traces without a matching frame are dropped.
+	ShowFrom string `protobuf:"bytes,4,opt,name=show_from,json=showFrom,proto3" json:"show_from,omitempty"`
+	// Frames below the matching frame closest to the root
+	// (the frame callees) are removed.
+	PruneFrom string `protobuf:"bytes,5,opt,name=prune_from,json=pruneFrom,proto3" json:"prune_from,omitempty"`
+}
+
+func (x *FrameFilter) Reset() {
+	*x = FrameFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1_types_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrameFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameFilter) ProtoMessage() {}
+
+func (x *FrameFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_types_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameFilter.ProtoReflect.Descriptor instead.
+func (*FrameFilter) Descriptor() ([]byte, []int) {
+	return file_types_v1_types_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FrameFilter) GetFocus() string {
+	if x != nil {
+		return x.Focus
+	}
+	return ""
+}
+
+func (x *FrameFilter) GetIgnore() string {
+	if x != nil {
+		return x.Ignore
+	}
+	return ""
+}
+
+func (x *FrameFilter) GetHide() string {
+	if x != nil {
+		return x.Hide
+	}
+	return ""
+}
+
+func (x *FrameFilter) GetShowFrom() string {
+	if x != nil {
+		return x.ShowFrom
+	}
+	return ""
+}
+
+func (x *FrameFilter) GetPruneFrom() string {
+	if x != nil {
+		return x.PruneFrom
+	}
+	return ""
+}
+
+type FunctionSelector struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Function name. If regex is set, the name is a regular
+	// expression that must match the whole function name.
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Regex bool   `protobuf:"varint,2,opt,name=regex,proto3" json:"regex,omitempty"`
+	// If set, only the self values of the function are attributed: the
+	// values of the samples where the function is the stack trace leaf.
+	// Otherwise, values of all the samples that include the function.
+	Self bool `protobuf:"varint,3,opt,name=self,proto3" json:"self,omitempty"`
+}
+
+func (x *FunctionSelector) Reset() {
+	*x = FunctionSelector{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1_types_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FunctionSelector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FunctionSelector) ProtoMessage() {}
+
+func (x *FunctionSelector) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_types_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FunctionSelector.ProtoReflect.Descriptor instead.
+func (*FunctionSelector) Descriptor() ([]byte, []int) {
+	return file_types_v1_types_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *FunctionSelector) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FunctionSelector) GetRegex() bool {
+	if x != nil {
+		return x.Regex
+	}
+	return false
+}
+
+func (x *FunctionSelector) GetSelf() bool {
+	if x != nil {
+		return x.Self
+	}
+	return false
+}
+
 type Location struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -809,7 +1116,7 @@ type Location struct {
 func (x *Location) Reset() {
 	*x = Location{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v1_types_proto_msgTypes[12]
+		mi := &file_types_v1_types_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -822,7 +1129,7 @@ func (x *Location) String() string {
 func (*Location) ProtoMessage() {}
 
 func (x *Location) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1_types_proto_msgTypes[12]
+	mi := &file_types_v1_types_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -835,7 +1142,7 @@ func (x *Location) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Location.ProtoReflect.Descriptor instead.
 func (*Location) Descriptor() ([]byte, []int) {
-	return file_types_v1_types_proto_rawDescGZIP(), []int{12}
+	return file_types_v1_types_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Location) GetName() string {
@@ -860,7 +1167,7 @@ type GoPGO struct {
 func (x *GoPGO) Reset() {
 	*x = GoPGO{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v1_types_proto_msgTypes[13]
+		mi := &file_types_v1_types_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -873,7 +1180,7 @@ func (x *GoPGO) String() string {
 func (*GoPGO) ProtoMessage() {}
 
 func (x *GoPGO) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1_types_proto_msgTypes[13]
+	mi := &file_types_v1_types_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -886,7 +1193,7 @@ func (x *GoPGO) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GoPGO.ProtoReflect.Descriptor instead.
 func (*GoPGO) Descriptor() ([]byte, []int) {
-	return file_types_v1_types_proto_rawDescGZIP(), []int{13}
+	return file_types_v1_types_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GoPGO) GetKeepLocations() uint32 {
@@ -903,6 +1210,152 @@ func (x *GoPGO) GetAggregateCallees() bool {
 	return false
 }
 
+// TopFunction holds the aggregated values of a function.
+type TopFunction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Empty, unless the functions are grouped by file or line.
+	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Zero, unless the functions are grouped by line.
+	Line int64 `protobuf:"varint,3,opt,name=line,proto3" json:"line,omitempty"`
+	// Sum of the values of the samples where the function is the leaf.
+	Self int64 `protobuf:"varint,4,opt,name=self,proto3" json:"self,omitempty"`
+	// Sum of the values of the samples where the function is present
+	// in the stack trace. Recursive calls are accounted once.
+	Total int64 `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *TopFunction) Reset() {
+	*x = TopFunction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1_types_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopFunction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopFunction) ProtoMessage() {}
+
+func (x *TopFunction) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_types_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopFunction.ProtoReflect.Descriptor instead.
+func (*TopFunction) Descriptor() ([]byte, []int) {
+	return file_types_v1_types_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TopFunction) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TopFunction) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *TopFunction) GetLine() int64 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *TopFunction) GetSelf() int64 {
+	if x != nil {
+		return x.Self
+	}
+	return 0
+}
+
+func (x *TopFunction) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// TopFunctionsFilter restricts the functions to those with the given
+// name and file name. Empty name or file name matches any. The self values
+// of the other functions are attributed to a function without a name, so
+// that the self values still sum up to the total of the samples.
+type TopFunctionsFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FunctionName string `protobuf:"bytes,1,opt,name=function_name,json=functionName,proto3" json:"function_name,omitempty"`
+	// If only the file name is set, the functions are grouped by line
+	// regardless of their names: the function name is left empty.
+	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+}
+
+func (x *TopFunctionsFilter) Reset() {
+	*x = TopFunctionsFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v1_types_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopFunctionsFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopFunctionsFilter) ProtoMessage() {}
+
+func (x *TopFunctionsFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v1_types_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopFunctionsFilter.ProtoReflect.Descriptor instead.
+func (*TopFunctionsFilter) Descriptor() ([]byte, []int) {
+	return file_types_v1_types_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TopFunctionsFilter) GetFunctionName() string {
+	if x != nil {
+		return x.FunctionName
+	}
+	return ""
+}
+
+func (x *TopFunctionsFilter) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
 type GetProfileStatsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -912,7 +1365,7 @@ type GetProfileStatsRequest struct {
 func (x *GetProfileStatsRequest) Reset() {
 	*x = GetProfileStatsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v1_types_proto_msgTypes[14]
+		mi := &file_types_v1_types_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -925,7 +1378,7 @@ func (x *GetProfileStatsRequest) String() string {
 func (*GetProfileStatsRequest) ProtoMessage() {}
 
 func (x *GetProfileStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1_types_proto_msgTypes[14]
+	mi := &file_types_v1_types_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -938,7 +1391,7 @@ func (x *GetProfileStatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProfileStatsRequest.ProtoReflect.Descriptor instead.
 func (*GetProfileStatsRequest) Descriptor() ([]byte, []int) {
-	return file_types_v1_types_proto_rawDescGZIP(), []int{14}
+	return file_types_v1_types_proto_rawDescGZIP(), []int{18}
 }
 
 type GetProfileStatsResponse struct {
@@ -957,7 +1410,7 @@ type GetProfileStatsResponse struct {
 func (x *GetProfileStatsResponse) Reset() {
 	*x = GetProfileStatsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v1_types_proto_msgTypes[15]
+		mi := &file_types_v1_types_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -970,7 +1423,7 @@ func (x *GetProfileStatsResponse) String() string {
 func (*GetProfileStatsResponse) ProtoMessage() {}
 
 func (x *GetProfileStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v1_types_proto_msgTypes[15]
+	mi := &file_types_v1_types_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -983,7 +1436,7 @@ func (x *GetProfileStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProfileStatsResponse.ProtoReflect.Descriptor instead.
 func (*GetProfileStatsResponse) Descriptor() ([]byte, []int) {
-	return file_types_v1_types_proto_rawDescGZIP(), []int{15}
+	return file_types_v1_types_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetProfileStatsResponse) GetDataIngested() bool {
@@ -1076,50 +1529,112 @@ var file_types_v1_types_proto_rawDesc = []byte{
 	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73,
 	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
 	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73,
-	0x22, 0x6d, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x53, 0x65,
-	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2f, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x73,
-	0x69, 0x74, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x63,
-	0x61, 0x6c, 0x6c, 0x53, 0x69, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x67, 0x6f, 0x5f, 0x70, 0x67,
-	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x6f, 0x50, 0x47, 0x4f, 0x52, 0x05, 0x67, 0x6f, 0x50, 0x67, 0x6f, 0x22,
-	0x1e, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
-	0x5b, 0x0a, 0x05, 0x47, 0x6f, 0x50, 0x47, 0x4f, 0x12, 0x25, 0x0a, 0x0e, 0x6b, 0x65, 0x65, 0x70,
-	0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0d, 0x6b, 0x65, 0x65, 0x70, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x2b, 0x0a, 0x11, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x61, 0x6c,
-	0x6c, 0x65, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x61, 0x67, 0x67, 0x72,
-	0x65, 0x67, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x73, 0x22, 0x18, 0x0a, 0x16,
-	0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9e, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x69, 0x6e, 0x67, 0x65, 0x73,
-	0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x49,
-	0x6e, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x6f, 0x6c, 0x64, 0x65, 0x73,
-	0x74, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x6e, 0x65, 0x77, 0x65, 0x73,
-	0x74, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6e, 0x65, 0x77, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x2a, 0x6b, 0x0a, 0x19, 0x54, 0x69, 0x6d, 0x65, 0x53,
-	0x65, 0x72, 0x69, 0x65, 0x73, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x20, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x53, 0x45, 0x52,
-	0x49, 0x45, 0x53, 0x5f, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x55, 0x4d, 0x10, 0x00, 0x12, 0x28, 0x0a, 0x24, 0x54, 0x49,
-	0x4d, 0x45, 0x5f, 0x53, 0x45, 0x52, 0x49, 0x45, 0x53, 0x5f, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47,
-	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x56, 0x45, 0x52, 0x41,
-	0x47, 0x45, 0x10, 0x01, 0x42, 0x9b, 0x01, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x67, 0x72, 0x61, 0x66, 0x61, 0x6e, 0x61, 0x2f, 0x70, 0x79, 0x72, 0x6f, 0x73, 0x63, 0x6f, 0x70,
-	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x67, 0x6f, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x54, 0x58, 0x58, 0xaa, 0x02, 0x08, 0x54, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x08, 0x54, 0x79, 0x70, 0x65, 0x73, 0x5c, 0x56, 0x31, 0xe2,
-	0x02, 0x14, 0x54, 0x79, 0x70, 0x65, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x09, 0x54, 0x79, 0x70, 0x65, 0x73, 0x3a, 0x3a,
-	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x22, 0xdf, 0x01, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2f, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x5f,
+	0x73, 0x69, 0x74, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08,
+	0x63, 0x61, 0x6c, 0x6c, 0x53, 0x69, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x67, 0x6f, 0x5f, 0x70,
+	0x67, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6f, 0x50, 0x47, 0x4f, 0x52, 0x05, 0x67, 0x6f, 0x50, 0x67, 0x6f,
+	0x12, 0x36, 0x0a, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x75,
+	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x08,
+	0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x0c, 0x66, 0x72, 0x61, 0x6d,
+	0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x22, 0x8b, 0x01, 0x0a, 0x0b, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x63, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x66, 0x6f, 0x63, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x67, 0x6e, 0x6f,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x68, 0x69, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x77, 0x5f, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x77, 0x46, 0x72, 0x6f,
+	0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x46, 0x72, 0x6f, 0x6d,
+	0x22, 0x50, 0x0a, 0x10, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x67, 0x65,
+	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x65, 0x6c, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x65,
+	0x6c, 0x66, 0x22, 0x1e, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x5b, 0x0a, 0x05, 0x47, 0x6f, 0x50, 0x47, 0x4f, 0x12, 0x25, 0x0a, 0x0e, 0x6b,
+	0x65, 0x65, 0x70, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6b, 0x65, 0x65, 0x70, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f,
+	0x63, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x61,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x65, 0x73, 0x22,
+	0x7b, 0x0a, 0x0b, 0x54, 0x6f, 0x70, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6c, 0x69,
+	0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x6c, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x73, 0x65, 0x6c, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x55, 0x0a, 0x12,
+	0x54, 0x6f, 0x70, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x75, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9e, 0x01,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x61, 0x74,
+	0x61, 0x5f, 0x69, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x2e,
+	0x0a, 0x13, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6f, 0x6c, 0x64,
+	0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2e,
+	0x0a, 0x13, 0x6e, 0x65, 0x77, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6e, 0x65, 0x77,
+	0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x2a, 0xd1,
+	0x02, 0x0a, 0x19, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x20,
+	0x54, 0x49, 0x4d, 0x45, 0x5f, 0x53, 0x45, 0x52, 0x49, 0x45, 0x53, 0x5f, 0x41, 0x47, 0x47, 0x52,
+	0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x55, 0x4d,
+	0x10, 0x00, 0x12, 0x28, 0x0a, 0x24, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x53, 0x45, 0x52, 0x49, 0x45,
+	0x53, 0x5f, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x41, 0x56, 0x45, 0x52, 0x41, 0x47, 0x45, 0x10, 0x01, 0x12, 0x24, 0x0a, 0x20,
+	0x54, 0x49, 0x4d, 0x45, 0x5f, 0x53, 0x45, 0x52, 0x49, 0x45, 0x53, 0x5f, 0x41, 0x47, 0x47, 0x52,
+	0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x49, 0x4e,
+	0x10, 0x02, 0x12, 0x24, 0x0a, 0x20, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x53, 0x45, 0x52, 0x49, 0x45,
+	0x53, 0x5f, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x4d, 0x41, 0x58, 0x10, 0x03, 0x12, 0x26, 0x0a, 0x22, 0x54, 0x49, 0x4d, 0x45,
+	0x5f, 0x53, 0x45, 0x52, 0x49, 0x45, 0x53, 0x5f, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x04,
+	0x12, 0x24, 0x0a, 0x20, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x53, 0x45, 0x52, 0x49, 0x45, 0x53, 0x5f,
+	0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x50, 0x35, 0x30, 0x10, 0x05, 0x12, 0x24, 0x0a, 0x20, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x53,
+	0x45, 0x52, 0x49, 0x45, 0x53, 0x5f, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x39, 0x30, 0x10, 0x06, 0x12, 0x24, 0x0a, 0x20,
+	0x54, 0x49, 0x4d, 0x45, 0x5f, 0x53, 0x45, 0x52, 0x49, 0x45, 0x53, 0x5f, 0x41, 0x47, 0x47, 0x52,
+	0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x39, 0x39,
+	0x10, 0x07, 0x2a, 0x52, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x45, 0x52, 0x49, 0x45, 0x53, 0x5f, 0x4f, 0x52, 0x44, 0x45,
+	0x52, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x45, 0x52,
+	0x49, 0x45, 0x53, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x58, 0x10, 0x01, 0x12,
+	0x15, 0x0a, 0x11, 0x53, 0x45, 0x52, 0x49, 0x45, 0x53, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f,
+	0x4c, 0x41, 0x53, 0x54, 0x10, 0x02, 0x2a, 0x7d, 0x0a, 0x14, 0x54, 0x6f, 0x70, 0x46, 0x75, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x23,
+	0x0a, 0x1f, 0x54, 0x4f, 0x50, 0x5f, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x5f,
+	0x47, 0x52, 0x4f, 0x55, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x4f, 0x50, 0x5f, 0x46, 0x55, 0x4e, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x53, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x46, 0x49,
+	0x4c, 0x45, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x4f, 0x50, 0x5f, 0x46, 0x55, 0x4e, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x53, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x4c,
+	0x49, 0x4e, 0x45, 0x10, 0x02, 0x42, 0x9b, 0x01, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x67, 0x72, 0x61, 0x66, 0x61, 0x6e, 0x61, 0x2f, 0x70, 0x79, 0x72, 0x6f, 0x73, 0x63, 0x6f,
+	0x70, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x67, 0x6f, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x54, 0x58, 0x58, 0xaa, 0x02, 0x08, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x08, 0x54, 0x79, 0x70, 0x65, 0x73, 0x5c, 0x56, 0x31,
+	0xe2, 0x02, 0x14, 0x54, 0x79, 0x70, 0x65, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x09, 0x54, 0x79, 0x70, 0x65, 0x73, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1134,40 +1649,48 @@ func file_types_v1_types_proto_rawDescGZIP() []byte {
 	return file_types_v1_types_proto_rawDescData
 }
 
-var file_types_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_types_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_types_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_types_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_types_v1_types_proto_goTypes = []interface{}{
 	(TimeSeriesAggregationType)(0),  // 0: types.v1.TimeSeriesAggregationType
-	(*LabelPair)(nil),               // 1: types.v1.LabelPair
-	(*ProfileType)(nil),             // 2: types.v1.ProfileType
-	(*Labels)(nil),                  // 3: types.v1.Labels
-	(*Series)(nil),                  // 4: types.v1.Series
-	(*Point)(nil),                   // 5: types.v1.Point
-	(*LabelValuesRequest)(nil),      // 6: types.v1.LabelValuesRequest
-	(*LabelValuesResponse)(nil),     // 7: types.v1.LabelValuesResponse
-	(*LabelNamesRequest)(nil),       // 8: types.v1.LabelNamesRequest
-	(*LabelNamesResponse)(nil),      // 9: types.v1.LabelNamesResponse
-	(*BlockInfo)(nil),               // 10: types.v1.BlockInfo
-	(*BlockCompaction)(nil),         // 11: types.v1.BlockCompaction
-	(*StackTraceSelector)(nil),      // 12: types.v1.StackTraceSelector
-	(*Location)(nil),                // 13: types.v1.Location
-	(*GoPGO)(nil),                   // 14: types.v1.GoPGO
-	(*GetProfileStatsRequest)(nil),  // 15: types.v1.GetProfileStatsRequest
-	(*GetProfileStatsResponse)(nil), // 16: types.v1.GetProfileStatsResponse
+	(SeriesOrder)(0),                // 1: types.v1.SeriesOrder
+	(TopFunctionsGrouping)(0),       // 2: types.v1.TopFunctionsGrouping
+	(*LabelPair)(nil),               // 3: types.v1.LabelPair
+	(*ProfileType)(nil),             // 4: types.v1.ProfileType
+	(*Labels)(nil),                  // 5: types.v1.Labels
+	(*Series)(nil),                  // 6: types.v1.Series
+	(*Point)(nil),                   // 7: types.v1.Point
+	(*LabelValuesRequest)(nil),      // 8: types.v1.LabelValuesRequest
+	(*LabelValuesResponse)(nil),     // 9: types.v1.LabelValuesResponse
+	(*LabelNamesRequest)(nil),       // 10: types.v1.LabelNamesRequest
+	(*LabelNamesResponse)(nil),      // 11: types.v1.LabelNamesResponse
+	(*BlockInfo)(nil),               // 12: types.v1.BlockInfo
+	(*BlockCompaction)(nil),         // 13: types.v1.BlockCompaction
+	(*StackTraceSelector)(nil),      // 14: types.v1.StackTraceSelector
+	(*FrameFilter)(nil),             // 15: types.v1.FrameFilter
+	(*FunctionSelector)(nil),        // 16: types.v1.FunctionSelector
+	(*Location)(nil),                // 17: types.v1.Location
+	(*GoPGO)(nil),                   // 18: types.v1.GoPGO
+	(*TopFunction)(nil),             // 19: types.v1.TopFunction
+	(*TopFunctionsFilter)(nil),      // 20: types.v1.TopFunctionsFilter
+	(*GetProfileStatsRequest)(nil),  // 21: types.v1.GetProfileStatsRequest
+	(*GetProfileStatsResponse)(nil), // 22: types.v1.GetProfileStatsResponse
 }
 var file_types_v1_types_proto_depIdxs = []int32{
-	1,  // 0: types.v1.Labels.labels:type_name -> types.v1.LabelPair
-	1,  // 1: types.v1.Series.labels:type_name -> types.v1.LabelPair
-	5,  // 2: types.v1.Series.points:type_name -> types.v1.Point
-	11, // 3: types.v1.BlockInfo.compaction:type_name -> types.v1.BlockCompaction
-	1,  // 4: types.v1.BlockInfo.labels:type_name -> types.v1.LabelPair
-	13, // 5: types.v1.StackTraceSelector.call_site:type_name -> types.v1.Location
-	14, // 6: types.v1.StackTraceSelector.go_pgo:type_name -> types.v1.GoPGO
-	7,  // [7:7] is the sub-list for method output_type
-	7,  // [7:7] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	3,  // 0: types.v1.Labels.labels:type_name -> types.v1.LabelPair
+	3,  // 1: types.v1.Series.labels:type_name -> types.v1.LabelPair
+	7,  // 2: types.v1.Series.points:type_name -> types.v1.Point
+	13, // 3: types.v1.BlockInfo.compaction:type_name -> types.v1.BlockCompaction
+	3,  // 4: types.v1.BlockInfo.labels:type_name -> types.v1.LabelPair
+	17, // 5: types.v1.StackTraceSelector.call_site:type_name -> types.v1.Location
+	18, // 6: types.v1.StackTraceSelector.go_pgo:type_name -> types.v1.GoPGO
+	16, // 7: types.v1.StackTraceSelector.function:type_name -> types.v1.FunctionSelector
+	15, // 8: types.v1.StackTraceSelector.frame_filter:type_name -> types.v1.FrameFilter
+	9,  // [9:9] is the sub-list for method output_type
+	9,  // [9:9] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_types_v1_types_proto_init() }
@@ -1321,7 +1844,7 @@ func file_types_v1_types_proto_init() {
 			}
 		}
 		file_types_v1_types_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Location); i {
+			switch v := v.(*FrameFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1333,7 +1856,7 @@ func file_types_v1_types_proto_init() {
 			}
 		}
 		file_types_v1_types_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GoPGO); i {
+			switch v := v.(*FunctionSelector); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1345,7 +1868,7 @@ func file_types_v1_types_proto_init() {
 			}
 		}
 		file_types_v1_types_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProfileStatsRequest); i {
+			switch v := v.(*Location); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1357,6 +1880,54 @@ func file_types_v1_types_proto_init() {
 			}
 		}
 		file_types_v1_types_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GoPGO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v1_types_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopFunction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v1_types_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopFunctionsFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v1_types_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProfileStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v1_types_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetProfileStatsResponse); i {
 			case 0:
 				return &v.state
@@ -1374,8 +1945,8 @@ func file_types_v1_types_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_types_v1_types_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   16,
+			NumEnums:      3,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
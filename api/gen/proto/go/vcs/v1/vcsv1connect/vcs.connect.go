@@ -33,6 +33,12 @@ const (
 // reflection-formatted method names, remove the leading slash and convert the remaining slash to a
 // period.
 const (
+	// VCSServiceAppProcedure is the fully-qualified name of the VCSService's App RPC.
+	VCSServiceAppProcedure = "/vcs.v1.VCSService/App"
+	// VCSServiceLoginProcedure is the fully-qualified name of the VCSService's Login RPC.
+	VCSServiceLoginProcedure = "/vcs.v1.VCSService/Login"
+	// VCSServiceRefreshProcedure is the fully-qualified name of the VCSService's Refresh RPC.
+	VCSServiceRefreshProcedure = "/vcs.v1.VCSService/Refresh"
 	// VCSServiceGithubAppProcedure is the fully-qualified name of the VCSService's GithubApp RPC.
 	VCSServiceGithubAppProcedure = "/vcs.v1.VCSService/GithubApp"
 	// VCSServiceGithubLoginProcedure is the fully-qualified name of the VCSService's GithubLogin RPC.
@@ -49,6 +55,9 @@ const (
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
 	vCSServiceServiceDescriptor             = v1.File_vcs_v1_vcs_proto.Services().ByName("VCSService")
+	vCSServiceAppMethodDescriptor           = vCSServiceServiceDescriptor.Methods().ByName("App")
+	vCSServiceLoginMethodDescriptor         = vCSServiceServiceDescriptor.Methods().ByName("Login")
+	vCSServiceRefreshMethodDescriptor       = vCSServiceServiceDescriptor.Methods().ByName("Refresh")
 	vCSServiceGithubAppMethodDescriptor     = vCSServiceServiceDescriptor.Methods().ByName("GithubApp")
 	vCSServiceGithubLoginMethodDescriptor   = vCSServiceServiceDescriptor.Methods().ByName("GithubLogin")
 	vCSServiceGithubRefreshMethodDescriptor = vCSServiceServiceDescriptor.Methods().ByName("GithubRefresh")
@@ -58,8 +67,17 @@ var (
 
 // VCSServiceClient is a client for the vcs.v1.VCSService service.
 type VCSServiceClient interface {
+	// App returns the OAuth app of the provider hosting the repository.
+	App(context.Context, *connect.Request[v1.AppRequest]) (*connect.Response[v1.AppResponse], error)
+	// Login exchanges the OAuth authorization code for a session cookie.
+	Login(context.Context, *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error)
+	// Refresh refreshes the OAuth token of the session cookie.
+	Refresh(context.Context, *connect.Request[v1.RefreshRequest]) (*connect.Response[v1.RefreshResponse], error)
+	// GithubApp is equivalent to App for GitHub repositories.
 	GithubApp(context.Context, *connect.Request[v1.GithubAppRequest]) (*connect.Response[v1.GithubAppResponse], error)
+	// GithubLogin is equivalent to Login for GitHub repositories.
 	GithubLogin(context.Context, *connect.Request[v1.GithubLoginRequest]) (*connect.Response[v1.GithubLoginResponse], error)
+	// GithubRefresh is equivalent to Refresh for GitHub repositories.
 	GithubRefresh(context.Context, *connect.Request[v1.GithubRefreshRequest]) (*connect.Response[v1.GithubRefreshResponse], error)
 	GetFile(context.Context, *connect.Request[v1.GetFileRequest]) (*connect.Response[v1.GetFileResponse], error)
 	GetCommit(context.Context, *connect.Request[v1.GetCommitRequest]) (*connect.Response[v1.GetCommitResponse], error)
@@ -75,6 +93,24 @@ type VCSServiceClient interface {
 func NewVCSServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) VCSServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &vCSServiceClient{
+		app: connect.NewClient[v1.AppRequest, v1.AppResponse](
+			httpClient,
+			baseURL+VCSServiceAppProcedure,
+			connect.WithSchema(vCSServiceAppMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		login: connect.NewClient[v1.LoginRequest, v1.LoginResponse](
+			httpClient,
+			baseURL+VCSServiceLoginProcedure,
+			connect.WithSchema(vCSServiceLoginMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		refresh: connect.NewClient[v1.RefreshRequest, v1.RefreshResponse](
+			httpClient,
+			baseURL+VCSServiceRefreshProcedure,
+			connect.WithSchema(vCSServiceRefreshMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		githubApp: connect.NewClient[v1.GithubAppRequest, v1.GithubAppResponse](
 			httpClient,
 			baseURL+VCSServiceGithubAppProcedure,
@@ -110,6 +146,9 @@ func NewVCSServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 
 // vCSServiceClient implements VCSServiceClient.
 type vCSServiceClient struct {
+	app           *connect.Client[v1.AppRequest, v1.AppResponse]
+	login         *connect.Client[v1.LoginRequest, v1.LoginResponse]
+	refresh       *connect.Client[v1.RefreshRequest, v1.RefreshResponse]
 	githubApp     *connect.Client[v1.GithubAppRequest, v1.GithubAppResponse]
 	githubLogin   *connect.Client[v1.GithubLoginRequest, v1.GithubLoginResponse]
 	githubRefresh *connect.Client[v1.GithubRefreshRequest, v1.GithubRefreshResponse]
@@ -117,6 +156,21 @@ type vCSServiceClient struct {
 	getCommit     *connect.Client[v1.GetCommitRequest, v1.GetCommitResponse]
 }
 
+// App calls vcs.v1.VCSService.App.
+func (c *vCSServiceClient) App(ctx context.Context, req *connect.Request[v1.AppRequest]) (*connect.Response[v1.AppResponse], error) {
+	return c.app.CallUnary(ctx, req)
+}
+
+// Login calls vcs.v1.VCSService.Login.
+func (c *vCSServiceClient) Login(ctx context.Context, req *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error) {
+	return c.login.CallUnary(ctx, req)
+}
+
+// Refresh calls vcs.v1.VCSService.Refresh.
+func (c *vCSServiceClient) Refresh(ctx context.Context, req *connect.Request[v1.RefreshRequest]) (*connect.Response[v1.RefreshResponse], error) {
+	return c.refresh.CallUnary(ctx, req)
+}
+
 // GithubApp calls vcs.v1.VCSService.GithubApp.
 func (c *vCSServiceClient) GithubApp(ctx context.Context, req *connect.Request[v1.GithubAppRequest]) (*connect.Response[v1.GithubAppResponse], error) {
 	return c.githubApp.CallUnary(ctx, req)
@@ -144,8 +198,17 @@ func (c *vCSServiceClient) GetCommit(ctx context.Context, req *connect.Request[v
 
 // VCSServiceHandler is an implementation of the vcs.v1.VCSService service.
 type VCSServiceHandler interface {
+	// App returns the OAuth app of the provider hosting the repository.
+	App(context.Context, *connect.Request[v1.AppRequest]) (*connect.Response[v1.AppResponse], error)
+	// Login exchanges the OAuth authorization code for a session cookie.
+	Login(context.Context, *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error)
+	// Refresh refreshes the OAuth token of the session cookie.
+	Refresh(context.Context, *connect.Request[v1.RefreshRequest]) (*connect.Response[v1.RefreshResponse], error)
+	// GithubApp is equivalent to App for GitHub repositories.
 	GithubApp(context.Context, *connect.Request[v1.GithubAppRequest]) (*connect.Response[v1.GithubAppResponse], error)
+	// GithubLogin is equivalent to Login for GitHub repositories.
 	GithubLogin(context.Context, *connect.Request[v1.GithubLoginRequest]) (*connect.Response[v1.GithubLoginResponse], error)
+	// GithubRefresh is equivalent to Refresh for GitHub repositories.
 	GithubRefresh(context.Context, *connect.Request[v1.GithubRefreshRequest]) (*connect.Response[v1.GithubRefreshResponse], error)
 	GetFile(context.Context, *connect.Request[v1.GetFileRequest]) (*connect.Response[v1.GetFileResponse], error)
 	GetCommit(context.Context, *connect.Request[v1.GetCommitRequest]) (*connect.Response[v1.GetCommitResponse], error)
@@ -157,6 +220,24 @@ type VCSServiceHandler interface {
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
 func NewVCSServiceHandler(svc VCSServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	vCSServiceAppHandler := connect.NewUnaryHandler(
+		VCSServiceAppProcedure,
+		svc.App,
+		connect.WithSchema(vCSServiceAppMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	vCSServiceLoginHandler := connect.NewUnaryHandler(
+		VCSServiceLoginProcedure,
+		svc.Login,
+		connect.WithSchema(vCSServiceLoginMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	vCSServiceRefreshHandler := connect.NewUnaryHandler(
+		VCSServiceRefreshProcedure,
+		svc.Refresh,
+		connect.WithSchema(vCSServiceRefreshMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	vCSServiceGithubAppHandler := connect.NewUnaryHandler(
 		VCSServiceGithubAppProcedure,
 		svc.GithubApp,
@@ -189,6 +270,12 @@ func NewVCSServiceHandler(svc VCSServiceHandler, opts ...connect.HandlerOption)
 	)
 	return "/vcs.v1.VCSService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
+		case VCSServiceAppProcedure:
+			vCSServiceAppHandler.ServeHTTP(w, r)
+		case VCSServiceLoginProcedure:
+			vCSServiceLoginHandler.ServeHTTP(w, r)
+		case VCSServiceRefreshProcedure:
+			vCSServiceRefreshHandler.ServeHTTP(w, r)
 		case VCSServiceGithubAppProcedure:
 			vCSServiceGithubAppHandler.ServeHTTP(w, r)
 		case VCSServiceGithubLoginProcedure:
@@ -208,6 +295,18 @@ func NewVCSServiceHandler(svc VCSServiceHandler, opts ...connect.HandlerOption)
 // UnimplementedVCSServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedVCSServiceHandler struct{}
 
+func (UnimplementedVCSServiceHandler) App(context.Context, *connect.Request[v1.AppRequest]) (*connect.Response[v1.AppResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vcs.v1.VCSService.App is not implemented"))
+}
+
+func (UnimplementedVCSServiceHandler) Login(context.Context, *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vcs.v1.VCSService.Login is not implemented"))
+}
+
+func (UnimplementedVCSServiceHandler) Refresh(context.Context, *connect.Request[v1.RefreshRequest]) (*connect.Response[v1.RefreshResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vcs.v1.VCSService.Refresh is not implemented"))
+}
+
 func (UnimplementedVCSServiceHandler) GithubApp(context.Context, *connect.Request[v1.GithubAppRequest]) (*connect.Response[v1.GithubAppResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vcs.v1.VCSService.GithubApp is not implemented"))
 }
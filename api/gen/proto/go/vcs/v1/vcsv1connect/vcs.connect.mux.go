@@ -19,6 +19,21 @@ const _ = connect.IsAtLeastVersion0_1_0
 // RegisterVCSServiceHandler register an HTTP handler to a mux.Router from the service
 // implementation.
 func RegisterVCSServiceHandler(mux *mux.Router, svc VCSServiceHandler, opts ...connect.HandlerOption) {
+	mux.Handle("/vcs.v1.VCSService/App", connect.NewUnaryHandler(
+		"/vcs.v1.VCSService/App",
+		svc.App,
+		opts...,
+	))
+	mux.Handle("/vcs.v1.VCSService/Login", connect.NewUnaryHandler(
+		"/vcs.v1.VCSService/Login",
+		svc.Login,
+		opts...,
+	))
+	mux.Handle("/vcs.v1.VCSService/Refresh", connect.NewUnaryHandler(
+		"/vcs.v1.VCSService/Refresh",
+		svc.Refresh,
+		opts...,
+	))
 	mux.Handle("/vcs.v1.VCSService/GithubApp", connect.NewUnaryHandler(
 		"/vcs.v1.VCSService/GithubApp",
 		svc.GithubApp,
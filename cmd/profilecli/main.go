@@ -75,6 +75,12 @@ func main() {
 	querySeriesParams := addQuerySeriesParams(querySeriesCmd)
 	queryLabelValuesCardinalityCmd := queryCmd.Command("label-values-cardinality", "Request label values cardinality.")
 	queryLabelValuesCardinalityParams := addQueryLabelValuesCardinalityParams(queryLabelValuesCardinalityCmd)
+	queryDiffCmd := queryCmd.Command("diff", "Request diff of two profiles.")
+	queryDiffOutput := queryDiffCmd.Flag("output", "How to output the result, examples: console, json, collapsed, pprof=./diff.pprof").Default("console").String()
+	queryDiffParams := addQueryDiffParams(queryDiffCmd)
+	queryFlameGraphCmd := queryCmd.Command("flamegraph", "Request merged profile and render it as a flame graph.")
+	queryFlameGraphOutput := queryFlameGraphCmd.Flag("output", "Where to write the flame graph, examples: html=./flamegraph.html, svg=./flamegraph.svg").Default("html=./flamegraph.html").String()
+	queryFlameGraphParams := addQueryFlameGraphParams(queryFlameGraphCmd)
 
 	queryTracerCmd := app.Command("query-tracer", "Analyze query traces.")
 	queryTracerParams := addQueryTracerParams(queryTracerCmd)
@@ -130,6 +136,16 @@ func main() {
 			os.Exit(checkError(err))
 		}
 
+	case queryDiffCmd.FullCommand():
+		if err := queryDiff(ctx, queryDiffParams, *queryDiffOutput); err != nil {
+			os.Exit(checkError(err))
+		}
+
+	case queryFlameGraphCmd.FullCommand():
+		if err := queryFlameGraph(ctx, queryFlameGraphParams, *queryFlameGraphOutput); err != nil {
+			os.Exit(checkError(err))
+		}
+
 	case queryTracerCmd.FullCommand():
 		if err := queryTracer(ctx, queryTracerParams); err != nil {
 			os.Exit(checkError(err))
@@ -30,13 +30,18 @@ import (
 	"github.com/grafana/pyroscope/api/gen/proto/go/storegateway/v1/storegatewayv1connect"
 	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
 	connectapi "github.com/grafana/pyroscope/pkg/api/connect"
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
 	"github.com/grafana/pyroscope/pkg/operations"
 )
 
 const (
-	outputConsole = "console"
-	outputRaw     = "raw"
-	outputPprof   = "pprof="
+	outputConsole   = "console"
+	outputRaw       = "raw"
+	outputPprof     = "pprof="
+	outputJSON      = "json"
+	outputCollapsed = "collapsed"
+	outputSVG       = "svg="
+	outputHTML      = "html="
 )
 
 func (c *phlareClient) queryClient() querierv1connect.QuerierServiceClient {
@@ -418,3 +423,273 @@ func queryLabelValuesCardinality(ctx context.Context, params *queryLabelValuesCa
 
 	return nil
 }
+
+var diffNormalizations = map[string]querierv1.DiffNormalization{
+	"none":          querierv1.DiffNormalization_DIFF_NORMALIZATION_NONE,
+	"total":         querierv1.DiffNormalization_DIFF_NORMALIZATION_TOTAL,
+	"profile-count": querierv1.DiffNormalization_DIFF_NORMALIZATION_PROFILE_COUNT,
+	"time-range":    querierv1.DiffNormalization_DIFF_NORMALIZATION_TIME_RANGE,
+}
+
+type queryDiffParams struct {
+	*phlareClient
+	Left                 queryParams
+	Right                queryParams
+	ProfileType          string
+	Normalization        string
+	SignificanceProfiles int64
+	TopN                 uint64
+}
+
+func addQueryDiffParams(queryCmd commander) *queryDiffParams {
+	params := new(queryDiffParams)
+	params.phlareClient = addPhlareClient(queryCmd)
+	queryCmd.Flag("left-from", "Beginning of the left (baseline) query.").Default("now-2h").StringVar(&params.Left.From)
+	queryCmd.Flag("left-to", "End of the left (baseline) query.").Default("now-1h").StringVar(&params.Left.To)
+	queryCmd.Flag("left-query", "Label selector of the left (baseline) query.").Default("{}").StringVar(&params.Left.Query)
+	queryCmd.Flag("right-from", "Beginning of the right (comparison) query.").Default("now-1h").StringVar(&params.Right.From)
+	queryCmd.Flag("right-to", "End of the right (comparison) query.").Default("now").StringVar(&params.Right.To)
+	queryCmd.Flag("right-query", "Label selector of the right (comparison) query.").Default("{}").StringVar(&params.Right.Query)
+	queryCmd.Flag("profile-type", "Profile type to query.").Default("process_cpu:cpu:nanoseconds:cpu:nanoseconds").StringVar(&params.ProfileType)
+	queryCmd.Flag("normalization", "How to normalize the left and right profiles (none, total, profile-count, time-range).").Default("none").EnumVar(&params.Normalization, "none", "total", "profile-count", "time-range")
+	queryCmd.Flag("significance-profiles", "Maximum number of the most recent individual profiles of each side used to estimate the significance of the changes. Zero disables the estimation.").Default("0").Int64Var(&params.SignificanceProfiles)
+	queryCmd.Flag("top-n", "Show the top N functions with the largest change in the console output.").Default("20").Uint64Var(&params.TopN)
+	return params
+}
+
+func queryDiff(ctx context.Context, params *queryDiffParams, outputFlag string) (err error) {
+	leftFrom, leftTo, err := params.Left.parseFromTo()
+	if err != nil {
+		return errors.Wrap(err, "left")
+	}
+	rightFrom, rightTo, err := params.Right.parseFromTo()
+	if err != nil {
+		return errors.Wrap(err, "right")
+	}
+	profileType, err := phlaremodel.ParseProfileTypeSelector(params.ProfileType)
+	if err != nil {
+		return err
+	}
+	level.Info(logger).Log("msg", "query profile diff",
+		"url", params.URL,
+		"type", params.ProfileType,
+		"left-query", params.Left.Query, "left-from", leftFrom, "left-to", leftTo,
+		"right-query", params.Right.Query, "right-from", rightFrom, "right-to", rightTo,
+		"normalization", params.Normalization,
+		"significance-profiles", params.SignificanceProfiles,
+		"output", outputFlag,
+	)
+
+	qc := params.phlareClient.queryClient()
+	resp, err := qc.Diff(ctx, connect.NewRequest(&querierv1.DiffRequest{
+		Left: &querierv1.SelectMergeStacktracesRequest{
+			ProfileTypeID: params.ProfileType,
+			LabelSelector: params.Left.Query,
+			Start:         leftFrom.UnixMilli(),
+			End:           leftTo.UnixMilli(),
+		},
+		Right: &querierv1.SelectMergeStacktracesRequest{
+			ProfileTypeID: params.ProfileType,
+			LabelSelector: params.Right.Query,
+			Start:         rightFrom.UnixMilli(),
+			End:           rightTo.UnixMilli(),
+		},
+		Normalization:        diffNormalizations[params.Normalization],
+		SignificanceProfiles: params.SignificanceProfiles,
+	}))
+	if err != nil {
+		return errors.Wrap(err, "failed to query")
+	}
+
+	fg := resp.Msg.Flamegraph
+	switch {
+	case outputFlag == outputConsole:
+		return printDiffTop(ctx, fg, int(params.TopN))
+	case outputFlag == outputJSON:
+		return json.NewEncoder(output(ctx)).Encode(phlaremodel.ExportDiffToFlamebearer(fg, profileType))
+	case outputFlag == outputCollapsed:
+		// The format is compatible with difffolded.pl: "stack left right".
+		w := output(ctx)
+		phlaremodel.IterateFlameGraphDiff(fg, func(stack []string, left, right int64) {
+			_, _ = fmt.Fprintf(w, "%s %d %d\n", strings.Join(stack, ";"), left, right)
+		})
+		return nil
+	case strings.HasPrefix(outputFlag, outputPprof):
+		return writeFile(strings.TrimPrefix(outputFlag, outputPprof), func(w io.Writer) error {
+			return diffToPprof(fg, profileType).Write(w)
+		})
+	}
+
+	return errors.Errorf("unknown output %s", outputFlag)
+}
+
+// printDiffTop prints the functions with the largest absolute change
+// of the self value.
+func printDiffTop(ctx context.Context, fg *querierv1.FlameGraphDiff, n int) error {
+	type function struct {
+		name        string
+		left, right int64
+	}
+	functions := make(map[string]*function)
+	phlaremodel.IterateFlameGraphDiff(fg, func(stack []string, left, right int64) {
+		name := stack[len(stack)-1]
+		f, ok := functions[name]
+		if !ok {
+			f = &function{name: name}
+			functions[name] = f
+		}
+		f.left += left
+		f.right += right
+	})
+	result := make([]*function, 0, len(functions))
+	for _, f := range functions {
+		result = append(result, f)
+	}
+	abs := func(v int64) int64 {
+		if v < 0 {
+			return -v
+		}
+		return v
+	}
+	sort.Slice(result, func(i, j int) bool {
+		di, dj := abs(result[i].right-result[i].left), abs(result[j].right-result[j].left)
+		if di != dj {
+			return di > dj
+		}
+		return result[i].name < result[j].name
+	})
+	if len(result) > n {
+		result = result[:n]
+	}
+
+	table := tablewriter.NewWriter(output(ctx))
+	table.SetHeader([]string{"Function", "Left", "Right", "Diff", "Change"})
+	for _, f := range result {
+		change := "new"
+		if f.left != 0 {
+			change = fmt.Sprintf("%+.2f%%", float64(f.right-f.left)*100/float64(f.left))
+		}
+		table.Append([]string{
+			f.name,
+			humanize.FormatInteger("#,###.", int(f.left)),
+			humanize.FormatInteger("#,###.", int(f.right)),
+			humanize.FormatInteger("#,###.", int(f.right-f.left)),
+			change,
+		})
+	}
+	table.Render()
+	return nil
+}
+
+// diffToPprof converts the diff to a pprof profile in the form produced by
+// "pprof -diff_base": left samples are negated and labeled as the base.
+func diffToPprof(fg *querierv1.FlameGraphDiff, profileType *typesv1.ProfileType) *gprofile.Profile {
+	p := &gprofile.Profile{
+		SampleType: []*gprofile.ValueType{{Type: profileType.SampleType, Unit: profileType.SampleUnit}},
+		PeriodType: &gprofile.ValueType{Type: profileType.PeriodType, Unit: profileType.PeriodUnit},
+	}
+	locations := make(map[string]*gprofile.Location)
+	location := func(name string) *gprofile.Location {
+		loc, ok := locations[name]
+		if !ok {
+			fn := &gprofile.Function{ID: uint64(len(p.Function) + 1), Name: name}
+			p.Function = append(p.Function, fn)
+			loc = &gprofile.Location{ID: uint64(len(p.Location) + 1), Line: []gprofile.Line{{Function: fn}}}
+			p.Location = append(p.Location, loc)
+			locations[name] = loc
+		}
+		return loc
+	}
+	phlaremodel.IterateFlameGraphDiff(fg, func(stack []string, left, right int64) {
+		// Locations are ordered from the leaf to the root.
+		locs := make([]*gprofile.Location, len(stack))
+		for i, name := range stack {
+			locs[len(stack)-1-i] = location(name)
+		}
+		if left != 0 {
+			p.Sample = append(p.Sample, &gprofile.Sample{
+				Location: locs,
+				Value:    []int64{-left},
+				Label:    map[string][]string{"pprof::base": {"true"}},
+			})
+		}
+		if right != 0 {
+			p.Sample = append(p.Sample, &gprofile.Sample{Location: locs, Value: []int64{right}})
+		}
+	})
+	return p
+}
+
+// writeFile creates a new file and writes the content produced by fn.
+// It fails if the file already exists.
+func writeFile(filePath string, fn func(io.Writer) error) (err error) {
+	if filePath == "" {
+		return errors.New("no file path specified")
+	}
+	f, err := os.OpenFile(filePath, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return errors.Wrap(err, "failed to create file")
+	}
+	defer runutil.CloseWithErrCapture(&err, f, "failed to close file")
+	return fn(f)
+}
+
+type queryFlameGraphParams struct {
+	*queryParams
+	ProfileType string
+	MaxNodes    int64
+}
+
+func addQueryFlameGraphParams(queryCmd commander) *queryFlameGraphParams {
+	params := new(queryFlameGraphParams)
+	params.queryParams = addQueryParams(queryCmd)
+	queryCmd.Flag("profile-type", "Profile type to query.").Default("process_cpu:cpu:nanoseconds:cpu:nanoseconds").StringVar(&params.ProfileType)
+	queryCmd.Flag("max-nodes", "Maximum number of nodes in the flame graph.").Default("8192").Int64Var(&params.MaxNodes)
+	return params
+}
+
+func queryFlameGraph(ctx context.Context, params *queryFlameGraphParams, outputFlag string) (err error) {
+	from, to, err := params.parseFromTo()
+	if err != nil {
+		return err
+	}
+	profileType, err := phlaremodel.ParseProfileTypeSelector(params.ProfileType)
+	if err != nil {
+		return err
+	}
+	level.Info(logger).Log("msg", "query flame graph", "url", params.URL, "from", from, "to", to, "query", params.Query, "type", params.ProfileType, "output", outputFlag)
+
+	qc := params.phlareClient.queryClient()
+	resp, err := qc.SelectMergeStacktraces(ctx, connect.NewRequest(&querierv1.SelectMergeStacktracesRequest{
+		ProfileTypeID: params.ProfileType,
+		LabelSelector: params.Query,
+		Start:         from.UnixMilli(),
+		End:           to.UnixMilli(),
+		MaxNodes:      &params.MaxNodes,
+		Format:        querierv1.ProfileFormat_PROFILE_FORMAT_TREE,
+	}))
+	if err != nil {
+		return errors.Wrap(err, "failed to query")
+	}
+	tree, err := phlaremodel.UnmarshalTree(resp.Msg.Tree)
+	if err != nil {
+		return errors.Wrap(err, "failed to unmarshal tree")
+	}
+
+	opts := phlaremodel.FlameGraphSVGOptions{
+		Title: fmt.Sprintf("%s %s (%s - %s)", params.ProfileType, params.Query, from.Format(time.RFC3339), to.Format(time.RFC3339)),
+		Unit:  profileType.SampleUnit,
+	}
+	switch {
+	case strings.HasPrefix(outputFlag, outputSVG):
+		return writeFile(strings.TrimPrefix(outputFlag, outputSVG), func(w io.Writer) error {
+			return phlaremodel.WriteFlameGraphSVG(w, tree, opts)
+		})
+	case strings.HasPrefix(outputFlag, outputHTML):
+		return writeFile(strings.TrimPrefix(outputFlag, outputHTML), func(w io.Writer) error {
+			return phlaremodel.WriteFlameGraphHTML(w, tree, opts)
+		})
+	}
+
+	return errors.Errorf("unknown output %s", outputFlag)
+}
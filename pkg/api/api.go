@@ -26,6 +26,7 @@ import (
 
 	"github.com/grafana/pyroscope/api/gen/proto/go/adhocprofiles/v1/adhocprofilesv1connect"
 	"github.com/grafana/pyroscope/api/gen/proto/go/ingester/v1/ingesterv1connect"
+	"github.com/grafana/pyroscope/api/gen/proto/go/otlp/collector/profiles/v1experimental/v1experimentalconnect"
 	"github.com/grafana/pyroscope/api/gen/proto/go/push/v1/pushv1connect"
 	"github.com/grafana/pyroscope/api/gen/proto/go/querier/v1/querierv1connect"
 	"github.com/grafana/pyroscope/api/gen/proto/go/settings/v1/settingsv1connect"
@@ -37,13 +38,16 @@ import (
 	"github.com/grafana/pyroscope/pkg/adhocprofiles"
 	connectapi "github.com/grafana/pyroscope/pkg/api/connect"
 	"github.com/grafana/pyroscope/pkg/compactor"
+	"github.com/grafana/pyroscope/pkg/debuginfo"
 	"github.com/grafana/pyroscope/pkg/distributor"
 	"github.com/grafana/pyroscope/pkg/frontend"
 	"github.com/grafana/pyroscope/pkg/frontend/frontendpb/frontendpbconnect"
 	"github.com/grafana/pyroscope/pkg/ingester"
+	"github.com/grafana/pyroscope/pkg/ingester/otlp"
 	"github.com/grafana/pyroscope/pkg/ingester/pyroscope"
 	"github.com/grafana/pyroscope/pkg/operations"
 	"github.com/grafana/pyroscope/pkg/querier"
+	"github.com/grafana/pyroscope/pkg/ruler"
 	"github.com/grafana/pyroscope/pkg/scheduler"
 	"github.com/grafana/pyroscope/pkg/scheduler/schedulerpb/schedulerpbconnect"
 	"github.com/grafana/pyroscope/pkg/settings"
@@ -215,11 +219,15 @@ func (a *API) RegisterOverridesExporter(oe *exporter.OverridesExporter) {
 }
 
 // RegisterDistributor registers the endpoints associated with the distributor.
-func (a *API) RegisterDistributor(d *distributor.Distributor) {
+// The size of OTLP/HTTP requests is limited to maxMessageSize.
+func (a *API) RegisterDistributor(d *distributor.Distributor, maxMessageSize int) {
 	pyroscopeHandler := pyroscope.NewPyroscopeIngestHandler(d, a.logger)
 	a.RegisterRoute("/ingest", pyroscopeHandler, true, true, "POST")
 	a.RegisterRoute("/pyroscope/ingest", pyroscopeHandler, true, true, "POST")
 	pushv1connect.RegisterPusherServiceHandler(a.server.HTTP, d, a.connectOptionsAuthRecovery()...)
+	otlpHandler := otlp.NewOTLPIngestHandler(d, a.logger, maxMessageSize)
+	v1experimentalconnect.RegisterProfilesServiceHandler(a.server.HTTP, otlpHandler, a.connectOptionsAuthRecovery()...)
+	a.RegisterRoute(otlp.HTTPPath, otlpHandler, true, true, "POST")
 	a.RegisterRoute("/distributor/ring", d, false, true, "GET", "POST")
 	a.indexPage.AddLinks(defaultWeight, "Distributor", []IndexPageLink{
 		{Desc: "Ring status", Path: "/distributor/ring"},
@@ -283,6 +291,8 @@ func (a *API) RegisterCompactor(c *compactor.MultitenantCompactor) {
 		{Desc: "Ring status", Path: "/compactor/ring"},
 	})
 	a.RegisterRoute("/compactor/ring", http.HandlerFunc(c.RingHandler), false, true, "GET", "POST")
+	a.RegisterRoute("/compactor/delete_requests", http.HandlerFunc(c.DeleteRequestsHandler), true, true, "GET")
+	a.RegisterRoute("/compactor/delete_requests", http.HandlerFunc(c.AddDeleteRequestHandler), true, true, "POST")
 }
 
 // RegisterQueryFrontend registers the endpoints associated with the query frontend.
@@ -320,6 +330,24 @@ func (a *API) RegisterAdHocProfiles(ahp *adhocprofiles.AdHocProfiles) {
 	adhocprofilesv1connect.RegisterAdHocProfileServiceHandler(a.server.HTTP, ahp, a.connectOptionsAuthRecovery()...)
 }
 
+// RegisterDebugInfo registers the debug information files API.
+func (a *API) RegisterDebugInfo(d *debuginfo.DebugInfo) {
+	a.RegisterRoute(debuginfo.Path, http.HandlerFunc(d.UploadHandler), true, true, "PUT", "POST")
+	a.RegisterRoute(debuginfo.Path, http.HandlerFunc(d.DownloadHandler), true, false, "GET")
+}
+
+// RegisterRuler registers the rule groups management API and the ring status page.
+func (a *API) RegisterRuler(r *ruler.Ruler) {
+	a.indexPage.AddLinks(defaultWeight, "Ruler", []IndexPageLink{
+		{Desc: "Ring status", Path: "/ruler/ring"},
+	})
+	a.RegisterRoute("/ruler/ring", http.HandlerFunc(r.RingHandler), false, true, "GET", "POST")
+	a.RegisterRoute("/ruler/rules", http.HandlerFunc(r.ListRuleGroupsHandler), true, true, "GET")
+	a.RegisterRoute("/ruler/rules", http.HandlerFunc(r.SetRuleGroupHandler), true, true, "POST")
+	a.RegisterRoute("/ruler/rules/{group}", http.HandlerFunc(r.GetRuleGroupHandler), true, true, "GET")
+	a.RegisterRoute("/ruler/rules/{group}", http.HandlerFunc(r.DeleteRuleGroupHandler), true, true, "DELETE")
+}
+
 func (a *API) connectOptionsRecovery() []connect.HandlerOption {
 	return append(connectapi.DefaultHandlerOptions(), a.recoveryMiddleware)
 }
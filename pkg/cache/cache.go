@@ -0,0 +1,81 @@
+// Package cache provides a pluggable cache, configured once and shared
+// by the components that need to cache data: it is either an in-process
+// LRU cache bounded in size or a memcached or redis client.
+package cache
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/go-kit/log"
+	"github.com/grafana/dskit/cache"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	BackendInMemory  = "inmemory"
+	BackendMemcached = cache.BackendMemcached
+	BackendRedis     = cache.BackendRedis
+)
+
+type Cache = cache.Cache
+
+type Config struct {
+	Backend   string                      `yaml:"backend"`
+	InMemory  InMemoryConfig              `yaml:"inmemory"`
+	Memcached cache.MemcachedClientConfig `yaml:"memcached"`
+	Redis     cache.RedisClientConfig     `yaml:"redis"`
+}
+
+type InMemoryConfig struct {
+	MaxItems     int `yaml:"max_items"`
+	MaxSizeBytes int `yaml:"max_size_bytes"`
+}
+
+func (cfg *Config) RegisterFlagsWithPrefix(prefix string, f *flag.FlagSet) {
+	f.StringVar(&cfg.Backend, prefix+"backend", "", fmt.Sprintf("Backend for the cache. Supported values: %s, %s, %s. The cache is disabled if empty.", BackendInMemory, BackendMemcached, BackendRedis))
+	f.IntVar(&cfg.InMemory.MaxItems, prefix+"inmemory.max-items", 1000, "Maximum number of items held by the in-memory cache.")
+	f.IntVar(&cfg.InMemory.MaxSizeBytes, prefix+"inmemory.max-size-bytes", 256<<20, "Maximum total size of the items held by the in-memory cache, in bytes.")
+	cfg.Memcached.RegisterFlagsWithPrefix(prefix+"memcached.", f)
+	cfg.Redis.RegisterFlagsWithPrefix(prefix+"redis.", f)
+}
+
+func (cfg *Config) Validate() error {
+	switch cfg.Backend {
+	case "":
+		return nil
+	case BackendInMemory:
+		if cfg.InMemory.MaxItems <= 0 {
+			return fmt.Errorf("the in-memory cache size must be positive, got %d", cfg.InMemory.MaxItems)
+		}
+		if cfg.InMemory.MaxSizeBytes <= 0 {
+			return fmt.Errorf("the in-memory cache size in bytes must be positive, got %d", cfg.InMemory.MaxSizeBytes)
+		}
+		return nil
+	default:
+		b := cache.BackendConfig{
+			Backend:   cfg.Backend,
+			Memcached: cfg.Memcached,
+			Redis:     cfg.Redis,
+		}
+		return b.Validate()
+	}
+}
+
+// New creates a new cache with the given name. The name is used to
+// distinguish the metrics of different caches. A nil cache is returned
+// if no backend is configured.
+func New(cfg Config, name string, logger log.Logger, reg prometheus.Registerer) (Cache, error) {
+	switch cfg.Backend {
+	case "":
+		return nil, nil
+	case BackendInMemory:
+		return newInMemoryCache(cfg.InMemory, name, reg)
+	default:
+		return cache.CreateClient(name, cache.BackendConfig{
+			Backend:   cfg.Backend,
+			Memcached: cfg.Memcached,
+			Redis:     cfg.Redis,
+		}, logger, reg)
+	}
+}
@@ -0,0 +1,88 @@
+package cache
+
+import (
+	"context"
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func defaultConfig() Config {
+	var cfg Config
+	cfg.RegisterFlagsWithPrefix("test.", flag.NewFlagSet("", flag.PanicOnError))
+	return cfg
+}
+
+func Test_New_Disabled(t *testing.T) {
+	cfg := defaultConfig()
+	require.NoError(t, cfg.Validate())
+	c, err := New(cfg, "test", log.NewNopLogger(), prometheus.NewRegistry())
+	require.NoError(t, err)
+	assert.Nil(t, c)
+}
+
+func Test_New_InMemory(t *testing.T) {
+	cfg := defaultConfig()
+	cfg.Backend = BackendInMemory
+	cfg.InMemory.MaxItems = 2
+	require.NoError(t, cfg.Validate())
+
+	c, err := New(cfg, "test", log.NewNopLogger(), prometheus.NewRegistry())
+	require.NoError(t, err)
+	c.StoreAsync(map[string][]byte{"a": []byte("1"), "b": []byte("2")}, time.Hour)
+	c.StoreAsync(map[string][]byte{"c": []byte("3")}, time.Hour)
+	assert.Equal(t, map[string][]byte{
+		"b": []byte("2"),
+		"c": []byte("3"),
+	}, c.Fetch(context.Background(), []string{"a", "b", "c"}))
+}
+
+func Test_New_InMemory_MaxSizeBytes(t *testing.T) {
+	cfg := defaultConfig()
+	cfg.Backend = BackendInMemory
+	cfg.InMemory.MaxSizeBytes = 10
+	require.NoError(t, cfg.Validate())
+
+	c, err := New(cfg, "test", log.NewNopLogger(), prometheus.NewRegistry())
+	require.NoError(t, err)
+	c.StoreAsync(map[string][]byte{"a": []byte("1234")}, time.Hour)
+	c.StoreAsync(map[string][]byte{"b": []byte("1234")}, time.Hour)
+	// Replacing an item does not count it twice.
+	c.StoreAsync(map[string][]byte{"b": []byte("1234")}, time.Hour)
+	assert.Len(t, c.Fetch(context.Background(), []string{"a", "b"}), 2)
+	c.StoreAsync(map[string][]byte{"c": []byte("1234")}, time.Hour)
+	assert.Equal(t, map[string][]byte{
+		"b": []byte("1234"),
+		"c": []byte("1234"),
+	}, c.Fetch(context.Background(), []string{"a", "b", "c"}))
+	// Items larger than the cache are not stored.
+	c.StoreAsync(map[string][]byte{"d": []byte("1234567890")}, time.Hour)
+	assert.Len(t, c.Fetch(context.Background(), []string{"b", "c", "d"}), 2)
+}
+
+func Test_Config_Validate(t *testing.T) {
+	cfg := defaultConfig()
+	cfg.Backend = BackendInMemory
+	cfg.InMemory.MaxItems = 0
+	assert.Error(t, cfg.Validate())
+
+	cfg = defaultConfig()
+	cfg.Backend = BackendInMemory
+	cfg.InMemory.MaxSizeBytes = 0
+	assert.Error(t, cfg.Validate())
+
+	cfg = defaultConfig()
+	cfg.Backend = BackendMemcached
+	assert.Error(t, cfg.Validate())
+	require.NoError(t, cfg.Memcached.Addresses.Set("localhost:11211"))
+	assert.NoError(t, cfg.Validate())
+
+	cfg = defaultConfig()
+	cfg.Backend = "unknown"
+	assert.Error(t, cfg.Validate())
+}
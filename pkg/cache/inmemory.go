@@ -0,0 +1,126 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/grafana/dskit/cache"
+	"github.com/hashicorp/golang-lru/v2/simplelru"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// inMemoryCache is an LRU cache bounded by both the number
+// of items and the total size of the keys and values.
+type inMemoryCache struct {
+	name    string
+	maxSize int
+
+	mu   sync.Mutex
+	lru  *simplelru.LRU[string, inMemoryItem]
+	size int
+
+	requests prometheus.Counter
+	hits     prometheus.Counter
+}
+
+type inMemoryItem struct {
+	data      []byte
+	expiresAt time.Time
+}
+
+func newInMemoryCache(cfg InMemoryConfig, name string, reg prometheus.Registerer) (*inMemoryCache, error) {
+	c := &inMemoryCache{
+		name:    name,
+		maxSize: cfg.MaxSizeBytes,
+		requests: promauto.With(reg).NewCounter(prometheus.CounterOpts{
+			Name:        "cache_memory_requests_total",
+			Help:        "Total number of requests to the in-memory cache.",
+			ConstLabels: map[string]string{"name": name},
+		}),
+		hits: promauto.With(reg).NewCounter(prometheus.CounterOpts{
+			Name:        "cache_memory_hits_total",
+			Help:        "Total number of requests to the in-memory cache that were a hit.",
+			ConstLabels: map[string]string{"name": name},
+		}),
+	}
+	var err error
+	c.lru, err = simplelru.NewLRU[string, inMemoryItem](cfg.MaxItems, func(k string, v inMemoryItem) {
+		c.size -= itemSize(k, v.data)
+	})
+	if err != nil {
+		return nil, err
+	}
+	promauto.With(reg).NewGaugeFunc(prometheus.GaugeOpts{
+		Name:        "cache_memory_items_count",
+		Help:        "Total number of items currently in the in-memory cache.",
+		ConstLabels: map[string]string{"name": name},
+	}, func() float64 {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		return float64(c.lru.Len())
+	})
+	promauto.With(reg).NewGaugeFunc(prometheus.GaugeOpts{
+		Name:        "cache_memory_size_bytes",
+		Help:        "Total size of the items currently in the in-memory cache.",
+		ConstLabels: map[string]string{"name": name},
+	}, func() float64 {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		return float64(c.size)
+	})
+	return c, nil
+}
+
+func itemSize(k string, v []byte) int { return len(k) + len(v) }
+
+func (c *inMemoryCache) StoreAsync(data map[string][]byte, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	expiresAt := time.Now().Add(ttl)
+	for k, v := range data {
+		size := itemSize(k, v)
+		if size > c.maxSize {
+			// The item would evict everything else.
+			continue
+		}
+		// The callback accounts for the replaced item.
+		c.lru.Remove(k)
+		c.lru.Add(k, inMemoryItem{data: v, expiresAt: expiresAt})
+		c.size += size
+		for c.size > c.maxSize {
+			c.lru.RemoveOldest()
+		}
+	}
+}
+
+func (c *inMemoryCache) Fetch(_ context.Context, keys []string, _ ...cache.Option) map[string][]byte {
+	c.requests.Add(float64(len(keys)))
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	found := make(map[string][]byte, len(keys))
+	now := time.Now()
+	for _, k := range keys {
+		item, ok := c.lru.Get(k)
+		if !ok {
+			continue
+		}
+		if item.expiresAt.After(now) {
+			found[k] = item.data
+			continue
+		}
+		c.lru.Remove(k)
+	}
+	c.hits.Add(float64(len(found)))
+	return found
+}
+
+func (c *inMemoryCache) Delete(_ context.Context, key string) error {
+	c.mu.Lock()
+	c.lru.Remove(key)
+	c.mu.Unlock()
+	return nil
+}
+
+func (c *inMemoryCache) Name() string { return "in-memory-" + c.name }
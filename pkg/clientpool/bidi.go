@@ -29,3 +29,17 @@ type BidiClientMergeSpanProfile interface {
 	CloseRequest() error
 	CloseResponse() error
 }
+
+type BidiClientMergeProfilesTopFunctions interface {
+	Send(*ingestv1.MergeProfilesTopFunctionsRequest) error
+	Receive() (*ingestv1.MergeProfilesTopFunctionsResponse, error)
+	CloseRequest() error
+	CloseResponse() error
+}
+
+type BidiClientMergeProfilesValues interface {
+	Send(*ingestv1.MergeProfilesValuesRequest) error
+	Receive() (*ingestv1.MergeProfilesValuesResponse, error)
+	CloseRequest() error
+	CloseResponse() error
+}
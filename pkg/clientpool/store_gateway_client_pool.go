@@ -75,3 +75,11 @@ func (c *storeGatewayPoolClient) MergeProfilesPprof(ctx context.Context) BidiCli
 func (c *storeGatewayPoolClient) MergeSpanProfile(ctx context.Context) BidiClientMergeSpanProfile {
 	return c.StoreGatewayServiceClient.MergeSpanProfile(ctx)
 }
+
+func (c *storeGatewayPoolClient) MergeProfilesTopFunctions(ctx context.Context) BidiClientMergeProfilesTopFunctions {
+	return c.StoreGatewayServiceClient.MergeProfilesTopFunctions(ctx)
+}
+
+func (c *storeGatewayPoolClient) MergeProfilesValues(ctx context.Context) BidiClientMergeProfilesValues {
+	return c.StoreGatewayServiceClient.MergeProfilesValues(ctx)
+}
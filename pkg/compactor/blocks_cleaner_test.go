@@ -33,6 +33,7 @@ import (
 	"github.com/grafana/pyroscope/pkg/phlaredb/bucketindex"
 	"github.com/grafana/pyroscope/pkg/test"
 	"github.com/grafana/pyroscope/pkg/util"
+	"github.com/grafana/pyroscope/pkg/validation"
 )
 
 type testBlocksCleanerOptions struct {
@@ -1022,6 +1023,7 @@ type mockConfigProvider struct {
 	userPartialBlockDelayInvalid map[string]bool
 	verifyChunks                 map[string]bool
 	downsamplerEnabled           map[string]bool
+	retentionRules               map[string][]validation.RetentionRule
 }
 
 func newMockConfigProvider() *mockConfigProvider {
@@ -1037,6 +1039,7 @@ func newMockConfigProvider() *mockConfigProvider {
 		userPartialBlockDelayInvalid: make(map[string]bool),
 		verifyChunks:                 make(map[string]bool),
 		downsamplerEnabled:           make(map[string]bool),
+		retentionRules:               make(map[string][]validation.RetentionRule),
 	}
 }
 
@@ -1099,6 +1102,10 @@ func (m *mockConfigProvider) CompactorDownsamplerEnabled(user string) bool {
 	return m.downsamplerEnabled[user]
 }
 
+func (m *mockConfigProvider) CompactorRetentionRules(user string) []validation.RetentionRule {
+	return m.retentionRules[user]
+}
+
 func (m *mockConfigProvider) S3SSEType(string) string {
 	return ""
 }
@@ -253,8 +253,11 @@ type BlockCompactor struct {
 	blockOpenConcurrency int
 	downsamplerEnabled   bool
 	splitBy              phlaredb.SplitByFunc
-	logger               log.Logger
-	metrics              *CompactorMetrics
+	// Profiles matching the filter are not
+	// written to the compacted blocks.
+	deleted phlaredb.ProfileFilter
+	logger  log.Logger
+	metrics *CompactorMetrics
 }
 
 type CompactorMetrics struct {
@@ -401,6 +404,7 @@ func (c *BlockCompactor) CompactWithSplitting(ctx context.Context, dest string,
 		SplitBy:            c.splitBy,
 		DownsamplerEnabled: c.downsamplerEnabled,
 		Logger:             c.logger,
+		Delete:             c.deleted,
 	})
 	if err != nil {
 		return nil, errors.Wrapf(err, "compact blocks %v", dirs)
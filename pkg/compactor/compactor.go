@@ -31,10 +31,13 @@ import (
 	"go.uber.org/atomic"
 
 	"github.com/grafana/pyroscope/pkg/objstore"
+	"github.com/grafana/pyroscope/pkg/phlaredb"
 	"github.com/grafana/pyroscope/pkg/phlaredb/block"
 	"github.com/grafana/pyroscope/pkg/phlaredb/bucket"
+	"github.com/grafana/pyroscope/pkg/phlaredb/tombstones"
 	"github.com/grafana/pyroscope/pkg/tenant"
 	"github.com/grafana/pyroscope/pkg/util"
+	"github.com/grafana/pyroscope/pkg/validation"
 )
 
 const (
@@ -71,6 +74,7 @@ type BlocksGrouperFactory func(
 ) Grouper
 
 // BlocksCompactorFactory builds and returns the compactor and planner to use to compact a tenant's blocks.
+// Profiles matching the deleted filter, if set, are removed from the compacted blocks.
 type BlocksCompactorFactory func(
 	ctx context.Context,
 	cfg Config,
@@ -78,6 +82,7 @@ type BlocksCompactorFactory func(
 	userID string,
 	logger log.Logger,
 	metrics *CompactorMetrics,
+	deleted phlaredb.ProfileFilter,
 ) (Compactor, error)
 
 // BlocksPlannerFactory builds and returns the compactor and planner to use to compact a tenant's blocks.
@@ -102,6 +107,7 @@ type Config struct {
 	MaxCompactionTime          time.Duration `yaml:"max_compaction_time" category:"advanced"`
 	NoBlocksFileCleanupEnabled bool          `yaml:"no_blocks_file_cleanup_enabled" category:"experimental"`
 	DownsamplerEnabled         bool          `yaml:"downsampler_enabled" category:"advanced"`
+	DeleteRequestsDelay        time.Duration `yaml:"delete_requests_delay" category:"advanced"`
 
 	// Compactor concurrency options
 	MaxOpeningBlocksConcurrency int `yaml:"max_opening_blocks_concurrency" category:"advanced"` // Number of goroutines opening blocks before compaction.
@@ -116,6 +122,8 @@ type Config struct {
 	CompactionJobsOrder string `yaml:"compaction_jobs_order" category:"advanced"`
 	CompactionSplitBy   string `yaml:"compaction_split_by" category:"advanced"`
 
+	BucketCache objstore.CachingBucketConfig `yaml:"bucket_cache" doc:"description=Configures the cache of the object store reads: block metadata files, attributes and headers of the block files."`
+
 	// No need to add options to customize the retry backoff,
 	// given the defaults should be fine, but allow to override
 	// it in tests.
@@ -155,11 +163,14 @@ func (cfg *Config) RegisterFlags(f *flag.FlagSet, logger log.Logger) {
 	// f.DurationVar(&cfg.TenantCleanupDelay, "compactor.tenant-cleanup-delay", 6*time.Hour, "For tenants marked for deletion, this is time between deleting of last block, and doing final cleanup (marker files, debug files) of the tenant.")
 	f.BoolVar(&cfg.NoBlocksFileCleanupEnabled, "compactor.no-blocks-file-cleanup-enabled", false, "If enabled, will delete the bucket-index, markers and debug files in the tenant bucket when there are no blocks left in the index.")
 	f.BoolVar(&cfg.DownsamplerEnabled, "compactor.downsampler-enabled", false, "If enabled, the compactor will downsample profiles in blocks at compaction level 3 and above. The original profiles are also kept.")
+	f.DurationVar(&cfg.DeleteRequestsDelay, "compactor.delete-requests-delay", 4*time.Hour, "How long after the end of the time range of a delete request the compactor removes the deleted profiles from the blocks. The delay must cover the time the ingesters take to upload the profiles. Until then, the deleted profiles are only filtered out at query time.")
 	// compactor concurrency options
 	f.IntVar(&cfg.MaxOpeningBlocksConcurrency, "compactor.max-opening-blocks-concurrency", 16, "Number of goroutines opening blocks before compaction.")
 
 	f.Var(&cfg.EnabledTenants, "compactor.enabled-tenants", "Comma separated list of tenants that can be compacted. If specified, only these tenants will be compacted by compactor, otherwise all tenants can be compacted. Subject to sharding.")
 	f.Var(&cfg.DisabledTenants, "compactor.disabled-tenants", "Comma separated list of tenants that cannot be compacted by this compactor. If specified, and compactor would normally pick given tenant for compaction (via -compactor.enabled-tenants or sharding), it will be ignored instead.")
+
+	cfg.BucketCache.RegisterFlagsWithPrefix("compactor.bucket-cache.", f)
 }
 
 func (cfg *Config) Validate(maxBlockDuration time.Duration) error {
@@ -185,6 +196,10 @@ func (cfg *Config) Validate(maxBlockDuration time.Duration) error {
 		return errInvalidCompactionSplitBy
 	}
 
+	if err := cfg.BucketCache.Validate(); err != nil {
+		return errors.Wrap(err, "bucket-cache configuration")
+	}
+
 	return nil
 }
 
@@ -215,6 +230,9 @@ type ConfigProvider interface {
 
 	// CompactorDownsamplerEnabled returns true if the downsampler is enabled for a given user.
 	CompactorDownsamplerEnabled(userId string) bool
+
+	// CompactorRetentionRules returns the retention rules for a given user.
+	CompactorRetentionRules(userID string) []validation.RetentionRule
 }
 
 // MultitenantCompactor is a multi-tenant TSDB blocks compactor based on Thanos.
@@ -263,6 +281,10 @@ type MultitenantCompactor struct {
 	compactionRunFailedTenants     prometheus.Gauge
 	compactionRunInterval          prometheus.Gauge
 	blocksMarkedForDeletion        prometheus.Counter
+	deleteRequestsProcessed        prometheus.Counter
+	deletedProfiles                prometheus.Counter
+	retentionDeletedProfiles       *prometheus.CounterVec
+	retentionReclaimedBytes        *prometheus.CounterVec
 
 	// Metrics shared across all BucketCompactor instances.
 	bucketCompactorMetrics *BucketCompactorMetrics
@@ -291,6 +313,11 @@ func NewMultitenantCompactor(compactorCfg Config, bucketClient objstore.Bucket,
 	blocksCompactorFactory := compactorCfg.BlocksCompactorFactory
 	blocksPlannerFactory := compactorCfg.BlocksPlannerFactory
 
+	bucketClient, err := objstore.NewCachingBucketFromConfig(compactorCfg.BucketCache, bucketClient, "compactor-bucket-cache", logger, registerer)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create caching bucket")
+	}
+
 	c, err := newMultitenantCompactor(compactorCfg, bucketClient, cfgProvider, logger, registerer, blocksGrouperFactory, blocksCompactorFactory, blocksPlannerFactory)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create blocks compactor")
@@ -367,6 +394,22 @@ func newMultitenantCompactor(
 			Help:        blocksMarkedForDeletionHelp,
 			ConstLabels: prometheus.Labels{"reason": "compaction"},
 		}),
+		deleteRequestsProcessed: promauto.With(registerer).NewCounter(prometheus.CounterOpts{
+			Name: "pyroscope_compactor_delete_requests_processed_total",
+			Help: "Total number of delete requests processed.",
+		}),
+		deletedProfiles: promauto.With(registerer).NewCounter(prometheus.CounterOpts{
+			Name: "pyroscope_compactor_deleted_profiles_total",
+			Help: "Total number of profiles removed from blocks by delete requests.",
+		}),
+		retentionDeletedProfiles: promauto.With(registerer).NewCounterVec(prometheus.CounterOpts{
+			Name: "pyroscope_compactor_retention_deleted_profiles_total",
+			Help: "Total number of expired profiles removed from blocks by retention rule.",
+		}, []string{"user", "rule"}),
+		retentionReclaimedBytes: promauto.With(registerer).NewCounterVec(prometheus.CounterOpts{
+			Name: "pyroscope_compactor_retention_reclaimed_bytes_total",
+			Help: "Total number of bytes reclaimed in the object storage by retention rule. Bytes reclaimed by rewriting a block are attributed to the rules by the number of deleted profiles.",
+		}, []string{"user", "rule"}),
 		blockUploadBlocks: promauto.With(registerer).NewGaugeVec(prometheus.GaugeOpts{
 			Name: "pyroscope_block_upload_api_blocks_total",
 			Help: "Total number of blocks successfully uploaded and validated using the block upload API.",
@@ -748,8 +791,20 @@ func (c *MultitenantCompactor) compactUser(ctx context.Context, userID string) e
 		return errors.Wrap(err, "failed to create syncer")
 	}
 
+	// Profiles of the tenant delete requests are removed by every compaction,
+	// not only by the block rewrites: otherwise, blocks compacted before the
+	// request is processed would still include the deleted profiles.
+	t, err := tombstones.NewStore(c.bucketClient).Tombstones(ctx, userID)
+	if err != nil {
+		return errors.Wrap(err, "failed to read delete requests")
+	}
+	var deleted phlaredb.ProfileFilter
+	if t != nil {
+		deleted = t.Deleted
+	}
+
 	// Create blocks compactor dependencies.
-	blocksCompactor, err := c.blocksCompactorFactory(ctx, c.compactorCfg, c.cfgProvider, userID, c.logger, c.compactorMetrics)
+	blocksCompactor, err := c.blocksCompactorFactory(ctx, c.compactorCfg, c.cfgProvider, userID, c.logger, c.compactorMetrics, deleted)
 	if err != nil {
 		return errors.Wrap(err, "failed to initialize compactor dependencies")
 	}
@@ -777,6 +832,21 @@ func (c *MultitenantCompactor) compactUser(ctx context.Context, userID string) e
 		return errors.Wrap(err, "compaction")
 	}
 
+	// Blocks are only rewritten by the compactor running
+	// the blocks cleaner for the tenant.
+	if owned, err := c.shardingStrategy.blocksCleanerOwnUser(userID); err != nil {
+		level.Info(userLogger).Log("msg", "skipped rewriting blocks because unable to check whether the tenant is owned by the compactor instance", "err", err)
+		return nil
+	} else if !owned {
+		return nil
+	}
+	if err := c.processDeleteRequests(ctx, userID, userBucket, fetcher, userLogger); err != nil {
+		return errors.Wrap(err, "delete requests")
+	}
+	if err := c.applyRetentionRules(ctx, userID, userBucket, fetcher, userLogger); err != nil {
+		return errors.Wrap(err, "retention rules")
+	}
+
 	return nil
 }
 
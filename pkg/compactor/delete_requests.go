@@ -0,0 +1,171 @@
+package compactor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/oklog/ulid"
+	"github.com/prometheus/common/model"
+
+	"github.com/grafana/pyroscope/pkg/objstore"
+	"github.com/grafana/pyroscope/pkg/phlaredb/block"
+	"github.com/grafana/pyroscope/pkg/phlaredb/tombstones"
+	"github.com/grafana/pyroscope/pkg/tenant"
+	"github.com/grafana/pyroscope/pkg/util"
+	httputil "github.com/grafana/pyroscope/pkg/util/http"
+)
+
+// processDeleteRequests rewrites the tenant blocks that include profiles
+// deleted by the pending delete requests, and marks the requests processed.
+//
+// A request is only processed once the end of its time range is older than
+// the configured delay: by then the profiles have been uploaded by the
+// ingesters. Blocks marked for no-compaction are not rewritten, the deleted
+// profiles are still filtered out at query time.
+//
+// The blocks are listed again after the rewrites: a request is only marked
+// processed if all the blocks overlapping its time range have been checked,
+// either directly or as the source of a rewritten copy. Blocks compacted in
+// the meantime are checked in the next run.
+func (c *MultitenantCompactor) processDeleteRequests(ctx context.Context, userID string, userBucket objstore.Bucket, fetcher *block.MetaFetcher, logger log.Logger) error {
+	store := tombstones.NewStore(c.bucketClient)
+	requests, err := store.List(ctx, userID)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	pending := make([]*tombstones.DeleteRequest, 0, len(requests))
+	for _, r := range requests {
+		if r.Status == tombstones.StatusPending && now.Sub(time.UnixMilli(r.End)) >= c.compactorCfg.DeleteRequestsDelay {
+			pending = append(pending, r)
+		}
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+	t, err := tombstones.New(pending...)
+	if err != nil {
+		return err
+	}
+
+	metas, _, err := fetcher.FetchWithoutMarkedForDeletion(ctx)
+	if err != nil {
+		return err
+	}
+	blocks := make([]*block.Meta, 0, len(metas))
+	for _, m := range metas {
+		if t.Overlapping(m.MinTime, m.MaxTime) != nil {
+			blocks = append(blocks, m)
+		}
+	}
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i].ULID.Compare(blocks[j].ULID) < 0
+	})
+	checked := make(map[ulid.ULID]struct{}, len(blocks))
+	for _, m := range blocks {
+		deleted, _, err := c.rewriteBlock(ctx, userID, userBucket, m, t.Deleted, logger)
+		if err != nil {
+			return fmt.Errorf("rewriting block %s: %w", m.ULID, err)
+		}
+		c.deletedProfiles.Add(float64(deleted))
+		checked[m.ULID] = struct{}{}
+	}
+
+	if metas, _, err = fetcher.FetchWithoutMarkedForDeletion(ctx); err != nil {
+		return err
+	}
+	for _, r := range pending {
+		if id, ok := uncheckedBlock(metas, checked, r); ok {
+			level.Info(logger).Log("msg", "delete request not processed yet", "request", r.ID, "block", id)
+			continue
+		}
+		if err = store.MarkProcessed(ctx, userID, r, now); err != nil {
+			return fmt.Errorf("marking delete request %s processed: %w", r.ID, err)
+		}
+		c.deleteRequestsProcessed.Inc()
+		level.Info(logger).Log("msg", "processed delete request", "request", r.ID, "selector", r.Selector)
+	}
+	return nil
+}
+
+// uncheckedBlock returns a block overlapping the time range of the
+// request that has not been checked for the deleted profiles.
+func uncheckedBlock(metas map[ulid.ULID]*block.Meta, checked map[ulid.ULID]struct{}, r *tombstones.DeleteRequest) (ulid.ULID, bool) {
+	for id, m := range metas {
+		if !m.InRange(model.Time(r.Start), model.Time(r.End)) {
+			continue
+		}
+		if _, ok := checked[id]; ok {
+			continue
+		}
+		if src, ok := rewrittenFrom(m); ok {
+			if _, ok = checked[src]; ok {
+				continue
+			}
+		}
+		return id, true
+	}
+	return ulid.ULID{}, false
+}
+
+// DeleteRequestsHandler lists the tenant delete requests.
+func (c *MultitenantCompactor) DeleteRequestsHandler(w http.ResponseWriter, req *http.Request) {
+	tenantID, err := tenant.ExtractTenantIDFromContext(req.Context())
+	if err != nil {
+		httputil.ErrorWithStatus(w, err, http.StatusBadRequest)
+		return
+	}
+	requests, err := tombstones.NewStore(c.bucketClient).List(req.Context(), tenantID)
+	if err != nil {
+		httputil.Error(w, err)
+		return
+	}
+	util.WriteJSONResponse(w, requests)
+}
+
+// AddDeleteRequestHandler creates a delete request for the profiles of
+// the series matching the 'selector' within the 'start' and 'end' time
+// range. Timestamps are given in Unix seconds or in the RFC 3339 format.
+//
+// Matching profiles are hidden from queries once the store-gateways
+// reload the requests, and are removed from the blocks by the compactor.
+func (c *MultitenantCompactor) AddDeleteRequestHandler(w http.ResponseWriter, req *http.Request) {
+	tenantID, err := tenant.ExtractTenantIDFromContext(req.Context())
+	if err != nil {
+		httputil.ErrorWithStatus(w, err, http.StatusBadRequest)
+		return
+	}
+	now := time.Now()
+	start, end := int64(0), now.UnixMilli()
+	if s := req.FormValue("start"); s != "" {
+		if start, err = util.ParseTime(s); err != nil {
+			httputil.ErrorWithStatus(w, err, http.StatusBadRequest)
+			return
+		}
+	}
+	if s := req.FormValue("end"); s != "" {
+		if end, err = util.ParseTime(s); err != nil {
+			httputil.ErrorWithStatus(w, err, http.StatusBadRequest)
+			return
+		}
+	}
+	r, err := tombstones.NewDeleteRequest(req.FormValue("selector"), time.UnixMilli(start), time.UnixMilli(end), now)
+	if err != nil {
+		httputil.ErrorWithStatus(w, err, http.StatusBadRequest)
+		return
+	}
+	if err = tombstones.NewStore(c.bucketClient).Set(req.Context(), tenantID, r); err != nil {
+		level.Error(c.logger).Log("msg", "failed to store delete request", "tenant", tenantID, "err", err)
+		httputil.Error(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	_ = json.NewEncoder(w).Encode(r)
+}
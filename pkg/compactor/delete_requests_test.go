@@ -0,0 +1,175 @@
+package compactor
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/grafana/dskit/user"
+	"github.com/oklog/ulid"
+	prom_testutil "github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pyroscope_objstore "github.com/grafana/pyroscope/pkg/objstore"
+	objstore_testutil "github.com/grafana/pyroscope/pkg/objstore/testutil"
+	"github.com/grafana/pyroscope/pkg/phlaredb"
+	"github.com/grafana/pyroscope/pkg/phlaredb/block"
+	"github.com/grafana/pyroscope/pkg/phlaredb/block/testutil"
+	"github.com/grafana/pyroscope/pkg/phlaredb/tombstones"
+	"github.com/grafana/pyroscope/pkg/pprof/testhelper"
+)
+
+func profilesGenerator(services ...string) func() []*testhelper.ProfileBuilder {
+	return func() (res []*testhelper.ProfileBuilder) {
+		for _, s := range services {
+			for i := int64(1); i <= 10; i++ {
+				res = append(res, testhelper.NewProfileBuilder(int64(time.Second)*i).
+					CPUProfile().
+					WithLabels("service_name", s).
+					ForStacktraceString("foo", "bar").AddSamples(1))
+			}
+		}
+		return res
+	}
+}
+
+func TestMultitenantCompactor_processDeleteRequests(t *testing.T) {
+	ctx := context.Background()
+	bucketClient, _ := objstore_testutil.NewFilesystemBucket(t, ctx, t.TempDir())
+	affected := createCustomBlock(t, bucketClient, "user-1", nil, profilesGenerator("a", "b"))
+	unaffected := createCustomBlock(t, bucketClient, "user-1", nil, profilesGenerator("a"))
+
+	c, _, _, _, _ := prepare(t, prepareConfig(t), bucketClient)
+	c.bucketClient = block.BucketWithGlobalMarkers(c.bucketClient)
+	store := tombstones.NewStore(c.bucketClient)
+	processed, err := tombstones.NewDeleteRequest(`{service_name="a"}`, time.Unix(1, 0), time.Unix(10, 0), time.Unix(100, 0))
+	require.NoError(t, err)
+	processed.Status = tombstones.StatusProcessed
+	require.NoError(t, store.Set(ctx, "user-1", processed))
+	pending, err := tombstones.NewDeleteRequest(`{service_name="b"}`, time.Unix(0, 0), time.Unix(5, 0), time.Unix(200, 0))
+	require.NoError(t, err)
+	require.NoError(t, store.Set(ctx, "user-1", pending))
+	// The time range end is within the delay.
+	recent, err := tombstones.NewDeleteRequest(`{service_name="a"}`, time.Unix(0, 0), time.Now(), time.Now())
+	require.NoError(t, err)
+	require.NoError(t, store.Set(ctx, "user-1", recent))
+
+	userBucket := pyroscope_objstore.NewTenantBucketClient("user-1", c.bucketClient, nil)
+	fetcher, err := block.NewMetaFetcher(log.NewNopLogger(), 1, userBucket, t.TempDir(), nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, c.processDeleteRequests(ctx, "user-1", userBucket, fetcher, log.NewNopLogger()))
+
+	metas, _, err := fetcher.FetchWithoutMarkedForDeletion(ctx)
+	require.NoError(t, err)
+	require.Len(t, metas, 2)
+	require.Contains(t, metas, unaffected)
+	require.NotContains(t, metas, affected)
+	for id, m := range metas {
+		if id != unaffected {
+			assert.Equal(t, uint64(15), m.Stats.NumProfiles)
+			src, ok := rewrittenFrom(m)
+			assert.True(t, ok)
+			assert.Equal(t, affected, src)
+		}
+	}
+	assert.Equal(t, float64(5), prom_testutil.ToFloat64(c.deletedProfiles))
+	assert.Equal(t, float64(1), prom_testutil.ToFloat64(c.deleteRequestsProcessed))
+
+	requests, err := store.List(ctx, "user-1")
+	require.NoError(t, err)
+	require.Len(t, requests, 3)
+	assert.Equal(t, tombstones.StatusProcessed, requests[0].Status)
+	assert.Equal(t, tombstones.StatusProcessed, requests[1].Status)
+	assert.Equal(t, tombstones.StatusPending, requests[2].Status)
+	exists, err := bucketClient.Exists(ctx, path.Join("user-1", "phlaredb", affected.String(), block.DeletionMarkFilename))
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	// Processed requests are not applied again.
+	require.NoError(t, c.processDeleteRequests(ctx, "user-1", userBucket, fetcher, log.NewNopLogger()))
+	assert.Equal(t, float64(1), prom_testutil.ToFloat64(c.deleteRequestsProcessed))
+}
+
+func Test_uncheckedBlock(t *testing.T) {
+	r := &tombstones.DeleteRequest{Start: 100, End: 200}
+	metas := map[ulid.ULID]*block.Meta{
+		ULID(1): {ULID: ULID(1), MinTime: 0, MaxTime: 99},
+		ULID(2): {ULID: ULID(2), MinTime: 150, MaxTime: 300},
+		ULID(3): {ULID: ULID(3), MinTime: 0, MaxTime: 300, Compaction: block.BlockMetaCompaction{
+			Parents: []block.BlockDesc{{ULID: ULID(4)}},
+			Hints:   []string{rewriteHint},
+		}},
+	}
+	checked := map[ulid.ULID]struct{}{ULID(2): {}, ULID(4): {}}
+	_, ok := uncheckedBlock(metas, checked, r)
+	assert.False(t, ok)
+
+	// The block has been compacted after the blocks were checked.
+	metas[ULID(5)] = &block.Meta{ULID: ULID(5), MinTime: 200, MaxTime: 300}
+	id, ok := uncheckedBlock(metas, checked, r)
+	assert.True(t, ok)
+	assert.Equal(t, ULID(5), id)
+}
+
+func TestBlockCompactor_DeletedProfiles(t *testing.T) {
+	ctx := context.Background()
+	dest := t.TempDir()
+	dirs := make([]string, 0, 2)
+	for _, service := range []string{"a", "b"} {
+		meta, dir := testutil.CreateBlock(t, profilesGenerator(service))
+		bdir := filepath.Join(dest, meta.ULID.String())
+		require.NoError(t, os.Rename(filepath.Join(dir, meta.ULID.String()), bdir))
+		dirs = append(dirs, bdir)
+	}
+	r, err := tombstones.NewDeleteRequest(`{service_name="a"}`, time.Unix(1, 0), time.Unix(5, 0), time.Unix(100, 0))
+	require.NoError(t, err)
+	deleted, err := tombstones.New(r)
+	require.NoError(t, err)
+
+	c := &BlockCompactor{
+		blockOpenConcurrency: 1,
+		splitBy:              phlaredb.SplitByFingerprint,
+		deleted:              deleted.Deleted,
+		logger:               log.NewNopLogger(),
+		metrics:              newCompactorMetrics(nil),
+	}
+	ids, err := c.CompactWithSplitting(ctx, dest, dirs, 1, 1)
+	require.NoError(t, err)
+	require.Len(t, ids, 1)
+	meta, err := block.ReadMetaFromDir(filepath.Join(dest, ids[0].String()))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(15), meta.Stats.NumProfiles)
+}
+
+func TestMultitenantCompactor_DeleteRequestsHandlers(t *testing.T) {
+	bucketClient, _ := objstore_testutil.NewFilesystemBucket(t, context.Background(), t.TempDir())
+	c, _, _, _, _ := prepare(t, prepareConfig(t), bucketClient)
+
+	add := func(form url.Values) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/compactor/delete_requests", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		w := httptest.NewRecorder()
+		c.AddDeleteRequestHandler(w, req.WithContext(user.InjectOrgID(req.Context(), "user-1")))
+		return w
+	}
+	w := add(url.Values{"selector": {`{service_name="a"}`}, "start": {"1"}, "end": {"2024-01-01T00:00:00Z"}})
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	assert.Contains(t, w.Body.String(), `"status":"pending"`)
+	assert.Equal(t, http.StatusBadRequest, add(url.Values{"selector": {`{}`}}).Code)
+	assert.Equal(t, http.StatusBadRequest, add(url.Values{"selector": {`{service_name="a"}`}, "start": {"x"}}).Code)
+
+	req := httptest.NewRequest(http.MethodGet, "/compactor/delete_requests", nil)
+	w = httptest.NewRecorder()
+	c.DeleteRequestsHandler(w, req.WithContext(user.InjectOrgID(req.Context(), "user-1")))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"selector":"{service_name=\"a\"}","start":1000,"end":1704067200000`)
+}
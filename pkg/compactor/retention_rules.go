@@ -0,0 +1,270 @@
+package compactor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/labels"
+
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/objstore"
+	"github.com/grafana/pyroscope/pkg/phlaredb"
+	"github.com/grafana/pyroscope/pkg/phlaredb/block"
+	"github.com/grafana/pyroscope/pkg/phlaredb/tsdb/index"
+	"github.com/grafana/pyroscope/pkg/validation"
+)
+
+// retentionPolicy decides which profiles are expired according
+// to the tenant retention rules.
+type retentionPolicy struct {
+	rules []retentionRule
+}
+
+type retentionRule struct {
+	name     string
+	selector string
+	matchers []*labels.Matcher
+	// Profiles taken before the cutoff (Unix nanoseconds) are expired.
+	cutoff int64
+	// Profiles taken before the overdue time have been expired
+	// for longer than the rule period.
+	overdue int64
+}
+
+// retentionRewriteMinShare is the minimum estimated share of the block
+// data expired for the block to be rewritten: otherwise, blocks
+// straddling the cutoff would be rewritten in every compaction run.
+const retentionRewriteMinShare = 0.2
+
+func newRetentionPolicy(rules []validation.RetentionRule, now time.Time) (*retentionPolicy, error) {
+	p := &retentionPolicy{rules: make([]retentionRule, 0, len(rules))}
+	for _, r := range rules {
+		matchers, err := r.Matchers()
+		if err != nil {
+			return nil, fmt.Errorf("retention rule %q: %w", r.Name, err)
+		}
+		p.rules = append(p.rules, retentionRule{
+			name:     r.Name,
+			selector: r.Selector,
+			matchers: matchers,
+			cutoff:   now.Add(-time.Duration(r.Period)).UnixNano(),
+			overdue:  now.Add(-2 * time.Duration(r.Period)).UnixNano(),
+		})
+	}
+	return p, nil
+}
+
+// rule returns the index of the first rule matching the series,
+// or -1, if there are none.
+func (p *retentionPolicy) rule(lbs phlaremodel.Labels) int {
+	for i, r := range p.rules {
+		if r.matches(lbs) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (r *retentionRule) matches(lbs phlaremodel.Labels) bool {
+	for _, m := range r.matchers {
+		if !m.Matches(lbs.Get(m.Name)) {
+			return false
+		}
+	}
+	return true
+}
+
+// selectors returns the selectors of the rules that may expire
+// profiles taken at or after minT.
+func (p *retentionPolicy) selectors(minT model.Time) []string {
+	var selectors []string
+	for _, r := range p.rules {
+		if r.cutoff > minT.UnixNano() {
+			selectors = append(selectors, r.selector)
+		}
+	}
+	return selectors
+}
+
+// applyRetentionRules deletes the tenant blocks with all the profiles
+// expired according to the tenant retention rules, and rewrites the blocks
+// that include expired profiles.
+//
+// A block is only rewritten if a meaningful share of its data is expired,
+// or if it includes profiles expired for longer than the rule period: a
+// block is therefore rewritten at most once per period, unless the share
+// of the expired data grows.
+func (c *MultitenantCompactor) applyRetentionRules(ctx context.Context, userID string, userBucket objstore.Bucket, fetcher *block.MetaFetcher, logger log.Logger) error {
+	rules := c.cfgProvider.CompactorRetentionRules(userID)
+	if len(rules) == 0 {
+		return nil
+	}
+	policy, err := newRetentionPolicy(rules, time.Now())
+	if err != nil {
+		return err
+	}
+
+	metas, _, err := fetcher.FetchWithoutMarkedForDeletion(ctx)
+	if err != nil {
+		return err
+	}
+	blocks := make([]*block.Meta, 0, len(metas))
+	for _, m := range metas {
+		if len(policy.selectors(m.MinTime)) > 0 {
+			blocks = append(blocks, m)
+		}
+	}
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i].ULID.Compare(blocks[j].ULID) < 0
+	})
+
+	deleted := make([]int, len(policy.rules))
+	for _, m := range blocks {
+		expired, err := c.expiredData(ctx, userBucket, m, policy)
+		if err != nil {
+			return fmt.Errorf("reading block %s series: %w", m.ULID, err)
+		}
+		if expired.all {
+			if err = c.deleteExpiredBlock(ctx, userID, userBucket, m, policy, expired, logger); err != nil {
+				return fmt.Errorf("deleting block %s: %w", m.ULID, err)
+			}
+			continue
+		}
+		if !expired.overdue && expired.total() < retentionRewriteMinShare {
+			continue
+		}
+		for i := range deleted {
+			deleted[i] = 0
+		}
+		total, reclaimed, err := c.rewriteBlock(ctx, userID, userBucket, m, func(lbs phlaremodel.Labels, timeNanos int64) bool {
+			if i := policy.rule(lbs); i >= 0 && timeNanos < policy.rules[i].cutoff {
+				deleted[i]++
+				return true
+			}
+			return false
+		}, logger)
+		if err != nil {
+			return fmt.Errorf("rewriting block %s: %w", m.ULID, err)
+		}
+		if total == 0 {
+			continue
+		}
+		// The bytes reclaimed are attributed to the
+		// rules by the number of profiles deleted.
+		for i, n := range deleted {
+			if n == 0 {
+				continue
+			}
+			r := policy.rules[i]
+			c.retentionDeletedProfiles.WithLabelValues(userID, r.name).Add(float64(n))
+			c.retentionReclaimedBytes.WithLabelValues(userID, r.name).Add(float64(reclaimed) * float64(n) / float64(total))
+			level.Info(logger).Log("msg", "deleted expired profiles", "block", m.ULID, "rule", r.name, "deleted_profiles", n)
+		}
+	}
+	return nil
+}
+
+// deleteExpiredBlock marks the block with all the profiles expired for
+// deletion. The profiles and the bytes reclaimed are attributed to the
+// rules by the estimated share of the block data they expire.
+func (c *MultitenantCompactor) deleteExpiredBlock(ctx context.Context, userID string, userBucket objstore.Bucket, meta *block.Meta, policy *retentionPolicy, expired *expiredData, logger log.Logger) error {
+	if err := block.MarkForDeletion(ctx, logger, userBucket, meta.ULID, "profiles expired by retention rules", false, c.blocksMarkedForDeletion); err != nil {
+		return err
+	}
+	total := expired.total()
+	for i, share := range expired.rules {
+		if share == 0 {
+			continue
+		}
+		r := policy.rules[i]
+		n := float64(meta.Stats.NumProfiles) * share / total
+		c.retentionDeletedProfiles.WithLabelValues(userID, r.name).Add(n)
+		c.retentionReclaimedBytes.WithLabelValues(userID, r.name).Add(float64(blockSize(meta)) * share / total)
+	}
+	level.Info(logger).Log("msg", "deleted block with all profiles expired", "block", meta.ULID, "deleted_profiles", meta.Stats.NumProfiles)
+	return nil
+}
+
+// expiredData describes the block data expired according to the
+// retention policy, as estimated from the block index.
+type expiredData struct {
+	// Share of the block data expired by each rule.
+	rules []float64
+	// All the block profiles are expired.
+	all bool
+	// The block includes profiles expired
+	// for longer than the rule period.
+	overdue bool
+}
+
+func (e *expiredData) total() (share float64) {
+	for _, s := range e.rules {
+		share += s
+	}
+	return share
+}
+
+// expiredData estimates the share of the block data expired by each rule:
+// only the block index is read. A series is retained according to the first
+// rule it matches, and its profiles are assumed to be evenly distributed
+// between the oldest and the newest one, as recorded in the index.
+func (c *MultitenantCompactor) expiredData(ctx context.Context, userBucket objstore.Bucket, meta *block.Meta, policy *retentionPolicy) (*expiredData, error) {
+	q := phlaredb.NewSingleBlockQuerierFromMeta(ctx, userBucket, meta)
+	defer q.Close()
+	if err := q.Open(ctx); err != nil {
+		return nil, err
+	}
+	k, v := index.AllPostingsKey()
+	postings, err := q.Index().Postings(k, nil, v)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		lbs     = make(phlaremodel.Labels, 0, 6)
+		chks    = make([]index.ChunkMeta, 1)
+		total   float64
+		expired = &expiredData{rules: make([]float64, len(policy.rules)), all: true}
+	)
+	for postings.Next() {
+		if _, err = q.Index().Series(postings.At(), &lbs, &chks); err != nil {
+			return nil, err
+		}
+		i := policy.rule(lbs)
+		for _, chk := range chks {
+			// The size is rounded to KB, empty
+			// series are still accounted.
+			size := float64(max(chk.KB, 1))
+			total += size
+			if i < 0 || chk.MinTime >= policy.rules[i].cutoff {
+				expired.all = false
+				continue
+			}
+			r := policy.rules[i]
+			if chk.MinTime < r.overdue {
+				expired.overdue = true
+			}
+			if chk.MaxTime < r.cutoff {
+				expired.rules[i] += size
+				continue
+			}
+			expired.all = false
+			expired.rules[i] += size * float64(r.cutoff-chk.MinTime) / float64(chk.MaxTime-chk.MinTime)
+		}
+	}
+	if err = postings.Err(); err != nil {
+		return nil, err
+	}
+	if total == 0 {
+		expired.all = false
+		return expired, nil
+	}
+	for i := range expired.rules {
+		expired.rules[i] /= total
+	}
+	return expired, nil
+}
@@ -0,0 +1,171 @@
+package compactor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	prom_testutil "github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	pyroscope_objstore "github.com/grafana/pyroscope/pkg/objstore"
+	objstore_testutil "github.com/grafana/pyroscope/pkg/objstore/testutil"
+	"github.com/grafana/pyroscope/pkg/phlaredb/block"
+	"github.com/grafana/pyroscope/pkg/pprof/testhelper"
+	"github.com/grafana/pyroscope/pkg/validation"
+)
+
+func Test_retentionPolicy(t *testing.T) {
+	now := time.Unix(100, 0)
+	p, err := newRetentionPolicy([]validation.RetentionRule{
+		{Name: "ci", Selector: `{env="ci"}`, Period: model.Duration(10 * time.Second)},
+		{Name: "checkout", Selector: `{service_name="checkout"}`, Period: model.Duration(50 * time.Second)},
+	}, now)
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, p.rule(phlaremodel.LabelsFromStrings("service_name", "checkout", "env", "ci")))
+	assert.Equal(t, 1, p.rule(phlaremodel.LabelsFromStrings("service_name", "checkout", "env", "prod")))
+	assert.Equal(t, -1, p.rule(phlaremodel.LabelsFromStrings("service_name", "cart")))
+
+	assert.Empty(t, p.selectors(model.TimeFromUnix(90)))
+	assert.Equal(t, []string{`{env="ci"}`}, p.selectors(model.TimeFromUnix(50)))
+	assert.Equal(t, []string{`{env="ci"}`, `{service_name="checkout"}`}, p.selectors(model.TimeFromUnix(10)))
+}
+
+func TestMultitenantCompactor_applyRetentionRules(t *testing.T) {
+	ctx := context.Background()
+	bucketClient, _ := objstore_testutil.NewFilesystemBucket(t, ctx, t.TempDir())
+	generator := func(env string) func() []*testhelper.ProfileBuilder {
+		return func() (res []*testhelper.ProfileBuilder) {
+			for i := int64(1); i <= 10; i++ {
+				res = append(res, testhelper.NewProfileBuilder(int64(time.Hour)*i).
+					CPUProfile().
+					WithLabels("service_name", "checkout", "env", env).
+					ForStacktraceString("foo", "bar").AddSamples(1))
+			}
+			return res
+		}
+	}
+	mixed := createCustomBlock(t, bucketClient, "user-1", nil, func() []*testhelper.ProfileBuilder {
+		return append(generator("ci")(), generator("prod")()...)
+	})
+	prod := createCustomBlock(t, bucketClient, "user-1", nil, generator("prod"))
+
+	cfgProvider := newMockConfigProvider()
+	cfgProvider.retentionRules["user-1"] = []validation.RetentionRule{
+		{Name: "ci", Selector: `{env="ci"}`, Period: model.Duration(time.Since(time.Unix(0, 0)) - 5*time.Hour - 30*time.Minute)},
+		{Name: "unused", Selector: `{env="dev"}`, Period: model.Duration(time.Hour)},
+	}
+	c, _, _, _, _ := prepareWithConfigProvider(t, prepareConfig(t), bucketClient, cfgProvider)
+	c.bucketClient = block.BucketWithGlobalMarkers(c.bucketClient)
+
+	userBucket := pyroscope_objstore.NewTenantBucketClient("user-1", c.bucketClient, nil)
+	fetcher, err := block.NewMetaFetcher(log.NewNopLogger(), 1, userBucket, t.TempDir(), nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, c.applyRetentionRules(ctx, "user-1", userBucket, fetcher, log.NewNopLogger()))
+
+	metas, _, err := fetcher.FetchWithoutMarkedForDeletion(ctx)
+	require.NoError(t, err)
+	require.Len(t, metas, 2)
+	require.Contains(t, metas, prod)
+	require.NotContains(t, metas, mixed)
+	for id, m := range metas {
+		if id != prod {
+			assert.Equal(t, uint64(15), m.Stats.NumProfiles)
+		}
+	}
+	assert.Equal(t, float64(5), prom_testutil.ToFloat64(c.retentionDeletedProfiles.WithLabelValues("user-1", "ci")))
+	assert.Greater(t, prom_testutil.ToFloat64(c.retentionReclaimedBytes.WithLabelValues("user-1", "ci")), float64(0))
+	assert.Equal(t, 1, prom_testutil.CollectAndCount(c.retentionDeletedProfiles))
+
+	// Blocks without expired profiles are not rewritten, even if
+	// they include series matching the rules older than the cutoff.
+	policy, err := newRetentionPolicy(cfgProvider.retentionRules["user-1"], time.Now())
+	require.NoError(t, err)
+	for _, m := range metas {
+		expired, err := c.expiredData(ctx, userBucket, m, policy)
+		require.NoError(t, err)
+		assert.Zero(t, expired.total())
+		assert.False(t, expired.all)
+	}
+	require.NoError(t, c.applyRetentionRules(ctx, "user-1", userBucket, fetcher, log.NewNopLogger()))
+	metas2, _, err := fetcher.FetchWithoutMarkedForDeletion(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, len(metas), len(metas2))
+	for id := range metas {
+		assert.Contains(t, metas2, id)
+	}
+}
+
+func TestMultitenantCompactor_applyRetentionRules_ExpiredShare(t *testing.T) {
+	ctx := context.Background()
+	bucketClient, _ := objstore_testutil.NewFilesystemBucket(t, ctx, t.TempDir())
+	generator := func(services ...string) func() []*testhelper.ProfileBuilder {
+		return func() (res []*testhelper.ProfileBuilder) {
+			for _, s := range services {
+				env := "prod"
+				if s == "ci" {
+					env = "ci"
+				}
+				for i := int64(1); i <= 10; i++ {
+					res = append(res, testhelper.NewProfileBuilder(int64(time.Hour)*i).
+						CPUProfile().
+						WithLabels("service_name", s, "env", env).
+						ForStacktraceString("foo", "bar").AddSamples(1))
+				}
+			}
+			return res
+		}
+	}
+	ci := createCustomBlock(t, bucketClient, "user-1", nil, generator("ci"))
+	// The CI profiles are only a small share of the block data.
+	mixed := createCustomBlock(t, bucketClient, "user-1", nil, generator("ci", "a", "b", "c", "d", "e", "f", "g", "h", "i"))
+
+	cfgProvider := newMockConfigProvider()
+	cfgProvider.retentionRules["user-1"] = []validation.RetentionRule{
+		{Name: "ci", Selector: `{env="ci"}`, Period: model.Duration(time.Since(time.Unix(0, 0)) - 10*time.Hour - 30*time.Minute)},
+	}
+	c, _, _, _, _ := prepareWithConfigProvider(t, prepareConfig(t), bucketClient, cfgProvider)
+	c.bucketClient = block.BucketWithGlobalMarkers(c.bucketClient)
+
+	userBucket := pyroscope_objstore.NewTenantBucketClient("user-1", c.bucketClient, nil)
+	fetcher, err := block.NewMetaFetcher(log.NewNopLogger(), 1, userBucket, t.TempDir(), nil, nil)
+	require.NoError(t, err)
+	policy, err := newRetentionPolicy(cfgProvider.retentionRules["user-1"], time.Now())
+	require.NoError(t, err)
+	metas, _, err := fetcher.FetchWithoutMarkedForDeletion(ctx)
+	require.NoError(t, err)
+	expired, err := c.expiredData(ctx, userBucket, metas[mixed], policy)
+	require.NoError(t, err)
+	assert.InDelta(t, 0.1, expired.total(), 0.05)
+	assert.False(t, expired.all)
+	assert.False(t, expired.overdue)
+
+	// The block with all the profiles expired is deleted without being
+	// rewritten, the block with few expired profiles is left intact.
+	require.NoError(t, c.applyRetentionRules(ctx, "user-1", userBucket, fetcher, log.NewNopLogger()))
+	metas, _, err = fetcher.FetchWithoutMarkedForDeletion(ctx)
+	require.NoError(t, err)
+	require.Len(t, metas, 1)
+	require.Contains(t, metas, mixed)
+	assert.NotContains(t, metas, ci)
+	assert.Equal(t, float64(1), prom_testutil.ToFloat64(c.blocksMarkedForDeletion))
+	assert.Equal(t, float64(10), prom_testutil.ToFloat64(c.retentionDeletedProfiles.WithLabelValues("user-1", "ci")))
+
+	// The profiles expired for longer than the rule period are deleted,
+	// regardless of the share of the block data they make.
+	cfgProvider.retentionRules["user-1"][0].Period = model.Duration(time.Since(time.Unix(0, 0)) / 3)
+	require.NoError(t, c.applyRetentionRules(ctx, "user-1", userBucket, fetcher, log.NewNopLogger()))
+	metas, _, err = fetcher.FetchWithoutMarkedForDeletion(ctx)
+	require.NoError(t, err)
+	require.Len(t, metas, 1)
+	require.NotContains(t, metas, mixed)
+	for _, m := range metas {
+		assert.Equal(t, uint64(90), m.Stats.NumProfiles)
+	}
+	assert.Equal(t, float64(20), prom_testutil.ToFloat64(c.retentionDeletedProfiles.WithLabelValues("user-1", "ci")))
+}
@@ -0,0 +1,134 @@
+package compactor
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/oklog/ulid"
+
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/objstore"
+	"github.com/grafana/pyroscope/pkg/objstore/client"
+	"github.com/grafana/pyroscope/pkg/objstore/providers/filesystem"
+	"github.com/grafana/pyroscope/pkg/phlaredb"
+	"github.com/grafana/pyroscope/pkg/phlaredb/block"
+)
+
+// rewriteHint is added to the compaction hints of the copy that
+// replaces a rewritten block: the block is the only parent of the copy.
+const rewriteHint = "rewrite"
+
+// rewrittenFrom returns the block the given block is a rewritten copy of.
+func rewrittenFrom(m *block.Meta) (ulid.ULID, bool) {
+	if len(m.Compaction.Parents) != 1 || !slices.Contains(m.Compaction.Hints, rewriteHint) {
+		return ulid.ULID{}, false
+	}
+	return m.Compaction.Parents[0].ULID, true
+}
+
+// rewriteBlock replaces the block with a copy that does not include the
+// profiles matching the filter. If there are none, the block is left intact.
+// It returns the number of deleted profiles and the number of bytes
+// reclaimed in the object storage.
+//
+// The copy is uploaded before the block is marked for deletion. If the
+// compactor fails in between, the deduplicate filter recognizes the block
+// by the rewrite hint of the copy, and the block is not rewritten again.
+func (c *MultitenantCompactor) rewriteBlock(
+	ctx context.Context,
+	userID string,
+	userBucket objstore.Bucket,
+	meta *block.Meta,
+	del phlaredb.ProfileFilter,
+	logger log.Logger,
+) (deleted int, reclaimed int64, err error) {
+	dir := filepath.Join(c.compactorCfg.DataDir, "rewrite", userID)
+	if err = os.RemoveAll(dir); err != nil {
+		return 0, 0, err
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			level.Warn(logger).Log("msg", "failed to remove block rewrite directory", "dir", dir, "err", err)
+		}
+	}()
+	src := filepath.Join(dir, meta.ULID.String())
+	if err = block.Download(ctx, logger, userBucket, meta.ULID, src); err != nil {
+		return 0, 0, err
+	}
+
+	localBucket, err := client.NewBucket(ctx, client.Config{
+		StorageBackendConfig: client.StorageBackendConfig{
+			Backend:    client.Filesystem,
+			Filesystem: filesystem.Config{Directory: dir},
+		},
+	}, "local-compactor")
+	if err != nil {
+		return 0, 0, err
+	}
+	defer localBucket.Close()
+	reader := phlaredb.NewSingleBlockQuerierFromMeta(ctx, localBucket, meta)
+	if err = reader.Open(ctx); err != nil {
+		return 0, 0, err
+	}
+	defer func() {
+		if err := reader.Close(); err != nil {
+			level.Warn(logger).Log("msg", "failed to close block", "block", meta.ULID, "err", err)
+		}
+	}()
+
+	metas, err := phlaredb.CompactWithSplitting(ctx, phlaredb.CompactWithSplittingOpts{
+		Src:                []phlaredb.BlockReader{reader},
+		Dst:                dir,
+		SplitCount:         1,
+		SplitBy:            phlaredb.SplitByFingerprint,
+		DownsamplerEnabled: c.compactorCfg.DownsamplerEnabled && c.cfgProvider.CompactorDownsamplerEnabled(userID),
+		Logger:             logger,
+		Delete: func(lbs phlaremodel.Labels, timeNanos int64) bool {
+			if del(lbs, timeNanos) {
+				deleted++
+				return true
+			}
+			return false
+		},
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	if deleted == 0 {
+		return 0, 0, nil
+	}
+
+	reclaimed = blockSize(meta)
+	for _, m := range metas {
+		bdir := filepath.Join(dir, m.ULID.String())
+		m.Compaction.Hints = append(m.Compaction.Hints, rewriteHint)
+		if _, err = m.WriteToFile(logger, bdir); err != nil {
+			return 0, 0, err
+		}
+		if err = phlaredb.ValidateLocalBlock(ctx, bdir); err != nil {
+			return 0, 0, fmt.Errorf("invalid result block %s: %w", m.ULID, err)
+		}
+		if err = block.Upload(ctx, logger, userBucket, bdir); err != nil {
+			return 0, 0, fmt.Errorf("upload of %s failed: %w", m.ULID, err)
+		}
+		reclaimed -= blockSize(&m)
+	}
+	// If all the profiles have been deleted, no block is created.
+	if err = deleteBlock(ctx, userBucket, meta.ULID, src, logger, c.blocksMarkedForDeletion); err != nil {
+		return 0, 0, err
+	}
+	level.Info(logger).Log("msg", "rewrote block with profiles deleted", "block", meta.ULID, "deleted_profiles", deleted, "new_blocks", len(metas))
+	return deleted, reclaimed, nil
+}
+
+func blockSize(m *block.Meta) (size int64) {
+	for _, f := range m.Files {
+		size += int64(f.SizeBytes)
+	}
+	return size
+}
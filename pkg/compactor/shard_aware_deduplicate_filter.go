@@ -34,6 +34,17 @@ func NewShardAwareDeduplicateFilter() *ShardAwareDeduplicateFilter {
 func (f *ShardAwareDeduplicateFilter) Filter(ctx context.Context, metas map[ulid.ULID]*block.Meta, synced block.GaugeVec) error {
 	f.duplicateIDs = f.duplicateIDs[:0]
 
+	// A rewritten block has the same sources as its copy, the copy is kept.
+	for _, meta := range metas {
+		if id, ok := rewrittenFrom(meta); ok && metas[id] != nil {
+			f.duplicateIDs = append(f.duplicateIDs, id)
+		}
+	}
+	for _, id := range f.duplicateIDs {
+		synced.WithLabelValues(duplicateMeta).Inc()
+		delete(metas, id)
+	}
+
 	metasByResolution := make(map[int64][]*block.Meta)
 	for _, meta := range metas {
 		res := meta.Downsample.Resolution
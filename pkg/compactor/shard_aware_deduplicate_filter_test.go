@@ -380,6 +380,25 @@ func TestShardAwareDeduplicateFilter_Filter(t *testing.T) {
 	}
 }
 
+func TestShardAwareDeduplicateFilter_RewrittenBlock(t *testing.T) {
+	f := NewShardAwareDeduplicateFilter()
+	m := newTestFetcherMetrics()
+
+	rewritten := &block.Meta{ULID: ULID(2), Compaction: block.BlockMetaCompaction{Sources: []ulid.ULID{ULID(1)}}}
+	// The copy has the same sources, but sorts before the rewritten block.
+	cp := &block.Meta{ULID: ULID(1), Compaction: block.BlockMetaCompaction{
+		Sources: []ulid.ULID{ULID(1)},
+		Parents: []block.BlockDesc{{ULID: ULID(2)}},
+		Hints:   []string{rewriteHint},
+	}}
+	metas := map[ulid.ULID]*block.Meta{ULID(1): cp, ULID(2): rewritten}
+
+	require.NoError(t, f.Filter(context.Background(), metas, m.Synced))
+	require.Equal(t, map[ulid.ULID]*block.Meta{ULID(1): cp}, metas)
+	require.Equal(t, []ulid.ULID{ULID(2)}, f.DuplicateIDs())
+	require.Equal(t, float64(1), promtest.ToFloat64(m.Synced.WithLabelValues(duplicateMeta)))
+}
+
 func newTestFetcherMetrics() *block.FetcherMetrics {
 	return &block.FetcherMetrics{
 		Synced: extprom.NewTxGaugeVec(nil, prometheus.GaugeOpts{}, []string{"state"}),
@@ -9,6 +9,8 @@ import (
 
 	"github.com/go-kit/log"
 	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/grafana/pyroscope/pkg/phlaredb"
 )
 
 func splitAndMergeGrouperFactory(_ context.Context, cfg Config, cfgProvider ConfigProvider, userID string, logger log.Logger, _ prometheus.Registerer) Grouper {
@@ -25,7 +27,7 @@ func splitAndMergePlannerFactory(cfg Config) Planner {
 	return NewSplitAndMergePlanner(cfg.BlockRanges.ToMilliseconds())
 }
 
-func splitAndMergeCompactorFactory(_ context.Context, cfg Config, cfgProvider ConfigProvider, userID string, logger log.Logger, metrics *CompactorMetrics) (Compactor, error) {
+func splitAndMergeCompactorFactory(_ context.Context, cfg Config, cfgProvider ConfigProvider, userID string, logger log.Logger, metrics *CompactorMetrics, deleted phlaredb.ProfileFilter) (Compactor, error) {
 	splitBy := getCompactionSplitBy(cfg.CompactionSplitBy)
 	if splitBy == nil {
 		return nil, errInvalidCompactionSplitBy
@@ -34,6 +36,7 @@ func splitAndMergeCompactorFactory(_ context.Context, cfg Config, cfgProvider Co
 		blockOpenConcurrency: cfg.MaxOpeningBlocksConcurrency,
 		downsamplerEnabled:   cfg.DownsamplerEnabled && cfgProvider.CompactorDownsamplerEnabled(userID),
 		splitBy:              splitBy,
+		deleted:              deleted,
 		logger:               logger,
 		metrics:              metrics,
 	}, nil
@@ -0,0 +1,140 @@
+package debuginfo
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"net/http"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/gorilla/mux"
+	"github.com/grafana/dskit/services"
+
+	"github.com/grafana/pyroscope/pkg/objstore"
+	"github.com/grafana/pyroscope/pkg/tenant"
+	httputil "github.com/grafana/pyroscope/pkg/util/http"
+)
+
+type Config struct {
+	SymbolizationEnabled bool          `yaml:"symbolization_enabled"`
+	DebuginfodURL        string        `yaml:"debuginfod_url"`
+	DebuginfodTimeout    time.Duration `yaml:"debuginfod_timeout"`
+	MaxFileSize          int64         `yaml:"max_file_size"`
+	MaxCacheSize         int64         `yaml:"max_cache_size_bytes"`
+	LoadTimeout          time.Duration `yaml:"load_timeout"`
+}
+
+func (cfg *Config) RegisterFlags(f *flag.FlagSet) {
+	f.BoolVar(&cfg.SymbolizationEnabled, "debuginfo.symbolization-enabled", false, "Resolve addresses of unsymbolized native profiles at query time, using the debug information files uploaded or fetched from the debuginfod server.")
+	f.StringVar(&cfg.DebuginfodURL, "debuginfo.debuginfod-url", "", "URL of the debuginfod server to fetch debug information files from, if they have not been uploaded.")
+	f.DurationVar(&cfg.DebuginfodTimeout, "debuginfo.debuginfod-timeout", 30*time.Second, "Timeout for fetching a debug information file from the debuginfod server.")
+	f.Int64Var(&cfg.MaxFileSize, "debuginfo.max-file-size", 512<<20, "Maximum size of a debug information file in bytes.")
+	f.Int64Var(&cfg.MaxCacheSize, "debuginfo.max-cache-size-bytes", 1<<30, "Maximum total size in bytes of the debug information files kept in memory for symbolization.")
+	f.DurationVar(&cfg.LoadTimeout, "debuginfo.load-timeout", time.Minute, "Timeout for loading a debug information file for symbolization. A file is loaded once for all the queries that need it, regardless of their cancellation.")
+}
+
+// DebugInfo serves the debug information files upload API, and
+// the debuginfod-compatible API to fetch the uploaded files.
+type DebugInfo struct {
+	services.Service
+
+	logger log.Logger
+	store  *Store
+}
+
+func New(cfg Config, bucket objstore.Bucket, logger log.Logger) *DebugInfo {
+	d := &DebugInfo{
+		logger: logger,
+		store:  NewStore(bucket, cfg.MaxFileSize),
+	}
+	d.Service = services.NewBasicService(nil, d.running, nil)
+	return d
+}
+
+func (d *DebugInfo) running(ctx context.Context) error {
+	<-ctx.Done()
+	return nil
+}
+
+// NewSymbolizer creates a symbolizer, if the symbolization is enabled.
+// The bucket is optional: if it is nil, only the debuginfod server is used.
+func NewSymbolizer(cfg Config, bucket objstore.Bucket, logger log.Logger) (*Symbolizer, error) {
+	if !cfg.SymbolizationEnabled {
+		return nil, nil
+	}
+	var store *Store
+	if bucket != nil {
+		store = NewStore(bucket, cfg.MaxFileSize)
+	}
+	var debuginfod *DebuginfodClient
+	if cfg.DebuginfodURL != "" {
+		debuginfod = NewDebuginfodClient(cfg.DebuginfodURL, cfg.DebuginfodTimeout, cfg.MaxFileSize)
+	}
+	return newSymbolizer(logger, store, debuginfod, cfg.MaxCacheSize, cfg.LoadTimeout), nil
+}
+
+// Path is the route of a debug information file. The path
+// prefix can be used as a debuginfod server URL.
+const Path = "/debuginfo/buildid/{build_id}/debuginfo"
+
+// UploadHandler stores the debug information file sent in
+// the request body. The file must be an ELF binary: if it
+// has a GNU build ID note, the build ID must match.
+func (d *DebugInfo) UploadHandler(w http.ResponseWriter, r *http.Request) {
+	tenantID, buildID, err := requestParams(r)
+	if err != nil {
+		httputil.ErrorWithStatus(w, err, http.StatusBadRequest)
+		return
+	}
+	data, err := readFile(r.Body, d.store.maxFileSize)
+	if err != nil {
+		if errors.Is(err, ErrFileTooLarge) {
+			httputil.ErrorWithStatus(w, err, http.StatusRequestEntityTooLarge)
+			return
+		}
+		httputil.ErrorWithStatus(w, err, http.StatusBadRequest)
+		return
+	}
+	if err = validateBinaryFile(bytes.NewReader(data), buildID); err != nil {
+		httputil.ErrorWithStatus(w, err, http.StatusBadRequest)
+		return
+	}
+	if err = d.store.Upload(r.Context(), tenantID, buildID, data); err != nil {
+		level.Error(d.logger).Log("msg", "failed to upload debug info", "build_id", buildID, "err", err)
+		httputil.Error(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// DownloadHandler serves the debug information file
+// in accordance with the debuginfod HTTP API.
+func (d *DebugInfo) DownloadHandler(w http.ResponseWriter, r *http.Request) {
+	tenantID, buildID, err := requestParams(r)
+	if err != nil {
+		httputil.ErrorWithStatus(w, err, http.StatusBadRequest)
+		return
+	}
+	data, err := d.store.Get(r.Context(), tenantID, buildID)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			httputil.ErrorWithStatus(w, err, http.StatusNotFound)
+			return
+		}
+		httputil.Error(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	_, _ = w.Write(data)
+}
+
+func requestParams(r *http.Request) (tenantID, buildID string, err error) {
+	if tenantID, err = tenant.ExtractTenantIDFromContext(r.Context()); err != nil {
+		return "", "", err
+	}
+	buildID, err = ValidateBuildID(mux.Vars(r)["build_id"])
+	return tenantID, buildID, err
+}
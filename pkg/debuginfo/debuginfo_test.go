@@ -0,0 +1,181 @@
+package debuginfo
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/gorilla/mux"
+	"github.com/grafana/dskit/user"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/pyroscope/pkg/objstore/testutil"
+	"github.com/grafana/pyroscope/pkg/phlaredb/symdb"
+)
+
+func newTestServer(t *testing.T, d *DebugInfo, tenantID string) *httptest.Server {
+	r := mux.NewRouter()
+	r.Path(Path).Methods(http.MethodPut).HandlerFunc(d.UploadHandler)
+	r.Path(Path).Methods(http.MethodGet).HandlerFunc(d.DownloadHandler)
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		r.ServeHTTP(w, req.WithContext(user.InjectOrgID(req.Context(), tenantID)))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func upload(t *testing.T, url string, data []byte) int {
+	req, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	return resp.StatusCode
+}
+
+func Test_DebugInfo_UploadDownload(t *testing.T) {
+	bucket, _ := testutil.NewFilesystemBucket(t, context.Background(), t.TempDir())
+	d := New(Config{MaxFileSize: 1 << 20}, bucket, log.NewNopLogger())
+	s := newTestServer(t, d, "tenant-a")
+	data := readExampleFile(t)
+
+	url := s.URL + "/debuginfo/buildid/" + exampleBuildID + "/debuginfo"
+	resp, err := http.Get(url)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	require.Equal(t, http.StatusBadRequest, upload(t, s.URL+"/debuginfo/buildid/0123/debuginfo", data))
+	require.Equal(t, http.StatusBadRequest, upload(t, s.URL+"/debuginfo/buildid/not-hex/debuginfo", data))
+	require.Equal(t, http.StatusOK, upload(t, url, data))
+
+	// The download API is debuginfod compatible.
+	c := NewDebuginfodClient(s.URL+"/debuginfo", time.Second, 1<<20)
+	fetched, err := c.Fetch(context.Background(), exampleBuildID)
+	require.NoError(t, err)
+	require.Equal(t, data, fetched)
+	_, err = c.Fetch(context.Background(), "0123")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	// Files are isolated by tenant.
+	other := newTestServer(t, d, "tenant-b")
+	_, err = NewDebuginfodClient(other.URL+"/debuginfo", time.Second, 1<<20).Fetch(context.Background(), exampleBuildID)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	d = New(Config{MaxFileSize: 1 << 10}, bucket, log.NewNopLogger())
+	s = newTestServer(t, d, "tenant-a")
+	require.Equal(t, http.StatusRequestEntityTooLarge, upload(t, s.URL+"/debuginfo/buildid/"+exampleBuildID+"/debuginfo", data))
+}
+
+func Test_Symbolizer(t *testing.T) {
+	// debuginfod server stand-in.
+	const blockedBuildID = "abcd"
+	const failingBuildID = "ffff"
+	release := make(chan struct{})
+	var requests int
+	debuginfod := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path == "/buildid/"+blockedBuildID+"/debuginfo" {
+			<-release
+		}
+		if r.URL.Path == "/buildid/"+failingBuildID+"/debuginfo" {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		if r.URL.Path != "/buildid/"+exampleBuildID+"/debuginfo" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = io.Copy(w, bytes.NewReader(readExampleFile(t)))
+	}))
+	defer debuginfod.Close()
+
+	bucket, _ := testutil.NewFilesystemBucket(t, context.Background(), t.TempDir())
+	s, err := NewSymbolizer(Config{
+		SymbolizationEnabled: true,
+		DebuginfodURL:        debuginfod.URL,
+		DebuginfodTimeout:    time.Second,
+		MaxCacheSize:         1 << 20,
+	}, bucket, log.NewNopLogger())
+	require.NoError(t, err)
+
+	ctx := user.InjectOrgID(context.Background(), "tenant-a")
+	m := symdb.SymbolizerMapping{
+		BuildID:     exampleBuildID,
+		MemoryStart: 0x7f0000001000,
+		MemoryLimit: 0x7f0000002000,
+		FileOffset:  0x1000,
+	}
+	frames, err := s.Symbolize(ctx, m, []uint64{0x7f0000001143, 0x7f0000003000})
+	require.NoError(t, err)
+	require.Equal(t, [][]symdb.SymbolizedFrame{
+		{{FunctionName: "compute", FileName: "/src/example.c", StartLine: 5, Line: 6}},
+		nil,
+	}, frames)
+	require.Equal(t, 1, requests)
+
+	// The file fetched from debuginfod is saved to the store.
+	stored, err := NewStore(bucket, 0).Get(ctx, "tenant-a", exampleBuildID)
+	require.NoError(t, err)
+	require.Equal(t, readExampleFile(t), stored)
+
+	// Missing files are remembered.
+	m.BuildID = "0123"
+	for i := 0; i < 2; i++ {
+		frames, err = s.Symbolize(ctx, m, []uint64{0x7f0000001143})
+		require.NoError(t, err)
+		require.Equal(t, [][]symdb.SymbolizedFrame{nil}, frames)
+	}
+	require.Equal(t, 2, requests)
+
+	// Files larger than the cache are loaded on every call.
+	small, err := NewSymbolizer(Config{
+		SymbolizationEnabled: true,
+		DebuginfodURL:        debuginfod.URL,
+		DebuginfodTimeout:    time.Second,
+		MaxCacheSize:         1 << 10,
+	}, nil, log.NewNopLogger())
+	require.NoError(t, err)
+	m.BuildID = exampleBuildID
+	for i := 0; i < 2; i++ {
+		frames, err = small.Symbolize(ctx, m, []uint64{0x7f0000001143})
+		require.NoError(t, err)
+		require.Len(t, frames[0], 1)
+	}
+	require.Equal(t, 4, requests)
+
+	// The loading is not canceled with the caller context.
+	m.BuildID = blockedBuildID
+	timeout, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	_, err = s.Symbolize(timeout, m, []uint64{0x7f0000001143})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	close(release)
+	frames, err = s.Symbolize(ctx, m, []uint64{0x7f0000001143})
+	require.NoError(t, err)
+	require.Equal(t, [][]symdb.SymbolizedFrame{nil}, frames)
+	require.Equal(t, 5, requests)
+
+	// Fetch errors other than not found are not remembered.
+	m.BuildID = failingBuildID
+	for i := 0; i < 2; i++ {
+		frames, err = s.Symbolize(ctx, m, []uint64{0x7f0000001143})
+		require.NoError(t, err)
+		require.Equal(t, [][]symdb.SymbolizedFrame{nil}, frames)
+	}
+	require.Equal(t, 7, requests)
+
+	// Expired entries of missing files are replaced.
+	size := s.size
+	s.addFile("tenant-a/0123", &cachedFile{expires: time.Now()})
+	require.Equal(t, size, s.size)
+
+	disabled, err := NewSymbolizer(Config{}, bucket, log.NewNopLogger())
+	require.NoError(t, err)
+	require.Nil(t, disabled)
+}
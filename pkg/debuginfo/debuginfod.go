@@ -0,0 +1,49 @@
+package debuginfo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DebuginfodClient fetches debug information files from
+// a server that implements the debuginfod HTTP API.
+type DebuginfodClient struct {
+	url         string
+	client      *http.Client
+	maxFileSize int64
+}
+
+func NewDebuginfodClient(url string, timeout time.Duration, maxFileSize int64) *DebuginfodClient {
+	return &DebuginfodClient{
+		url:         strings.TrimSuffix(url, "/"),
+		client:      &http.Client{Timeout: timeout},
+		maxFileSize: maxFileSize,
+	}
+}
+
+func debuginfodPath(buildID string) string {
+	return "/buildid/" + buildID + "/debuginfo"
+}
+
+func (c *DebuginfodClient) Fetch(ctx context.Context, buildID string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url+debuginfodPath(buildID), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, fmt.Errorf("debuginfod server responded with status %s", resp.Status)
+	}
+	return readFile(resp.Body, c.maxFileSize)
+}
@@ -0,0 +1,417 @@
+package debuginfo
+
+import (
+	"bytes"
+	"debug/dwarf"
+	"debug/elf"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/grafana/pyroscope/pkg/phlaredb/symdb"
+)
+
+// binaryFile provides symbol information of an ELF binary.
+// Addresses are resolved with DWARF debug information, if present,
+// falling back to the ELF symbol tables.
+type binaryFile struct {
+	loads   []elf.ProgHeader
+	symbols []elf.Symbol
+	dwarf   *dwarf.Data
+
+	// Sorted by low address; the ranges do not overlap.
+	functions []*function
+	// LineReader is stateful, therefore the access
+	// to the line tables must be synchronized.
+	m     sync.Mutex
+	units map[dwarf.Offset]*dwarf.LineReader
+}
+
+type function struct {
+	low, high uint64
+	unit      dwarf.Offset
+	name      string
+	file      string
+	startLine int64
+	// Inlined calls within the function,
+	// ordered from the outermost ones.
+	inlined []*inlinedCall
+}
+
+type inlinedCall struct {
+	ranges    [][2]uint64
+	depth     int
+	name      string
+	file      string
+	startLine int64
+	callFile  string
+	callLine  int64
+}
+
+func openBinaryFile(r io.ReaderAt) (*binaryFile, error) {
+	f, err := elf.NewFile(r)
+	if err != nil {
+		return nil, err
+	}
+	b := binaryFile{units: make(map[dwarf.Offset]*dwarf.LineReader)}
+	for _, p := range f.Progs {
+		if p.Type == elf.PT_LOAD {
+			b.loads = append(b.loads, p.ProgHeader)
+		}
+	}
+	b.symbols = functionSymbols(f)
+	if b.dwarf, err = f.DWARF(); err != nil {
+		// The binary has no debug information: ELF
+		// symbol tables are used to resolve addresses.
+		b.dwarf = nil
+		return &b, nil
+	}
+	if err = b.readFunctions(); err != nil {
+		return nil, fmt.Errorf("reading DWARF: %w", err)
+	}
+	return &b, nil
+}
+
+func functionSymbols(f *elf.File) []elf.Symbol {
+	symbols, _ := f.Symbols()
+	if len(symbols) == 0 {
+		symbols, _ = f.DynamicSymbols()
+	}
+	functions := symbols[:0]
+	for _, s := range symbols {
+		if elf.ST_TYPE(s.Info) == elf.STT_FUNC && s.Value != 0 {
+			functions = append(functions, s)
+		}
+	}
+	sort.Slice(functions, func(i, j int) bool {
+		return functions[i].Value < functions[j].Value
+	})
+	return functions
+}
+
+// readFunctions collects the subprograms and the inlined
+// subroutines found in the DWARF debug information.
+func (b *binaryFile) readFunctions() error {
+	type declaration struct {
+		name string
+		file int64
+		line int64
+		unit dwarf.Offset
+	}
+	declarations := make(map[dwarf.Offset]*declaration)
+	type reference struct {
+		origin dwarf.Offset
+		set    func(*declaration, []*dwarf.LineFile)
+	}
+	var references []reference
+
+	var (
+		unit  *dwarf.Entry
+		files []*dwarf.LineFile
+		depth int
+		// The function and the inlined
+		// calls stack of the current scope.
+		scope []int
+		fn    []*function
+	)
+	r := b.dwarf.Reader()
+	for {
+		e, err := r.Next()
+		if err != nil {
+			return err
+		}
+		if e == nil {
+			break
+		}
+		if e.Tag == 0 {
+			depth--
+			for len(scope) > 0 && scope[len(scope)-1] >= depth {
+				scope = scope[:len(scope)-1]
+			}
+			if len(scope) == 0 {
+				fn = nil
+			}
+			continue
+		}
+		entryDepth := depth
+		if e.Children {
+			depth++
+		}
+
+		switch e.Tag {
+		case dwarf.TagCompileUnit:
+			unit, files = e, nil
+			if lr, err := b.dwarf.LineReader(e); err == nil && lr != nil {
+				b.units[e.Offset] = lr
+				files = lr.Files()
+			}
+			continue
+
+		case dwarf.TagSubprogram:
+			d := &declaration{unit: unit.Offset}
+			d.name, _ = e.Val(dwarf.AttrName).(string)
+			if d.name == "" {
+				// The linkage name is only used if the name is missing.
+				d.name, _ = e.Val(dwarf.AttrLinkageName).(string)
+			}
+			d.file, _ = e.Val(dwarf.AttrDeclFile).(int64)
+			d.line, _ = e.Val(dwarf.AttrDeclLine).(int64)
+			declarations[e.Offset] = d
+			ranges, _ := b.dwarf.Ranges(e)
+			if len(ranges) == 0 {
+				continue
+			}
+			fn = fn[:0]
+			for _, rng := range ranges {
+				f := &function{
+					low:       rng[0],
+					high:      rng[1],
+					unit:      unit.Offset,
+					name:      d.name,
+					file:      lineFileName(files, d.file),
+					startLine: d.line,
+				}
+				fn = append(fn, f)
+				b.functions = append(b.functions, f)
+			}
+			if origin, ok := entryOrigin(e); ok && d.name == "" {
+				functions := append([]*function(nil), fn...)
+				references = append(references, reference{
+					origin: origin,
+					set: func(o *declaration, files []*dwarf.LineFile) {
+						for _, f := range functions {
+							f.name = o.name
+							f.file = lineFileName(files, o.file)
+							f.startLine = o.line
+						}
+					},
+				})
+			}
+			if e.Children {
+				scope = append(scope[:0], entryDepth)
+			}
+
+		case dwarf.TagInlinedSubroutine:
+			if len(scope) == 0 {
+				continue
+			}
+			ranges, _ := b.dwarf.Ranges(e)
+			if len(ranges) == 0 {
+				continue
+			}
+			c := &inlinedCall{ranges: ranges, depth: len(scope)}
+			callFile, _ := e.Val(dwarf.AttrCallFile).(int64)
+			c.callFile = lineFileName(files, callFile)
+			c.callLine, _ = e.Val(dwarf.AttrCallLine).(int64)
+			for _, f := range fn {
+				f.inlined = append(f.inlined, c)
+			}
+			if origin, ok := entryOrigin(e); ok {
+				references = append(references, reference{
+					origin: origin,
+					set: func(o *declaration, files []*dwarf.LineFile) {
+						c.name = o.name
+						c.file = lineFileName(files, o.file)
+						c.startLine = o.line
+					},
+				})
+			}
+			if e.Children {
+				scope = append(scope, entryDepth)
+			}
+		}
+	}
+
+	// Abstract origins and specifications may refer to entries
+	// declared later, therefore they are resolved at the very end.
+	for _, ref := range references {
+		d, ok := declarations[ref.origin]
+		if !ok {
+			continue
+		}
+		var files []*dwarf.LineFile
+		if lr := b.units[d.unit]; lr != nil {
+			files = lr.Files()
+		}
+		ref.set(d, files)
+	}
+
+	sort.Slice(b.functions, func(i, j int) bool {
+		return b.functions[i].low < b.functions[j].low
+	})
+	return nil
+}
+
+func entryOrigin(e *dwarf.Entry) (dwarf.Offset, bool) {
+	if o, ok := e.Val(dwarf.AttrAbstractOrigin).(dwarf.Offset); ok {
+		return o, true
+	}
+	o, ok := e.Val(dwarf.AttrSpecification).(dwarf.Offset)
+	return o, ok
+}
+
+func lineFileName(files []*dwarf.LineFile, i int64) string {
+	if i < 0 || int(i) >= len(files) || files[i] == nil {
+		return ""
+	}
+	return files[i].Name
+}
+
+// address translates the runtime address of the mapping
+// into the virtual address of the binary.
+func (b *binaryFile) address(m symdb.SymbolizerMapping, addr uint64) (uint64, bool) {
+	if m.MemoryStart == 0 && m.MemoryLimit == 0 && m.FileOffset == 0 {
+		// The address is not relocated.
+		return addr, true
+	}
+	if addr < m.MemoryStart || (m.MemoryLimit > 0 && addr >= m.MemoryLimit) {
+		return 0, false
+	}
+	offset := addr - m.MemoryStart + m.FileOffset
+	for _, p := range b.loads {
+		if offset >= p.Off && offset < p.Off+p.Filesz {
+			return offset - p.Off + p.Vaddr, true
+		}
+	}
+	return 0, false
+}
+
+// resolve returns the frames of the virtual address, ordered
+// from the innermost one. If the address can't be resolved,
+// the result is empty.
+func (b *binaryFile) resolve(addr uint64) []symdb.SymbolizedFrame {
+	if f := b.function(addr); f != nil {
+		return b.frames(f, addr)
+	}
+	i := sort.Search(len(b.symbols), func(i int) bool {
+		return b.symbols[i].Value > addr
+	}) - 1
+	if i < 0 {
+		return nil
+	}
+	s := b.symbols[i]
+	if s.Size > 0 && addr >= s.Value+s.Size {
+		return nil
+	}
+	return []symdb.SymbolizedFrame{{FunctionName: s.Name}}
+}
+
+func (b *binaryFile) function(addr uint64) *function {
+	i := sort.Search(len(b.functions), func(i int) bool {
+		return b.functions[i].low > addr
+	}) - 1
+	if i < 0 || addr >= b.functions[i].high {
+		return nil
+	}
+	return b.functions[i]
+}
+
+func (b *binaryFile) frames(f *function, addr uint64) []symdb.SymbolizedFrame {
+	var calls []*inlinedCall
+	for _, c := range f.inlined {
+		if containsAddress(c.ranges, addr) {
+			calls = append(calls, c)
+		}
+	}
+	sort.SliceStable(calls, func(i, j int) bool {
+		return calls[i].depth < calls[j].depth
+	})
+
+	frames := make([]symdb.SymbolizedFrame, 0, len(calls)+1)
+	file, line := b.line(f.unit, addr)
+	// The innermost frame location is defined by the line table;
+	// the location of the outer frames is the inlined call site.
+	for i := len(calls) - 1; i >= 0; i-- {
+		c := calls[i]
+		frames = append(frames, symdb.SymbolizedFrame{
+			FunctionName: c.name,
+			FileName:     orDefault(file, c.file),
+			StartLine:    c.startLine,
+			Line:         line,
+		})
+		file, line = c.callFile, c.callLine
+	}
+	return append(frames, symdb.SymbolizedFrame{
+		FunctionName: f.name,
+		FileName:     orDefault(file, f.file),
+		StartLine:    f.startLine,
+		Line:         line,
+	})
+}
+
+func (b *binaryFile) line(unit dwarf.Offset, addr uint64) (string, int64) {
+	b.m.Lock()
+	defer b.m.Unlock()
+	lr := b.units[unit]
+	if lr == nil {
+		return "", 0
+	}
+	var e dwarf.LineEntry
+	if err := lr.SeekPC(addr, &e); err != nil || e.File == nil {
+		return "", 0
+	}
+	return e.File.Name, int64(e.Line)
+}
+
+func containsAddress(ranges [][2]uint64, addr uint64) bool {
+	for _, r := range ranges {
+		if addr >= r[0] && addr < r[1] {
+			return true
+		}
+	}
+	return false
+}
+
+func orDefault(s, d string) string {
+	if s != "" {
+		return s
+	}
+	return d
+}
+
+var errBuildIDMismatch = errors.New("build ID mismatch")
+
+// validateBinaryFile checks that the data is an ELF file. If the file
+// has a GNU build ID note, it must match the build ID given.
+func validateBinaryFile(r io.ReaderAt, buildID string) error {
+	f, err := elf.NewFile(r)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	id, ok := gnuBuildID(f)
+	if ok && id != buildID {
+		return fmt.Errorf("%w: file build ID is %s", errBuildIDMismatch, id)
+	}
+	return nil
+}
+
+func gnuBuildID(f *elf.File) (string, bool) {
+	s := f.Section(".note.gnu.build-id")
+	if s == nil {
+		return "", false
+	}
+	data, err := s.Data()
+	if err != nil || len(data) < 16 {
+		return "", false
+	}
+	// Note header: name size, description size, type.
+	nameSize := f.ByteOrder.Uint32(data[0:4])
+	descSize := f.ByteOrder.Uint32(data[4:8])
+	noteType := f.ByteOrder.Uint32(data[8:12])
+	nameEnd := 12 + align4(nameSize)
+	if noteType != 3 || uint64(nameEnd)+uint64(descSize) > uint64(len(data)) {
+		return "", false
+	}
+	if !bytes.Equal(bytes.TrimRight(data[12:12+nameSize], "\x00"), []byte("GNU")) {
+		return "", false
+	}
+	return hex.EncodeToString(data[nameEnd : nameEnd+descSize]), true
+}
+
+func align4(n uint32) uint32 {
+	return (n + 3) &^ 3
+}
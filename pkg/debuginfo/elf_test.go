@@ -0,0 +1,77 @@
+package debuginfo
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/pyroscope/pkg/phlaredb/symdb"
+)
+
+const (
+	exampleBuildID = "de77b564e4efaa78fee0ca7cdbeee99fe7d6265b"
+	// compute function address; the first instructions
+	// belong to the inlined square function call.
+	exampleComputeAddr = 0x1140
+)
+
+func readExampleFile(t *testing.T) []byte {
+	data, err := os.ReadFile("testdata/example")
+	require.NoError(t, err)
+	return data
+}
+
+func Test_binaryFile_resolve(t *testing.T) {
+	f, err := openBinaryFile(bytes.NewReader(readExampleFile(t)))
+	require.NoError(t, err)
+
+	require.Equal(t, []symdb.SymbolizedFrame{
+		{FunctionName: "square", FileName: "/src/example.c", StartLine: 1, Line: 2},
+		{FunctionName: "compute", FileName: "/src/example.c", StartLine: 5, Line: 6},
+	}, f.resolve(exampleComputeAddr))
+
+	require.Equal(t, []symdb.SymbolizedFrame{
+		{FunctionName: "compute", FileName: "/src/example.c", StartLine: 5, Line: 6},
+	}, f.resolve(exampleComputeAddr+3))
+
+	require.Empty(t, f.resolve(0x10))
+}
+
+func Test_binaryFile_resolve_symtab(t *testing.T) {
+	f, err := openBinaryFile(bytes.NewReader(readExampleFile(t)))
+	require.NoError(t, err)
+	// Without the debug information, only the symbol table is used.
+	f.functions = nil
+	require.Equal(t, []symdb.SymbolizedFrame{{FunctionName: "compute"}}, f.resolve(exampleComputeAddr+3))
+}
+
+func Test_binaryFile_address(t *testing.T) {
+	f, err := openBinaryFile(bytes.NewReader(readExampleFile(t)))
+	require.NoError(t, err)
+
+	// The executable segment is mapped at the file offset 0x1000.
+	m := symdb.SymbolizerMapping{
+		MemoryStart: 0x7f0000001000,
+		MemoryLimit: 0x7f0000002000,
+		FileOffset:  0x1000,
+	}
+	addr, ok := f.address(m, 0x7f0000001140)
+	require.True(t, ok)
+	require.Equal(t, uint64(exampleComputeAddr), addr)
+
+	_, ok = f.address(m, 0x7f0000003000)
+	require.False(t, ok)
+
+	addr, ok = f.address(symdb.SymbolizerMapping{}, exampleComputeAddr)
+	require.True(t, ok)
+	require.Equal(t, uint64(exampleComputeAddr), addr)
+}
+
+func Test_validateBinaryFile(t *testing.T) {
+	data := readExampleFile(t)
+	require.NoError(t, validateBinaryFile(bytes.NewReader(data), exampleBuildID))
+	require.ErrorIs(t, validateBinaryFile(bytes.NewReader(data), "0123"), errBuildIDMismatch)
+	require.Error(t, validateBinaryFile(bytes.NewReader([]byte("not an elf file")), exampleBuildID))
+}
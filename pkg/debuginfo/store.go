@@ -0,0 +1,87 @@
+package debuginfo
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/grafana/pyroscope/pkg/objstore"
+)
+
+var (
+	ErrNotFound       = errors.New("debug info not found")
+	ErrInvalidBuildID = errors.New("invalid build ID")
+	ErrFileTooLarge   = errors.New("debug info file is too large")
+)
+
+const maxBuildIDLength = 128
+
+// ValidateBuildID checks that the build ID is a hex string
+// and returns it in the canonical, lower case, form.
+func ValidateBuildID(buildID string) (string, error) {
+	if len(buildID) == 0 || len(buildID) > maxBuildIDLength {
+		return "", ErrInvalidBuildID
+	}
+	buildID = strings.ToLower(buildID)
+	for _, c := range buildID {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return "", ErrInvalidBuildID
+		}
+	}
+	return buildID, nil
+}
+
+// Store keeps debug information files in the object storage,
+// keyed by the tenant and the build ID of the binary.
+type Store struct {
+	bucket      objstore.Bucket
+	maxFileSize int64
+}
+
+func NewStore(bucket objstore.Bucket, maxFileSize int64) *Store {
+	return &Store{
+		bucket:      bucket,
+		maxFileSize: maxFileSize,
+	}
+}
+
+func (s *Store) tenantBucket(tenantID string) objstore.Bucket {
+	return objstore.NewPrefixedBucket(s.bucket, tenantID+"/debuginfo")
+}
+
+func (s *Store) Upload(ctx context.Context, tenantID, buildID string, data []byte) error {
+	if s.maxFileSize > 0 && int64(len(data)) > s.maxFileSize {
+		return ErrFileTooLarge
+	}
+	return s.tenantBucket(tenantID).Upload(ctx, buildID, bytes.NewReader(data))
+}
+
+func (s *Store) Get(ctx context.Context, tenantID, buildID string) ([]byte, error) {
+	b := s.tenantBucket(tenantID)
+	r, err := b.Get(ctx, buildID)
+	if err != nil {
+		if b.IsObjNotFoundErr(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("fetching debug info %s: %w", buildID, err)
+	}
+	defer r.Close()
+	return readFile(r, s.maxFileSize)
+}
+
+func readFile(r io.Reader, maxSize int64) ([]byte, error) {
+	if maxSize <= 0 {
+		return io.ReadAll(r)
+	}
+	data, err := io.ReadAll(io.LimitReader(r, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxSize {
+		return nil, ErrFileTooLarge
+	}
+	return data, nil
+}
@@ -0,0 +1,188 @@
+package debuginfo
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/hashicorp/golang-lru/v2/simplelru"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/grafana/pyroscope/pkg/phlaredb/symdb"
+	"github.com/grafana/pyroscope/pkg/tenant"
+)
+
+// missingFileTTL specifies how long the absence of a debug
+// info file is remembered, before the file is requested again.
+const missingFileTTL = time.Minute
+
+// Symbolizer resolves addresses with the debug information found in
+// the store, or fetched from the debuginfod server, if configured.
+// Files fetched from debuginfod are saved to the store.
+type Symbolizer struct {
+	logger      log.Logger
+	store       *Store
+	debuginfod  *DebuginfodClient
+	loadTimeout time.Duration
+
+	group singleflight.Group
+
+	// The cache is bounded by the total size of the files.
+	// The size of a file is approximated by its size on disk.
+	mu      sync.Mutex
+	files   *simplelru.LRU[string, *cachedFile]
+	size    int64
+	maxSize int64
+}
+
+type cachedFile struct {
+	file    *binaryFile
+	size    int64
+	expires time.Time
+}
+
+// newSymbolizer creates a Symbolizer. Either of the store
+// and the debuginfod client may be nil.
+func newSymbolizer(logger log.Logger, store *Store, debuginfod *DebuginfodClient, maxCacheSize int64, loadTimeout time.Duration) *Symbolizer {
+	s := &Symbolizer{
+		logger:      logger,
+		store:       store,
+		debuginfod:  debuginfod,
+		loadTimeout: loadTimeout,
+		maxSize:     maxCacheSize,
+	}
+	// The number of entries is only bounded by the total size.
+	s.files, _ = simplelru.NewLRU[string, *cachedFile](math.MaxInt, func(_ string, c *cachedFile) {
+		s.size -= c.size
+	})
+	return s
+}
+
+func (s *Symbolizer) Symbolize(ctx context.Context, m symdb.SymbolizerMapping, addresses []uint64) ([][]symdb.SymbolizedFrame, error) {
+	frames := make([][]symdb.SymbolizedFrame, len(addresses))
+	buildID, err := ValidateBuildID(m.BuildID)
+	if err != nil {
+		return frames, nil
+	}
+	f, err := s.binaryFile(ctx, buildID)
+	if err != nil || f == nil {
+		return frames, err
+	}
+	for i, addr := range addresses {
+		if a, ok := f.address(m, addr); ok {
+			frames[i] = f.resolve(a)
+		}
+	}
+	return frames, nil
+}
+
+// binaryFile returns the file with the given build ID. If the file
+// can't be found or parsed, nil is returned: only the context
+// cancellation is considered an error.
+//
+// The file is loaded once for all the concurrent callers, therefore
+// the loading is not bound to the caller context but to loadTimeout.
+func (s *Symbolizer) binaryFile(ctx context.Context, buildID string) (*binaryFile, error) {
+	tenantID, _ := tenant.ExtractTenantIDFromContext(ctx)
+	key := tenantID + "/" + buildID
+	if f, ok := s.cachedFile(key); ok {
+		return f, nil
+	}
+	ch := s.group.DoChan(key, func() (interface{}, error) {
+		loadCtx := context.WithoutCancel(ctx)
+		if s.loadTimeout > 0 {
+			var cancel context.CancelFunc
+			loadCtx, cancel = context.WithTimeout(loadCtx, s.loadTimeout)
+			defer cancel()
+		}
+		f, size, ok := s.loadBinaryFile(loadCtx, tenantID, buildID)
+		if ok {
+			s.addFile(key, &cachedFile{file: f, size: size, expires: time.Now().Add(missingFileTTL)})
+		}
+		return f, nil
+	})
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case r := <-ch:
+		return r.Val.(*binaryFile), nil
+	}
+}
+
+func (s *Symbolizer) cachedFile(key string) (*binaryFile, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.files.Get(key)
+	if ok && (c.file != nil || time.Now().Before(c.expires)) {
+		return c.file, true
+	}
+	return nil, false
+}
+
+func (s *Symbolizer) addFile(key string, c *cachedFile) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// Entries of missing files must be accounted as well.
+	c.size += int64(len(key))
+	// An expired entry of a missing file may exist:
+	// the eviction callback accounts for its removal.
+	s.files.Remove(key)
+	if c.size > s.maxSize {
+		// The file is used by the current callers only.
+		return
+	}
+	s.files.Add(key, c)
+	s.size += c.size
+	for s.size > s.maxSize {
+		s.files.RemoveOldest()
+	}
+}
+
+// loadBinaryFile returns the file with the given build ID and its size.
+// If the file can't be loaded, nil is returned. The result should only be
+// cached if the file was loaded, or is known not to exist or be invalid:
+// transient fetch errors are not cached.
+func (s *Symbolizer) loadBinaryFile(ctx context.Context, tenantID, buildID string) (*binaryFile, int64, bool) {
+	data, err := s.fetch(ctx, tenantID, buildID)
+	switch {
+	case err == nil:
+	case errors.Is(err, ErrNotFound):
+		return nil, 0, true
+	default:
+		level.Warn(s.logger).Log("msg", "failed to fetch debug info", "build_id", buildID, "err", err)
+		return nil, 0, false
+	}
+	f, err := openBinaryFile(bytes.NewReader(data))
+	if err != nil {
+		level.Warn(s.logger).Log("msg", "failed to read debug info", "build_id", buildID, "err", err)
+		return nil, 0, true
+	}
+	return f, int64(len(data)), true
+}
+
+func (s *Symbolizer) fetch(ctx context.Context, tenantID, buildID string) ([]byte, error) {
+	if s.store != nil && tenantID != "" {
+		data, err := s.store.Get(ctx, tenantID, buildID)
+		if !errors.Is(err, ErrNotFound) {
+			return data, err
+		}
+	}
+	if s.debuginfod == nil {
+		return nil, ErrNotFound
+	}
+	data, err := s.debuginfod.Fetch(ctx, buildID)
+	if err != nil {
+		return nil, err
+	}
+	if s.store != nil && tenantID != "" {
+		if err = s.store.Upload(ctx, tenantID, buildID, data); err != nil {
+			level.Warn(s.logger).Log("msg", "failed to store debug info", "build_id", buildID, "err", err)
+		}
+	}
+	return data, nil
+}
@@ -0,0 +1,11 @@
+static inline __attribute__((always_inline)) int square(int x) {
+	return x * x;
+}
+
+__attribute__((noinline)) int compute(int x) {
+	return square(x) + 1;
+}
+
+int main(int argc, char **argv) {
+	return compute(argc);
+}
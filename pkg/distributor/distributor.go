@@ -205,11 +205,26 @@ func (d *Distributor) stopping(_ error) error {
 }
 
 func (d *Distributor) Push(ctx context.Context, grpcReq *connect.Request[pushv1.PushRequest]) (*connect.Response[pushv1.PushResponse], error) {
-	req := &distributormodel.PushRequest{
-		Series: make([]*distributormodel.ProfileSeries, 0, len(grpcReq.Msg.Series)),
+	req, err := parsePushRequest(grpcReq.Msg)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := d.PushParsed(ctx, req)
+	if err != nil && validation.ReasonOf(err) != validation.Unknown {
+		if sp := opentracing.SpanFromContext(ctx); sp != nil {
+			ext.LogError(sp, err)
+		}
+		level.Debug(util.LoggerWithContext(ctx, d.logger)).Log("msg", "failed to validate profile", "err", err)
+		return resp, err
 	}
+	return resp, err
+}
 
-	for _, grpcSeries := range grpcReq.Msg.Series {
+func parsePushRequest(msg *pushv1.PushRequest) (*distributormodel.PushRequest, error) {
+	req := &distributormodel.PushRequest{
+		Series: make([]*distributormodel.ProfileSeries, 0, len(msg.Series)),
+	}
+	for _, grpcSeries := range msg.Series {
 		series := &distributormodel.ProfileSeries{
 			Labels:  grpcSeries.Labels,
 			Samples: make([]*distributormodel.ProfileSample, 0, len(grpcSeries.Samples)),
@@ -229,15 +244,7 @@ func (d *Distributor) Push(ctx context.Context, grpcReq *connect.Request[pushv1.
 		}
 		req.Series = append(req.Series, series)
 	}
-	resp, err := d.PushParsed(ctx, req)
-	if err != nil && validation.ReasonOf(err) != validation.Unknown {
-		if sp := opentracing.SpanFromContext(ctx); sp != nil {
-			ext.LogError(sp, err)
-		}
-		level.Debug(util.LoggerWithContext(ctx, d.logger)).Log("msg", "failed to validate profile", "err", err)
-		return resp, err
-	}
-	return resp, err
+	return req, nil
 }
 
 func (d *Distributor) GetProfileLanguage(series *distributormodel.ProfileSeries) string {
@@ -262,13 +269,7 @@ func (d *Distributor) PushParsed(ctx context.Context, req *distributormodel.Push
 		return nil, connect.NewError(connect.CodeUnauthenticated, err)
 	}
 
-	for _, series := range req.Series {
-		serviceName := phlaremodel.Labels(series.Labels).Get(phlaremodel.LabelNameServiceName)
-		if serviceName == "" {
-			series.Labels = append(series.Labels, &typesv1.LabelPair{Name: phlaremodel.LabelNameServiceName, Value: "unspecified"})
-		}
-		sort.Sort(phlaremodel.Labels(series.Labels))
-	}
+	setDefaultServiceName(req)
 
 	haveRawPprof := req.RawProfileType == distributormodel.RawProfileTypePPROF
 	d.bytesReceivedTotalStats.Inc(int64(req.RawProfileSize))
@@ -326,18 +327,7 @@ func (d *Distributor) PushParsed(ctx context.Context, req *distributormodel.Push
 
 	// Normalisation is quite an expensive operation,
 	// therefore it should be done after the rate limit check.
-	for _, series := range req.Series {
-		for _, sample := range series.Samples {
-			if series.Language == "go" {
-				sample.Profile.Profile = pprof.FixGoProfile(sample.Profile.Profile)
-			}
-			sample.Profile.Normalize()
-		}
-	}
-
-	if err := injectMappingVersions(req.Series); err != nil {
-		_ = level.Warn(d.logger).Log("msg", "failed to inject mapping versions", "err", err)
-	}
+	d.normalizeProfiles(req.Series)
 
 	// If aggregation is configured for the tenant, we try to determine
 	// whether the profile is eligible for aggregation based on the series
@@ -373,6 +363,30 @@ func (d *Distributor) PushParsed(ctx context.Context, req *distributormodel.Push
 	return d.sendRequests(ctx, req, tenantID)
 }
 
+func setDefaultServiceName(req *distributormodel.PushRequest) {
+	for _, series := range req.Series {
+		serviceName := phlaremodel.Labels(series.Labels).Get(phlaremodel.LabelNameServiceName)
+		if serviceName == "" {
+			series.Labels = append(series.Labels, &typesv1.LabelPair{Name: phlaremodel.LabelNameServiceName, Value: "unspecified"})
+		}
+		sort.Sort(phlaremodel.Labels(series.Labels))
+	}
+}
+
+func (d *Distributor) normalizeProfiles(series []*distributormodel.ProfileSeries) {
+	for _, s := range series {
+		for _, sample := range s.Samples {
+			if s.Language == "go" {
+				sample.Profile.Profile = pprof.FixGoProfile(sample.Profile.Profile)
+			}
+			sample.Profile.Normalize()
+		}
+	}
+	if err := injectMappingVersions(series); err != nil {
+		_ = level.Warn(d.logger).Log("msg", "failed to inject mapping versions", "err", err)
+	}
+}
+
 func (d *Distributor) sendAggregatedProfile(ctx context.Context, req *distributormodel.PushRequest, tenantID string, handler func() (*pprof.ProfileMerge, error)) {
 	d.asyncRequests.Add(1)
 	// We must not reuse the request in goroutine.
@@ -404,39 +418,17 @@ func (d *Distributor) sendAggregatedProfile(ctx context.Context, req *distributo
 }
 
 func (d *Distributor) sendRequests(ctx context.Context, req *distributormodel.PushRequest, tenantID string) (resp *connect.Response[pushv1.PushResponse], err error) {
-	// Reduce cardinality of session_id label.
-	maxSessionsPerSeries := d.limits.MaxSessionsPerSeries(tenantID)
-	for _, series := range req.Series {
-		series.Labels = d.limitMaxSessionsPerSeries(maxSessionsPerSeries, series.Labels)
-	}
 	usageGroups := d.limits.DistributorUsageGroups(tenantID)
-
-	// Next we split profiles by labels and apply relabel rules.
-	profileSeries, bytesRelabelDropped, profilesRelabelDropped := extractSampleSeries(req, tenantID, usageGroups, d.limits.IngestionRelabelingRules(tenantID))
+	profileSeries, bytesRelabelDropped, profilesRelabelDropped := d.prepareSeries(req, tenantID, usageGroups)
 	validation.DiscardedBytes.WithLabelValues(string(validation.RelabelRules), tenantID).Add(bytesRelabelDropped)
 	validation.DiscardedProfiles.WithLabelValues(string(validation.RelabelRules), tenantID).Add(profilesRelabelDropped)
-
-	// Filter our series and profiles without samples.
-	for _, series := range profileSeries {
-		series.Samples = slices.RemoveInPlace(series.Samples, func(sample *distributormodel.ProfileSample, _ int) bool {
-			return len(sample.Profile.Sample) == 0
-		})
-	}
-	profileSeries = slices.RemoveInPlace(profileSeries, func(series *distributormodel.ProfileSeries, i int) bool {
-		return len(series.Samples) == 0
-	})
 	if len(profileSeries) == 0 {
 		return connect.NewResponse(&pushv1.PushResponse{}), nil
 	}
 
 	// Validate the labels again and generate tokens for shuffle sharding.
 	keys := make([]uint32, len(profileSeries))
-	enforceLabelsOrder := d.limits.EnforceLabelsOrder(tenantID)
 	for i, series := range profileSeries {
-		if enforceLabelsOrder {
-			series.Labels = phlaremodel.Labels(series.Labels).InsertSorted(phlaremodel.LabelNameOrder, phlaremodel.LabelOrderEnforced)
-		}
-
 		groups := usageGroups.GetUsageGroups(tenantID, phlaremodel.Labels(series.Labels))
 
 		if err = validation.ValidateLabels(d.limits, tenantID, series.Labels); err != nil {
@@ -510,6 +502,38 @@ func (d *Distributor) sendRequests(ctx context.Context, req *distributormodel.Pu
 	}
 }
 
+// prepareSeries splits the request profiles into the series they are
+// written as: the relabeling rules and the tenant labels limits are applied.
+// Series and profiles without samples are removed. If usageGroups is nil,
+// the bytes dropped by the relabeling rules are not attributed to usage groups.
+func (d *Distributor) prepareSeries(req *distributormodel.PushRequest, tenantID string, usageGroups *validation.UsageGroupConfig) (profileSeries []*distributormodel.ProfileSeries, bytesRelabelDropped, profilesRelabelDropped float64) {
+	// Reduce cardinality of session_id label.
+	maxSessionsPerSeries := d.limits.MaxSessionsPerSeries(tenantID)
+	for _, series := range req.Series {
+		series.Labels = d.limitMaxSessionsPerSeries(maxSessionsPerSeries, series.Labels)
+	}
+
+	// Next we split profiles by labels and apply relabel rules.
+	profileSeries, bytesRelabelDropped, profilesRelabelDropped = extractSampleSeries(req, tenantID, usageGroups, d.limits.IngestionRelabelingRules(tenantID))
+
+	// Filter our series and profiles without samples.
+	for _, series := range profileSeries {
+		series.Samples = slices.RemoveInPlace(series.Samples, func(sample *distributormodel.ProfileSample, _ int) bool {
+			return len(sample.Profile.Sample) == 0
+		})
+	}
+	profileSeries = slices.RemoveInPlace(profileSeries, func(series *distributormodel.ProfileSeries, i int) bool {
+		return len(series.Samples) == 0
+	})
+
+	if d.limits.EnforceLabelsOrder(tenantID) {
+		for _, series := range profileSeries {
+			series.Labels = phlaremodel.Labels(series.Labels).InsertSorted(phlaremodel.LabelNameOrder, phlaremodel.LabelOrderEnforced)
+		}
+	}
+	return profileSeries, bytesRelabelDropped, profilesRelabelDropped
+}
+
 // sampleSize returns the size of a samples in bytes.
 func sampleSize(stringTable []string, samplesSlice []*profilev1.Sample) int64 {
 	var size int64
@@ -770,7 +794,11 @@ func extractSampleSeries(req *distributormodel.PushRequest, tenantID string, usa
 			Labels:  series.Labels,
 			Samples: make([]*distributormodel.ProfileSample, 0, len(series.Samples)),
 		}
-		usageGroups := usageGroups.GetUsageGroups(tenantID, phlaremodel.Labels(series.Labels))
+		var seriesUsageGroups *validation.UsageGroupMatch
+		if usageGroups != nil {
+			m := usageGroups.GetUsageGroups(tenantID, phlaremodel.Labels(series.Labels))
+			seriesUsageGroups = &m
+		}
 
 		for _, raw := range series.Samples {
 			pprof.RenameLabel(raw.Profile.Profile, pprof.ProfileIDLabelName, pprof.SpanIDLabelName)
@@ -786,7 +814,9 @@ func extractSampleSeries(req *distributormodel.PushRequest, tenantID string, usa
 					if !keep {
 						bytesRelabelDropped += float64(raw.Profile.SizeVT())
 						profilesRelabelDropped++ // in this case we dropped a whole profile
-						usageGroups.CountDiscardedBytes(string(validation.RelabelRules), int64(raw.Profile.SizeVT()))
+						if seriesUsageGroups != nil {
+							seriesUsageGroups.CountDiscardedBytes(string(validation.RelabelRules), int64(raw.Profile.SizeVT()))
+						}
 						continue
 					}
 				}
@@ -810,7 +840,9 @@ func extractSampleSeries(req *distributormodel.PushRequest, tenantID string, usa
 					if !keep {
 						droppedBytes := sampleSize(raw.Profile.Profile.StringTable, group.Samples)
 						bytesRelabelDropped += float64(droppedBytes)
-						usageGroups.CountDiscardedBytes(string(validation.RelabelRules), droppedBytes)
+						if seriesUsageGroups != nil {
+							seriesUsageGroups.CountDiscardedBytes(string(validation.RelabelRules), droppedBytes)
+						}
 						continue
 					}
 				}
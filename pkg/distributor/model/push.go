@@ -10,6 +10,8 @@ type RawProfileType string
 
 const RawProfileTypePPROF = RawProfileType("pprof")
 const RawProfileTypeJFR = RawProfileType("jfr")
+const RawProfileTypePerfScript = RawProfileType("perf_script")
+const RawProfileTypeOTLP = RawProfileType("otlp")
 
 type PushRequest struct {
 	RawProfileSize int
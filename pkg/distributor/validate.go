@@ -0,0 +1,137 @@
+package distributor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/dustin/go-humanize"
+	"github.com/prometheus/common/model"
+
+	pushv1 "github.com/grafana/pyroscope/api/gen/proto/go/push/v1"
+	distributormodel "github.com/grafana/pyroscope/pkg/distributor/model"
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/tenant"
+	"github.com/grafana/pyroscope/pkg/validation"
+)
+
+// Validate runs the push request through the ingestion pipeline without
+// writing the profiles. The response describes the series the profiles
+// would be written as, and every validation error.
+func (d *Distributor) Validate(ctx context.Context, grpcReq *connect.Request[pushv1.PushRequest]) (*connect.Response[pushv1.ValidateResponse], error) {
+	req, err := parsePushRequest(grpcReq.Msg)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := d.ValidateParsed(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// ValidateParsed is the dry-run counterpart of PushParsed: parsing,
+// relabeling, usage groups, limits and normalization are applied, but the
+// profiles are not sent to ingesters, and do not count towards the tenant
+// rate limit and the ingestion metrics.
+//
+// Unlike PushParsed, validation does not stop at the first error: the
+// errors are reported per series.
+func (d *Distributor) ValidateParsed(ctx context.Context, req *distributormodel.PushRequest) (*pushv1.ValidateResponse, error) {
+	now := model.Now()
+	tenantID, err := tenant.ExtractTenantIDFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	setDefaultServiceName(req)
+
+	resp := new(pushv1.ValidateResponse)
+	for _, series := range req.Series {
+		for _, lbs := range series.Labels {
+			req.TotalBytesUncompressed += int64(len(lbs.Name) + len(lbs.Value))
+		}
+		for _, raw := range series.Samples {
+			req.TotalProfiles++
+			req.TotalBytesUncompressed += int64(raw.Profile.SizeVT())
+		}
+	}
+	if req.TotalProfiles == 0 {
+		resp.Errors = append(resp.Errors, "no profiles received")
+	}
+	// The request can't be accepted if it exceeds the burst size,
+	// regardless of the current tenant ingestion rate.
+	if burst := d.ingestionRateLimiter.Burst(time.Now(), tenantID); req.TotalBytesUncompressed > int64(burst) {
+		resp.Errors = append(resp.Errors, fmt.Sprintf("push request size (%s) exceeds the ingestion burst size (%s)",
+			humanize.IBytes(uint64(req.TotalBytesUncompressed)), humanize.IBytes(uint64(burst))))
+	}
+
+	usageGroups := d.limits.DistributorUsageGroups(tenantID)
+	for _, series := range req.Series {
+		resp.Series = append(resp.Series, d.validateSeries(tenantID, series, usageGroups, now)...)
+	}
+	return resp, nil
+}
+
+func (d *Distributor) validateSeries(tenantID string, series *distributormodel.ProfileSeries, usageGroups *validation.UsageGroupConfig, now model.Time) []*pushv1.ValidatedSeries {
+	received := &pushv1.ValidatedSeries{
+		Labels:      phlaremodel.Labels(series.Labels).Clone(),
+		Profiles:    int64(len(series.Samples)),
+		UsageGroups: usageGroupNames(usageGroups, tenantID, series.Labels),
+	}
+	for _, raw := range series.Samples {
+		received.Samples += int64(len(raw.Profile.Sample))
+		if err := validation.ValidateProfile(d.limits, tenantID, raw.Profile.Profile, raw.Profile.SizeVT(), series.Labels, now); err != nil {
+			received.Errors = append(received.Errors, err.Error())
+		}
+	}
+	if len(received.Errors) > 0 {
+		return []*pushv1.ValidatedSeries{received}
+	}
+
+	d.GetProfileLanguage(series)
+	d.normalizeProfiles([]*distributormodel.ProfileSeries{series})
+	var samples int64
+	for _, raw := range series.Samples {
+		samples += int64(len(raw.Profile.Sample))
+	}
+	req := &distributormodel.PushRequest{Series: []*distributormodel.ProfileSeries{series}}
+	profileSeries, _, profilesDropped := d.prepareSeries(req, tenantID, nil)
+
+	result := make([]*pushv1.ValidatedSeries, 0, len(profileSeries)+1)
+	for _, s := range profileSeries {
+		v := &pushv1.ValidatedSeries{
+			Labels:      s.Labels,
+			Profiles:    int64(len(s.Samples)),
+			UsageGroups: usageGroupNames(usageGroups, tenantID, s.Labels),
+		}
+		for _, raw := range s.Samples {
+			v.Samples += int64(len(raw.Profile.Sample))
+		}
+		if err := validation.ValidateLabels(d.limits, tenantID, s.Labels); err != nil {
+			v.Errors = append(v.Errors, err.Error())
+		}
+		samples -= v.Samples
+		result = append(result, v)
+	}
+	// Profiles and samples that are not written, are dropped by the
+	// relabeling rules: samples without values are removed silently
+	// by the normalization.
+	if profilesDropped > 0 || samples > 0 {
+		received.Profiles = int64(profilesDropped)
+		received.Samples = samples
+		received.Dropped = true
+		result = append(result, received)
+	}
+	return result
+}
+
+func usageGroupNames(usageGroups *validation.UsageGroupConfig, tenantID string, lbs phlaremodel.Labels) []string {
+	if usageGroups == nil {
+		return nil
+	}
+	names := usageGroups.GetUsageGroups(tenantID, lbs).Names()
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,135 @@
+package distributor
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/go-kit/log"
+	"github.com/grafana/dskit/ring"
+	"github.com/grafana/dskit/ring/client"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/relabel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pushv1 "github.com/grafana/pyroscope/api/gen/proto/go/push/v1"
+	"github.com/grafana/pyroscope/api/gen/proto/go/push/v1/pushv1connect"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+	distributormodel "github.com/grafana/pyroscope/pkg/distributor/model"
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	pprof2 "github.com/grafana/pyroscope/pkg/pprof"
+	"github.com/grafana/pyroscope/pkg/tenant"
+	"github.com/grafana/pyroscope/pkg/testhelper"
+	"github.com/grafana/pyroscope/pkg/validation"
+)
+
+func Test_Validate(t *testing.T) {
+	ing := newFakeIngester(t, false)
+	d, err := New(Config{
+		DistributorRing: ringConfig,
+	}, testhelper.NewMockRing([]ring.InstanceDesc{
+		{Addr: "foo"},
+	}, 3), &poolFactory{f: func(addr string) (client.PoolClient, error) {
+		return ing, nil
+	}}, newOverrides(t), nil, log.NewLogfmtLogger(os.Stdout))
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.Handle(pushv1connect.NewPusherServiceHandler(d, handlerOptions...))
+	s := httptest.NewServer(mux)
+	defer s.Close()
+
+	client := pushv1connect.NewPusherServiceClient(http.DefaultClient, s.URL, clientOptions...)
+	resp, err := client.Validate(tenant.InjectTenantID(context.Background(), "user-1"), connect.NewRequest(&pushv1.PushRequest{
+		Series: []*pushv1.RawProfileSeries{
+			{
+				Labels: []*typesv1.LabelPair{
+					{Name: "clusterdddwqdqdqdqdqdqw", Value: "us-central1"},
+					{Name: "__name__", Value: "cpu"},
+					{Name: phlaremodel.LabelNameServiceName, Value: "svc"},
+				},
+				Samples: []*pushv1.RawSample{
+					{RawProfile: collectTestProfileBytes(t)},
+				},
+			},
+		},
+	}))
+	require.NoError(t, err)
+
+	// The request exceeds the burst size, and the label name is too long.
+	require.Len(t, resp.Msg.Errors, 1)
+	assert.Contains(t, resp.Msg.Errors[0], "exceeds the ingestion burst size")
+	require.Len(t, resp.Msg.Series, 1)
+	assert.False(t, resp.Msg.Series[0].Dropped)
+	assert.Equal(t, int64(1), resp.Msg.Series[0].Profiles)
+	require.Len(t, resp.Msg.Series[0].Errors, 1)
+	assert.Contains(t, resp.Msg.Series[0].Errors[0], "clusterdddwqdqdqdqdqdqw")
+
+	// Nothing is sent to ingesters.
+	assert.Empty(t, ing.requests)
+}
+
+func Test_ValidateParsed(t *testing.T) {
+	overrides := validation.MockOverrides(func(defaults *validation.Limits, tenantLimits map[string]*validation.Limits) {
+		l := validation.MockDefaultLimits()
+		l.IngestionRelabelingRules = []*relabel.Config{
+			{Action: relabel.Drop, SourceLabels: []model.LabelName{"function"}, Regex: relabel.MustNewRegexp("slow")},
+		}
+		l.DistributorUsageGroups = &validation.UsageGroupConfig{}
+		tenantLimits["user-1"] = l
+	})
+	d, err := New(Config{DistributorRing: ringConfig},
+		testhelper.NewMockRing([]ring.InstanceDesc{{Addr: "foo"}}, 3),
+		&poolFactory{f: func(addr string) (client.PoolClient, error) { return newFakeIngester(t, false), nil }},
+		overrides, nil, log.NewLogfmtLogger(os.Stdout))
+	require.NoError(t, err)
+
+	invalid := testProfile(time.Now().UnixNano())
+	invalid.Location[0].Id = 0
+	resp, err := d.ValidateParsed(tenant.InjectTenantID(context.Background(), "user-1"), &distributormodel.PushRequest{
+		Series: []*distributormodel.ProfileSeries{
+			{
+				Labels: []*typesv1.LabelPair{
+					{Name: "__name__", Value: "cpu"},
+					{Name: phlaremodel.LabelNameServiceName, Value: "svc"},
+				},
+				Samples: []*distributormodel.ProfileSample{
+					{Profile: &pprof2.Profile{Profile: testProfile(time.Now().UnixNano())}},
+				},
+			},
+			{
+				Labels: []*typesv1.LabelPair{
+					{Name: "__name__", Value: "cpu"},
+					{Name: phlaremodel.LabelNameServiceName, Value: "invalid"},
+				},
+				Samples: []*distributormodel.ProfileSample{
+					{Profile: &pprof2.Profile{Profile: invalid}},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Errors)
+	require.Len(t, resp.Series, 3)
+
+	// The sample with the "function" label is dropped by the relabeling rule.
+	assert.Equal(t, "svc", phlaremodel.Labels(resp.Series[0].Labels).Get(phlaremodel.LabelNameServiceName))
+	assert.Equal(t, "bar", phlaremodel.Labels(resp.Series[0].Labels).Get("foo"))
+	assert.Equal(t, int64(1), resp.Series[0].Profiles)
+	assert.Equal(t, int64(1), resp.Series[0].Samples)
+	assert.False(t, resp.Series[0].Dropped)
+	assert.Empty(t, resp.Series[0].Errors)
+
+	assert.Equal(t, "svc", phlaremodel.Labels(resp.Series[1].Labels).Get(phlaremodel.LabelNameServiceName))
+	assert.Equal(t, int64(1), resp.Series[1].Samples)
+	assert.True(t, resp.Series[1].Dropped)
+
+	assert.Equal(t, "invalid", phlaremodel.Labels(resp.Series[2].Labels).Get(phlaremodel.LabelNameServiceName))
+	assert.False(t, resp.Series[2].Dropped)
+	require.Len(t, resp.Series[2].Errors, 1)
+}
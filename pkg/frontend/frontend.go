@@ -52,9 +52,12 @@ type Config struct {
 	Addr string `yaml:"address" category:"advanced"`
 	Port int    `yaml:"-"`
 
+	ResultsCache ResultsCacheConfig `yaml:"results_cache" doc:"description=Configures the cache of the query results. Results of the queries split by interval are cached per interval."`
+
 	// This configuration is injected internally.
 	QuerySchedulerDiscovery schedulerdiscovery.Config `yaml:"-"`
 	MaxLoopDuration         time.Duration             `yaml:"-"`
+	QueryStoreAfter         time.Duration             `yaml:"-"`
 }
 
 func (cfg *Config) RegisterFlags(f *flag.FlagSet, logger log.Logger) {
@@ -65,6 +68,7 @@ func (cfg *Config) RegisterFlags(f *flag.FlagSet, logger log.Logger) {
 	f.StringVar(&cfg.Addr, "query-frontend.instance-addr", "", "IP address to advertise to the querier (via scheduler) (default is auto-detected from network interfaces).")
 
 	cfg.GRPCClientConfig.RegisterFlagsWithPrefix("query-frontend.grpc-client-config", f)
+	cfg.ResultsCache.RegisterFlags(f)
 }
 
 func (cfg *Config) Validate() error {
@@ -72,6 +76,9 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("scheduler address cannot be specified when query-scheduler service discovery mode is set to '%s'", cfg.QuerySchedulerDiscovery.Mode)
 	}
 
+	if err := cfg.ResultsCache.Validate(); err != nil {
+		return err
+	}
 	return cfg.GRPCClientConfig.Validate()
 }
 
@@ -93,15 +100,18 @@ type Frontend struct {
 	schedulerWorkers        *frontendSchedulerWorkers
 	schedulerWorkersWatcher *services.FailureWatcher
 	requests                *requestsInProgress
+	resultsCache            *resultsCache
 	frontendpb.UnimplementedFrontendForQuerierServer
 }
 
 type Limits interface {
 	QuerySplitDuration(string) time.Duration
+	QueryShards(string) int
 	MaxQueryParallelism(string) int
 	MaxQueryLength(tenantID string) time.Duration
 	MaxQueryLookback(tenantID string) time.Duration
 	QueryAnalysisEnabled(string) bool
+	MaxTopFunctions(string) int
 	validation.FlameGraphLimits
 }
 
@@ -142,6 +152,11 @@ func NewFrontend(cfg Config, limits Limits, log log.Logger, reg prometheus.Regis
 		return nil, err
 	}
 
+	resultsCache, err := newResultsCache(cfg, log, reg)
+	if err != nil {
+		return nil, err
+	}
+
 	f := &Frontend{
 		cfg:                     cfg,
 		log:                     log,
@@ -150,6 +165,7 @@ func NewFrontend(cfg Config, limits Limits, log log.Logger, reg prometheus.Regis
 		schedulerWorkers:        schedulerWorkers,
 		schedulerWorkersWatcher: services.NewFailureWatcher(),
 		requests:                newRequestsInProgress(),
+		resultsCache:            resultsCache,
 	}
 	f.GRPCRoundTripper = &realFrontendRoundTripper{frontend: f}
 	// Randomize to avoid getting responses from queries sent before restart, which could lead to mixing results
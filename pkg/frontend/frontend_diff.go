@@ -2,13 +2,18 @@ package frontend
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"slices"
 
 	"connectrpc.com/connect"
 	"github.com/grafana/dskit/tenant"
 	"golang.org/x/sync/errgroup"
 
+	profilev1 "github.com/grafana/pyroscope/api/gen/proto/go/google/v1"
 	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
 	"github.com/grafana/pyroscope/api/gen/proto/go/querier/v1/querierv1connect"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
 	phlaremodel "github.com/grafana/pyroscope/pkg/model"
 	"github.com/grafana/pyroscope/pkg/util/connectgrpc"
 	"github.com/grafana/pyroscope/pkg/validation"
@@ -35,26 +40,214 @@ func (f *Frontend) Diff(ctx context.Context,
 	}
 	c.Msg.Left.MaxNodes = &maxNodes
 	c.Msg.Right.MaxNodes = &maxNodes
+	if c.Msg.SignificanceProfiles > maxDiffSignificanceProfiles {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("significance profiles must not exceed %d", maxDiffSignificanceProfiles))
+	}
+	if c.Msg.SignificanceProfiles > 1 &&
+		(selectsDiffStackTraces(c.Msg.Left.StackTraceSelector) || selectsDiffStackTraces(c.Msg.Right.StackTraceSelector)) {
+		// The individual profiles are not subject to the selector.
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("significance is not supported with a stack trace selector"))
+	}
 
-	var left, right *phlaremodel.Tree
+	var left, right diffSide
 	g.Go(func() error {
 		var leftErr error
-		left, leftErr = f.selectMergeStacktracesTree(ctx, connect.NewRequest(c.Msg.Left))
+		left, leftErr = f.diffSide(ctx, c.Msg.Left, c.Msg.Normalization, c.Msg.SignificanceProfiles)
 		return leftErr
 	})
 	g.Go(func() error {
 		var rightErr error
-		right, rightErr = f.selectMergeStacktracesTree(ctx, connect.NewRequest(c.Msg.Right))
+		right, rightErr = f.diffSide(ctx, c.Msg.Right, c.Msg.Normalization, c.Msg.SignificanceProfiles)
 		return rightErr
 	})
 	if err = g.Wait(); err != nil {
 		return nil, err
 	}
 
-	diff, err := phlaremodel.NewFlamegraphDiff(left, right, maxNodes)
+	diff, err := phlaremodel.NewFlamegraphDiffWithOptions(left.tree, right.tree, maxNodes, phlaremodel.DiffOptions{
+		LeftScale:    left.scale,
+		RightScale:   right.scale,
+		LeftSamples:  left.samples,
+		RightSamples: right.samples,
+	})
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
 	return connect.NewResponse(&querierv1.DiffResponse{Flamegraph: diff}), nil
 }
+
+const (
+	maxDiffSignificanceProfiles = 64
+	// Maximum number of the individual profiles of a diff
+	// side fetched concurrently.
+	diffProfilesConcurrency = 8
+)
+
+// selectsDiffStackTraces reports whether the selector
+// affects the stack traces of the merged profile.
+func selectsDiffStackTraces(selector *typesv1.StackTraceSelector) bool {
+	return len(selector.GetCallSite()) > 0 ||
+		selector.GetGoPgo() != nil ||
+		selector.GetFrameFilter() != nil
+}
+
+type diffSide struct {
+	tree    *phlaremodel.Tree
+	scale   float64
+	samples []phlaremodel.DiffSample
+}
+
+// diffSide fetches the profile of a diff side and, if requested, up to
+// n individual profiles the significance of the changes is estimated from.
+func (f *Frontend) diffSide(
+	ctx context.Context,
+	req *querierv1.SelectMergeStacktracesRequest,
+	normalization querierv1.DiffNormalization,
+	n int64,
+) (s diffSide, err error) {
+	var profiles int64
+	g, ctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		var treeErr error
+		s.tree, treeErr = f.selectMergeStacktracesTree(ctx, connect.NewRequest(req))
+		return treeErr
+	})
+	if n > 1 {
+		g.Go(func() error {
+			var samplesErr error
+			s.samples, samplesErr = f.diffSamples(ctx, req, normalization, n)
+			return samplesErr
+		})
+	}
+	if normalization == querierv1.DiffNormalization_DIFF_NORMALIZATION_PROFILE_COUNT {
+		g.Go(func() error {
+			var countErr error
+			profiles, countErr = f.profileCount(ctx, req)
+			return countErr
+		})
+	}
+	if err = g.Wait(); err != nil {
+		return s, err
+	}
+	s.scale = phlaremodel.DiffScale(normalization, s.tree.Total(), profiles, req.Start, req.End)
+	return s, nil
+}
+
+// diffSamples returns up to n most recent individual profiles of the
+// diff side. Each profile is a single observation of the node values:
+// if the diff is normalized by total, the values are divided by the
+// profile total. Other normalizations do not apply to a single profile.
+func (f *Frontend) diffSamples(
+	ctx context.Context,
+	req *querierv1.SelectMergeStacktracesRequest,
+	normalization querierv1.DiffNormalization,
+	n int64,
+) ([]phlaremodel.DiffSample, error) {
+	resp, err := f.SelectProfiles(ctx, connect.NewRequest(&querierv1.SelectProfilesRequest{
+		ProfileTypeID: req.ProfileTypeID,
+		LabelSelector: req.LabelSelector,
+		Start:         req.Start,
+		End:           req.End,
+		Limit:         n,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	infos := resp.Msg.Profiles
+	samples := make([]phlaremodel.DiffSample, len(infos))
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(diffProfilesConcurrency)
+	for i, info := range infos {
+		i, info := i, info
+		g.Go(func() error {
+			p, err := f.GetProfileByID(ctx, connect.NewRequest(&querierv1.GetProfileByIDRequest{
+				ProfileTypeID: req.ProfileTypeID,
+				LabelSelector: req.LabelSelector,
+				Id:            info.Id,
+				Start:         info.Timestamp,
+				End:           info.Timestamp + 1,
+			}))
+			if connect.CodeOf(err) == connect.CodeNotFound {
+				// The profile may have been deleted.
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			samples[i].Tree = profileTree(p.Msg)
+			if normalization == querierv1.DiffNormalization_DIFF_NORMALIZATION_TOTAL {
+				samples[i].Scale = float64(samples[i].Tree.Total())
+			}
+			return nil
+		})
+	}
+	if err = g.Wait(); err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(samples, func(s phlaremodel.DiffSample) bool {
+		return s.Tree == nil
+	}), nil
+}
+
+// profileTree builds the tree of the profile. The profile is expected
+// to have a single sample type, as returned by GetProfileByID.
+func profileTree(p *profilev1.Profile) *phlaremodel.Tree {
+	functions := make(map[uint64]string, len(p.Function))
+	for _, fn := range p.Function {
+		functions[fn.Id] = p.StringTable[fn.Name]
+	}
+	locations := make(map[uint64]*profilev1.Location, len(p.Location))
+	for _, loc := range p.Location {
+		locations[loc.Id] = loc
+	}
+	t := new(phlaremodel.Tree)
+	var stack []string
+	for _, s := range p.Sample {
+		if len(s.Value) == 0 {
+			continue
+		}
+		stack = stack[:0]
+		// The stack is ordered from the root to the leaf,
+		// while the sample locations are leaf first.
+		for i := len(s.LocationId) - 1; i >= 0; i-- {
+			loc, ok := locations[s.LocationId[i]]
+			if !ok {
+				continue
+			}
+			for j := len(loc.Line) - 1; j >= 0; j-- {
+				stack = append(stack, functions[loc.Line[j].FunctionId])
+			}
+		}
+		t.InsertStack(s.Value[0], stack...)
+	}
+	return t
+}
+
+// profileCount returns the number of profiles in the time range.
+func (f *Frontend) profileCount(ctx context.Context, req *querierv1.SelectMergeStacktracesRequest) (int64, error) {
+	step := req.End - req.Start
+	if step <= 0 {
+		return 0, nil
+	}
+	resp, err := f.SelectSeries(ctx, connect.NewRequest(&querierv1.SelectSeriesRequest{
+		ProfileTypeID: req.ProfileTypeID,
+		LabelSelector: req.LabelSelector,
+		Start:         req.Start,
+		End:           req.End,
+		Step:          float64(step) / 1000,
+		Aggregation:   typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_COUNT.Enum(),
+	}))
+	if err != nil {
+		return 0, err
+	}
+	var count float64
+	for _, s := range resp.Msg.Series {
+		for _, p := range s.Points {
+			count += p.Value
+		}
+	}
+	return int64(math.Round(count)), nil
+}
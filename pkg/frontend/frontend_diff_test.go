@@ -2,6 +2,7 @@ package frontend
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -11,7 +12,10 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
+	profilev1 "github.com/grafana/pyroscope/api/gen/proto/go/google/v1"
 	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
+	"github.com/grafana/pyroscope/api/gen/proto/go/querier/v1/querierv1connect"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
 	"github.com/grafana/pyroscope/pkg/model"
 	"github.com/grafana/pyroscope/pkg/util/connectgrpc"
 	"github.com/grafana/pyroscope/pkg/util/httpgrpc"
@@ -23,6 +27,10 @@ func (m *mockLimits) QuerySplitDuration(_ string) time.Duration {
 	return time.Hour
 }
 
+func (m *mockLimits) QueryShards(_ string) int {
+	return 0
+}
+
 func (m *mockLimits) MaxQueryParallelism(_ string) int {
 	return 100
 }
@@ -47,6 +55,10 @@ func (m *mockLimits) MaxFlameGraphNodesMax(_ string) int {
 	return 100_000
 }
 
+func (m *mockLimits) MaxTopFunctions(_ string) int {
+	return 10_000
+}
+
 type mockRoundTripper struct {
 	callback func(ctx context.Context, req *httpgrpc.HTTPRequest) (*httpgrpc.HTTPResponse, error)
 }
@@ -177,9 +189,152 @@ func Test_Frontend_Diff(t *testing.T) {
 				LeftTicks:  2,
 				RightTicks: 3,
 				MaxSelf:    2,
+				LeftFactor: 1,
+				Stats: []*querierv1.DiffLevelStats{
+					{RelativeChange: []float64{1.0 / 3}, Significance: []float64{0}},
+					{RelativeChange: []float64{1.0 / 3}, Significance: []float64{0}},
+					{RelativeChange: []float64{1.0 / 3}, Significance: []float64{0}},
+					{RelativeChange: []float64{-1, 1}, Significance: []float64{0, 0}},
+				},
 			},
 			resp.Msg.Flamegraph,
 		)
 	})
 
+	t.Run("normalized diff with significance", func(t *testing.T) {
+		// Values of the individual profiles on the left and right.
+		values := map[string][]int64{
+			"left":  {10, 11, 9, 10},
+			"right": {30, 32, 31, 29},
+		}
+		side := func(start int64) string {
+			if start >= now+2000 {
+				return "right"
+			}
+			return "left"
+		}
+		frontend.GRPCRoundTripper = &mockRoundTripper{callback: func(ctx context.Context, req *httpgrpc.HTTPRequest) (*httpgrpc.HTTPResponse, error) {
+			switch req.Url {
+			case querierv1connect.QuerierServiceSelectSeriesProcedure:
+				return connectgrpc.HandleUnary[querierv1.SelectSeriesRequest, querierv1.SelectSeriesResponse](ctx, req, func(ctx context.Context, req *connect.Request[querierv1.SelectSeriesRequest]) (*connect.Response[querierv1.SelectSeriesResponse], error) {
+					require.Equal(t, typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_COUNT, req.Msg.GetAggregation())
+					return connect.NewResponse(&querierv1.SelectSeriesResponse{
+						Series: []*typesv1.Series{{Points: []*typesv1.Point{{Timestamp: req.Msg.End, Value: 4}}}},
+					}), nil
+				})
+			case querierv1connect.QuerierServiceSelectProfilesProcedure:
+				return connectgrpc.HandleUnary[querierv1.SelectProfilesRequest, querierv1.SelectProfilesResponse](ctx, req, func(ctx context.Context, req *connect.Request[querierv1.SelectProfilesRequest]) (*connect.Response[querierv1.SelectProfilesResponse], error) {
+					require.Equal(t, int64(4), req.Msg.Limit)
+					resp := &querierv1.SelectProfilesResponse{Total: 4}
+					for i := range values[side(req.Msg.Start)] {
+						resp.Profiles = append(resp.Profiles, &querierv1.ProfileInfo{
+							Id:        fmt.Sprintf("%s-%d", side(req.Msg.Start), i),
+							Timestamp: req.Msg.Start + int64(i),
+						})
+					}
+					return connect.NewResponse(resp), nil
+				})
+			case querierv1connect.QuerierServiceGetProfileByIDProcedure:
+				return connectgrpc.HandleUnary[querierv1.GetProfileByIDRequest, profilev1.Profile](ctx, req, func(ctx context.Context, req *connect.Request[querierv1.GetProfileByIDRequest]) (*connect.Response[profilev1.Profile], error) {
+					require.Equal(t, req.Msg.Start+1, req.Msg.End)
+					var i int
+					_, err := fmt.Sscanf(req.Msg.Id, side(req.Msg.Start)+"-%d", &i)
+					require.NoError(t, err)
+					return connect.NewResponse(&profilev1.Profile{
+						StringTable: []string{"", "foo", "bar"},
+						Function:    []*profilev1.Function{{Id: 1, Name: 1}, {Id: 2, Name: 2}},
+						Location: []*profilev1.Location{
+							{Id: 1, Line: []*profilev1.Line{{FunctionId: 1}}},
+							{Id: 2, Line: []*profilev1.Line{{FunctionId: 2}}},
+						},
+						Sample: []*profilev1.Sample{{LocationId: []uint64{2, 1}, Value: []int64{values[side(req.Msg.Start)][i]}}},
+					}), nil
+				})
+			default:
+				return connectgrpc.HandleUnary[querierv1.SelectMergeStacktracesRequest, querierv1.SelectMergeStacktracesResponse](ctx, req, func(ctx context.Context, req *connect.Request[querierv1.SelectMergeStacktracesRequest]) (*connect.Response[querierv1.SelectMergeStacktracesResponse], error) {
+					s := new(model.Tree)
+					for _, v := range values[side(req.Msg.Start)] {
+						s.InsertStack(v, "foo", "bar")
+					}
+					return connect.NewResponse(&querierv1.SelectMergeStacktracesResponse{
+						Flamegraph: model.NewFlameGraph(s, -1),
+					}), nil
+				})
+			}
+		}}
+
+		resp, err := frontend.Diff(
+			ctx,
+			connect.NewRequest(&querierv1.DiffRequest{
+				Left: &querierv1.SelectMergeStacktracesRequest{
+					ProfileTypeID: profileType,
+					LabelSelector: "{}",
+					Start:         now + 0000,
+					End:           now + 1000,
+				},
+				Right: &querierv1.SelectMergeStacktracesRequest{
+					ProfileTypeID: profileType,
+					LabelSelector: "{}",
+					Start:         now + 2000,
+					End:           now + 2500,
+				},
+				Normalization:        querierv1.DiffNormalization_DIFF_NORMALIZATION_PROFILE_COUNT,
+				SignificanceProfiles: 4,
+			}),
+		)
+		require.NoError(t, err)
+		fg := resp.Msg.Flamegraph
+		require.Equal(t, 1.0, fg.LeftFactor)
+		require.Equal(t, int64(40), fg.LeftTicks)
+		require.Equal(t, int64(122), fg.RightTicks)
+		require.Len(t, fg.Stats, 3)
+		require.InDeltaSlice(t, []float64{82.0 / 122}, fg.Stats[2].RelativeChange, 1e-12)
+		require.Greater(t, fg.Stats[2].Significance[0], 0.99)
+	})
+
+	t.Run("too many significance profiles", func(t *testing.T) {
+		_, err := frontend.Diff(ctx, connect.NewRequest(&querierv1.DiffRequest{
+			Left:                 &querierv1.SelectMergeStacktracesRequest{},
+			Right:                &querierv1.SelectMergeStacktracesRequest{},
+			SignificanceProfiles: maxDiffSignificanceProfiles + 1,
+		}))
+		require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+
+	t.Run("significance with stack trace selector", func(t *testing.T) {
+		_, err := frontend.Diff(ctx, connect.NewRequest(&querierv1.DiffRequest{
+			Left: &querierv1.SelectMergeStacktracesRequest{},
+			Right: &querierv1.SelectMergeStacktracesRequest{
+				StackTraceSelector: &typesv1.StackTraceSelector{
+					FrameFilter: &typesv1.FrameFilter{Focus: "foo"},
+				},
+			},
+			SignificanceProfiles: 4,
+		}))
+		require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+}
+
+func Test_Frontend_profileCount(t *testing.T) {
+	ctx := user.InjectOrgID(context.Background(), "test")
+	_, ctx = opentracing.StartSpanFromContext(ctx, "test")
+	start := time.Now().Truncate(time.Minute).UnixMilli()
+
+	frontend := Frontend{
+		limits: &mockLimits{},
+		GRPCRoundTripper: &mockRoundTripper{callback: func(ctx context.Context, req *httpgrpc.HTTPRequest) (*httpgrpc.HTTPResponse, error) {
+			return connectgrpc.HandleUnary[querierv1.SelectSeriesRequest, querierv1.SelectSeriesResponse](ctx, req, func(ctx context.Context, req *connect.Request[querierv1.SelectSeriesRequest]) (*connect.Response[querierv1.SelectSeriesResponse], error) {
+				require.Equal(t, 30.0, req.Msg.Step)
+				require.Equal(t, typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_COUNT, req.Msg.GetAggregation())
+				return connect.NewResponse(&querierv1.SelectSeriesResponse{
+					Series: []*typesv1.Series{{Points: []*typesv1.Point{{Timestamp: start, Value: 3}, {Timestamp: start + 30000, Value: 2}}}},
+				}), nil
+			})
+		}},
+	}
+
+	req := &querierv1.SelectMergeStacktracesRequest{Start: start, End: start + 30000}
+	count, err := frontend.profileCount(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), count)
 }
@@ -0,0 +1,51 @@
+package frontend
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/grafana/dskit/user"
+	"github.com/opentracing/opentracing-go"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
+	"github.com/grafana/pyroscope/pkg/util/connectgrpc"
+	"github.com/grafana/pyroscope/pkg/util/httpgrpc"
+)
+
+func Test_Frontend_SelectHeatmap(t *testing.T) {
+	ctx := user.InjectOrgID(context.Background(), "test")
+	_, ctx = opentracing.StartSpanFromContext(ctx, "test")
+
+	end := time.Now().Truncate(time.Hour).Add(30 * time.Minute)
+	start := end.Add(-time.Hour)
+
+	var calls atomic.Int64
+	frontend := Frontend{
+		limits: &mockLimits{},
+		GRPCRoundTripper: &mockRoundTripper{callback: func(ctx context.Context, req *httpgrpc.HTTPRequest) (*httpgrpc.HTTPResponse, error) {
+			return connectgrpc.HandleUnary[querierv1.SelectHeatmapRequest, querierv1.SelectHeatmapResponse](ctx, req, func(ctx context.Context, req *connect.Request[querierv1.SelectHeatmapRequest]) (*connect.Response[querierv1.SelectHeatmapResponse], error) {
+				// The query spans two split intervals but must not be split.
+				require.Equal(t, start.UnixMilli(), req.Msg.Start)
+				require.Equal(t, end.UnixMilli(), req.Msg.End)
+				calls.Inc()
+				return connect.NewResponse(&querierv1.SelectHeatmapResponse{
+					Heatmap: &querierv1.Heatmap{Start: req.Msg.Start, End: req.Msg.End},
+				}), nil
+			})
+		}},
+	}
+
+	resp, err := frontend.SelectHeatmap(ctx, connect.NewRequest(&querierv1.SelectHeatmapRequest{
+		ProfileTypeID: "memory:inuse_space:bytes:space:byte",
+		LabelSelector: "{}",
+		Start:         start.UnixMilli(),
+		End:           end.UnixMilli(),
+	}))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), calls.Load())
+	require.Equal(t, start.UnixMilli(), resp.Msg.Heatmap.Start)
+}
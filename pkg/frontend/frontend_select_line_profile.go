@@ -0,0 +1,92 @@
+package frontend
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/grafana/dskit/tenant"
+	"github.com/opentracing/opentracing-go"
+	"github.com/prometheus/common/model"
+	"go.uber.org/atomic"
+	"golang.org/x/sync/errgroup"
+
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
+	"github.com/grafana/pyroscope/api/gen/proto/go/querier/v1/querierv1connect"
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/util/connectgrpc"
+	validationutil "github.com/grafana/pyroscope/pkg/util/validation"
+	"github.com/grafana/pyroscope/pkg/validation"
+)
+
+func (f *Frontend) SelectLineProfile(ctx context.Context, c *connect.Request[querierv1.SelectLineProfileRequest]) (*connect.Response[querierv1.SelectLineProfileResponse], error) {
+	opentracing.SpanFromContext(ctx).
+		SetTag("start", model.Time(c.Msg.Start).Time().String()).
+		SetTag("end", model.Time(c.Msg.End).Time().String()).
+		SetTag("selector", c.Msg.LabelSelector).
+		SetTag("function_name", c.Msg.FunctionName).
+		SetTag("filename", c.Msg.Filename).
+		SetTag("profile_type", c.Msg.ProfileTypeID)
+
+	ctx = connectgrpc.WithProcedure(ctx, querierv1connect.QuerierServiceSelectLineProfileProcedure)
+	tenantIDs, err := tenant.TenantIDs(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if c.Msg.FunctionName == "" && c.Msg.Filename == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("function name or file name must be specified"))
+	}
+	validated, err := validation.ValidateRangeRequest(f.limits, tenantIDs, model.Interval{Start: model.Time(c.Msg.Start), End: model.Time(c.Msg.End)}, model.Now())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if validated.IsEmpty {
+		return connect.NewResponse(&querierv1.SelectLineProfileResponse{}), nil
+	}
+	c.Msg.Start = int64(validated.Start)
+	c.Msg.End = int64(validated.End)
+
+	g, ctx := errgroup.WithContext(ctx)
+	if maxConcurrent := validationutil.SmallestPositiveNonZeroIntPerTenant(tenantIDs, f.limits.MaxQueryParallelism); maxConcurrent > 0 {
+		g.SetLimit(maxConcurrent)
+	}
+
+	interval := validationutil.MaxDurationOrZeroPerTenant(tenantIDs, f.limits.QuerySplitDuration)
+	intervals := NewTimeIntervalIterator(time.UnixMilli(int64(validated.Start)), time.UnixMilli(int64(validated.End)), interval)
+
+	m := phlaremodel.NewLineProfileMerger()
+	var total atomic.Int64
+	for intervals.Next() {
+		r := intervals.At()
+		g.Go(func() error {
+			req := connectgrpc.CloneRequest(c, &querierv1.SelectLineProfileRequest{
+				ProfileTypeID:      c.Msg.ProfileTypeID,
+				LabelSelector:      c.Msg.LabelSelector,
+				Start:              r.Start.UnixMilli(),
+				End:                r.End.UnixMilli(),
+				FunctionName:       c.Msg.FunctionName,
+				Filename:           c.Msg.Filename,
+				StackTraceSelector: c.Msg.StackTraceSelector,
+			})
+			resp, err := connectgrpc.RoundTripUnary[
+				querierv1.SelectLineProfileRequest,
+				querierv1.SelectLineProfileResponse](ctx, f, req)
+			if err != nil {
+				return err
+			}
+			m.MergeLines(resp.Msg.Lines)
+			total.Add(resp.Msg.Total)
+			return nil
+		})
+	}
+
+	if err = g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&querierv1.SelectLineProfileResponse{
+		Lines: m.Lines(),
+		Total: total.Load(),
+	}), nil
+}
@@ -0,0 +1,67 @@
+package frontend
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/grafana/dskit/user"
+	"github.com/opentracing/opentracing-go"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
+	"github.com/grafana/pyroscope/pkg/util/connectgrpc"
+	"github.com/grafana/pyroscope/pkg/util/httpgrpc"
+)
+
+func Test_Frontend_SelectLineProfile(t *testing.T) {
+	ctx := user.InjectOrgID(context.Background(), "test")
+	_, ctx = opentracing.StartSpanFromContext(ctx, "test")
+
+	var calls atomic.Int64
+	frontend := Frontend{
+		limits: &mockLimits{},
+		GRPCRoundTripper: &mockRoundTripper{callback: func(ctx context.Context, req *httpgrpc.HTTPRequest) (*httpgrpc.HTTPResponse, error) {
+			return connectgrpc.HandleUnary[querierv1.SelectLineProfileRequest, querierv1.SelectLineProfileResponse](ctx, req, func(ctx context.Context, req *connect.Request[querierv1.SelectLineProfileRequest]) (*connect.Response[querierv1.SelectLineProfileResponse], error) {
+				require.Equal(t, "main.main", req.Msg.FunctionName)
+				calls.Inc()
+				return connect.NewResponse(&querierv1.SelectLineProfileResponse{
+					Lines: []*querierv1.LineValues{
+						{Line: 12, Self: 1, Total: 2},
+						{Line: 10, Self: 3, Total: 3},
+					},
+					Total: 10,
+				}), nil
+			})
+		}},
+	}
+
+	end := time.Now().Truncate(time.Hour).Add(30 * time.Minute)
+	resp, err := frontend.SelectLineProfile(ctx, connect.NewRequest(&querierv1.SelectLineProfileRequest{
+		ProfileTypeID: "process_cpu:cpu:nanoseconds:cpu:nanoseconds",
+		LabelSelector: "{}",
+		Start:         end.Add(-time.Hour).UnixMilli(),
+		End:           end.UnixMilli(),
+		FunctionName:  "main.main",
+	}))
+	require.NoError(t, err)
+
+	// The query is split into two intervals.
+	n := calls.Load()
+	require.Equal(t, int64(2), n)
+	require.Equal(t, &querierv1.SelectLineProfileResponse{
+		Lines: []*querierv1.LineValues{
+			{Line: 10, Self: 3 * n, Total: 3 * n},
+			{Line: 12, Self: n, Total: 2 * n},
+		},
+		Total: 10 * n,
+	}, resp.Msg)
+
+	_, err = frontend.SelectLineProfile(ctx, connect.NewRequest(&querierv1.SelectLineProfileRequest{
+		Start: end.Add(-time.Hour).UnixMilli(),
+		End:   end.UnixMilli(),
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
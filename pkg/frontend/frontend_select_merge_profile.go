@@ -43,6 +43,11 @@ func (f *Frontend) SelectMergeProfile(ctx context.Context, c *connect.Request[qu
 	c.Msg.Start = int64(validated.Start)
 	c.Msg.End = int64(validated.End)
 
+	selectors, err := f.shardSelectors(tenantIDs, c.Msg.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+
 	g, ctx := errgroup.WithContext(ctx)
 	if maxConcurrent := validationutil.SmallestPositiveNonZeroIntPerTenant(tenantIDs, f.limits.MaxQueryParallelism); maxConcurrent > 0 {
 		g.SetLimit(maxConcurrent)
@@ -59,25 +64,28 @@ func (f *Frontend) SelectMergeProfile(ctx context.Context, c *connect.Request[qu
 	var m pprof.ProfileMerge
 	for intervals.Next() {
 		r := intervals.At()
-		g.Go(func() error {
-			req := connectgrpc.CloneRequest(c, &querierv1.SelectMergeProfileRequest{
-				ProfileTypeID:      c.Msg.ProfileTypeID,
-				LabelSelector:      c.Msg.LabelSelector,
-				Start:              r.Start.UnixMilli(),
-				End:                r.End.UnixMilli(),
-				MaxNodes:           c.Msg.MaxNodes,
-				StackTraceSelector: c.Msg.StackTraceSelector,
+		for _, selector := range selectors {
+			selector := selector
+			g.Go(func() error {
+				req := connectgrpc.CloneRequest(c, &querierv1.SelectMergeProfileRequest{
+					ProfileTypeID:      c.Msg.ProfileTypeID,
+					LabelSelector:      selector,
+					Start:              r.Start.UnixMilli(),
+					End:                r.End.UnixMilli(),
+					MaxNodes:           c.Msg.MaxNodes,
+					StackTraceSelector: c.Msg.StackTraceSelector,
+				})
+				resp, err := connectgrpc.RoundTripUnary[
+					querierv1.SelectMergeProfileRequest,
+					profilev1.Profile](ctx, f, req)
+				if err != nil {
+					return err
+				}
+				lock.Lock()
+				defer lock.Unlock()
+				return m.Merge(resp.Msg)
 			})
-			resp, err := connectgrpc.RoundTripUnary[
-				querierv1.SelectMergeProfileRequest,
-				profilev1.Profile](ctx, f, req)
-			if err != nil {
-				return err
-			}
-			lock.Lock()
-			defer lock.Unlock()
-			return m.Merge(resp.Msg)
-		})
+		}
 	}
 
 	if err = g.Wait(); err != nil {
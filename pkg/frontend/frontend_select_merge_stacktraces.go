@@ -74,16 +74,65 @@ func (f *Frontend) selectMergeStacktracesTree(ctx context.Context,
 	interval := validationutil.MaxDurationOrZeroPerTenant(tenantIDs, f.limits.QuerySplitDuration)
 	intervals := NewTimeIntervalIterator(time.UnixMilli(int64(validated.Start)), time.UnixMilli(int64(validated.End)), interval)
 
+	var sts []byte
+	if c.Msg.StackTraceSelector != nil {
+		if sts, err = c.Msg.StackTraceSelector.MarshalVT(); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	selectors, err := f.shardSelectors(tenantIDs, c.Msg.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	type subquery struct {
+		r        TimeInterval
+		selector string
+		key      string
+	}
+	var (
+		subqueries []subquery
+		keys       []string
+		now        = time.Now()
+	)
 	for intervals.Next() {
 		r := intervals.At()
+		for _, selector := range selectors {
+			q := subquery{r: r, selector: selector}
+			if f.resultsCache.cacheable(r, interval, now) {
+				q.key = newResultsCacheKey(querierv1connect.QuerierServiceSelectMergeStacktracesProcedure).
+					string(tenant.JoinTenantIDs(tenantIDs)).
+					string(c.Msg.ProfileTypeID).
+					string(selector).
+					int(maxNodes).
+					bytes(sts).
+					interval(r)
+			}
+			subqueries = append(subqueries, q)
+			keys = append(keys, q.key)
+		}
+	}
+
+	cached := f.resultsCache.fetch(ctx, keys)
+	w := f.resultsCache.writer()
+	for i := range subqueries {
+		q := subqueries[i]
+		if b, ok := cached[q.key]; ok {
+			if err = m.MergeTreeBytes(b); err != nil {
+				return nil, err
+			}
+			continue
+		}
 		g.Go(func() error {
 			req := connectgrpc.CloneRequest(c, &querierv1.SelectMergeStacktracesRequest{
-				ProfileTypeID: c.Msg.ProfileTypeID,
-				LabelSelector: c.Msg.LabelSelector,
-				Start:         r.Start.UnixMilli(),
-				End:           r.End.UnixMilli(),
-				MaxNodes:      &maxNodes,
-				Format:        querierv1.ProfileFormat_PROFILE_FORMAT_TREE,
+				ProfileTypeID:      c.Msg.ProfileTypeID,
+				LabelSelector:      q.selector,
+				Start:              q.r.Start.UnixMilli(),
+				End:                q.r.End.UnixMilli(),
+				MaxNodes:           &maxNodes,
+				Format:             querierv1.ProfileFormat_PROFILE_FORMAT_TREE,
+				StackTraceSelector: c.Msg.StackTraceSelector,
 			})
 			resp, err := connectgrpc.RoundTripUnary[
 				querierv1.SelectMergeStacktracesRequest,
@@ -91,13 +140,13 @@ func (f *Frontend) selectMergeStacktracesTree(ctx context.Context,
 			if err != nil {
 				return err
 			}
-			if len(resp.Msg.Tree) > 0 {
-				err = m.MergeTreeBytes(resp.Msg.Tree)
-			} else if resp.Msg.Flamegraph != nil {
+			if len(resp.Msg.Tree) == 0 && resp.Msg.Flamegraph != nil {
 				// For backward compatibility.
 				m.MergeFlameGraph(resp.Msg.Flamegraph)
+				return nil
 			}
-			return err
+			w.add(q.key, resp.Msg.Tree)
+			return m.MergeTreeBytes(resp.Msg.Tree)
 		})
 	}
 
@@ -105,5 +154,6 @@ func (f *Frontend) selectMergeStacktracesTree(ctx context.Context,
 		return nil, err
 	}
 
+	w.store()
 	return m.Tree(), nil
 }
@@ -0,0 +1,50 @@
+package frontend
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/grafana/dskit/user"
+	"github.com/opentracing/opentracing-go"
+	"github.com/stretchr/testify/require"
+
+	profilev1 "github.com/grafana/pyroscope/api/gen/proto/go/google/v1"
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
+	"github.com/grafana/pyroscope/pkg/util/connectgrpc"
+	"github.com/grafana/pyroscope/pkg/util/httpgrpc"
+)
+
+func Test_Frontend_GetProfileByID(t *testing.T) {
+	ctx := user.InjectOrgID(context.Background(), "test")
+	_, ctx = opentracing.StartSpanFromContext(ctx, "test")
+
+	const id = "8d5b7c3e-2f0b-4c55-9d6e-1f8a4b2c9e10"
+	frontend := Frontend{
+		limits: &mockLimits{},
+		GRPCRoundTripper: &mockRoundTripper{callback: func(ctx context.Context, req *httpgrpc.HTTPRequest) (*httpgrpc.HTTPResponse, error) {
+			return connectgrpc.HandleUnary[querierv1.GetProfileByIDRequest, profilev1.Profile](ctx, req, func(ctx context.Context, req *connect.Request[querierv1.GetProfileByIDRequest]) (*connect.Response[profilev1.Profile], error) {
+				require.Equal(t, id, req.Msg.Id)
+				return connect.NewResponse(&profilev1.Profile{TimeNanos: 1}), nil
+			})
+		}},
+	}
+
+	end := time.Now()
+	resp, err := frontend.GetProfileByID(ctx, connect.NewRequest(&querierv1.GetProfileByIDRequest{
+		ProfileTypeID: "memory:inuse_space:bytes:space:byte",
+		Id:            id,
+		Start:         end.Add(-time.Minute).UnixMilli(),
+		End:           end.UnixMilli(),
+	}))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), resp.Msg.TimeNanos)
+
+	_, err = frontend.SelectProfiles(ctx, connect.NewRequest(&querierv1.SelectProfilesRequest{
+		Start: end.Add(-time.Minute).UnixMilli(),
+		End:   end.UnixMilli(),
+		Limit: -1,
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
@@ -12,6 +12,7 @@ import (
 
 	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
 	"github.com/grafana/pyroscope/api/gen/proto/go/querier/v1/querierv1connect"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
 	phlaremodel "github.com/grafana/pyroscope/pkg/model"
 	"github.com/grafana/pyroscope/pkg/util/connectgrpc"
 	validationutil "github.com/grafana/pyroscope/pkg/util/validation"
@@ -51,23 +52,74 @@ func (f *Frontend) SelectSeries(ctx context.Context,
 		g.SetLimit(maxConcurrent)
 	}
 
-	m := phlaremodel.NewSeriesMerger(false)
 	interval := validationutil.MaxDurationOrZeroPerTenant(tenantIDs, f.limits.QuerySplitDuration)
-	intervals := NewTimeIntervalIterator(time.UnixMilli(c.Msg.Start), time.UnixMilli(c.Msg.End), interval,
-		WithAlignment(time.Second*time.Duration(c.Msg.Step)))
+	selectors := []string{c.Msg.LabelSelector}
+	if c.Msg.GetLimit() > 0 {
+		// Series are ranked over the whole query time range,
+		// therefore, the query can't be split into intervals
+		// or shards.
+		interval = 0
+	} else if shardableAggregation(c.Msg.Aggregation) {
+		if selectors, err = f.shardSelectors(tenantIDs, c.Msg.LabelSelector); err != nil {
+			return nil, err
+		}
+	}
+	// Points of the sub-queries are summed: shards include
+	// distinct series, and intervals don't overlap.
+	m := phlaremodel.NewSeriesMerger(len(selectors) > 1)
+	ranges, cacheable := f.seriesIntervals(c.Msg, interval)
 
-	for intervals.Next() {
-		r := intervals.At()
+	type subquery struct {
+		r        TimeInterval
+		selector string
+		key      string
+	}
+	var (
+		subqueries []subquery
+		keys       []string
+		now        = time.Now()
+	)
+	for _, selector := range selectors {
+		var key *resultsCacheKey
+		if cacheable {
+			if key, err = seriesResultsCacheKey(tenantIDs, c.Msg, selector); err != nil {
+				return nil, err
+			}
+		}
+		for _, r := range ranges {
+			q := subquery{r: r, selector: selector}
+			if key != nil && f.resultsCache.cacheable(r, interval, now) {
+				q.key = key.interval(r)
+			}
+			subqueries = append(subqueries, q)
+			keys = append(keys, q.key)
+		}
+	}
+
+	cached := f.resultsCache.fetch(ctx, keys)
+	w := f.resultsCache.writer()
+	for i := range subqueries {
+		q := subqueries[i]
+		if b, ok := cached[q.key]; ok {
+			var resp querierv1.SelectSeriesResponse
+			if err = resp.UnmarshalVT(b); err != nil {
+				return nil, err
+			}
+			m.MergeSeries(resp.Series)
+			continue
+		}
 		g.Go(func() error {
 			req := connectgrpc.CloneRequest(c, &querierv1.SelectSeriesRequest{
 				ProfileTypeID:      c.Msg.ProfileTypeID,
-				LabelSelector:      c.Msg.LabelSelector,
-				Start:              r.Start.UnixMilli(),
-				End:                r.End.UnixMilli(),
+				LabelSelector:      q.selector,
+				Start:              q.r.Start.UnixMilli(),
+				End:                q.r.End.UnixMilli(),
 				GroupBy:            c.Msg.GroupBy,
 				Step:               c.Msg.Step,
 				Aggregation:        c.Msg.Aggregation,
 				StackTraceSelector: c.Msg.StackTraceSelector,
+				Limit:              c.Msg.Limit,
+				Order:              c.Msg.Order,
 			})
 			resp, err := connectgrpc.RoundTripUnary[
 				querierv1.SelectSeriesRequest,
@@ -75,6 +127,15 @@ func (f *Frontend) SelectSeries(ctx context.Context,
 			if err != nil {
 				return err
 			}
+			if q.key != "" {
+				// The response must be marshalled before the series
+				// are merged: the merger modifies them in place.
+				b, err := resp.Msg.MarshalVT()
+				if err != nil {
+					return err
+				}
+				w.add(q.key, b)
+			}
 			m.MergeSeries(resp.Msg.Series)
 			return nil
 		})
@@ -84,5 +145,76 @@ func (f *Frontend) SelectSeries(ctx context.Context,
 		return nil, err
 	}
 
+	w.store()
 	return connect.NewResponse(&querierv1.SelectSeriesResponse{Series: m.Series()}), nil
 }
+
+// seriesIntervals splits the query time range into intervals. The points
+// of a series are aligned to the query start, therefore, each interval
+// must start at a multiple of the step, counting from the query start.
+//
+// If the results cache is enabled and the split interval is a multiple
+// of the step, intervals are aligned to the split interval, shifted by
+// the offset of the query start from a multiple of the step. This way,
+// queries with the same step and a matching offset (e.g. refreshes of
+// a dashboard, where the query start is aligned to the step) share the
+// intervals, and their results can be cached.
+func (f *Frontend) seriesIntervals(req *querierv1.SelectSeriesRequest, interval time.Duration) ([]TimeInterval, bool) {
+	var (
+		ranges []TimeInterval
+		start  = time.UnixMilli(req.Start)
+		end    = time.UnixMilli(req.End)
+		step   = time.Duration(req.Step * float64(time.Second))
+	)
+	if f.resultsCache == nil || interval <= 0 || step <= 0 || interval%step != 0 {
+		intervals := NewTimeIntervalIterator(start, end, interval, WithAlignment(step))
+		for intervals.Next() {
+			ranges = append(ranges, intervals.At())
+		}
+		return ranges, false
+	}
+	offset := time.Duration(start.UnixNano() % int64(step))
+	intervals := NewTimeIntervalIterator(start.Add(-offset), end.Add(-offset), interval)
+	for intervals.Next() {
+		r := intervals.At()
+		r.Start, r.End = r.Start.Add(offset), r.End.Add(offset)
+		ranges = append(ranges, r)
+	}
+	return ranges, true
+}
+
+// shardableAggregation reports whether the series aggregated with the
+// function can be split into shards: the results are summed.
+func shardableAggregation(a *typesv1.TimeSeriesAggregationType) bool {
+	if a == nil {
+		return true
+	}
+	switch *a {
+	case typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_SUM,
+		typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_COUNT:
+		return true
+	}
+	return false
+}
+
+func seriesResultsCacheKey(tenantIDs []string, req *querierv1.SelectSeriesRequest, selector string) (*resultsCacheKey, error) {
+	k := newResultsCacheKey(querierv1connect.QuerierServiceSelectSeriesProcedure).
+		string(tenant.JoinTenantIDs(tenantIDs)).
+		string(req.ProfileTypeID).
+		string(selector).
+		strings(req.GroupBy).
+		float(req.Step)
+	if req.Aggregation != nil {
+		k.int(int64(*req.Aggregation))
+	} else {
+		k.int(-1)
+	}
+	var sts []byte
+	if req.StackTraceSelector != nil {
+		var err error
+		if sts, err = req.StackTraceSelector.MarshalVT(); err != nil {
+			return nil, err
+		}
+	}
+	return k.bytes(sts), nil
+}
@@ -0,0 +1,117 @@
+package frontend
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/grafana/dskit/tenant"
+	"github.com/opentracing/opentracing-go"
+	"github.com/prometheus/common/model"
+	"golang.org/x/sync/errgroup"
+
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
+	"github.com/grafana/pyroscope/api/gen/proto/go/querier/v1/querierv1connect"
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/util/connectgrpc"
+	validationutil "github.com/grafana/pyroscope/pkg/util/validation"
+	"github.com/grafana/pyroscope/pkg/validation"
+)
+
+func (f *Frontend) SelectTopFunctions(ctx context.Context, c *connect.Request[querierv1.SelectTopFunctionsRequest]) (*connect.Response[querierv1.SelectTopFunctionsResponse], error) {
+	opentracing.SpanFromContext(ctx).
+		SetTag("start", model.Time(c.Msg.Start).Time().String()).
+		SetTag("end", model.Time(c.Msg.End).Time().String()).
+		SetTag("selector", c.Msg.LabelSelector).
+		SetTag("grouping", c.Msg.Grouping.String()).
+		SetTag("profile_type", c.Msg.ProfileTypeID)
+
+	ctx = connectgrpc.WithProcedure(ctx, querierv1connect.QuerierServiceSelectTopFunctionsProcedure)
+	tenantIDs, err := tenant.TenantIDs(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if c.Msg.Limit < 0 || c.Msg.Offset < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("limit and offset must not be negative"))
+	}
+	validated, err := validation.ValidateRangeRequest(f.limits, tenantIDs, model.Interval{Start: model.Time(c.Msg.Start), End: model.Time(c.Msg.End)}, model.Now())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if validated.IsEmpty {
+		return connect.NewResponse(&querierv1.SelectTopFunctionsResponse{}), nil
+	}
+	c.Msg.Start = int64(validated.Start)
+	c.Msg.End = int64(validated.End)
+
+	g, ctx := errgroup.WithContext(ctx)
+	if maxConcurrent := validationutil.SmallestPositiveNonZeroIntPerTenant(tenantIDs, f.limits.MaxQueryParallelism); maxConcurrent > 0 {
+		g.SetLimit(maxConcurrent)
+	}
+
+	interval := validationutil.MaxDurationOrZeroPerTenant(tenantIDs, f.limits.QuerySplitDuration)
+	intervals := NewTimeIntervalIterator(time.UnixMilli(int64(validated.Start)), time.UnixMilli(int64(validated.End)), interval)
+
+	// The response is bounded by the tenant limit: the queriers
+	// return at most maxFunctions functions per interval, and
+	// the page can not go beyond the limit.
+	maxFunctions := validationutil.SmallestPositiveNonZeroIntPerTenant(tenantIDs, f.limits.MaxTopFunctions)
+	offset, limit := c.Msg.Offset, c.Msg.Limit
+	if maxFunctions > 0 && (limit == 0 || offset+limit > int64(maxFunctions)) {
+		limit = int64(maxFunctions) - offset
+	}
+
+	// The pagination can only be applied once all the intervals
+	// are merged. If the query is split, a function truncated in
+	// some of the intervals may be reported with partial values.
+	var (
+		mu             sync.Mutex
+		total          int64
+		totalFunctions int64
+	)
+	m := phlaremodel.NewTopFunctionsMerger()
+	for intervals.Next() {
+		r := intervals.At()
+		g.Go(func() error {
+			req := connectgrpc.CloneRequest(c, &querierv1.SelectTopFunctionsRequest{
+				ProfileTypeID:      c.Msg.ProfileTypeID,
+				LabelSelector:      c.Msg.LabelSelector,
+				Start:              r.Start.UnixMilli(),
+				End:                r.End.UnixMilli(),
+				Grouping:           c.Msg.Grouping,
+				OrderBy:            c.Msg.OrderBy,
+				StackTraceSelector: c.Msg.StackTraceSelector,
+				Limit:              int64(maxFunctions),
+			})
+			resp, err := connectgrpc.RoundTripUnary[
+				querierv1.SelectTopFunctionsRequest,
+				querierv1.SelectTopFunctionsResponse](ctx, f, req)
+			if err != nil {
+				return err
+			}
+			m.MergeTopFunctions(resp.Msg.Functions)
+			mu.Lock()
+			total += resp.Msg.Total
+			totalFunctions = max(totalFunctions, resp.Msg.TotalFunctions)
+			mu.Unlock()
+			return nil
+		})
+	}
+
+	if err = g.Wait(); err != nil {
+		return nil, err
+	}
+
+	functions := m.TopFunctions()
+	phlaremodel.SortTopFunctions(functions, c.Msg.OrderBy)
+	resp := &querierv1.SelectTopFunctionsResponse{
+		TotalFunctions: max(totalFunctions, int64(len(functions))),
+		Total:          total,
+	}
+	if maxFunctions == 0 || limit > 0 {
+		resp.Functions = phlaremodel.PaginateTopFunctions(functions, offset, limit)
+	}
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,129 @@
+package frontend
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/grafana/dskit/user"
+	"github.com/opentracing/opentracing-go"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+	"github.com/grafana/pyroscope/pkg/util/connectgrpc"
+	"github.com/grafana/pyroscope/pkg/util/httpgrpc"
+)
+
+func Test_Frontend_SelectTopFunctions(t *testing.T) {
+	ctx := user.InjectOrgID(context.Background(), "test")
+	_, ctx = opentracing.StartSpanFromContext(ctx, "test")
+
+	var calls atomic.Int64
+	frontend := Frontend{
+		limits: &mockLimits{},
+		GRPCRoundTripper: &mockRoundTripper{callback: func(ctx context.Context, req *httpgrpc.HTTPRequest) (*httpgrpc.HTTPResponse, error) {
+			return connectgrpc.HandleUnary[querierv1.SelectTopFunctionsRequest, querierv1.SelectTopFunctionsResponse](ctx, req, func(ctx context.Context, req *connect.Request[querierv1.SelectTopFunctionsRequest]) (*connect.Response[querierv1.SelectTopFunctionsResponse], error) {
+				// Pagination is applied by the frontend, the queriers
+				// are only asked for the top functions up to the limit.
+				require.Equal(t, int64(10_000), req.Msg.Limit)
+				require.Zero(t, req.Msg.Offset)
+				calls.Inc()
+				return connect.NewResponse(&querierv1.SelectTopFunctionsResponse{
+					Functions: []*typesv1.TopFunction{
+						{Name: "a", Self: 1, Total: 2},
+						{Name: "b", Self: 3, Total: 3},
+						{Name: "c", Self: 0, Total: 4},
+					},
+					TotalFunctions: 3,
+					Total:          4,
+				}), nil
+			})
+		}},
+	}
+
+	end := time.Now().Truncate(time.Hour).Add(30 * time.Minute)
+	resp, err := frontend.SelectTopFunctions(ctx, connect.NewRequest(&querierv1.SelectTopFunctionsRequest{
+		ProfileTypeID: "memory:inuse_space:bytes:space:byte",
+		LabelSelector: "{}",
+		Start:         end.Add(-time.Hour).UnixMilli(),
+		End:           end.UnixMilli(),
+		OrderBy:       querierv1.TopFunctionsOrder_TOP_FUNCTIONS_ORDER_SELF,
+		Offset:        1,
+		Limit:         1,
+	}))
+	require.NoError(t, err)
+
+	// The query is split into two intervals.
+	n := calls.Load()
+	require.Equal(t, int64(2), n)
+	require.Equal(t, &querierv1.SelectTopFunctionsResponse{
+		Functions:      []*typesv1.TopFunction{{Name: "a", Self: n, Total: 2 * n}},
+		TotalFunctions: 3,
+		Total:          4 * n,
+	}, resp.Msg)
+
+	_, err = frontend.SelectTopFunctions(ctx, connect.NewRequest(&querierv1.SelectTopFunctionsRequest{
+		Start: end.Add(-time.Hour).UnixMilli(),
+		End:   end.UnixMilli(),
+		Limit: -1,
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+type topFunctionsLimits struct {
+	mockLimits
+	maxTopFunctions int
+}
+
+func (m *topFunctionsLimits) MaxTopFunctions(string) int { return m.maxTopFunctions }
+
+func Test_Frontend_SelectTopFunctions_MaxFunctions(t *testing.T) {
+	ctx := user.InjectOrgID(context.Background(), "test")
+	_, ctx = opentracing.StartSpanFromContext(ctx, "test")
+
+	frontend := Frontend{
+		limits: &topFunctionsLimits{maxTopFunctions: 2},
+		GRPCRoundTripper: &mockRoundTripper{callback: func(ctx context.Context, req *httpgrpc.HTTPRequest) (*httpgrpc.HTTPResponse, error) {
+			return connectgrpc.HandleUnary[querierv1.SelectTopFunctionsRequest, querierv1.SelectTopFunctionsResponse](ctx, req, func(ctx context.Context, req *connect.Request[querierv1.SelectTopFunctionsRequest]) (*connect.Response[querierv1.SelectTopFunctionsResponse], error) {
+				require.Equal(t, int64(2), req.Msg.Limit)
+				return connect.NewResponse(&querierv1.SelectTopFunctionsResponse{
+					Functions: []*typesv1.TopFunction{
+						{Name: "b", Self: 3, Total: 3},
+						{Name: "a", Self: 1, Total: 2},
+					},
+					TotalFunctions: 3,
+					Total:          4,
+				}), nil
+			})
+		}},
+	}
+
+	end := time.Now().Truncate(time.Hour).Add(30 * time.Minute)
+	query := func(offset, limit int64) *querierv1.SelectTopFunctionsResponse {
+		resp, err := frontend.SelectTopFunctions(ctx, connect.NewRequest(&querierv1.SelectTopFunctionsRequest{
+			ProfileTypeID: "memory:inuse_space:bytes:space:byte",
+			LabelSelector: "{}",
+			Start:         end.Add(-30 * time.Minute).UnixMilli(),
+			End:           end.UnixMilli(),
+			OrderBy:       querierv1.TopFunctionsOrder_TOP_FUNCTIONS_ORDER_SELF,
+			Offset:        offset,
+			Limit:         limit,
+		}))
+		require.NoError(t, err)
+		return resp.Msg
+	}
+
+	resp := query(0, 0)
+	require.Equal(t, []*typesv1.TopFunction{
+		{Name: "b", Self: 3, Total: 3},
+		{Name: "a", Self: 1, Total: 2},
+	}, resp.Functions)
+	require.Equal(t, int64(3), resp.TotalFunctions)
+	require.Equal(t, int64(4), resp.Total)
+
+	require.Equal(t, []*typesv1.TopFunction{{Name: "a", Self: 1, Total: 2}}, query(1, 10).Functions)
+	require.Empty(t, query(2, 10).Functions)
+}
@@ -9,6 +9,18 @@ import (
 	"github.com/grafana/pyroscope/pkg/util/connectgrpc"
 )
 
+func (f *Frontend) App(ctx context.Context, req *connect.Request[vcsv1.AppRequest]) (*connect.Response[vcsv1.AppResponse], error) {
+	return connectgrpc.RoundTripUnary[vcsv1.AppRequest, vcsv1.AppResponse](ctx, f, req)
+}
+
+func (f *Frontend) Login(ctx context.Context, req *connect.Request[vcsv1.LoginRequest]) (*connect.Response[vcsv1.LoginResponse], error) {
+	return connectgrpc.RoundTripUnary[vcsv1.LoginRequest, vcsv1.LoginResponse](ctx, f, req)
+}
+
+func (f *Frontend) Refresh(ctx context.Context, req *connect.Request[vcsv1.RefreshRequest]) (*connect.Response[vcsv1.RefreshResponse], error) {
+	return connectgrpc.RoundTripUnary[vcsv1.RefreshRequest, vcsv1.RefreshResponse](ctx, f, req)
+}
+
 func (f *Frontend) GithubApp(ctx context.Context, req *connect.Request[vcsv1.GithubAppRequest]) (*connect.Response[vcsv1.GithubAppResponse], error) {
 	return connectgrpc.RoundTripUnary[vcsv1.GithubAppRequest, vcsv1.GithubAppResponse](ctx, f, req)
 }
@@ -0,0 +1,172 @@
+package frontend
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"flag"
+	"hash"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/grafana/pyroscope/pkg/cache"
+)
+
+type ResultsCacheConfig struct {
+	cache.Config `yaml:",inline"`
+	TTL          time.Duration `yaml:"ttl" category:"advanced"`
+}
+
+func (cfg *ResultsCacheConfig) RegisterFlags(f *flag.FlagSet) {
+	cfg.Config.RegisterFlagsWithPrefix("query-frontend.results-cache.", f)
+	f.DurationVar(&cfg.TTL, "query-frontend.results-cache.ttl", 7*24*time.Hour, "Time to live of the cached query results.")
+}
+
+// resultsCache caches the results of the sub-queries the frontend splits
+// queries into. Only results of complete split intervals are cached: as
+// the split intervals are aligned, a query that covers the same time range
+// partially reuses them. Results of recent intervals are not cached, as the
+// data may still be changing, e.g. because of late profiles or in-flight
+// ingester flushes.
+type resultsCache struct {
+	cache cache.Cache
+	ttl   time.Duration
+	// Results of intervals ending after now-queryStoreAfter are not cached.
+	queryStoreAfter time.Duration
+}
+
+func newResultsCache(cfg Config, logger log.Logger, reg prometheus.Registerer) (*resultsCache, error) {
+	c, err := cache.New(cfg.ResultsCache.Config, "frontend-results-cache", logger,
+		prometheus.WrapRegistererWithPrefix("pyroscope_", reg))
+	if err != nil || c == nil {
+		return nil, err
+	}
+	return &resultsCache{
+		cache:           c,
+		ttl:             cfg.ResultsCache.TTL,
+		queryStoreAfter: cfg.QueryStoreAfter,
+	}, nil
+}
+
+// cacheable reports whether the results of the split interval r can be
+// cached. Split intervals are expected to be aligned to the split interval
+// duration, therefore, r is complete only if its length equals to it.
+func (c *resultsCache) cacheable(r TimeInterval, interval time.Duration, now time.Time) bool {
+	if c == nil || interval <= 0 {
+		return false
+	}
+	// Adjacent intervals don't overlap: the end is exclusive.
+	if r.End.Sub(r.Start) != interval-time.Nanosecond {
+		return false
+	}
+	return r.End.Before(now.Add(-c.queryStoreAfter))
+}
+
+// fetch returns cached results for the given keys. Empty keys are ignored.
+func (c *resultsCache) fetch(ctx context.Context, keys []string) map[string][]byte {
+	if c == nil {
+		return nil
+	}
+	lookup := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if k != "" {
+			lookup = append(lookup, k)
+		}
+	}
+	if len(lookup) == 0 {
+		return nil
+	}
+	return c.cache.Fetch(ctx, lookup)
+}
+
+// resultsCacheWriter collects the results to be cached; they're stored
+// at once, when all the sub-queries have succeeded.
+type resultsCacheWriter struct {
+	c       *resultsCache
+	mu      sync.Mutex
+	results map[string][]byte
+}
+
+func (c *resultsCache) writer() *resultsCacheWriter {
+	return &resultsCacheWriter{c: c}
+}
+
+func (w *resultsCacheWriter) add(key string, value []byte) {
+	if w.c == nil || key == "" {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.results == nil {
+		w.results = make(map[string][]byte)
+	}
+	w.results[key] = value
+}
+
+func (w *resultsCacheWriter) store() {
+	if w.c == nil || len(w.results) == 0 {
+		return
+	}
+	w.c.cache.StoreAsync(w.results, w.c.ttl)
+}
+
+// resultsCacheKey builds cache keys from the query parameters. The key
+// is a hash of the parameters, as they may be arbitrary long, and may
+// contain characters not allowed by the cache backend.
+type resultsCacheKey struct {
+	h   hash.Hash
+	buf [8]byte
+}
+
+func newResultsCacheKey(method string) *resultsCacheKey {
+	k := &resultsCacheKey{h: sha256.New()}
+	k.string(method)
+	return k
+}
+
+func (k *resultsCacheKey) string(s string) *resultsCacheKey {
+	k.int(int64(len(s)))
+	_, _ = k.h.Write([]byte(s))
+	return k
+}
+
+func (k *resultsCacheKey) strings(s []string) *resultsCacheKey {
+	k.int(int64(len(s)))
+	for _, x := range s {
+		k.string(x)
+	}
+	return k
+}
+
+func (k *resultsCacheKey) int(v int64) *resultsCacheKey {
+	binary.LittleEndian.PutUint64(k.buf[:], uint64(v))
+	_, _ = k.h.Write(k.buf[:])
+	return k
+}
+
+func (k *resultsCacheKey) float(v float64) *resultsCacheKey {
+	return k.int(int64(math.Float64bits(v)))
+}
+
+func (k *resultsCacheKey) bytes(b []byte) *resultsCacheKey {
+	k.int(int64(len(b)))
+	_, _ = k.h.Write(b)
+	return k
+}
+
+// interval completes the key with the interval boundaries. The key
+// prefix can be reused for multiple intervals.
+func (k *resultsCacheKey) interval(r TimeInterval) string {
+	h := sha256.New()
+	var buf [16]byte
+	binary.LittleEndian.PutUint64(buf[:8], uint64(r.Start.UnixMilli()))
+	binary.LittleEndian.PutUint64(buf[8:], uint64(r.End.UnixMilli()))
+	_, _ = h.Write(k.h.Sum(nil))
+	_, _ = h.Write(buf[:])
+	return "frontend:" + hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,149 @@
+package frontend
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/grafana/dskit/cache"
+	"github.com/grafana/dskit/user"
+	"github.com/opentracing/opentracing-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+	"github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/util/connectgrpc"
+	"github.com/grafana/pyroscope/pkg/util/httpgrpc"
+)
+
+type mockSplitLimits struct {
+	mockLimits
+	split time.Duration
+}
+
+func (m *mockSplitLimits) QuerySplitDuration(string) time.Duration { return m.split }
+
+func Test_ResultsCache_Cacheable(t *testing.T) {
+	now := time.Unix(0, 0).Add(24 * time.Hour)
+	c := &resultsCache{queryStoreAfter: time.Hour}
+	interval := 15 * time.Minute
+	start := now.Add(-2 * time.Hour)
+
+	assert.True(t, c.cacheable(TimeInterval{start, start.Add(interval - 1)}, interval, now))
+	// Incomplete interval.
+	assert.False(t, c.cacheable(TimeInterval{start.Add(time.Minute), start.Add(interval - 1)}, interval, now))
+	// Too recent.
+	start = now.Add(-time.Hour)
+	assert.False(t, c.cacheable(TimeInterval{start, start.Add(interval - 1)}, interval, now))
+	// No split.
+	assert.False(t, c.cacheable(TimeInterval{start, start.Add(interval - 1)}, 0, now))
+	// No cache.
+	assert.False(t, (*resultsCache)(nil).cacheable(TimeInterval{start, start.Add(interval - 1)}, interval, now))
+}
+
+func Test_ResultsCache_SelectMergeStacktraces(t *testing.T) {
+	var calls atomic.Int64
+	f := Frontend{
+		limits:       &mockSplitLimits{split: 15 * time.Minute},
+		resultsCache: &resultsCache{cache: cache.NewMockCache(), ttl: time.Hour, queryStoreAfter: time.Hour},
+		GRPCRoundTripper: &mockRoundTripper{callback: func(ctx context.Context, req *httpgrpc.HTTPRequest) (*httpgrpc.HTTPResponse, error) {
+			return connectgrpc.HandleUnary[querierv1.SelectMergeStacktracesRequest, querierv1.SelectMergeStacktracesResponse](ctx, req,
+				func(ctx context.Context, req *connect.Request[querierv1.SelectMergeStacktracesRequest]) (*connect.Response[querierv1.SelectMergeStacktracesResponse], error) {
+					calls.Inc()
+					s := new(model.Tree)
+					s.InsertStack(1, "foo", "bar")
+					return connect.NewResponse(&querierv1.SelectMergeStacktracesResponse{Tree: s.Bytes(-1)}), nil
+				})
+		}},
+	}
+
+	ctx := user.InjectOrgID(context.Background(), "test")
+	_, ctx = opentracing.StartSpanFromContext(ctx, "test")
+	start := time.Now().Add(-3 * time.Hour).Truncate(time.Hour)
+	query := func(start, end time.Time) *model.Tree {
+		resp, err := f.SelectMergeStacktraces(ctx, connect.NewRequest(&querierv1.SelectMergeStacktracesRequest{
+			ProfileTypeID: "memory:inuse_space:bytes:space:byte",
+			LabelSelector: "{}",
+			Start:         start.UnixMilli(),
+			End:           end.UnixMilli(),
+			Format:        querierv1.ProfileFormat_PROFILE_FORMAT_TREE,
+		}))
+		require.NoError(t, err)
+		tree, err := model.UnmarshalTree(resp.Msg.Tree)
+		require.NoError(t, err)
+		return tree
+	}
+
+	// The last interval is not complete: it ends at the query end.
+	tree := query(start, start.Add(time.Hour))
+	assert.Equal(t, int64(4), tree.Total())
+	assert.Equal(t, int64(4), calls.Load())
+	assert.Len(t, f.resultsCache.cache.(*cache.MockCache).GetItems(), 3)
+
+	calls.Store(0)
+	tree = query(start, start.Add(time.Hour))
+	assert.Equal(t, int64(4), tree.Total())
+	assert.Equal(t, int64(1), calls.Load())
+
+	// Partially overlapping query.
+	calls.Store(0)
+	tree = query(start.Add(30*time.Minute), start.Add(90*time.Minute))
+	assert.Equal(t, int64(4), tree.Total())
+	assert.Equal(t, int64(3), calls.Load())
+	assert.Len(t, f.resultsCache.cache.(*cache.MockCache).GetItems(), 5)
+}
+
+func Test_ResultsCache_SelectSeries(t *testing.T) {
+	var calls atomic.Int64
+	f := Frontend{
+		limits:       &mockSplitLimits{split: 15 * time.Minute},
+		resultsCache: &resultsCache{cache: cache.NewMockCache(), ttl: time.Hour, queryStoreAfter: time.Hour},
+		GRPCRoundTripper: &mockRoundTripper{callback: func(ctx context.Context, req *httpgrpc.HTTPRequest) (*httpgrpc.HTTPResponse, error) {
+			return connectgrpc.HandleUnary[querierv1.SelectSeriesRequest, querierv1.SelectSeriesResponse](ctx, req,
+				func(ctx context.Context, req *connect.Request[querierv1.SelectSeriesRequest]) (*connect.Response[querierv1.SelectSeriesResponse], error) {
+					calls.Inc()
+					step := int64(req.Msg.Step * 1000)
+					s := &typesv1.Series{Labels: []*typesv1.LabelPair{{Name: "foo", Value: "bar"}}}
+					for ts := req.Msg.Start; ts <= req.Msg.End; ts += step {
+						s.Points = append(s.Points, &typesv1.Point{Timestamp: ts, Value: 1})
+					}
+					return connect.NewResponse(&querierv1.SelectSeriesResponse{Series: []*typesv1.Series{s}}), nil
+				})
+		}},
+	}
+
+	ctx := user.InjectOrgID(context.Background(), "test")
+	_, ctx = opentracing.StartSpanFromContext(ctx, "test")
+	query := func(start, end time.Time) []*typesv1.Series {
+		resp, err := f.SelectSeries(ctx, connect.NewRequest(&querierv1.SelectSeriesRequest{
+			ProfileTypeID: "memory:inuse_space:bytes:space:byte",
+			LabelSelector: "{}",
+			Start:         start.UnixMilli(),
+			End:           end.UnixMilli(),
+			Step:          60,
+		}))
+		require.NoError(t, err)
+		return resp.Msg.Series
+	}
+
+	// The query start is not aligned to the step:
+	// points are expected at the query start + k*step.
+	start := time.Now().Add(-3 * time.Hour).Truncate(time.Hour).Add(10 * time.Second)
+	expected := query(start, start.Add(time.Hour))
+	require.Len(t, expected, 1)
+	require.Len(t, expected[0].Points, 61)
+	for i, p := range expected[0].Points {
+		assert.Equal(t, start.Add(time.Duration(i)*time.Minute).UnixMilli(), p.Timestamp)
+	}
+	// Intervals are shifted by the offset of the query start.
+	assert.Equal(t, int64(4), calls.Load())
+	assert.Len(t, f.resultsCache.cache.(*cache.MockCache).GetItems(), 3)
+
+	calls.Store(0)
+	assert.Equal(t, expected, query(start, start.Add(time.Hour)))
+	assert.Equal(t, int64(1), calls.Load())
+}
@@ -0,0 +1,54 @@
+package frontend
+
+import (
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/grafana/pyroscope/pkg/phlaredb/sharding"
+	validationutil "github.com/grafana/pyroscope/pkg/util/validation"
+)
+
+// shardSelectors returns the label selectors of the sub-queries a query
+// is split into by series: each of them includes the query shard matcher
+// (__query_shard__), which ingesters and store-gateways use to select
+// the series of the shard. If query sharding is disabled for the tenants,
+// or the selector already specifies the shard, the query is not split.
+func (f *Frontend) shardSelectors(tenantIDs []string, selector string) ([]string, error) {
+	shards := validationutil.SmallestPositiveNonZeroIntPerTenant(tenantIDs, f.limits.QueryShards)
+	if shards <= 1 {
+		return []string{selector}, nil
+	}
+	matchers, err := parser.ParseMetricSelector(selector)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	shard, _, err := sharding.ShardFromMatchers(matchers)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if shard != nil {
+		return []string{selector}, nil
+	}
+	selectors := make([]string, shards)
+	for i := range selectors {
+		s := sharding.ShardSelector{ShardIndex: uint64(i), ShardCount: uint64(shards)}
+		selectors[i] = matchersString(append(matchers[:len(matchers):len(matchers)], s.Matcher()))
+	}
+	return selectors, nil
+}
+
+func matchersString(matchers []*labels.Matcher) string {
+	var b strings.Builder
+	b.WriteRune('{')
+	for i, m := range matchers {
+		if i > 0 {
+			b.WriteRune(',')
+		}
+		b.WriteString(m.String())
+	}
+	b.WriteRune('}')
+	return b.String()
+}
@@ -0,0 +1,132 @@
+package frontend
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/grafana/dskit/user"
+	"github.com/opentracing/opentracing-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+	"github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/util/connectgrpc"
+	"github.com/grafana/pyroscope/pkg/util/httpgrpc"
+)
+
+type mockShardLimits struct {
+	mockLimits
+	shards int
+}
+
+func (m *mockShardLimits) QueryShards(string) int { return m.shards }
+
+func Test_shardSelectors(t *testing.T) {
+	f := Frontend{limits: &mockShardLimits{shards: 4}}
+	selectors, err := f.shardSelectors([]string{"test"}, `{service_name="foo"}`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		`{service_name="foo",__query_shard__="1_of_4"}`,
+		`{service_name="foo",__query_shard__="2_of_4"}`,
+		`{service_name="foo",__query_shard__="3_of_4"}`,
+		`{service_name="foo",__query_shard__="4_of_4"}`,
+	}, selectors)
+
+	selectors, err = f.shardSelectors([]string{"test"}, `{__query_shard__="1_of_2"}`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{`{__query_shard__="1_of_2"}`}, selectors)
+
+	f = Frontend{limits: &mockShardLimits{}}
+	selectors, err = f.shardSelectors([]string{"test"}, `{service_name="foo"}`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{`{service_name="foo"}`}, selectors)
+}
+
+func Test_QuerySharding_SelectMergeStacktraces(t *testing.T) {
+	var (
+		mu        sync.Mutex
+		selectors []string
+	)
+	f := Frontend{
+		limits: &mockShardLimits{shards: 4},
+		GRPCRoundTripper: &mockRoundTripper{callback: func(ctx context.Context, req *httpgrpc.HTTPRequest) (*httpgrpc.HTTPResponse, error) {
+			return connectgrpc.HandleUnary[querierv1.SelectMergeStacktracesRequest, querierv1.SelectMergeStacktracesResponse](ctx, req,
+				func(ctx context.Context, req *connect.Request[querierv1.SelectMergeStacktracesRequest]) (*connect.Response[querierv1.SelectMergeStacktracesResponse], error) {
+					mu.Lock()
+					selectors = append(selectors, req.Msg.LabelSelector)
+					mu.Unlock()
+					s := new(model.Tree)
+					s.InsertStack(1, "foo", "bar")
+					return connect.NewResponse(&querierv1.SelectMergeStacktracesResponse{Tree: s.Bytes(-1)}), nil
+				})
+		}},
+	}
+
+	ctx := user.InjectOrgID(context.Background(), "test")
+	_, ctx = opentracing.StartSpanFromContext(ctx, "test")
+	start := time.Now().Add(-2 * time.Hour).Truncate(time.Hour)
+	resp, err := f.SelectMergeStacktraces(ctx, connect.NewRequest(&querierv1.SelectMergeStacktracesRequest{
+		ProfileTypeID: "memory:inuse_space:bytes:space:byte",
+		LabelSelector: `{service_name="foo"}`,
+		Start:         start.UnixMilli(),
+		End:           start.Add(30 * time.Minute).UnixMilli(),
+		Format:        querierv1.ProfileFormat_PROFILE_FORMAT_TREE,
+	}))
+	require.NoError(t, err)
+	tree, err := model.UnmarshalTree(resp.Msg.Tree)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), tree.Total())
+	assert.ElementsMatch(t, []string{
+		`{service_name="foo",__query_shard__="1_of_4"}`,
+		`{service_name="foo",__query_shard__="2_of_4"}`,
+		`{service_name="foo",__query_shard__="3_of_4"}`,
+		`{service_name="foo",__query_shard__="4_of_4"}`,
+	}, selectors)
+}
+
+func Test_QuerySharding_SelectSeries(t *testing.T) {
+	f := Frontend{
+		limits: &mockShardLimits{shards: 2},
+		GRPCRoundTripper: &mockRoundTripper{callback: func(ctx context.Context, req *httpgrpc.HTTPRequest) (*httpgrpc.HTTPResponse, error) {
+			return connectgrpc.HandleUnary[querierv1.SelectSeriesRequest, querierv1.SelectSeriesResponse](ctx, req,
+				func(ctx context.Context, req *connect.Request[querierv1.SelectSeriesRequest]) (*connect.Response[querierv1.SelectSeriesResponse], error) {
+					s := &typesv1.Series{Labels: []*typesv1.LabelPair{{Name: "foo", Value: "bar"}}}
+					s.Points = append(s.Points, &typesv1.Point{Timestamp: req.Msg.Start, Value: 1})
+					return connect.NewResponse(&querierv1.SelectSeriesResponse{Series: []*typesv1.Series{s}}), nil
+				})
+		}},
+	}
+
+	ctx := user.InjectOrgID(context.Background(), "test")
+	_, ctx = opentracing.StartSpanFromContext(ctx, "test")
+	start := time.Now().Add(-2 * time.Hour).Truncate(time.Hour)
+	query := func(aggregation typesv1.TimeSeriesAggregationType) []*typesv1.Series {
+		resp, err := f.SelectSeries(ctx, connect.NewRequest(&querierv1.SelectSeriesRequest{
+			ProfileTypeID: "memory:inuse_space:bytes:space:byte",
+			LabelSelector: "{}",
+			Start:         start.UnixMilli(),
+			End:           start.Add(30 * time.Minute).UnixMilli(),
+			Step:          60,
+			Aggregation:   &aggregation,
+		}))
+		require.NoError(t, err)
+		return resp.Msg.Series
+	}
+
+	// Points of the shards are summed.
+	series := query(typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_SUM)
+	require.Len(t, series, 1)
+	require.Len(t, series[0].Points, 1)
+	assert.Equal(t, float64(2), series[0].Points[0].Value)
+
+	// Averages can't be merged: the query is not sharded.
+	series = query(typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_AVERAGE)
+	require.Len(t, series, 1)
+	require.Len(t, series[0].Points, 1)
+	assert.Equal(t, float64(1), series[0].Points[0].Value)
+}
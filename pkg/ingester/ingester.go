@@ -4,6 +4,8 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"os"
+	"path/filepath"
 	"sync"
 	"time"
 
@@ -146,9 +148,40 @@ func New(phlarectx context.Context, cfg Config, dbConfig phlaredb.Config, storag
 }
 
 func (i *Ingester) starting(ctx context.Context) error {
+	// The WAL must be replayed before the ingester joins the ring.
+	if i.dbConfig.WALEnabled {
+		if err := i.replayWAL(); err != nil {
+			return fmt.Errorf("replaying WAL: %w", err)
+		}
+	}
 	return services.StartManagerAndAwaitHealthy(ctx, i.subservices)
 }
 
+// replayWAL opens instances of all the tenants that have a write-ahead log
+// on the local disk: the WAL is replayed when the tenant database is opened.
+func (i *Ingester) replayWAL() error {
+	entries, err := os.ReadDir(i.dbConfig.DataPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		if _, err = os.Stat(filepath.Join(i.dbConfig.DataPath, e.Name(), phlaredb.PathWAL)); err != nil {
+			continue
+		}
+		level.Info(i.logger).Log("msg", "replaying tenant WAL", "tenant", e.Name())
+		if _, err = i.GetOrCreateInstance(e.Name()); err != nil {
+			return fmt.Errorf("tenant %s: %w", e.Name(), err)
+		}
+	}
+	return nil
+}
+
 func (i *Ingester) running(ctx context.Context) error {
 	select {
 	case <-ctx.Done():
@@ -4,6 +4,7 @@ import (
 	"context"
 	"path"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-kit/log"
@@ -15,8 +16,13 @@ import (
 	"github.com/grafana/pyroscope/pkg/phlaredb"
 	"github.com/grafana/pyroscope/pkg/phlaredb/block"
 	"github.com/grafana/pyroscope/pkg/phlaredb/shipper"
+	"github.com/grafana/pyroscope/pkg/phlaredb/tombstones"
 )
 
+// tombstonesSyncInterval is how frequently the delete
+// requests of the tenant are reloaded from the storage.
+const tombstonesSyncInterval = time.Minute
+
 type instance struct {
 	*phlaredb.PhlareDB
 	shipper     *shipper.Shipper
@@ -24,6 +30,9 @@ type instance struct {
 	logger      log.Logger
 	reg         prometheus.Registerer
 
+	deleteRequests *tombstones.Store
+	tombstones     atomic.Pointer[tombstones.Tombstones]
+
 	cancel   context.CancelFunc
 	wg       sync.WaitGroup
 	tenantID string
@@ -37,18 +46,24 @@ func newInstance(phlarectx context.Context, cfg phlaredb.Config, tenantID string
 	reg := prometheus.WrapRegistererWith(prometheus.Labels{"component": "ingester"}, phlarecontext.Registry(phlarectx))
 	phlarectx = phlarecontext.WithRegistry(phlarectx, reg)
 
-	db, err := phlaredb.New(phlarectx, cfg, limiter, phlareobj.NewPrefixedBucket(localBucket, tenantID))
-	if err != nil {
-		return nil, err
-	}
-	ctx, cancel := context.WithCancel(phlarectx)
 	inst := &instance{
-		PhlareDB: db,
 		logger:   phlarecontext.Logger(phlarectx),
 		reg:      reg,
-		cancel:   cancel,
 		tenantID: tenantID,
 	}
+	if storageBucket != nil {
+		// Profiles deleted by the tenant are filtered out at query
+		// time, until the compactor removes them from the blocks.
+		inst.deleteRequests = tombstones.NewStore(storageBucket)
+		phlarectx = phlaredb.ContextWithTombstones(phlarectx, inst)
+	}
+	db, err := phlaredb.New(phlarectx, cfg, limiter, phlareobj.NewPrefixedBucket(localBucket, tenantID))
+	if err != nil {
+		return nil, err
+	}
+	inst.PhlareDB = db
+	ctx, cancel := context.WithCancel(phlarectx)
+	inst.cancel = cancel
 	// Todo we should not ship when using filesystem storage.
 	if storageBucket != nil {
 		inst.shipper = shipper.New(
@@ -77,6 +92,9 @@ func (i *instance) loop(ctx context.Context) {
 	go func() {
 		i.runShipper(ctx)
 	}()
+	tombstonesTicker := time.NewTicker(tombstonesSyncInterval)
+	defer tombstonesTicker.Stop()
+	i.syncTombstones(ctx)
 
 	for {
 		select {
@@ -84,10 +102,31 @@ func (i *instance) loop(ctx context.Context) {
 			return
 		case <-shipperTicker.C: // run shipper loop
 			i.runShipper(ctx)
+		case <-tombstonesTicker.C:
+			i.syncTombstones(ctx)
 		}
 	}
 }
 
+// Tombstones returns the tombstones of the tenant delete requests:
+// the deleted profiles are filtered out at query time.
+func (i *instance) Tombstones() *tombstones.Tombstones {
+	return i.tombstones.Load()
+}
+
+func (i *instance) syncTombstones(ctx context.Context) {
+	if i.deleteRequests == nil {
+		return
+	}
+	t, err := i.deleteRequests.Tombstones(ctx, i.tenantID)
+	if err != nil {
+		// Keep the previously loaded tombstones.
+		level.Warn(i.logger).Log("msg", "failed to load delete requests", "err", err)
+		return
+	}
+	i.tombstones.Store(t)
+}
+
 func (i *instance) runShipper(ctx context.Context) {
 	i.shipperLock.Lock()
 	defer i.shipperLock.Unlock()
@@ -0,0 +1,432 @@
+package otlp
+
+import (
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/prometheus/model/labels"
+	commonv1 "go.opentelemetry.io/proto/otlp/common/v1"
+
+	profilev1 "github.com/grafana/pyroscope/api/gen/proto/go/google/v1"
+	otlpcollector "github.com/grafana/pyroscope/api/gen/proto/go/otlp/collector/profiles/v1experimental"
+	otlpprofiles "github.com/grafana/pyroscope/api/gen/proto/go/otlp/profiles/v1experimental"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+	distributormodel "github.com/grafana/pyroscope/pkg/distributor/model"
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/pprof"
+)
+
+const (
+	attrServiceName = "service.name"
+
+	labelNameScopeName    = "otel_scope_name"
+	labelNameScopeVersion = "otel_scope_version"
+	labelNameTraceID      = "trace_id"
+)
+
+// ConvertRequest converts OTLP profiles into a push request. Every profile
+// container becomes a separate series: its labels are made of the resource,
+// the instrumentation scope, and the profile attributes, in that order of
+// precedence (later wins).
+func ConvertRequest(req *otlpcollector.ExportProfilesServiceRequest) (*distributormodel.PushRequest, error) {
+	res := &distributormodel.PushRequest{
+		RawProfileSize: req.SizeVT(),
+		RawProfileType: distributormodel.RawProfileTypeOTLP,
+	}
+	for _, rp := range req.ResourceProfiles {
+		resourceLabels := make(map[string]string)
+		if rp.Resource != nil {
+			addAttributes(resourceLabels, rp.Resource.Attributes)
+		}
+		for _, sp := range rp.ScopeProfiles {
+			scopeLabels := make(map[string]string, len(resourceLabels))
+			for k, v := range resourceLabels {
+				scopeLabels[k] = v
+			}
+			if sp.Scope != nil {
+				if sp.Scope.Name != "" {
+					scopeLabels[labelNameScopeName] = sp.Scope.Name
+				}
+				if sp.Scope.Version != "" {
+					scopeLabels[labelNameScopeVersion] = sp.Scope.Version
+				}
+				addAttributes(scopeLabels, sp.Scope.Attributes)
+			}
+			for _, c := range sp.Profiles {
+				if c.Profile == nil {
+					continue
+				}
+				series, err := convertProfileContainer(c, scopeLabels)
+				if err != nil {
+					return nil, err
+				}
+				res.Series = append(res.Series, series)
+			}
+		}
+	}
+	return res, nil
+}
+
+func convertProfileContainer(c *otlpprofiles.ProfileContainer, scopeLabels map[string]string) (*distributormodel.ProfileSeries, error) {
+	p, err := convertProfile(c.Profile)
+	if err != nil {
+		return nil, fmt.Errorf("invalid profile %x: %w", c.ProfileId, err)
+	}
+	if p.TimeNanos == 0 {
+		p.TimeNanos = int64(c.StartTimeUnixNano)
+	}
+	if p.DurationNanos == 0 && c.EndTimeUnixNano > c.StartTimeUnixNano {
+		p.DurationNanos = int64(c.EndTimeUnixNano - c.StartTimeUnixNano)
+	}
+
+	ls := make(map[string]string, len(scopeLabels)+len(c.Attributes)+2)
+	for k, v := range scopeLabels {
+		ls[k] = v
+	}
+	addAttributes(ls, c.Attributes)
+	if _, ok := ls[labels.MetricName]; !ok {
+		name := metricName(p)
+		if name == "" {
+			return nil, fmt.Errorf("invalid profile %x: unable to determine the profile name from the sample types", c.ProfileId)
+		}
+		ls[labels.MetricName] = name
+	}
+	// OTLP profiles already hold the values collected over the profile
+	// duration, therefore the delta must not be computed on ingestion.
+	ls[phlaremodel.LabelNameDelta] = "false"
+
+	series := &distributormodel.ProfileSeries{
+		Labels: make([]*typesv1.LabelPair, 0, len(ls)),
+		Samples: []*distributormodel.ProfileSample{{
+			Profile: pprof.RawFromProto(p),
+		}},
+	}
+	for k, v := range ls {
+		series.Labels = append(series.Labels, &typesv1.LabelPair{Name: k, Value: v})
+	}
+	sort.Sort(phlaremodel.Labels(series.Labels))
+	if id, err := uuid.FromBytes(c.ProfileId); err == nil {
+		series.Samples[0].ID = id.String()
+	}
+	return series, nil
+}
+
+// addAttributes adds the attributes with scalar values to the label set.
+// Attribute names are sanitized to be valid label names, except for the
+// service name, which is mapped to the service_name label.
+func addAttributes(ls map[string]string, attrs []*commonv1.KeyValue) {
+	for _, a := range attrs {
+		v, ok := attributeValue(a.Value)
+		if !ok || v == "" {
+			continue
+		}
+		name := a.Key
+		if name == attrServiceName {
+			name = phlaremodel.LabelNameServiceName
+		}
+		ls[sanitizeLabelName(name)] = v
+	}
+}
+
+func attributeValue(v *commonv1.AnyValue) (string, bool) {
+	switch x := v.GetValue().(type) {
+	case *commonv1.AnyValue_StringValue:
+		return x.StringValue, true
+	case *commonv1.AnyValue_IntValue:
+		return strconv.FormatInt(x.IntValue, 10), true
+	case *commonv1.AnyValue_BoolValue:
+		return strconv.FormatBool(x.BoolValue), true
+	case *commonv1.AnyValue_DoubleValue:
+		return strconv.FormatFloat(x.DoubleValue, 'g', -1, 64), true
+	default:
+		return "", false
+	}
+}
+
+func sanitizeLabelName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' {
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+func metricName(p *profilev1.Profile) string {
+	var st string
+	for _, t := range p.SampleType {
+		st = p.StringTable[t.Type]
+		switch {
+		case st == "wall":
+			return "wall"
+		case strings.Contains(st, "cpu"), st == "samples":
+			return "process_cpu"
+		case strings.HasPrefix(st, "alloc_"), strings.HasPrefix(st, "inuse_"), st == "space", st == "objects":
+			return "memory"
+		case strings.HasPrefix(st, "mutex_"):
+			return "mutex"
+		case strings.HasPrefix(st, "block_"):
+			return "block"
+		case st == "goroutines", st == "goroutine":
+			return "goroutines"
+		}
+	}
+	return st
+}
+
+// convertProfile converts the OTLP profile into a pprof profile. In contrast
+// to pprof, OTLP profiles refer to the mapping, location, and function tables
+// by index rather than by identifier; the resulting pprof entries are
+// identified by their index + 1.
+func convertProfile(src *otlpprofiles.Profile) (*profilev1.Profile, error) {
+	c := &converter{
+		src: src,
+		dst: &profilev1.Profile{
+			StringTable:       slices.Clone(src.StringTable),
+			DropFrames:        src.DropFrames,
+			KeepFrames:        src.KeepFrames,
+			TimeNanos:         src.TimeNanos,
+			DurationNanos:     src.DurationNanos,
+			Period:            src.Period,
+			Comment:           src.Comment,
+			DefaultSampleType: src.DefaultSampleType,
+		},
+		strings: make(map[string]int64),
+	}
+	if len(c.dst.StringTable) == 0 {
+		c.dst.StringTable = []string{""}
+	}
+	for i, s := range c.dst.StringTable {
+		c.strings[s] = int64(i)
+	}
+	if err := c.convert(); err != nil {
+		return nil, err
+	}
+	return c.dst, nil
+}
+
+type converter struct {
+	src     *otlpprofiles.Profile
+	dst     *profilev1.Profile
+	strings map[string]int64
+}
+
+func (c *converter) convert() error {
+	if err := c.checkStrings(); err != nil {
+		return err
+	}
+	c.dst.SampleType = make([]*profilev1.ValueType, len(c.src.SampleType))
+	for i, t := range c.src.SampleType {
+		c.dst.SampleType[i] = &profilev1.ValueType{Type: t.Type, Unit: t.Unit}
+	}
+	if t := c.src.PeriodType; t != nil {
+		c.dst.PeriodType = &profilev1.ValueType{Type: t.Type, Unit: t.Unit}
+	}
+
+	c.dst.Mapping = make([]*profilev1.Mapping, len(c.src.Mapping))
+	for i, m := range c.src.Mapping {
+		c.dst.Mapping[i] = &profilev1.Mapping{
+			Id:              uint64(i + 1),
+			MemoryStart:     m.MemoryStart,
+			MemoryLimit:     m.MemoryLimit,
+			FileOffset:      m.FileOffset,
+			Filename:        m.Filename,
+			BuildId:         m.BuildId,
+			HasFunctions:    m.HasFunctions,
+			HasFilenames:    m.HasFilenames,
+			HasLineNumbers:  m.HasLineNumbers,
+			HasInlineFrames: m.HasInlineFrames,
+		}
+	}
+
+	c.dst.Function = make([]*profilev1.Function, len(c.src.Function))
+	for i, f := range c.src.Function {
+		c.dst.Function[i] = &profilev1.Function{
+			Id:         uint64(i + 1),
+			Name:       f.Name,
+			SystemName: f.SystemName,
+			Filename:   f.Filename,
+			StartLine:  f.StartLine,
+		}
+	}
+
+	c.dst.Location = make([]*profilev1.Location, len(c.src.Location))
+	for i, l := range c.src.Location {
+		loc := &profilev1.Location{
+			Id:       uint64(i + 1),
+			Address:  l.Address,
+			IsFolded: l.IsFolded,
+			Line:     make([]*profilev1.Line, len(l.Line)),
+		}
+		if len(c.src.Mapping) > 0 {
+			if l.MappingIndex >= uint64(len(c.src.Mapping)) {
+				return fmt.Errorf("location %d: mapping index %d out of range", i, l.MappingIndex)
+			}
+			loc.MappingId = l.MappingIndex + 1
+		}
+		for j, line := range l.Line {
+			if line.FunctionIndex >= uint64(len(c.src.Function)) {
+				return fmt.Errorf("location %d: function index %d out of range", i, line.FunctionIndex)
+			}
+			loc.Line[j] = &profilev1.Line{
+				FunctionId: line.FunctionIndex + 1,
+				Line:       line.Line,
+			}
+		}
+		c.dst.Location[i] = loc
+	}
+
+	c.dst.Sample = make([]*profilev1.Sample, len(c.src.Sample))
+	for i, s := range c.src.Sample {
+		sample, err := c.convertSample(s)
+		if err != nil {
+			return fmt.Errorf("sample %d: %w", i, err)
+		}
+		c.dst.Sample[i] = sample
+	}
+	return nil
+}
+
+func (c *converter) convertSample(s *otlpprofiles.Sample) (*profilev1.Sample, error) {
+	if len(s.Value) != len(c.src.SampleType) {
+		return nil, fmt.Errorf("expected %d values, got %d", len(c.src.SampleType), len(s.Value))
+	}
+	dst := &profilev1.Sample{
+		Value: s.Value,
+		Label: make([]*profilev1.Label, 0, len(s.Label)+len(s.Attributes)+1),
+	}
+	if s.LocationsLength > 0 {
+		end := s.LocationsStartIndex + s.LocationsLength
+		if end > uint64(len(c.src.LocationIndices)) {
+			return nil, fmt.Errorf("locations [%d:%d] out of range", s.LocationsStartIndex, end)
+		}
+		dst.LocationId = make([]uint64, s.LocationsLength)
+		for i, x := range c.src.LocationIndices[s.LocationsStartIndex:end] {
+			if x < 0 || x >= int64(len(c.src.Location)) {
+				return nil, fmt.Errorf("location index %d out of range", x)
+			}
+			dst.LocationId[i] = uint64(x) + 1
+		}
+	} else {
+		dst.LocationId = make([]uint64, len(s.LocationIndex))
+		for i, x := range s.LocationIndex {
+			if x >= uint64(len(c.src.Location)) {
+				return nil, fmt.Errorf("location index %d out of range", x)
+			}
+			dst.LocationId[i] = x + 1
+		}
+	}
+
+	for _, l := range s.Label {
+		dst.Label = append(dst.Label, &profilev1.Label{Key: l.Key, Str: l.Str, Num: l.Num, NumUnit: l.NumUnit})
+	}
+	for _, x := range s.Attributes {
+		if x >= uint64(len(c.src.AttributeTable)) {
+			return nil, fmt.Errorf("attribute index %d out of range", x)
+		}
+		a := c.src.AttributeTable[x]
+		if v, ok := attributeValue(a.Value); ok {
+			dst.Label = append(dst.Label, &profilev1.Label{
+				Key: c.string(sanitizeLabelName(a.Key)),
+				Str: c.string(v),
+			})
+		}
+	}
+	// Links to spans are stored as trace_id and span_id labels,
+	// as in pprof profiles produced by the span profilers.
+	if len(c.src.LinkTable) > 0 {
+		if s.Link >= uint64(len(c.src.LinkTable)) {
+			return nil, fmt.Errorf("link index %d out of range", s.Link)
+		}
+		link := c.src.LinkTable[s.Link]
+		if len(link.TraceId) > 0 {
+			dst.Label = append(dst.Label, &profilev1.Label{
+				Key: c.string(labelNameTraceID),
+				Str: c.string(hex.EncodeToString(link.TraceId)),
+			})
+		}
+		if len(link.SpanId) > 0 {
+			dst.Label = append(dst.Label, &profilev1.Label{
+				Key: c.string(pprof.SpanIDLabelName),
+				Str: c.string(hex.EncodeToString(link.SpanId)),
+			})
+		}
+	}
+	return dst, nil
+}
+
+func (c *converter) checkStrings() error {
+	n := int64(len(c.dst.StringTable))
+	check := func(what string, x int64) error {
+		if x < 0 || x >= n {
+			return fmt.Errorf("%s: string index %d out of range", what, x)
+		}
+		return nil
+	}
+	for _, x := range []int64{c.src.DropFrames, c.src.KeepFrames, c.src.DefaultSampleType} {
+		if err := check("profile", x); err != nil {
+			return err
+		}
+	}
+	for _, x := range c.src.Comment {
+		if err := check("comment", x); err != nil {
+			return err
+		}
+	}
+	for _, t := range c.src.SampleType {
+		if err := check("sample type", t.Type); err != nil {
+			return err
+		}
+		if err := check("sample type", t.Unit); err != nil {
+			return err
+		}
+	}
+	if t := c.src.PeriodType; t != nil {
+		if err := check("period type", t.Type); err != nil {
+			return err
+		}
+		if err := check("period type", t.Unit); err != nil {
+			return err
+		}
+	}
+	for _, m := range c.src.Mapping {
+		if err := check("mapping", m.Filename); err != nil {
+			return err
+		}
+		if err := check("mapping", m.BuildId); err != nil {
+			return err
+		}
+	}
+	for _, f := range c.src.Function {
+		for _, x := range []int64{f.Name, f.SystemName, f.Filename} {
+			if err := check("function", x); err != nil {
+				return err
+			}
+		}
+	}
+	for _, s := range c.src.Sample {
+		for _, l := range s.Label {
+			for _, x := range []int64{l.Key, l.Str, l.NumUnit} {
+				if err := check("label", x); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (c *converter) string(s string) int64 {
+	if i, ok := c.strings[s]; ok {
+		return i
+	}
+	i := int64(len(c.dst.StringTable))
+	c.dst.StringTable = append(c.dst.StringTable, s)
+	c.strings[s] = i
+	return i
+}
@@ -0,0 +1,158 @@
+package otlp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commonv1 "go.opentelemetry.io/proto/otlp/common/v1"
+	resourcev1 "go.opentelemetry.io/proto/otlp/resource/v1"
+
+	otlpcollector "github.com/grafana/pyroscope/api/gen/proto/go/otlp/collector/profiles/v1experimental"
+	otlpprofiles "github.com/grafana/pyroscope/api/gen/proto/go/otlp/profiles/v1experimental"
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/pprof"
+)
+
+func stringAttr(k, v string) *commonv1.KeyValue {
+	return &commonv1.KeyValue{Key: k, Value: &commonv1.AnyValue{Value: &commonv1.AnyValue_StringValue{StringValue: v}}}
+}
+
+func testProfile() *otlpprofiles.Profile {
+	return &otlpprofiles.Profile{
+		StringTable: []string{"", "samples", "count", "cpu", "nanoseconds", "main", "foo", "bar", "app", "thread", "worker"},
+		SampleType:  []*otlpprofiles.ValueType{{Type: 1, Unit: 2}, {Type: 3, Unit: 4}},
+		PeriodType:  &otlpprofiles.ValueType{Type: 3, Unit: 4},
+		Period:      10000000,
+		Mapping:     []*otlpprofiles.Mapping{{Filename: 8, HasFunctions: true}},
+		Function: []*otlpprofiles.Function{
+			{Name: 5},
+			{Name: 6},
+			{Name: 7},
+		},
+		// Locations are shared between the samples.
+		Location: []*otlpprofiles.Location{
+			{Line: []*otlpprofiles.Line{{FunctionIndex: 0}}},
+			{Line: []*otlpprofiles.Line{{FunctionIndex: 1}}},
+			{Line: []*otlpprofiles.Line{{FunctionIndex: 2}}},
+		},
+		LocationIndices: []int64{1, 0, 2, 0},
+		AttributeTable:  []*commonv1.KeyValue{stringAttr("thread.name", "worker")},
+		LinkTable: []*otlpprofiles.Link{
+			{},
+			{TraceId: []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, SpanId: []byte{0xba, 0xdb, 0xad, 0xba, 0xdb, 0xad, 0xba, 0xda}},
+		},
+		Sample: []*otlpprofiles.Sample{
+			// foo;main
+			{LocationsStartIndex: 0, LocationsLength: 2, Value: []int64{1, 10000000}, Attributes: []uint64{0}},
+			// bar;main
+			{LocationsStartIndex: 2, LocationsLength: 2, Value: []int64{2, 20000000}, Link: 1},
+			// Deprecated location_index: main
+			{LocationIndex: []uint64{0}, Value: []int64{3, 30000000}},
+		},
+	}
+}
+
+func testRequest() *otlpcollector.ExportProfilesServiceRequest {
+	return &otlpcollector.ExportProfilesServiceRequest{
+		ResourceProfiles: []*otlpprofiles.ResourceProfiles{{
+			Resource: &resourcev1.Resource{Attributes: []*commonv1.KeyValue{
+				stringAttr("service.name", "my-service"),
+				stringAttr("host.name", "host-1"),
+				{Key: "process.pid", Value: &commonv1.AnyValue{Value: &commonv1.AnyValue_IntValue{IntValue: 42}}},
+				{Key: "ignored", Value: &commonv1.AnyValue{Value: &commonv1.AnyValue_ArrayValue{}}},
+			}},
+			ScopeProfiles: []*otlpprofiles.ScopeProfiles{{
+				Scope: &commonv1.InstrumentationScope{Name: "profiler", Version: "1.0"},
+				Profiles: []*otlpprofiles.ProfileContainer{{
+					ProfileId:         []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+					StartTimeUnixNano: 1e9,
+					EndTimeUnixNano:   11e9,
+					Attributes:        []*commonv1.KeyValue{stringAttr("host.name", "host-2")},
+					Profile:           testProfile(),
+				}},
+			}},
+		}},
+	}
+}
+
+func Test_ConvertRequest(t *testing.T) {
+	src := testRequest()
+	strings := src.ResourceProfiles[0].ScopeProfiles[0].Profiles[0].Profile.StringTable
+	req, err := ConvertRequest(src)
+	require.NoError(t, err)
+	// The source string table is left intact.
+	assert.Equal(t, testProfile().StringTable, strings)
+	require.Len(t, req.Series, 1)
+	s := req.Series[0]
+	assert.Equal(t,
+		`{__delta__="false", __name__="process_cpu", host_name="host-2", otel_scope_name="profiler", otel_scope_version="1.0", process_pid="42", service_name="my-service"}`,
+		phlaremodel.Labels(s.Labels).ToPrometheusLabels().String())
+	require.Len(t, s.Samples, 1)
+	assert.Equal(t, "01020304-0506-0708-090a-0b0c0d0e0f10", s.Samples[0].ID)
+
+	p := s.Samples[0].Profile.Profile
+	assert.Equal(t, int64(1e9), p.TimeNanos)
+	assert.Equal(t, int64(10e9), p.DurationNanos)
+	assert.Equal(t, int64(10000000), p.Period)
+	require.Len(t, p.Sample, 3)
+
+	stack := func(i int) []string {
+		var names []string
+		for _, id := range p.Sample[i].LocationId {
+			loc := p.Location[id-1]
+			assert.Equal(t, id, loc.Id)
+			assert.Equal(t, uint64(1), loc.MappingId)
+			fn := p.Function[loc.Line[0].FunctionId-1]
+			names = append(names, p.StringTable[fn.Name])
+		}
+		return names
+	}
+	assert.Equal(t, []string{"foo", "main"}, stack(0))
+	assert.Equal(t, []string{"bar", "main"}, stack(1))
+	assert.Equal(t, []string{"main"}, stack(2))
+	assert.Equal(t, []int64{2, 20000000}, p.Sample[1].Value)
+
+	label := func(i int) map[string]string {
+		m := make(map[string]string)
+		for _, l := range p.Sample[i].Label {
+			m[p.StringTable[l.Key]] = p.StringTable[l.Str]
+		}
+		return m
+	}
+	assert.Equal(t, map[string]string{"thread_name": "worker"}, label(0))
+	assert.Equal(t, map[string]string{
+		labelNameTraceID:      "0102030405060708090a0b0c0d0e0f10",
+		pprof.SpanIDLabelName: "badbadbadbadbada",
+	}, label(1))
+	assert.Equal(t, map[string]string{}, label(2))
+}
+
+func Test_ConvertRequest_Invalid(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		modify func(*otlpprofiles.Profile)
+	}{
+		{"string index", func(p *otlpprofiles.Profile) { p.Function[0].Name = 100 }},
+		{"drop frames", func(p *otlpprofiles.Profile) { p.DropFrames = 100 }},
+		{"keep frames", func(p *otlpprofiles.Profile) { p.KeepFrames = -1 }},
+		{"default sample type", func(p *otlpprofiles.Profile) { p.DefaultSampleType = 100 }},
+		{"comment", func(p *otlpprofiles.Profile) { p.Comment = []int64{100} }},
+		{"function index", func(p *otlpprofiles.Profile) { p.Location[0].Line[0].FunctionIndex = 3 }},
+		{"mapping index", func(p *otlpprofiles.Profile) { p.Location[0].MappingIndex = 1 }},
+		{"location indices", func(p *otlpprofiles.Profile) { p.Sample[1].LocationsLength = 3 }},
+		{"location index", func(p *otlpprofiles.Profile) { p.LocationIndices[0] = 3 }},
+		{"attribute index", func(p *otlpprofiles.Profile) { p.Sample[0].Attributes = []uint64{1} }},
+		{"link index", func(p *otlpprofiles.Profile) { p.Sample[0].Link = 2 }},
+		{"values", func(p *otlpprofiles.Profile) { p.Sample[0].Value = []int64{1} }},
+		{"sample types", func(p *otlpprofiles.Profile) { p.SampleType = nil; p.PeriodType = nil; p.Sample = nil }},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			req := testRequest()
+			tc.modify(req.ResourceProfiles[0].ScopeProfiles[0].Profiles[0].Profile)
+			_, err := ConvertRequest(req)
+			assert.Error(t, err)
+		})
+	}
+}
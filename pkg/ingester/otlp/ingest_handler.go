@@ -0,0 +1,131 @@
+package otlp
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+
+	otlpcollector "github.com/grafana/pyroscope/api/gen/proto/go/otlp/collector/profiles/v1experimental"
+	"github.com/grafana/pyroscope/api/gen/proto/go/otlp/collector/profiles/v1experimental/v1experimentalconnect"
+	pushv1 "github.com/grafana/pyroscope/api/gen/proto/go/push/v1"
+	distributormodel "github.com/grafana/pyroscope/pkg/distributor/model"
+	"github.com/grafana/pyroscope/pkg/tenant"
+	httputil "github.com/grafana/pyroscope/pkg/util/http"
+)
+
+// HTTPPath is the path of the OTLP/HTTP profiles endpoint.
+const HTTPPath = "/v1experimental/profiles"
+
+const contentTypeProtobuf = "application/x-protobuf"
+
+type PushService interface {
+	PushParsed(ctx context.Context, req *distributormodel.PushRequest) (*connect.Response[pushv1.PushResponse], error)
+}
+
+// Handler receives OTLP profiles over gRPC (as ProfilesService)
+// and HTTP/protobuf, and pushes them to the PushService.
+type Handler struct {
+	svc PushService
+	log log.Logger
+	// maxMessageSize limits the size of the HTTP request body,
+	// both compressed and decompressed. Zero means no limit.
+	maxMessageSize int64
+}
+
+var _ v1experimentalconnect.ProfilesServiceHandler = (*Handler)(nil)
+
+func NewOTLPIngestHandler(svc PushService, l log.Logger, maxMessageSize int) *Handler {
+	return &Handler{
+		svc:            svc,
+		log:            l,
+		maxMessageSize: int64(maxMessageSize),
+	}
+}
+
+func (h *Handler) Export(ctx context.Context, req *connect.Request[otlpcollector.ExportProfilesServiceRequest]) (*connect.Response[otlpcollector.ExportProfilesServiceResponse], error) {
+	if err := h.export(ctx, req.Msg); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&otlpcollector.ExportProfilesServiceResponse{}), nil
+}
+
+func (h *Handler) export(ctx context.Context, req *otlpcollector.ExportProfilesServiceRequest) error {
+	pushReq, err := ConvertRequest(req)
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if len(pushReq.Series) == 0 {
+		tenantID, _ := tenant.ExtractTenantIDFromContext(ctx)
+		_ = level.Debug(h.log).Log("msg", "empty otlp profiles request", "orgID", tenantID)
+		return nil
+	}
+	_, err = h.svc.PushParsed(ctx, pushReq)
+	return err
+}
+
+// ServeHTTP handles OTLP/HTTP requests. Only the binary protobuf
+// encoding is supported, optionally gzip-compressed.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType != contentTypeProtobuf {
+		httputil.ErrorWithStatus(w, fmt.Errorf("unsupported content type %q, expected %q", mediaType, contentTypeProtobuf), http.StatusUnsupportedMediaType)
+		return
+	}
+	b, err := h.readBody(w, r)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			httputil.ErrorWithStatus(w, fmt.Errorf("request body exceeds the maximum message size of %d bytes", h.maxMessageSize), http.StatusRequestEntityTooLarge)
+			return
+		}
+		httputil.ErrorWithStatus(w, err, http.StatusBadRequest)
+		return
+	}
+	var req otlpcollector.ExportProfilesServiceRequest
+	if err = req.UnmarshalVT(b); err != nil {
+		httputil.ErrorWithStatus(w, fmt.Errorf("failed to decode request: %w", err), http.StatusBadRequest)
+		return
+	}
+	if err = h.export(r.Context(), &req); err != nil {
+		tenantID, _ := tenant.ExtractTenantIDFromContext(r.Context())
+		_ = level.Error(h.log).Log("msg", "otlp ingest", "err", err, "orgID", tenantID)
+		httputil.Error(w, err)
+		return
+	}
+	resp, err := (&otlpcollector.ExportProfilesServiceResponse{}).MarshalVT()
+	if err != nil {
+		httputil.Error(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", contentTypeProtobuf)
+	_, _ = w.Write(resp)
+}
+
+// readBody reads the request body, decompressing it if needed. The size
+// of the decompressed body is limited as well, so that a small compressed
+// request can not exhaust the memory.
+func (h *Handler) readBody(w http.ResponseWriter, r *http.Request) ([]byte, error) {
+	var body io.ReadCloser = r.Body
+	if h.maxMessageSize > 0 {
+		body = http.MaxBytesReader(w, body, h.maxMessageSize)
+	}
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		gr, err := gzip.NewReader(body)
+		if err != nil {
+			return nil, err
+		}
+		defer gr.Close()
+		body = gr
+		if h.maxMessageSize > 0 {
+			body = http.MaxBytesReader(w, body, h.maxMessageSize)
+		}
+	}
+	return io.ReadAll(body)
+}
@@ -0,0 +1,127 @@
+package otlp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/go-kit/log"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+
+	otlpcollector "github.com/grafana/pyroscope/api/gen/proto/go/otlp/collector/profiles/v1experimental"
+	"github.com/grafana/pyroscope/api/gen/proto/go/otlp/collector/profiles/v1experimental/v1experimentalconnect"
+	pushv1 "github.com/grafana/pyroscope/api/gen/proto/go/push/v1"
+	distributormodel "github.com/grafana/pyroscope/pkg/distributor/model"
+)
+
+type mockPushService struct {
+	requests []*distributormodel.PushRequest
+}
+
+func (m *mockPushService) PushParsed(_ context.Context, req *distributormodel.PushRequest) (*connect.Response[pushv1.PushResponse], error) {
+	m.requests = append(m.requests, req)
+	return connect.NewResponse(&pushv1.PushResponse{}), nil
+}
+
+func Test_Handler_HTTP(t *testing.T) {
+	svc := new(mockPushService)
+	h := NewOTLPIngestHandler(svc, log.NewNopLogger(), 0)
+
+	body, err := testRequest().MarshalVT()
+	require.NoError(t, err)
+	var gz bytes.Buffer
+	gw := gzip.NewWriter(&gz)
+	_, err = gw.Write(body)
+	require.NoError(t, err)
+	require.NoError(t, gw.Close())
+
+	req := httptest.NewRequest("POST", HTTPPath, bytes.NewReader(gz.Bytes()))
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("Content-Encoding", "gzip")
+	res := httptest.NewRecorder()
+	h.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+	assert.Equal(t, "application/x-protobuf", res.Header().Get("Content-Type"))
+	require.Len(t, svc.requests, 1)
+	assert.Equal(t, distributormodel.RawProfileTypeOTLP, svc.requests[0].RawProfileType)
+	assert.Len(t, svc.requests[0].Series, 1)
+
+	req = httptest.NewRequest("POST", HTTPPath, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	res = httptest.NewRecorder()
+	h.ServeHTTP(res, req)
+	assert.Equal(t, http.StatusUnsupportedMediaType, res.Code)
+
+	req = httptest.NewRequest("POST", HTTPPath, bytes.NewReader([]byte("invalid")))
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	res = httptest.NewRecorder()
+	h.ServeHTTP(res, req)
+	assert.Equal(t, http.StatusBadRequest, res.Code)
+	assert.Len(t, svc.requests, 1)
+}
+
+func Test_Handler_HTTP_MaxMessageSize(t *testing.T) {
+	svc := new(mockPushService)
+	h := NewOTLPIngestHandler(svc, log.NewNopLogger(), 4<<10)
+
+	// The compressed body is small, but it exceeds
+	// the limit once decompressed.
+	var gz bytes.Buffer
+	gw := gzip.NewWriter(&gz)
+	_, err := gw.Write(make([]byte, 1<<20))
+	require.NoError(t, err)
+	require.NoError(t, gw.Close())
+	require.Less(t, gz.Len(), 4<<10)
+
+	req := httptest.NewRequest("POST", HTTPPath, bytes.NewReader(gz.Bytes()))
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("Content-Encoding", "gzip")
+	res := httptest.NewRecorder()
+	h.ServeHTTP(res, req)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, res.Code)
+
+	req = httptest.NewRequest("POST", HTTPPath, bytes.NewReader(make([]byte, 8<<10)))
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	res = httptest.NewRecorder()
+	h.ServeHTTP(res, req)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, res.Code)
+
+	body, err := testRequest().MarshalVT()
+	require.NoError(t, err)
+	req = httptest.NewRequest("POST", HTTPPath, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	res = httptest.NewRecorder()
+	h.ServeHTTP(res, req)
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.Len(t, svc.requests, 1)
+}
+
+func Test_Handler_GRPC(t *testing.T) {
+	svc := new(mockPushService)
+	router := mux.NewRouter()
+	v1experimentalconnect.RegisterProfilesServiceHandler(router, NewOTLPIngestHandler(svc, log.NewNopLogger(), 0))
+	s := httptest.NewServer(h2c.NewHandler(router, &http2.Server{}))
+	defer s.Close()
+
+	client := v1experimentalconnect.NewProfilesServiceClient(s.Client(), s.URL, connect.WithGRPC())
+	_, err := client.Export(context.Background(), connect.NewRequest(testRequest()))
+	require.NoError(t, err)
+	require.Len(t, svc.requests, 1)
+
+	invalid := testRequest()
+	invalid.ResourceProfiles[0].ScopeProfiles[0].Profiles[0].Profile.Function[0].Name = 100
+	_, err = client.Export(context.Background(), connect.NewRequest(invalid))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	_, err = client.Export(context.Background(), connect.NewRequest(&otlpcollector.ExportProfilesServiceRequest{}))
+	require.NoError(t, err)
+	assert.Len(t, svc.requests, 1)
+}
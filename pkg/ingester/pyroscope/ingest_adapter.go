@@ -29,18 +29,27 @@ import (
 type PushService interface {
 	Push(ctx context.Context, req *connect.Request[pushv1.PushRequest]) (*connect.Response[pushv1.PushResponse], error)
 	PushParsed(ctx context.Context, req *model.PushRequest) (*connect.Response[pushv1.PushResponse], error)
+	Validate(ctx context.Context, req *connect.Request[pushv1.PushRequest]) (*connect.Response[pushv1.ValidateResponse], error)
+	ValidateParsed(ctx context.Context, req *model.PushRequest) (*pushv1.ValidateResponse, error)
 }
 
 func NewPyroscopeIngestHandler(svc PushService, logger log.Logger) http.Handler {
-	return NewIngestHandler(
-		logger,
-		&pyroscopeIngesterAdapter{svc: svc, log: logger},
-	)
+	return ingestHandler{
+		log:      level.Error(logger),
+		ingester: &pyroscopeIngesterAdapter{svc: svc, log: logger},
+		dryRun: func() (ingestion.Ingester, *pushv1.ValidateResponse) {
+			resp := new(pushv1.ValidateResponse)
+			return &pyroscopeIngesterAdapter{svc: svc, log: logger, validation: resp}, resp
+		},
+	}
 }
 
 type pyroscopeIngesterAdapter struct {
 	svc PushService
 	log log.Logger
+	// If set, profiles are validated instead of being pushed,
+	// and the results are appended to the response.
+	validation *pushv1.ValidateResponse
 }
 
 func (p *pyroscopeIngesterAdapter) Ingest(ctx context.Context, in *ingestion.IngestInput) error {
@@ -152,6 +161,14 @@ func (p *pyroscopeIngesterAdapter) Put(ctx context.Context, pi *storage.PutInput
 		ID:         uuid.New().String(),
 	}}
 	req.Series = append(req.Series, series)
+	if p.validation != nil {
+		resp, err := p.svc.Validate(ctx, connect.NewRequest(req))
+		if err != nil {
+			return fmt.Errorf("pyroscopeIngesterAdapter failed to validate: %w", err)
+		}
+		p.appendValidation(resp.Msg)
+		return nil
+	}
 	_, err = p.svc.Push(ctx, connect.NewRequest(req))
 	if err != nil {
 		return fmt.Errorf("pyroscopeIngesterAdapter failed to push: %w", err)
@@ -175,6 +192,14 @@ func (p *pyroscopeIngesterAdapter) parseToPprof(ctx context.Context, in *ingesti
 			"orgID", tenantID)
 		return nil
 	}
+	if p.validation != nil {
+		resp, err := p.svc.ValidateParsed(ctx, plainReq)
+		if err != nil {
+			return fmt.Errorf("validating IngestInput-pprof failed %w", err)
+		}
+		p.appendValidation(resp)
+		return nil
+	}
 	_, err = p.svc.PushParsed(ctx, plainReq)
 	if err != nil {
 		return fmt.Errorf("pushing IngestInput-pprof failed %w", err)
@@ -182,6 +207,11 @@ func (p *pyroscopeIngesterAdapter) parseToPprof(ctx context.Context, in *ingesti
 	return nil
 }
 
+func (p *pyroscopeIngesterAdapter) appendValidation(resp *pushv1.ValidateResponse) {
+	p.validation.Series = append(p.validation.Series, resp.Series...)
+	p.validation.Errors = append(p.validation.Errors, resp.Errors...)
+}
+
 func convertMetadata(pi *storage.PutInput) (metricName, stType, stUnit, app string, err error) {
 	app = pi.Key.AppName()
 	parts := strings.Split(app, ".")
@@ -16,9 +16,12 @@ import (
 
 	"github.com/go-kit/log"
 	"github.com/go-kit/log/level"
+	"google.golang.org/protobuf/encoding/protojson"
 
+	pushv1 "github.com/grafana/pyroscope/api/gen/proto/go/push/v1"
 	"github.com/grafana/pyroscope/pkg/og/agent/types"
 	"github.com/grafana/pyroscope/pkg/og/convert/jfr"
+	"github.com/grafana/pyroscope/pkg/og/convert/perf"
 	"github.com/grafana/pyroscope/pkg/og/convert/pprof"
 	"github.com/grafana/pyroscope/pkg/og/convert/profile"
 	"github.com/grafana/pyroscope/pkg/og/ingestion"
@@ -33,6 +36,9 @@ import (
 type ingestHandler struct {
 	log      log.Logger
 	ingester ingestion.Ingester
+	// dryRun, if set, returns an ingester that validates the profiles
+	// without writing them, and the response it populates.
+	dryRun func() (ingestion.Ingester, *pushv1.ValidateResponse)
 }
 
 func NewIngestHandler(l log.Logger, p ingestion.Ingester) http.Handler {
@@ -51,7 +57,24 @@ func (h ingestHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = h.ingester.Ingest(r.Context(), input)
+	ingester := h.ingester
+	var validation *pushv1.ValidateResponse
+	if dryRun := r.URL.Query().Get("dryRun"); dryRun != "" {
+		ok, err := strconv.ParseBool(dryRun)
+		if err != nil {
+			httputil.ErrorWithStatus(w, fmt.Errorf("dryRun: %w", err), http.StatusBadRequest)
+			return
+		}
+		if ok {
+			if h.dryRun == nil {
+				httputil.ErrorWithStatus(w, fmt.Errorf("dry run is not supported"), http.StatusBadRequest)
+				return
+			}
+			ingester, validation = h.dryRun()
+		}
+	}
+
+	err = ingester.Ingest(r.Context(), input)
 	if err != nil {
 		_ = h.log.Log("msg", "pyroscope ingest", "err", err, "orgID", tenantID)
 
@@ -60,6 +83,16 @@ func (h ingestHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		} else {
 			httputil.ErrorWithStatus(w, err, http.StatusUnprocessableEntity)
 		}
+		return
+	}
+	if validation != nil {
+		data, err := protojson.Marshal(validation)
+		if err != nil {
+			httputil.Error(w, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(data)
 	}
 }
 
@@ -159,6 +192,12 @@ func (h ingestHandler) ingestInputFromRequest(r *http.Request) (*ingestion.Inges
 			RawData: b,
 		}
 
+	case format == "perf_script":
+		input.Format = ingestion.FormatPerfScript
+		input.Profile = &perf.RawProfile{
+			RawData: b,
+		}
+
 	case strings.Contains(contentType, "multipart/form-data"):
 		input.Profile = &pprof.RawProfile{
 			FormDataContentType: contentType,
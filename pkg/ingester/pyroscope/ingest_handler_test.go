@@ -16,6 +16,7 @@ import (
 	"github.com/prometheus/prometheus/model/labels"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	profilev1 "github.com/grafana/pyroscope/api/gen/proto/go/google/v1"
 	pushv1 "github.com/grafana/pyroscope/api/gen/proto/go/push/v1"
@@ -56,6 +57,32 @@ func (m *MockPushService) PushParsed(ctx context.Context, req *model.PushRequest
 	return nil, nil
 }
 
+func (m *MockPushService) Validate(ctx context.Context, req *connect.Request[pushv1.PushRequest]) (*connect.Response[pushv1.ValidateResponse], error) {
+	resp := new(pushv1.ValidateResponse)
+	for _, series := range req.Msg.Series {
+		resp.Series = append(resp.Series, &pushv1.ValidatedSeries{
+			Labels:   series.Labels,
+			Profiles: int64(len(series.Samples)),
+		})
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (m *MockPushService) ValidateParsed(ctx context.Context, req *model.PushRequest) (*pushv1.ValidateResponse, error) {
+	resp := new(pushv1.ValidateResponse)
+	for _, series := range req.Series {
+		v := &pushv1.ValidatedSeries{
+			Labels:   series.Labels,
+			Profiles: int64(len(series.Samples)),
+		}
+		for _, sample := range series.Samples {
+			v.Samples += int64(len(sample.Profile.Sample))
+		}
+		resp.Series = append(resp.Series, v)
+	}
+	return resp, nil
+}
+
 type DumpProfile struct {
 	Collapsed  []string
 	Labels     string
@@ -163,6 +190,67 @@ func TestCorruptedJFR422(t *testing.T) {
 	require.Equal(t, 422, res.Code)
 }
 
+func TestIngestPerfScript(t *testing.T) {
+	l := log.NewSyncLogger(log.NewLogfmtLogger(os.Stderr))
+	svc := &MockPushService{Keep: true, T: t}
+	h := NewPyroscopeIngestHandler(svc, l)
+
+	body := "perf 617960 [004] 116825.359144: cpu-clock:\n" +
+		"        ffffffffb43f9179 do_syscall_64+0x69 (/lib/modules/5.19.0/build/vmlinux)\n" +
+		"                  27ae79 main+0x6a9 (/usr/bin/perf)\n" +
+		"\n"
+	res := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/ingest?name=perfapp&format=perf_script&sampleRate=100", bytes.NewReader([]byte(body)))
+	h.ServeHTTP(res, req)
+	require.Equal(t, 200, res.Code)
+
+	actual := svc.selectActualProfile(labels.FromStrings(
+		"__name__", "process_cpu",
+		"__delta__", "false",
+		"service_name", "perfapp",
+		"pyroscope_spy", "unknown",
+		"comm", "perf",
+		"pid", "617960",
+	), "cpu")
+	assert.Equal(t, []string{"main+0x6a9;do_syscall_64+0x69 10000000"}, actual.Collapsed)
+}
+
+func TestIngestDryRun(t *testing.T) {
+	l := log.NewSyncLogger(log.NewLogfmtLogger(os.Stderr))
+	svc := &MockPushService{Keep: true, T: t}
+	h := NewPyroscopeIngestHandler(svc, l)
+
+	body := "perf 617960 [004] 116825.359144: cpu-clock:\n" +
+		"        ffffffffb43f9179 do_syscall_64+0x69 (/lib/modules/5.19.0/build/vmlinux)\n" +
+		"                  27ae79 main+0x6a9 (/usr/bin/perf)\n" +
+		"\n"
+	res := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/ingest?name=perfapp&format=perf_script&dryRun=true", bytes.NewReader([]byte(body)))
+	h.ServeHTTP(res, req)
+	require.Equal(t, 200, res.Code)
+	assert.Empty(t, svc.reqPprof)
+
+	var resp pushv1.ValidateResponse
+	require.NoError(t, protojson.Unmarshal(res.Body.Bytes(), &resp))
+	require.Len(t, resp.Series, 1)
+	assert.Equal(t, int64(1), resp.Series[0].Profiles)
+	assert.Equal(t, int64(1), resp.Series[0].Samples)
+
+	res = httptest.NewRecorder()
+	req = httptest.NewRequest("POST", "/ingest?name=app&format=lines&dryRun=true", bytes.NewReader([]byte("foo;bar\n")))
+	h.ServeHTTP(res, req)
+	require.Equal(t, 200, res.Code)
+	assert.Empty(t, svc.reqPprof)
+	require.NoError(t, protojson.Unmarshal(res.Body.Bytes(), &resp))
+	require.Len(t, resp.Series, 1)
+	assert.Equal(t, int64(1), resp.Series[0].Profiles)
+
+	res = httptest.NewRecorder()
+	req = httptest.NewRequest("POST", "/ingest?name=app&format=lines&dryRun=maybe", bytes.NewReader([]byte("foo;bar\n")))
+	h.ServeHTTP(res, req)
+	require.Equal(t, 400, res.Code)
+}
+
 func createJFRRequestBody(t *testing.T, jfr, labels []byte) ([]byte, string) {
 	var b bytes.Buffer
 	w := multipart.NewWriter(&b)
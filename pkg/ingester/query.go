@@ -68,6 +68,24 @@ func (i *Ingester) MergeSpanProfile(ctx context.Context, stream *connect.BidiStr
 	})
 }
 
+func (i *Ingester) MergeProfilesTopFunctions(ctx context.Context, stream *connect.BidiStream[ingestv1.MergeProfilesTopFunctionsRequest, ingestv1.MergeProfilesTopFunctionsResponse]) error {
+	return i.forInstance(ctx, func(instance *instance) error {
+		return instance.MergeProfilesTopFunctions(ctx, stream)
+	})
+}
+
+func (i *Ingester) MergeProfilesValues(ctx context.Context, stream *connect.BidiStream[ingestv1.MergeProfilesValuesRequest, ingestv1.MergeProfilesValuesResponse]) error {
+	return i.forInstance(ctx, func(instance *instance) error {
+		return instance.MergeProfilesValues(ctx, stream)
+	})
+}
+
+func (i *Ingester) GetProfileByID(ctx context.Context, req *connect.Request[ingestv1.GetProfileByIDRequest]) (*connect.Response[ingestv1.GetProfileByIDResponse], error) {
+	return forInstanceUnary(ctx, i, func(instance *instance) (*connect.Response[ingestv1.GetProfileByIDResponse], error) {
+		return instance.GetProfileByID(ctx, req)
+	})
+}
+
 func (i *Ingester) GetProfileStats(ctx context.Context, req *connect.Request[typesv1.GetProfileStatsRequest]) (*connect.Response[typesv1.GetProfileStatsResponse], error) {
 	return forInstanceUnary(ctx, i, func(instance *instance) (*connect.Response[typesv1.GetProfileStatsResponse], error) {
 		return instance.GetProfileStats(ctx, req)
@@ -171,6 +171,18 @@ func ExportDiffToFlamebearer(fg *querierv1.FlameGraphDiff, profileType *typesv1.
 	fb.LeftTicks = uint64(fg.LeftTicks)
 	fb.RightTicks = uint64(fg.RightTicks)
 	fb.FlamebearerProfileV1.Metadata.Format = "double"
+	if len(fg.Stats) > 0 {
+		stats := &flamebearer.FlamebearerDiffStatsV1{
+			LeftFactor:     fg.LeftFactor,
+			RelativeChange: make([][]float64, len(fg.Stats)),
+			Significance:   make([][]float64, len(fg.Stats)),
+		}
+		for i, s := range fg.Stats {
+			stats.RelativeChange[i] = s.RelativeChange
+			stats.Significance[i] = s.Significance
+		}
+		fb.DiffStats = stats
+	}
 
 	return fb
 }
@@ -3,6 +3,8 @@ package model
 import (
 	"bytes"
 	"fmt"
+	"math"
+	"sort"
 
 	"github.com/grafana/pyroscope/pkg/og/structs/cappedarr"
 
@@ -24,6 +26,55 @@ import (
 //	i+5 = self    , right tree
 //	i+6 = index in the names array
 func NewFlamegraphDiff(left, right *Tree, maxNodes int64) (*querierv1.FlameGraphDiff, error) {
+	return NewFlamegraphDiffWithOptions(left, right, maxNodes, DiffOptions{})
+}
+
+// DiffOptions control normalization and per-node statistics of the diff.
+type DiffOptions struct {
+	// LeftScale and RightScale are the normalization denominators of the
+	// left and right profiles: e.g., the total, the number of profiles, or
+	// the time range length. If both are positive, the left profile values
+	// are scaled to the right profile: v * RightScale / LeftScale.
+	LeftScale, RightScale float64
+	// LeftSamples and RightSamples are optional samples of the individual
+	// profiles of each side. They are used to estimate the significance
+	// of the node changes.
+	LeftSamples, RightSamples []DiffSample
+}
+
+// DiffSample is an individual profile: a single observation of the node
+// values. Node values are divided by Scale, if it is positive.
+type DiffSample struct {
+	Tree  *Tree
+	Scale float64
+}
+
+func (o DiffOptions) leftFactor() float64 {
+	if o.LeftScale > 0 && o.RightScale > 0 {
+		return o.RightScale / o.LeftScale
+	}
+	return 1
+}
+
+// DiffScale returns the normalization denominator of a diff side.
+// Zero is returned if the side should not be normalized.
+func DiffScale(n querierv1.DiffNormalization, total, profiles, start, end int64) float64 {
+	switch n {
+	case querierv1.DiffNormalization_DIFF_NORMALIZATION_TOTAL:
+		return float64(total)
+	case querierv1.DiffNormalization_DIFF_NORMALIZATION_PROFILE_COUNT:
+		return float64(profiles)
+	case querierv1.DiffNormalization_DIFF_NORMALIZATION_TIME_RANGE:
+		return float64(end - start)
+	default:
+		return 0
+	}
+}
+
+// NewFlamegraphDiffWithOptions generates a FlameGraphDiff from 2 trees,
+// see NewFlamegraphDiff. Unless the options are empty, the left tree values
+// are normalized in place, and per-node statistics are computed.
+func NewFlamegraphDiffWithOptions(left, right *Tree, maxNodes int64, opts DiffOptions) (*querierv1.FlameGraphDiff, error) {
 	// The algorithm doesn't work properly with negative nodes
 	// Although it's possible to silently drop these nodes
 	// Let's fail early and analyze properly with real data when the issue happens
@@ -31,6 +82,10 @@ func NewFlamegraphDiff(left, right *Tree, maxNodes int64) (*querierv1.FlameGraph
 	if err != nil {
 		return nil, err
 	}
+	leftFactor := opts.leftFactor()
+	if leftFactor != 1 {
+		scaleTree(left, leftFactor)
+	}
 	leftTree, rightTree := combineTree(left, right)
 
 	totalLeft := leftTree.root[0].total
@@ -43,10 +98,13 @@ func NewFlamegraphDiff(left, right *Tree, maxNodes int64) (*querierv1.FlameGraph
 		MaxSelf:    0,
 		LeftTicks:  totalLeft,
 		RightTicks: totalRight,
+		LeftFactor: leftFactor,
+		Stats:      []*querierv1.DiffLevelStats{},
 	}
 
 	leftNodes, xLeftOffsets := leftTree.root, []int64{0}
 	rghtNodes, xRghtOffsets := rightTree.root, []int64{0}
+	leftSmps, rghtSmps := [][]*node{sampleRoots(opts.LeftSamples)}, [][]*node{sampleRoots(opts.RightSamples)}
 	levels := []int{0}
 	var minVal int64
 	if maxNodes > 0 {
@@ -59,6 +117,8 @@ func NewFlamegraphDiff(left, right *Tree, maxNodes int64) (*querierv1.FlameGraph
 		leftNodes, rghtNodes = leftNodes[1:], rghtNodes[1:]
 		xLeftOffset, xRghtOffset := xLeftOffsets[0], xRghtOffsets[0]
 		xLeftOffsets, xRghtOffsets = xLeftOffsets[1:], xRghtOffsets[1:]
+		leftSmp, rghtSmp := leftSmps[0], rghtSmps[0]
+		leftSmps, rghtSmps = leftSmps[1:], rghtSmps[1:]
 
 		level := levels[0]
 		levels = levels[1:]
@@ -78,6 +138,7 @@ func NewFlamegraphDiff(left, right *Tree, maxNodes int64) (*querierv1.FlameGraph
 
 			if level == len(res.Levels) {
 				res.Levels = append(res.Levels, &querierv1.Level{})
+				res.Stats = append(res.Stats, &querierv1.DiffLevelStats{})
 			}
 			res.MaxSelf = max(res.MaxSelf, left.self)
 			res.MaxSelf = max(res.MaxSelf, rght.self)
@@ -96,6 +157,9 @@ func NewFlamegraphDiff(left, right *Tree, maxNodes int64) (*querierv1.FlameGraph
 			}
 
 			res.Levels[level].Values = append(values, res.Levels[level].Values...)
+			stats := res.Stats[level]
+			stats.RelativeChange = append([]float64{relativeChange(left.total, rght.total)}, stats.RelativeChange...)
+			stats.Significance = append([]float64{significance(leftSmp, opts.LeftSamples, rghtSmp, opts.RightSamples)}, stats.Significance...)
 			xLeftOffset += left.self
 			xRghtOffset += rght.self
 			otherLeftTotal, otherRghtTotal := int64(0), int64(0)
@@ -109,6 +173,8 @@ func NewFlamegraphDiff(left, right *Tree, maxNodes int64) (*querierv1.FlameGraph
 					xRghtOffsets = prependInt64(xRghtOffsets, xRghtOffset)
 					leftNodes = prependTreeNode(leftNodes, leftNode)
 					rghtNodes = prependTreeNode(rghtNodes, rghtNode)
+					leftSmps = prependSampleNodes(leftSmps, sampleChildren(leftSmp, leftNode.name))
+					rghtSmps = prependSampleNodes(rghtSmps, sampleChildren(rghtSmp, rghtNode.name))
 					xLeftOffset += leftNode.total
 					xRghtOffset += rghtNode.total
 				} else {
@@ -118,6 +184,9 @@ func NewFlamegraphDiff(left, right *Tree, maxNodes int64) (*querierv1.FlameGraph
 			}
 			if otherLeftTotal != 0 || otherRghtTotal != 0 {
 				levels = prependInt(levels, level+1)
+				// Significance is not estimated for the truncated nodes.
+				leftSmps = prependSampleNodes(leftSmps, nil)
+				rghtSmps = prependSampleNodes(rghtSmps, nil)
 				{
 					leftNode := &node{
 						name:  "other",
@@ -373,3 +442,135 @@ func prependTreeNode(s []*node, x *node) []*node {
 	s[0] = x
 	return s
 }
+
+func prependSampleNodes(s [][]*node, x []*node) [][]*node {
+	s = append(s, nil)
+	copy(s[1:], s)
+	s[0] = x
+	return s
+}
+
+// scaleTree multiplies self values of the tree nodes
+// by the factor and recalculates the totals.
+func scaleTree(t *Tree, f float64) {
+	for _, n := range t.root {
+		scaleNode(n, f)
+	}
+}
+
+func scaleNode(n *node, f float64) int64 {
+	n.self = int64(math.Round(float64(n.self) * f))
+	n.total = n.self
+	for _, c := range n.children {
+		n.total += scaleNode(c, f)
+	}
+	return n.total
+}
+
+// sampleRoots returns the fake roots of the sample trees,
+// matching the root created by combineTree.
+func sampleRoots(samples []DiffSample) []*node {
+	if len(samples) == 0 {
+		return nil
+	}
+	roots := make([]*node, len(samples))
+	for i, s := range samples {
+		if s.Tree != nil {
+			roots[i] = &node{children: s.Tree.root, total: s.Tree.Total()}
+		}
+	}
+	return roots
+}
+
+// sampleChildren returns the child nodes with the given name
+// of the sample nodes. Nil is returned for missing nodes.
+func sampleChildren(nodes []*node, name string) []*node {
+	if len(nodes) == 0 {
+		return nil
+	}
+	children := make([]*node, len(nodes))
+	for i, n := range nodes {
+		if n == nil {
+			continue
+		}
+		// Children are sorted by name.
+		j := sort.Search(len(n.children), func(j int) bool { return n.children[j].name >= name })
+		if j < len(n.children) && n.children[j].name == name {
+			children[i] = n.children[j]
+		}
+	}
+	return children
+}
+
+// relativeChange returns (right - left) / max(left, right).
+func relativeChange(left, right int64) float64 {
+	m := max(left, right)
+	if m == 0 {
+		return 0
+	}
+	return float64(right-left) / float64(m)
+}
+
+// significance returns 1 - p-value of the Welch's t-test comparing the
+// node totals observed in the left and right samples. Zero is returned
+// if there are not enough observations.
+func significance(leftNodes []*node, leftSamples []DiffSample, rghtNodes []*node, rghtSamples []DiffSample) float64 {
+	if len(leftNodes) < 2 || len(rghtNodes) < 2 {
+		return 0
+	}
+	return 1 - welchTTest(sampleValues(leftNodes, leftSamples), sampleValues(rghtNodes, rghtSamples))
+}
+
+func sampleValues(nodes []*node, samples []DiffSample) []float64 {
+	values := make([]float64, len(nodes))
+	for i, n := range nodes {
+		if n == nil {
+			continue
+		}
+		values[i] = float64(n.total)
+		if s := samples[i].Scale; s > 0 {
+			values[i] /= s
+		}
+	}
+	return values
+}
+
+// IterateFlameGraphDiff calls fn for each node of the diff that has
+// a non-zero self value in either of the profiles. The stack is ordered
+// from the root to the leaf and must not be retained by fn. The "total"
+// root node is not included.
+func IterateFlameGraphDiff(fg *querierv1.FlameGraphDiff, fn func(stack []string, left, right int64)) {
+	levels := make([]*querierv1.Level, len(fg.Levels))
+	for i, l := range fg.Levels {
+		levels[i] = &querierv1.Level{Values: append([]int64(nil), l.Values...)}
+	}
+	deltaDecoding(levels, 0, 7)
+	deltaDecoding(levels, 3, 7)
+	stack := make([]string, 0, len(levels))
+	for i := 1; i < len(levels); i++ {
+		values := levels[i].Values
+		for j := 0; j < len(values); j += 7 {
+			left, right := values[j+2], values[j+5]
+			if left == 0 && right == 0 {
+				continue
+			}
+			stack = stack[:i]
+			stack[i-1] = fg.Names[values[j+6]]
+			xl, xr := values[j], values[j+3]
+			for k := i - 1; k > 0; k-- {
+				// The parent is the last node of the upper level that
+				// starts at or before the node in both profiles.
+				parent := levels[k].Values
+				p := sort.Search(len(parent)/7, func(p int) bool {
+					return parent[p*7] > xl || parent[p*7+3] > xr
+				}) - 1
+				if p < 0 {
+					p = 0
+				}
+				stack[k-1] = fg.Names[parent[p*7+6]]
+				xl, xr = parent[p*7], parent[p*7+3]
+			}
+			fn(stack, left, right)
+		}
+	}
+}
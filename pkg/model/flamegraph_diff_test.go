@@ -2,6 +2,7 @@ package model
 
 import (
 	"math"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -123,3 +124,97 @@ func Test_Diff_No_Common_Root(t *testing.T) {
 	_, err := NewFlamegraphDiff(tr, tr2, 1024)
 	assert.NoError(t, err)
 }
+
+func Test_Diff_Tree_Normalized(t *testing.T) {
+	tr := newTree([]stacktraces{
+		{locations: []string{"b", "a"}, value: 1},
+		{locations: []string{"c", "a"}, value: 2},
+	})
+	tr2 := newTree([]stacktraces{
+		{locations: []string{"b", "a"}, value: 2},
+		{locations: []string{"c", "a"}, value: 10},
+	})
+
+	// The right profile covers a time range 2 times longer.
+	res, err := NewFlamegraphDiffWithOptions(tr, tr2, 1024, DiffOptions{LeftScale: 10, RightScale: 20})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"total", "a", "c", "b"}, res.Names)
+	assert.Equal(t, 2.0, res.LeftFactor)
+	assert.Equal(t, int64(6), res.LeftTicks)
+	assert.Equal(t, int64(12), res.RightTicks)
+	assert.Equal(t, []int64{0, 2, 2, 0, 2, 2, 3, 0, 4, 4, 0, 10, 10, 2}, res.Levels[2].Values)
+
+	assert.Equal(t, 3, len(res.Stats))
+	assert.Equal(t, []float64{0.5}, res.Stats[0].RelativeChange)
+	// b, c
+	assert.Equal(t, []float64{0, 0.6}, res.Stats[2].RelativeChange)
+	assert.Equal(t, []float64{0, 0}, res.Stats[2].Significance)
+}
+
+func Test_Diff_Tree_Significance(t *testing.T) {
+	sample := func(b, c int64) DiffSample {
+		return DiffSample{Scale: 2, Tree: newTree([]stacktraces{
+			{locations: []string{"b", "a"}, value: b},
+			{locations: []string{"c", "a"}, value: c},
+		})}
+	}
+	left := []DiffSample{sample(10, 10), sample(11, 10), sample(9, 10), sample(10, 10)}
+	right := []DiffSample{sample(10, 30), sample(9, 32), sample(11, 31), sample(10, 29)}
+	merge := func(samples []DiffSample) *Tree {
+		t := new(Tree)
+		for _, s := range samples {
+			t.Merge(newTree([]stacktraces{
+				{locations: []string{"b", "a"}, value: s.Tree.root[0].children[0].total},
+				{locations: []string{"c", "a"}, value: s.Tree.root[0].children[1].total},
+			}))
+		}
+		return t
+	}
+
+	res, err := NewFlamegraphDiffWithOptions(merge(left), merge(right), 1024, DiffOptions{
+		LeftSamples:  left,
+		RightSamples: right,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"total", "a", "c", "b"}, res.Names)
+	stats := res.Stats[2]
+	// b is not changed.
+	assert.Equal(t, 0.0, stats.RelativeChange[0])
+	assert.Less(t, stats.Significance[0], 0.1)
+	// c is changed significantly.
+	assert.InDelta(t, 82.0/122, stats.RelativeChange[1], 1e-12)
+	assert.Greater(t, stats.Significance[1], 0.99)
+}
+
+func Test_IterateFlameGraphDiff(t *testing.T) {
+	tr := newTree([]stacktraces{
+		{locations: []string{"b", "a"}, value: 1},
+		{locations: []string{"c", "a"}, value: 2},
+		{locations: []string{"e", "a"}, value: 3},
+		{locations: []string{"a"}, value: 1},
+	})
+	tr2 := newTree([]stacktraces{
+		{locations: []string{"b", "a"}, value: 4},
+		{locations: []string{"d", "a"}, value: 8},
+		{locations: []string{"f", "e", "a"}, value: 12},
+	})
+	res, err := NewFlamegraphDiff(tr, tr2, 1024)
+	assert.NoError(t, err)
+
+	type sample struct {
+		stack       string
+		left, right int64
+	}
+	var actual []sample
+	IterateFlameGraphDiff(res, func(stack []string, left, right int64) {
+		actual = append(actual, sample{strings.Join(stack, ";"), left, right})
+	})
+	assert.Equal(t, []sample{
+		{"a", 1, 0},
+		{"a;b", 1, 4},
+		{"a;c", 2, 0},
+		{"a;d", 0, 8},
+		{"a;e", 3, 0},
+		{"a;e;f", 0, 12},
+	}, actual)
+}
@@ -0,0 +1,168 @@
+package model
+
+import (
+	"bufio"
+	"fmt"
+	"hash/fnv"
+	"html"
+	"io"
+	"strings"
+)
+
+// FlameGraphSVGOptions control the flame graph rendering.
+type FlameGraphSVGOptions struct {
+	// Title is shown at the top of the flame graph.
+	Title string
+	// Unit is shown next to the node values in tooltips.
+	Unit string
+	// Width of the image in pixels. Defaults to 1200.
+	Width int
+}
+
+const (
+	svgDefaultWidth  = 1200
+	svgPadding       = 10
+	svgFrameHeight   = 16
+	svgFontSize      = 12
+	svgFontWidth     = 0.59 * svgFontSize
+	svgTitleHeight   = 3 * svgFontSize
+	svgMinFrameWidth = 0.1
+)
+
+type svgFrame struct {
+	name  string
+	total int64
+	x, w  float64
+	depth int
+}
+
+// WriteFlameGraphSVG renders the tree as a self-contained SVG image.
+// The root node is at the top; frames narrower than a pixel fraction
+// are omitted.
+func WriteFlameGraphSVG(dst io.Writer, t *Tree, o FlameGraphSVGOptions) error {
+	w := bufio.NewWriter(dst)
+	width := o.Width
+	if width <= 0 {
+		width = svgDefaultWidth
+	}
+	frames, depth := svgFrames(t, float64(width-2*svgPadding))
+	height := 2*svgPadding + svgTitleHeight + depth*svgFrameHeight
+	total := t.Total()
+
+	_, _ = fmt.Fprintf(w, `<?xml version="1.0" standalone="no"?>
+<svg version="1.1" width="%d" height="%d" viewBox="0 0 %d %d" xmlns="http://www.w3.org/2000/svg">
+<style>text { font-family: Verdana, sans-serif; font-size: %dpx; fill: #000000; } rect { stroke: #ffffff; stroke-width: 0.5; }</style>
+<rect x="0" y="0" width="100%%" height="100%%" fill="#f8f8f8" style="stroke: none"/>
+<text x="%d" y="%d" text-anchor="middle" style="font-size: %dpx">%s</text>
+`, width, height, width, height, svgFontSize, width/2, svgPadding+svgFontSize+2, svgFontSize+5, html.EscapeString(o.Title))
+
+	for _, f := range frames {
+		x := svgPadding + f.x
+		y := svgPadding + svgTitleHeight + f.depth*svgFrameHeight
+		r, g, b := svgFrameColor(f.name)
+		_, _ = fmt.Fprintf(w, `<g><title>%s</title><rect x="%.1f" y="%d" width="%.1f" height="%d" rx="2" fill="rgb(%d,%d,%d)"/>`,
+			html.EscapeString(svgFrameTitle(f, total, o.Unit)), x, y, f.w, svgFrameHeight-1, r, g, b)
+		if label := svgFrameLabel(f.name, f.w); label != "" {
+			_, _ = fmt.Fprintf(w, `<text x="%.1f" y="%d">%s</text>`, x+3, y+svgFrameHeight-4, html.EscapeString(label))
+		}
+		_, _ = w.WriteString("</g>\n")
+	}
+	_, _ = w.WriteString("</svg>\n")
+	return w.Flush()
+}
+
+// WriteFlameGraphHTML renders the tree as a standalone HTML page
+// with the flame graph SVG embedded.
+func WriteFlameGraphHTML(dst io.Writer, t *Tree, o FlameGraphSVGOptions) error {
+	_, err := fmt.Fprintf(dst, `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>%s</title>
+</head>
+<body style="margin: 0">
+`, html.EscapeString(o.Title))
+	if err != nil {
+		return err
+	}
+	// Skip the XML declaration.
+	var b strings.Builder
+	if err = WriteFlameGraphSVG(&b, t, o); err != nil {
+		return err
+	}
+	svg := b.String()
+	svg = svg[strings.Index(svg, "<svg"):]
+	_, err = fmt.Fprintf(dst, "%s</body>\n</html>\n", svg)
+	return err
+}
+
+// svgFrames returns the frames to render, including the "total" root
+// frame, and the number of levels.
+func svgFrames(t *Tree, width float64) ([]svgFrame, int) {
+	total := t.Total()
+	if total == 0 {
+		return nil, 0
+	}
+	scale := width / float64(total)
+	frames := []svgFrame{{name: "total", total: total, w: width}}
+	type item struct {
+		n     *node
+		x     float64
+		depth int
+	}
+	depth := 1
+	nodes := make([]item, 0, defaultDFSSize)
+	x := 0.0
+	for _, n := range t.root {
+		nodes = append(nodes, item{n: n, x: x, depth: 1})
+		x += float64(n.total) * scale
+	}
+	for len(nodes) > 0 {
+		it := nodes[len(nodes)-1]
+		nodes = nodes[:len(nodes)-1]
+		w := float64(it.n.total) * scale
+		if w < svgMinFrameWidth {
+			continue
+		}
+		frames = append(frames, svgFrame{name: it.n.name, total: it.n.total, x: it.x, w: w, depth: it.depth})
+		if it.depth+1 > depth {
+			depth = it.depth + 1
+		}
+		x := it.x
+		for _, c := range it.n.children {
+			nodes = append(nodes, item{n: c, x: x, depth: it.depth + 1})
+			x += float64(c.total) * scale
+		}
+	}
+	return frames, depth
+}
+
+func svgFrameTitle(f svgFrame, total int64, unit string) string {
+	v := fmt.Sprint(f.total)
+	if unit != "" {
+		v += " " + unit
+	}
+	return fmt.Sprintf("%s (%s, %.2f%%)", f.name, v, float64(f.total)*100/float64(total))
+}
+
+// svgFrameLabel returns the frame name truncated to fit the frame width.
+func svgFrameLabel(name string, width float64) string {
+	n := int((width - 6) / svgFontWidth)
+	if n < 3 {
+		return ""
+	}
+	r := []rune(name)
+	if len(r) <= n {
+		return name
+	}
+	return string(r[:n-2]) + ".."
+}
+
+// svgFrameColor returns a warm color derived from the frame name,
+// so the same function has the same color across flame graphs.
+func svgFrameColor(name string) (r, g, b int) {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(name))
+	v := h.Sum32()
+	return 205 + int(v%50), int((v >> 8) % 230), int((v >> 16) % 55)
+}
@@ -0,0 +1,49 @@
+package model
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_WriteFlameGraphSVG(t *testing.T) {
+	tr := newTree([]stacktraces{
+		{locations: []string{"b", "a"}, value: 1},
+		{locations: []string{"c<T>", "a"}, value: 3},
+	})
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteFlameGraphSVG(&buf, tr, FlameGraphSVGOptions{Title: "cpu", Unit: "samples"}))
+	svg := buf.String()
+
+	// The output must be a well-formed XML document.
+	d := xml.NewDecoder(strings.NewReader(svg))
+	for {
+		if _, err := d.Token(); err != nil {
+			assert.Equal(t, "EOF", err.Error())
+			break
+		}
+	}
+	assert.Equal(t, 4, strings.Count(svg, "<g>"))
+	assert.Contains(t, svg, "<title>total (4 samples, 100.00%)</title>")
+	assert.Contains(t, svg, "<title>c&lt;T&gt; (3 samples, 75.00%)</title>")
+	assert.Contains(t, svg, `height="104"`)
+
+	buf.Reset()
+	require.NoError(t, WriteFlameGraphHTML(&buf, tr, FlameGraphSVGOptions{Title: "cpu"}))
+	page := buf.String()
+	assert.True(t, strings.HasPrefix(page, "<!DOCTYPE html>"))
+	assert.Contains(t, page, "<title>cpu</title>")
+	assert.NotContains(t, page, "<?xml")
+	assert.Contains(t, page, "<svg")
+}
+
+func Test_svgFrameLabel(t *testing.T) {
+	assert.Equal(t, "", svgFrameLabel("main", 10))
+	assert.Equal(t, "main", svgFrameLabel("main", 100))
+	assert.Equal(t, "runtime...", svgFrameLabel("runtime.mallocgc", 80))
+}
@@ -30,6 +30,7 @@ const (
 	LabelNameSessionID          = "__session_id__"
 	LabelNameType               = "__type__"
 	LabelNameUnit               = "__unit__"
+	LabelNameTenantID           = "__tenant_id__"
 
 	LabelNameServiceGitRef     = "service_git_ref"
 	LabelNameServiceName       = "service_name"
@@ -38,6 +39,9 @@ const (
 	LabelNameOrder     = "__order__"
 	LabelOrderEnforced = "enforced"
 
+	LabelNameOtherSeries = "__other__"
+	LabelOtherSeries     = "true"
+
 	LabelNamePyroscopeSpy = "pyroscope_spy"
 
 	labelSep = '\xfe'
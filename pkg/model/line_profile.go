@@ -0,0 +1,66 @@
+package model
+
+import (
+	"sort"
+	"sync"
+
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+)
+
+// LineProfileMerger sums up the values of the lines
+// with matching line number.
+type LineProfileMerger struct {
+	mu    sync.Mutex
+	lines map[int64]*querierv1.LineValues
+}
+
+func NewLineProfileMerger() *LineProfileMerger {
+	return &LineProfileMerger{lines: make(map[int64]*querierv1.LineValues)}
+}
+
+func (m *LineProfileMerger) MergeLines(lines []*querierv1.LineValues) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, l := range lines {
+		m.merge(l.Line, l.Self, l.Total)
+	}
+}
+
+// MergeTopFunctions merges the values of the functions grouped by line,
+// which are expected to be filtered by function name or file name already.
+// Functions without line number are ignored.
+func (m *LineProfileMerger) MergeTopFunctions(functions []*typesv1.TopFunction) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, f := range functions {
+		if f.Line <= 0 {
+			continue
+		}
+		m.merge(f.Line, f.Self, f.Total)
+	}
+}
+
+func (m *LineProfileMerger) merge(line, self, total int64) {
+	d, ok := m.lines[line]
+	if !ok {
+		d = &querierv1.LineValues{Line: line}
+		m.lines[line] = d
+	}
+	d.Self += self
+	d.Total += total
+}
+
+// Lines returns the merged lines in ascending order of line number.
+func (m *LineProfileMerger) Lines() []*querierv1.LineValues {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	r := make([]*querierv1.LineValues, 0, len(m.lines))
+	for _, l := range m.lines {
+		r = append(r, l)
+	}
+	sort.Slice(r, func(i, j int) bool {
+		return r[i].Line < r[j].Line
+	})
+	return r
+}
@@ -0,0 +1,28 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+)
+
+func Test_LineProfileMerger(t *testing.T) {
+	functions := []*typesv1.TopFunction{
+		{Name: "main", Filename: "main.go", Line: 10, Self: 1, Total: 5},
+		{Name: "main", Filename: "main.go", Line: 12, Self: 2, Total: 2},
+		{Name: "main", Filename: "other.go", Line: 10, Self: 4, Total: 4},
+		// The functions not matching the filter.
+		{Self: 5},
+	}
+
+	m := NewLineProfileMerger()
+	m.MergeTopFunctions(functions)
+	m.MergeLines([]*querierv1.LineValues{{Line: 12, Self: 1, Total: 1}})
+	require.Equal(t, []*querierv1.LineValues{
+		{Line: 10, Self: 5, Total: 9},
+		{Line: 12, Self: 3, Total: 3},
+	}, m.Lines())
+}
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"math"
 	"sort"
 	"sync"
 
@@ -8,30 +9,56 @@ import (
 )
 
 func MergeSeries(aggregation *typesv1.TimeSeriesAggregationType, series ...[]*typesv1.Series) []*typesv1.Series {
-	var m *SeriesMerger
-	if aggregation == nil || *aggregation == typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_SUM {
-		m = NewSeriesMerger(true)
-	} else {
-		m = NewSeriesMerger(false)
-	}
+	m := newSeriesMerger(seriesMergeFunc(aggregation))
 	for _, s := range series {
 		m.MergeSeries(s)
 	}
 	return m.Series()
 }
 
+// seriesMergeFunc returns the function that merges values of samples
+// with matching timestamps. Aggregations that are not associative,
+// such as average, count, and percentiles, require all the samples
+// to be retained: nil is returned for them.
+func seriesMergeFunc(aggregation *typesv1.TimeSeriesAggregationType) func(a, b float64) float64 {
+	if aggregation == nil {
+		return sumValues
+	}
+	switch *aggregation {
+	case typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_SUM:
+		return sumValues
+	case typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_MIN:
+		return math.Min
+	case typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_MAX:
+		return math.Max
+	}
+	return nil
+}
+
+func sumValues(a, b float64) float64 { return a + b }
+
 type SeriesMerger struct {
 	mu     sync.Mutex
 	series map[uint64]*typesv1.Series
-	sum    bool
+	merge  func(a, b float64) float64
 }
 
 // NewSeriesMerger creates a new series merger. If sum is set, samples
 // with matching timestamps are summed, otherwise duplicates are retained.
 func NewSeriesMerger(sum bool) *SeriesMerger {
+	if sum {
+		return newSeriesMerger(sumValues)
+	}
+	return newSeriesMerger(nil)
+}
+
+// newSeriesMerger creates a new series merger that merges samples
+// with matching timestamps with the function given. If the function
+// is nil, duplicates are retained.
+func newSeriesMerger(merge func(a, b float64) float64) *SeriesMerger {
 	return &SeriesMerger{
 		series: make(map[uint64]*typesv1.Series),
-		sum:    sum,
+		merge:  merge,
 	}
 }
 
@@ -71,19 +98,17 @@ func (m *SeriesMerger) mergePoints(points []*typesv1.Point) int {
 	if l < 2 {
 		return l
 	}
-	sort.Slice(points, func(i, j int) bool {
+	sort.SliceStable(points, func(i, j int) bool {
 		return points[i].Timestamp < points[j].Timestamp
 	})
 	var j int
 	for i := 1; i < l; i++ {
-		if points[j].Timestamp != points[i].Timestamp || !m.sum {
+		if points[j].Timestamp != points[i].Timestamp || m.merge == nil {
 			j++
 			points[j] = points[i]
 			continue
 		}
-		if m.sum {
-			points[j].Value += points[i].Value
-		}
+		points[j].Value = m.merge(points[j].Value, points[i].Value)
 	}
 	return j + 1
 }
@@ -85,3 +85,39 @@ func Test_SeriesMerger_Overlap_Sum(t *testing.T) {
 		})
 	}
 }
+
+func Test_SeriesMerger_Overlap_Aggregation(t *testing.T) {
+	in := func() [][]*typesv1.Series {
+		return [][]*typesv1.Series{
+			{{Labels: LabelsFromStrings("foo", "bar"), Points: []*typesv1.Point{{Timestamp: 1, Value: 1}, {Timestamp: 2, Value: 3}}}},
+			{{Labels: LabelsFromStrings("foo", "bar"), Points: []*typesv1.Point{{Timestamp: 2, Value: 2}}}},
+		}
+	}
+	for _, tc := range []struct {
+		aggregation typesv1.TimeSeriesAggregationType
+		out         []*typesv1.Point
+	}{
+		{
+			aggregation: typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_MIN,
+			out:         []*typesv1.Point{{Timestamp: 1, Value: 1}, {Timestamp: 2, Value: 2}},
+		},
+		{
+			aggregation: typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_MAX,
+			out:         []*typesv1.Point{{Timestamp: 1, Value: 1}, {Timestamp: 2, Value: 3}},
+		},
+		{
+			aggregation: typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_COUNT,
+			out:         []*typesv1.Point{{Timestamp: 1, Value: 1}, {Timestamp: 2, Value: 3}, {Timestamp: 2, Value: 2}},
+		},
+		{
+			aggregation: typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_P99,
+			out:         []*typesv1.Point{{Timestamp: 1, Value: 1}, {Timestamp: 2, Value: 3}, {Timestamp: 2, Value: 2}},
+		},
+	} {
+		tc := tc
+		t.Run(tc.aggregation.String(), func(t *testing.T) {
+			out := []*typesv1.Series{{Labels: LabelsFromStrings("foo", "bar"), Points: tc.out}}
+			testhelper.EqualProto(t, out, MergeSeries(&tc.aggregation, in()...))
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package model
+
+import (
+	"sort"
+	"sync"
+
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+)
+
+type topFunctionKey struct {
+	name     string
+	filename string
+	line     int64
+}
+
+// TopFunctionsMerger sums up the values of the functions
+// with matching name, file name, and line number.
+type TopFunctionsMerger struct {
+	mu        sync.Mutex
+	functions map[topFunctionKey]*typesv1.TopFunction
+}
+
+func NewTopFunctionsMerger() *TopFunctionsMerger {
+	return &TopFunctionsMerger{functions: make(map[topFunctionKey]*typesv1.TopFunction)}
+}
+
+func (m *TopFunctionsMerger) MergeTopFunctions(functions []*typesv1.TopFunction) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, f := range functions {
+		k := topFunctionKey{name: f.Name, filename: f.Filename, line: f.Line}
+		d, ok := m.functions[k]
+		if !ok {
+			m.functions[k] = f.CloneVT()
+			continue
+		}
+		d.Self += f.Self
+		d.Total += f.Total
+	}
+}
+
+// TopFunctions returns the merged functions in no particular order.
+func (m *TopFunctionsMerger) TopFunctions() []*typesv1.TopFunction {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	r := make([]*typesv1.TopFunction, 0, len(m.functions))
+	for _, f := range m.functions {
+		r = append(r, f)
+	}
+	return r
+}
+
+// SortTopFunctions sorts the functions in descending order of the value
+// specified. Ties are broken by name, file name, and line number.
+func SortTopFunctions(functions []*typesv1.TopFunction, order querierv1.TopFunctionsOrder) {
+	value := func(f *typesv1.TopFunction) int64 { return f.Self }
+	if order == querierv1.TopFunctionsOrder_TOP_FUNCTIONS_ORDER_TOTAL {
+		value = func(f *typesv1.TopFunction) int64 { return f.Total }
+	}
+	sort.Slice(functions, func(i, j int) bool {
+		a, b := functions[i], functions[j]
+		if va, vb := value(a), value(b); va != vb {
+			return va > vb
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		if a.Filename != b.Filename {
+			return a.Filename < b.Filename
+		}
+		return a.Line < b.Line
+	})
+}
+
+// TopFunctionsTotal returns the sum of the values of all the samples
+// the functions were collected from.
+func TopFunctionsTotal(functions []*typesv1.TopFunction) int64 {
+	var total int64
+	for _, f := range functions {
+		total += f.Self
+	}
+	return total
+}
+
+// PaginateTopFunctions returns the page of the sorted functions
+// specified by the offset and limit. Zero limit means no limit.
+func PaginateTopFunctions(functions []*typesv1.TopFunction, offset, limit int64) []*typesv1.TopFunction {
+	if offset >= int64(len(functions)) {
+		return nil
+	}
+	if offset > 0 {
+		functions = functions[offset:]
+	}
+	if limit > 0 && limit < int64(len(functions)) {
+		functions = functions[:limit]
+	}
+	return functions
+}
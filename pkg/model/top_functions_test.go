@@ -0,0 +1,60 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+)
+
+func Test_TopFunctionsMerger(t *testing.T) {
+	m := NewTopFunctionsMerger()
+	a := []*typesv1.TopFunction{
+		{Name: "a", Self: 1, Total: 3},
+		{Name: "b", Filename: "b.go", Line: 1, Self: 2, Total: 2},
+	}
+	m.MergeTopFunctions(a)
+	m.MergeTopFunctions([]*typesv1.TopFunction{
+		{Name: "a", Self: 1, Total: 1},
+		{Name: "b", Filename: "b.go", Line: 2, Self: 1, Total: 5},
+		{Name: "c", Total: 4},
+	})
+	// The source must not be modified.
+	require.Equal(t, int64(1), a[0].Self)
+
+	functions := m.TopFunctions()
+	SortTopFunctions(functions, querierv1.TopFunctionsOrder_TOP_FUNCTIONS_ORDER_SELF)
+	require.Equal(t, []*typesv1.TopFunction{
+		{Name: "a", Self: 2, Total: 4},
+		{Name: "b", Filename: "b.go", Line: 1, Self: 2, Total: 2},
+		{Name: "b", Filename: "b.go", Line: 2, Self: 1, Total: 5},
+		{Name: "c", Total: 4},
+	}, functions)
+	require.Equal(t, int64(5), TopFunctionsTotal(functions))
+
+	SortTopFunctions(functions, querierv1.TopFunctionsOrder_TOP_FUNCTIONS_ORDER_TOTAL)
+	require.Equal(t, []*typesv1.TopFunction{
+		{Name: "b", Filename: "b.go", Line: 2, Self: 1, Total: 5},
+		{Name: "a", Self: 2, Total: 4},
+		{Name: "c", Total: 4},
+		{Name: "b", Filename: "b.go", Line: 1, Self: 2, Total: 2},
+	}, functions)
+}
+
+func Test_PaginateTopFunctions(t *testing.T) {
+	functions := []*typesv1.TopFunction{{Name: "a"}, {Name: "b"}, {Name: "c"}}
+	for _, tc := range []struct {
+		offset, limit int64
+		expected      []*typesv1.TopFunction
+	}{
+		{0, 0, functions},
+		{1, 0, functions[1:]},
+		{1, 1, functions[1:2]},
+		{0, 5, functions},
+		{3, 1, nil},
+	} {
+		require.Equal(t, tc.expected, PaginateTopFunctions(functions, tc.offset, tc.limit))
+	}
+}
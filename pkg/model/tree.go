@@ -427,8 +427,11 @@ func UnmarshalTree(b []byte) (*Tree, error) {
 		}
 	}
 
-	// Remove the virtual root.
-	t.root = root.children[0].children
+	// Remove the virtual root. The tree root node is detached,
+	// similarly to the root of a tree built with InsertStack.
+	r := root.children[0]
+	r.parent = nil
+	t.root = r.children
 
 	return t, nil
 }
@@ -0,0 +1,94 @@
+package model
+
+import "math"
+
+// welchTTest returns the two-sided p-value of the Welch's t-test
+// for the null hypothesis that the samples have equal means.
+func welchTTest(a, b []float64) float64 {
+	n1, n2 := float64(len(a)), float64(len(b))
+	if n1 < 2 || n2 < 2 {
+		return 1
+	}
+	m1, v1 := meanVariance(a)
+	m2, v2 := meanVariance(b)
+	s1, s2 := v1/n1, v2/n2
+	se := s1 + s2
+	if se == 0 {
+		if m1 == m2 {
+			return 1
+		}
+		// Both samples are constant, but differ.
+		return 0
+	}
+	t := (m1 - m2) / math.Sqrt(se)
+	df := se * se / (s1*s1/(n1-1) + s2*s2/(n2-1))
+	return regIncBeta(df/2, 0.5, df/(df+t*t))
+}
+
+func meanVariance(x []float64) (mean, variance float64) {
+	for _, v := range x {
+		mean += v
+	}
+	mean /= float64(len(x))
+	for _, v := range x {
+		d := v - mean
+		variance += d * d
+	}
+	return mean, variance / float64(len(x)-1)
+}
+
+// regIncBeta returns the regularized incomplete beta function I_x(a, b).
+func regIncBeta(a, b, x float64) float64 {
+	switch {
+	case x <= 0:
+		return 0
+	case x >= 1:
+		return 1
+	}
+	la, _ := math.Lgamma(a)
+	lb, _ := math.Lgamma(b)
+	lab, _ := math.Lgamma(a + b)
+	front := math.Exp(lab - la - lb + a*math.Log(x) + b*math.Log(1-x))
+	// The continued fraction converges rapidly for x < (a+1)/(a+b+2).
+	if x < (a+1)/(a+b+2) {
+		return front * betaContinuedFraction(a, b, x) / a
+	}
+	return 1 - front*betaContinuedFraction(b, a, 1-x)/b
+}
+
+// betaContinuedFraction evaluates the continued fraction
+// of the incomplete beta function with the modified Lentz's method.
+func betaContinuedFraction(a, b, x float64) float64 {
+	const (
+		maxIterations = 200
+		epsilon       = 1e-14
+		tiny          = 1e-300
+	)
+	c, d := 1.0, 1-(a+b)*x/(a+1)
+	if math.Abs(d) < tiny {
+		d = tiny
+	}
+	d = 1 / d
+	h := d
+	for m := 1.0; m <= maxIterations; m++ {
+		for _, aa := range [2]float64{
+			m * (b - m) * x / ((a + 2*m - 1) * (a + 2*m)),
+			-(a + m) * (a + b + m) * x / ((a + 2*m) * (a + 2*m + 1)),
+		} {
+			d = 1 + aa*d
+			if math.Abs(d) < tiny {
+				d = tiny
+			}
+			c = 1 + aa/c
+			if math.Abs(c) < tiny {
+				c = tiny
+			}
+			d = 1 / d
+			h *= d * c
+		}
+		if math.Abs(d*c-1) < epsilon {
+			break
+		}
+	}
+	return h
+}
@@ -0,0 +1,24 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_regIncBeta(t *testing.T) {
+	// Two-sided p-value of the Student's t-distribution: t=2, df=10.
+	assert.InDelta(t, 0.07339, regIncBeta(5, 0.5, 10.0/14), 1e-5)
+	assert.InDelta(t, 0.5, regIncBeta(2, 2, 0.5), 1e-12)
+	assert.Equal(t, 0.0, regIncBeta(2, 2, 0))
+	assert.Equal(t, 1.0, regIncBeta(2, 2, 1))
+}
+
+func Test_welchTTest(t *testing.T) {
+	// t=-2, df=8.
+	assert.InDelta(t, 0.08052, welchTTest([]float64{1, 2, 3, 4, 5}, []float64{3, 4, 5, 6, 7}), 1e-5)
+	assert.InDelta(t, 1, welchTTest([]float64{1, 2, 3}, []float64{1, 2, 3}), 1e-12)
+	assert.Equal(t, 0.0, welchTTest([]float64{1, 1}, []float64{2, 2}))
+	assert.Equal(t, 1.0, welchTTest([]float64{1, 1}, []float64{1, 1}))
+	assert.Equal(t, 1.0, welchTTest([]float64{1}, []float64{2, 2}))
+}
@@ -0,0 +1,355 @@
+package objstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/thanos-io/objstore"
+
+	"github.com/grafana/pyroscope/pkg/cache"
+)
+
+const (
+	cacheOpExists     = "exists"
+	cacheOpGet        = "get"
+	cacheOpAttributes = "attributes"
+	cacheOpGetRange   = "getrange"
+)
+
+// CachingBucketConfig configures the caching bucket. Object kinds and
+// operations to be cached are predefined: see NewCachingBucketFromConfig.
+type CachingBucketConfig struct {
+	cache.Config `yaml:",inline"`
+
+	MetaExistsTTL      time.Duration `yaml:"meta_exists_ttl" category:"advanced"`
+	MetaDoesntExistTTL time.Duration `yaml:"meta_doesnt_exist_ttl" category:"advanced"`
+	MetaContentTTL     time.Duration `yaml:"meta_content_ttl" category:"advanced"`
+	MetaMaxSize        int           `yaml:"meta_max_size_bytes" category:"advanced"`
+	AttributesTTL      time.Duration `yaml:"attributes_ttl" category:"advanced"`
+	GetRangeTTL        time.Duration `yaml:"get_range_ttl" category:"advanced"`
+	GetRangeMaxSize    int           `yaml:"get_range_max_size_bytes" category:"advanced"`
+	GetRangeTailSize   int           `yaml:"get_range_tail_size_bytes" category:"advanced"`
+}
+
+func (cfg *CachingBucketConfig) RegisterFlagsWithPrefix(prefix string, f *flag.FlagSet) {
+	cfg.Config.RegisterFlagsWithPrefix(prefix, f)
+	f.DurationVar(&cfg.MetaExistsTTL, prefix+"meta-exists-ttl", 2*time.Hour, "How long to cache information that a block metadata file exists.")
+	f.DurationVar(&cfg.MetaDoesntExistTTL, prefix+"meta-doesnt-exist-ttl", 5*time.Minute, "How long to cache information that a block metadata file doesn't exist.")
+	f.DurationVar(&cfg.MetaContentTTL, prefix+"meta-content-ttl", 24*time.Hour, "How long to cache the content of block metadata files.")
+	f.IntVar(&cfg.MetaMaxSize, prefix+"meta-max-size-bytes", 1<<20, "Maximum size of a block metadata file to be cached.")
+	f.DurationVar(&cfg.AttributesTTL, prefix+"attributes-ttl", 168*time.Hour, "How long to cache attributes of block files.")
+	f.DurationVar(&cfg.GetRangeTTL, prefix+"get-range-ttl", 24*time.Hour, "How long to cache ranges of block files, such as the symbols index and parquet footers and page indexes.")
+	f.IntVar(&cfg.GetRangeMaxSize, prefix+"get-range-max-size-bytes", 1<<20, "Maximum size of a block file range to be cached. Larger ranges are read from the object store.")
+	f.IntVar(&cfg.GetRangeTailSize, prefix+"get-range-tail-size-bytes", 4<<20, "Only the ranges within the given number of bytes from the end of a block file are cached: this is where the symbols index and parquet footers and page indexes are stored. Ranges of the data sections are read from the object store.")
+}
+
+// NewCachingBucketFromConfig wraps the bucket with a caching bucket, if the
+// cache is configured. The following object kinds are cached:
+//
+//   - meta: existence and content of block meta.json files.
+//   - block: attributes of the block files: TSDB index, symbols and parquet
+//     tables, and ranges of their metadata sections, stored at the end of
+//     the files. These files are immutable once uploaded.
+func NewCachingBucketFromConfig(cfg CachingBucketConfig, bkt Bucket, name string, logger log.Logger, reg prometheus.Registerer) (Bucket, error) {
+	c, err := cache.New(cfg.Config, name, logger, prometheus.WrapRegistererWithPrefix("pyroscope_", reg))
+	if err != nil || c == nil {
+		return bkt, err
+	}
+	b := NewCachingBucket(bkt, c, logger, prometheus.WrapRegistererWith(prometheus.Labels{"name": name}, reg))
+	b.CacheExists("meta", isBlockMetaFile, cfg.MetaExistsTTL, cfg.MetaDoesntExistTTL)
+	b.CacheGet("meta", isBlockMetaFile, cfg.MetaContentTTL, int64(cfg.MetaMaxSize))
+	b.CacheAttributes("block", isBlockFile, cfg.AttributesTTL)
+	b.CacheGetRange("block", isBlockFile, cfg.GetRangeTTL, int64(cfg.GetRangeMaxSize), int64(cfg.GetRangeTailSize))
+	return b, nil
+}
+
+func isBlockMetaFile(name string) bool {
+	return strings.HasSuffix(name, objstore.DirDelim+"meta.json")
+}
+
+func isBlockFile(name string) bool {
+	return strings.HasSuffix(name, ".tsdb") ||
+		strings.HasSuffix(name, ".symdb") ||
+		strings.HasSuffix(name, ".parquet")
+}
+
+type cachePolicy struct {
+	kind    string
+	matcher func(name string) bool
+	ttl     time.Duration
+	// Exists only: TTL of the negative result.
+	doesntExistTTL time.Duration
+	// Get and GetRange only: larger objects are not cached.
+	maxSize int64
+	// GetRange only: if not zero, only the ranges within the
+	// given number of bytes from the end of the object are cached.
+	tailSize int64
+}
+
+// CachingBucket caches the results of read operations on objects that
+// match the configured policies. The objects are expected to be immutable:
+// the cache is only invalidated when an object is uploaded or deleted via
+// the caching bucket, and only for the existence, content and attributes.
+type CachingBucket struct {
+	Bucket
+
+	cache  cache.Cache
+	logger log.Logger
+
+	policies map[string][]cachePolicy
+
+	requests *prometheus.CounterVec
+	hits     *prometheus.CounterVec
+}
+
+func NewCachingBucket(bkt Bucket, c cache.Cache, logger log.Logger, reg prometheus.Registerer) *CachingBucket {
+	return &CachingBucket{
+		Bucket:   bkt,
+		cache:    c,
+		logger:   logger,
+		policies: make(map[string][]cachePolicy),
+		requests: promauto.With(reg).NewCounterVec(prometheus.CounterOpts{
+			Name: "pyroscope_objstore_caching_bucket_requests_total",
+			Help: "Number of object store requests eligible for caching.",
+		}, []string{"operation", "kind"}),
+		hits: promauto.With(reg).NewCounterVec(prometheus.CounterOpts{
+			Name: "pyroscope_objstore_caching_bucket_hits_total",
+			Help: "Number of object store requests served from the cache.",
+		}, []string{"operation", "kind"}),
+	}
+}
+
+// CacheExists caches the result of Exists calls for matching objects.
+func (b *CachingBucket) CacheExists(kind string, matcher func(string) bool, existsTTL, doesntExistTTL time.Duration) {
+	b.addPolicy(cacheOpExists, cachePolicy{kind: kind, matcher: matcher, ttl: existsTTL, doesntExistTTL: doesntExistTTL})
+}
+
+// CacheGet caches the content of matching objects not larger than maxSize.
+func (b *CachingBucket) CacheGet(kind string, matcher func(string) bool, ttl time.Duration, maxSize int64) {
+	b.addPolicy(cacheOpGet, cachePolicy{kind: kind, matcher: matcher, ttl: ttl, maxSize: maxSize})
+}
+
+// CacheAttributes caches the attributes of matching objects.
+func (b *CachingBucket) CacheAttributes(kind string, matcher func(string) bool, ttl time.Duration) {
+	b.addPolicy(cacheOpAttributes, cachePolicy{kind: kind, matcher: matcher, ttl: ttl})
+}
+
+// CacheGetRange caches ranges of matching objects. A range is cached as
+// is, therefore only the reads of the very same range hit the cache.
+// If tailSize is not zero, only the ranges within tailSize bytes from the
+// end of the object are cached: the object size is fetched with Attributes.
+func (b *CachingBucket) CacheGetRange(kind string, matcher func(string) bool, ttl time.Duration, maxSize, tailSize int64) {
+	b.addPolicy(cacheOpGetRange, cachePolicy{kind: kind, matcher: matcher, ttl: ttl, maxSize: maxSize, tailSize: tailSize})
+}
+
+func (b *CachingBucket) addPolicy(op string, p cachePolicy) {
+	b.policies[op] = append(b.policies[op], p)
+	// Initialize the metrics, so that they're exported.
+	b.requests.WithLabelValues(op, p.kind)
+	b.hits.WithLabelValues(op, p.kind)
+}
+
+func (b *CachingBucket) policy(op, name string) *cachePolicy {
+	for i, p := range b.policies[op] {
+		if p.matcher(name) {
+			return &b.policies[op][i]
+		}
+	}
+	return nil
+}
+
+func cacheKey(op, name string, args ...int64) string {
+	var sb strings.Builder
+	sb.WriteString(op)
+	sb.WriteByte(':')
+	sb.WriteString(name)
+	for _, a := range args {
+		sb.WriteByte(':')
+		sb.WriteString(strconv.FormatInt(a, 10))
+	}
+	return sb.String()
+}
+
+func (b *CachingBucket) fetch(ctx context.Context, op string, p *cachePolicy, key string) ([]byte, bool) {
+	b.requests.WithLabelValues(op, p.kind).Inc()
+	v, ok := b.cache.Fetch(ctx, []string{key})[key]
+	if ok {
+		b.hits.WithLabelValues(op, p.kind).Inc()
+	}
+	return v, ok
+}
+
+func (b *CachingBucket) store(key string, value []byte, ttl time.Duration) {
+	if ttl > 0 {
+		b.cache.StoreAsync(map[string][]byte{key: value}, ttl)
+	}
+}
+
+func (b *CachingBucket) Exists(ctx context.Context, name string) (bool, error) {
+	p := b.policy(cacheOpExists, name)
+	if p == nil {
+		return b.Bucket.Exists(ctx, name)
+	}
+	key := cacheKey(cacheOpExists, name)
+	if v, ok := b.fetch(ctx, cacheOpExists, p, key); ok && len(v) == 1 {
+		return v[0] == 1, nil
+	}
+	exists, err := b.Bucket.Exists(ctx, name)
+	if err != nil {
+		return false, err
+	}
+	if exists {
+		b.store(key, []byte{1}, p.ttl)
+	} else {
+		b.store(key, []byte{0}, p.doesntExistTTL)
+	}
+	return exists, nil
+}
+
+func (b *CachingBucket) Get(ctx context.Context, name string) (io.ReadCloser, error) {
+	p := b.policy(cacheOpGet, name)
+	if p == nil {
+		return b.Bucket.Get(ctx, name)
+	}
+	key := cacheKey(cacheOpGet, name)
+	if v, ok := b.fetch(ctx, cacheOpGet, p, key); ok {
+		return io.NopCloser(bytes.NewReader(v)), nil
+	}
+	rc, err := b.Bucket.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return b.readAndStore(rc, key, p)
+}
+
+func (b *CachingBucket) GetRange(ctx context.Context, name string, off, length int64) (io.ReadCloser, error) {
+	p := b.policy(cacheOpGetRange, name)
+	if p == nil || off < 0 || length <= 0 || length > p.maxSize || !b.inTail(ctx, p, name, off) {
+		return b.Bucket.GetRange(ctx, name, off, length)
+	}
+	key := cacheKey(cacheOpGetRange, name, off, length)
+	if v, ok := b.fetch(ctx, cacheOpGetRange, p, key); ok {
+		return io.NopCloser(bytes.NewReader(v)), nil
+	}
+	rc, err := b.Bucket.GetRange(ctx, name, off, length)
+	if err != nil {
+		return nil, err
+	}
+	return b.readAndStore(rc, key, p)
+}
+
+// inTail reports whether the range starting at the offset is within
+// the tail of the object, where the metadata sections are stored.
+func (b *CachingBucket) inTail(ctx context.Context, p *cachePolicy, name string, off int64) bool {
+	if p.tailSize <= 0 {
+		return true
+	}
+	attrs, err := b.Attributes(ctx, name)
+	if err != nil {
+		return false
+	}
+	return off >= attrs.Size-p.tailSize
+}
+
+// readAndStore reads the object into memory and caches it, unless it is
+// larger than allowed: the caller gets the entire object regardless.
+func (b *CachingBucket) readAndStore(rc io.ReadCloser, key string, p *cachePolicy) (io.ReadCloser, error) {
+	buf, err := io.ReadAll(io.LimitReader(rc, p.maxSize+1))
+	if err != nil {
+		_ = rc.Close()
+		return nil, err
+	}
+	if int64(len(buf)) > p.maxSize {
+		return struct {
+			io.Reader
+			io.Closer
+		}{
+			Reader: io.MultiReader(bytes.NewReader(buf), rc),
+			Closer: rc,
+		}, nil
+	}
+	if err = rc.Close(); err != nil {
+		level.Warn(b.logger).Log("msg", "failed to close object reader", "key", key, "err", err)
+	}
+	b.store(key, buf, p.ttl)
+	return io.NopCloser(bytes.NewReader(buf)), nil
+}
+
+func (b *CachingBucket) Attributes(ctx context.Context, name string) (objstore.ObjectAttributes, error) {
+	p := b.policy(cacheOpAttributes, name)
+	if p == nil {
+		return b.Bucket.Attributes(ctx, name)
+	}
+	key := cacheKey(cacheOpAttributes, name)
+	if v, ok := b.fetch(ctx, cacheOpAttributes, p, key); ok {
+		var attrs objstore.ObjectAttributes
+		if err := json.Unmarshal(v, &attrs); err == nil {
+			return attrs, nil
+		}
+	}
+	attrs, err := b.Bucket.Attributes(ctx, name)
+	if err != nil {
+		return attrs, err
+	}
+	if v, err := json.Marshal(attrs); err == nil {
+		b.store(key, v, p.ttl)
+	}
+	return attrs, nil
+}
+
+func (b *CachingBucket) ReaderAt(ctx context.Context, name string) (ReaderAtCloser, error) {
+	if b.policy(cacheOpGetRange, name) == nil {
+		return b.Bucket.ReaderAt(ctx, name)
+	}
+	return &ReaderAt{
+		GetRangeReader: b,
+		name:           name,
+		ctx:            ctx,
+	}, nil
+}
+
+func (b *CachingBucket) Upload(ctx context.Context, name string, r io.Reader) error {
+	defer b.invalidate(ctx, name)
+	return b.Bucket.Upload(ctx, name, r)
+}
+
+func (b *CachingBucket) Delete(ctx context.Context, name string) error {
+	defer b.invalidate(ctx, name)
+	return b.Bucket.Delete(ctx, name)
+}
+
+func (b *CachingBucket) invalidate(ctx context.Context, name string) {
+	for _, op := range []string{cacheOpExists, cacheOpGet, cacheOpAttributes} {
+		if b.policy(op, name) == nil {
+			continue
+		}
+		if err := b.cache.Delete(ctx, cacheKey(op, name)); err != nil {
+			level.Warn(b.logger).Log("msg", "failed to invalidate cached object", "op", op, "name", name, "err", err)
+		}
+	}
+}
+
+// ReaderWithExpectedErrs implements objstore.Bucket.
+func (b *CachingBucket) ReaderWithExpectedErrs(fn IsOpFailureExpectedFunc) BucketReader {
+	return b.WithExpectedErrs(fn)
+}
+
+// WithExpectedErrs implements objstore.Bucket.
+func (b *CachingBucket) WithExpectedErrs(fn IsOpFailureExpectedFunc) Bucket {
+	if ib, ok := b.Bucket.(InstrumentedBucket); ok {
+		c := *b
+		c.Bucket = ib.WithExpectedErrs(fn)
+		return &c
+	}
+	return b
+}
@@ -0,0 +1,174 @@
+package objstore
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/grafana/dskit/cache"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thanos-io/objstore"
+)
+
+type countingBucket struct {
+	objstore.Bucket
+	calls map[string]int
+}
+
+func (b *countingBucket) Exists(ctx context.Context, name string) (bool, error) {
+	b.calls[cacheOpExists]++
+	return b.Bucket.Exists(ctx, name)
+}
+
+func (b *countingBucket) Get(ctx context.Context, name string) (io.ReadCloser, error) {
+	b.calls[cacheOpGet]++
+	return b.Bucket.Get(ctx, name)
+}
+
+func (b *countingBucket) GetRange(ctx context.Context, name string, off, length int64) (io.ReadCloser, error) {
+	b.calls[cacheOpGetRange]++
+	return b.Bucket.GetRange(ctx, name, off, length)
+}
+
+func (b *countingBucket) Attributes(ctx context.Context, name string) (objstore.ObjectAttributes, error) {
+	b.calls[cacheOpAttributes]++
+	return b.Bucket.Attributes(ctx, name)
+}
+
+func newTestCachingBucket(t *testing.T) (*CachingBucket, *countingBucket) {
+	counting := &countingBucket{Bucket: objstore.NewInMemBucket(), calls: make(map[string]int)}
+	b := NewCachingBucket(NewBucket(counting), cache.NewMockCache(), log.NewNopLogger(), prometheus.NewRegistry())
+	b.CacheExists("meta", isBlockMetaFile, time.Hour, time.Minute)
+	b.CacheGet("meta", isBlockMetaFile, time.Hour, 16)
+	b.CacheAttributes("block", isBlockFile, time.Hour)
+	b.CacheGetRange("block", isBlockFile, time.Hour, 4, 6)
+	ctx := context.Background()
+	require.NoError(t, b.Upload(ctx, "tenant/01HMWHBRCEE3A7XN4Q8ZSDV9NY/meta.json", strings.NewReader(`{"version":3}`)))
+	require.NoError(t, b.Upload(ctx, "tenant/01HMWHBRCEE3A7XN4Q8ZSDV9NY/profiles.parquet", strings.NewReader("0123456789")))
+	require.NoError(t, b.Upload(ctx, "tenant/bucket-index.json.gz", strings.NewReader("index")))
+	return b, counting
+}
+
+func readAll(t *testing.T, rc io.ReadCloser, err error) string {
+	require.NoError(t, err)
+	defer rc.Close()
+	b, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	return string(b)
+}
+
+func Test_CachingBucket_Exists(t *testing.T) {
+	b, counting := newTestCachingBucket(t)
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		exists, err := b.Exists(ctx, "tenant/01HMWHBRCEE3A7XN4Q8ZSDV9NY/meta.json")
+		require.NoError(t, err)
+		assert.True(t, exists)
+		exists, err = b.Exists(ctx, "tenant/01HMWHBRCEE3A7XN4Q8ZSDV9NZ/meta.json")
+		require.NoError(t, err)
+		assert.False(t, exists)
+	}
+	assert.Equal(t, 2, counting.calls[cacheOpExists])
+	assert.Equal(t, float64(2), testutil.ToFloat64(b.hits.WithLabelValues(cacheOpExists, "meta")))
+
+	// Deletion invalidates the cached value.
+	require.NoError(t, b.Delete(ctx, "tenant/01HMWHBRCEE3A7XN4Q8ZSDV9NY/meta.json"))
+	exists, err := b.Exists(ctx, "tenant/01HMWHBRCEE3A7XN4Q8ZSDV9NY/meta.json")
+	require.NoError(t, err)
+	assert.False(t, exists)
+	assert.Equal(t, 3, counting.calls[cacheOpExists])
+
+	// Not cached.
+	for i := 0; i < 2; i++ {
+		exists, err = b.Exists(ctx, "tenant/bucket-index.json.gz")
+		require.NoError(t, err)
+		assert.True(t, exists)
+	}
+	assert.Equal(t, 5, counting.calls[cacheOpExists])
+}
+
+func Test_CachingBucket_Get(t *testing.T) {
+	b, counting := newTestCachingBucket(t)
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		rc, err := b.Get(ctx, "tenant/01HMWHBRCEE3A7XN4Q8ZSDV9NY/meta.json")
+		assert.Equal(t, `{"version":3}`, readAll(t, rc, err))
+	}
+	assert.Equal(t, 1, counting.calls[cacheOpGet])
+
+	// Upload invalidates the cached value.
+	require.NoError(t, b.Upload(ctx, "tenant/01HMWHBRCEE3A7XN4Q8ZSDV9NY/meta.json", strings.NewReader(`{"version":3,"ulid":"01HMWHBRCEE3A7XN4Q8ZSDV9NY"}`)))
+	// The object is too large to be cached.
+	for i := 0; i < 2; i++ {
+		rc, err := b.Get(ctx, "tenant/01HMWHBRCEE3A7XN4Q8ZSDV9NY/meta.json")
+		assert.Equal(t, `{"version":3,"ulid":"01HMWHBRCEE3A7XN4Q8ZSDV9NY"}`, readAll(t, rc, err))
+	}
+	assert.Equal(t, 3, counting.calls[cacheOpGet])
+
+	_, err := b.Get(ctx, "tenant/01HMWHBRCEE3A7XN4Q8ZSDV9NZ/meta.json")
+	assert.True(t, b.IsObjNotFoundErr(err))
+}
+
+func Test_CachingBucket_GetRange(t *testing.T) {
+	b, counting := newTestCachingBucket(t)
+	ctx := context.Background()
+	const name = "tenant/01HMWHBRCEE3A7XN4Q8ZSDV9NY/profiles.parquet"
+	for i := 0; i < 2; i++ {
+		rc, err := b.GetRange(ctx, name, 6, 4)
+		assert.Equal(t, "6789", readAll(t, rc, err))
+	}
+	assert.Equal(t, 1, counting.calls[cacheOpGetRange])
+	// The object size is only fetched once.
+	assert.Equal(t, 1, counting.calls[cacheOpAttributes])
+
+	// The range is not in the tail of the object.
+	for i := 0; i < 2; i++ {
+		rc, err := b.GetRange(ctx, name, 0, 2)
+		assert.Equal(t, "01", readAll(t, rc, err))
+	}
+	assert.Equal(t, 3, counting.calls[cacheOpGetRange])
+
+	// The range is too large to be cached.
+	for i := 0; i < 2; i++ {
+		rc, err := b.GetRange(ctx, name, 5, 5)
+		assert.Equal(t, "56789", readAll(t, rc, err))
+	}
+	assert.Equal(t, 5, counting.calls[cacheOpGetRange])
+
+	// Reads via ReaderAt hit the cache.
+	r, err := b.ReaderAt(ctx, name)
+	require.NoError(t, err)
+	buf := make([]byte, 4)
+	n, err := r.ReadAt(buf, 6)
+	require.NoError(t, err)
+	assert.Equal(t, 4, n)
+	assert.True(t, bytes.Equal([]byte("6789"), buf))
+	assert.Equal(t, 5, counting.calls[cacheOpGetRange])
+}
+
+func Test_CachingBucket_Attributes(t *testing.T) {
+	b, counting := newTestCachingBucket(t)
+	ctx := context.Background()
+	const name = "tenant/01HMWHBRCEE3A7XN4Q8ZSDV9NY/profiles.parquet"
+	expected, err := b.Attributes(ctx, name)
+	require.NoError(t, err)
+	attrs, err := b.Attributes(ctx, name)
+	require.NoError(t, err)
+	assert.Equal(t, expected.Size, attrs.Size)
+	assert.True(t, expected.LastModified.Equal(attrs.LastModified))
+	assert.Equal(t, 1, counting.calls[cacheOpAttributes])
+}
+
+func Test_NewCachingBucketFromConfig_Disabled(t *testing.T) {
+	bkt := NewBucket(objstore.NewInMemBucket())
+	b, err := NewCachingBucketFromConfig(CachingBucketConfig{}, bkt, "test", log.NewNopLogger(), prometheus.NewRegistry())
+	require.NoError(t, err)
+	assert.Equal(t, bkt, b)
+}
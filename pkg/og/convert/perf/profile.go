@@ -0,0 +1,214 @@
+package perf
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/prometheus/model/labels"
+
+	profilev1 "github.com/grafana/pyroscope/api/gen/proto/go/google/v1"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+	distributormodel "github.com/grafana/pyroscope/pkg/distributor/model"
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/og/agent/types"
+	"github.com/grafana/pyroscope/pkg/og/ingestion"
+	"github.com/grafana/pyroscope/pkg/og/storage"
+	"github.com/grafana/pyroscope/pkg/pprof"
+)
+
+const (
+	LabelNameComm = "comm"
+	LabelNamePid  = "pid"
+)
+
+// RawProfile is the text output of `perf script`.
+type RawProfile struct {
+	RawData []byte
+}
+
+func (p *RawProfile) Bytes() ([]byte, error) { return p.RawData, nil }
+
+func (*RawProfile) ContentType() string { return "text/plain" }
+
+func (p *RawProfile) Parse(context.Context, storage.Putter, storage.MetricsExporter, ingestion.Metadata) error {
+	return fmt.Errorf("parsing to Tree/storage.Putter is no longer supported")
+}
+
+// ParseToPprof converts perf script events into CPU profiles: one series
+// per process, identified by the comm and pid labels. Every event is
+// accounted with its period if perf script prints one, and with the period
+// derived from the sample rate otherwise. Symbol offsets are stripped.
+func (p *RawProfile) ParseToPprof(_ context.Context, md ingestion.Metadata) (*distributormodel.PushRequest, error) {
+	res := &distributormodel.PushRequest{
+		RawProfileSize: len(p.RawData),
+		RawProfileType: distributormodel.RawProfileTypePerfScript,
+	}
+	sampleRate := md.SampleRate
+	if sampleRate == 0 {
+		sampleRate = types.DefaultSampleRate
+	}
+	period := time.Second.Nanoseconds() / int64(sampleRate)
+
+	type process struct {
+		comm    string
+		pid     int
+		builder *profileBuilder
+	}
+	var processes []*process
+	byKey := make(map[string]*process)
+	parser := NewScriptParser(p.RawData)
+	for {
+		e, err := parser.parseEvent()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse perf script: %w", err)
+		}
+		k := strconv.Itoa(e.pid) + "/" + string(e.comm)
+		x, ok := byKey[k]
+		if !ok {
+			x = &process{
+				comm:    string(e.comm),
+				pid:     e.pid,
+				builder: newProfileBuilder(md, period),
+			}
+			byKey[k] = x
+			processes = append(processes, x)
+		}
+		value := period
+		if e.period > 0 {
+			value = e.period
+		}
+		x.builder.addSample(e.stack, value)
+	}
+
+	for _, x := range processes {
+		res.Series = append(res.Series, &distributormodel.ProfileSeries{
+			Labels: createLabels(md, x.comm, x.pid),
+			Samples: []*distributormodel.ProfileSample{{
+				Profile: pprof.RawFromProto(x.builder.profile),
+			}},
+		})
+	}
+	return res, nil
+}
+
+func createLabels(md ingestion.Metadata, comm string, pid int) []*typesv1.LabelPair {
+	ls := make([]*typesv1.LabelPair, 0, len(md.Key.Labels())+6)
+	ls = append(ls, &typesv1.LabelPair{
+		Name:  labels.MetricName,
+		Value: "process_cpu",
+	}, &typesv1.LabelPair{
+		Name:  phlaremodel.LabelNameDelta,
+		Value: "false",
+	}, &typesv1.LabelPair{
+		Name:  phlaremodel.LabelNameServiceName,
+		Value: md.Key.AppName(),
+	}, &typesv1.LabelPair{
+		Name:  phlaremodel.LabelNamePyroscopeSpy,
+		Value: md.SpyName,
+	}, &typesv1.LabelPair{
+		Name:  LabelNameComm,
+		Value: comm,
+	}, &typesv1.LabelPair{
+		Name:  LabelNamePid,
+		Value: strconv.Itoa(pid),
+	})
+	for k, v := range md.Key.Labels() {
+		if !phlaremodel.IsLabelAllowedForIngestion(k) {
+			continue
+		}
+		switch k {
+		case phlaremodel.LabelNameServiceName, LabelNameComm, LabelNamePid:
+			continue
+		}
+		ls = append(ls, &typesv1.LabelPair{
+			Name:  k,
+			Value: v,
+		})
+	}
+	return ls
+}
+
+type profileBuilder struct {
+	profile   *profilev1.Profile
+	strings   map[string]int64
+	locations map[string]uint64
+	samples   map[string]*profilev1.Sample
+	key       strings.Builder
+}
+
+func newProfileBuilder(md ingestion.Metadata, period int64) *profileBuilder {
+	b := &profileBuilder{
+		profile: &profilev1.Profile{
+			StringTable:   []string{""},
+			Mapping:       []*profilev1.Mapping{{Id: 1}},
+			TimeNanos:     md.StartTime.UnixNano(),
+			DurationNanos: md.EndTime.Sub(md.StartTime).Nanoseconds(),
+			Period:        period,
+		},
+		strings:   make(map[string]int64),
+		locations: make(map[string]uint64),
+		samples:   make(map[string]*profilev1.Sample),
+	}
+	cpu, nanoseconds := b.string("cpu"), b.string("nanoseconds")
+	b.profile.SampleType = []*profilev1.ValueType{{Type: cpu, Unit: nanoseconds}}
+	b.profile.PeriodType = &profilev1.ValueType{Type: cpu, Unit: nanoseconds}
+	return b
+}
+
+func (b *profileBuilder) addSample(stack [][]byte, value int64) {
+	b.key.Reset()
+	for i, frame := range stack {
+		stack[i] = trimSymbolOffset(frame)
+		b.key.Write(stack[i])
+		b.key.WriteByte(';')
+	}
+	k := b.key.String()
+	if s, ok := b.samples[k]; ok {
+		s.Value[0] += value
+		return
+	}
+	s := &profilev1.Sample{
+		LocationId: make([]uint64, len(stack)),
+		Value:      []int64{value},
+	}
+	for i, frame := range stack {
+		s.LocationId[i] = b.location(string(frame))
+	}
+	b.samples[k] = s
+	b.profile.Sample = append(b.profile.Sample, s)
+}
+
+func (b *profileBuilder) location(name string) uint64 {
+	if id, ok := b.locations[name]; ok {
+		return id
+	}
+	id := uint64(len(b.profile.Location) + 1)
+	b.profile.Function = append(b.profile.Function, &profilev1.Function{
+		Id:   id,
+		Name: b.string(name),
+	})
+	b.profile.Location = append(b.profile.Location, &profilev1.Location{
+		Id:        id,
+		MappingId: 1,
+		Line:      []*profilev1.Line{{FunctionId: id}},
+	})
+	b.locations[name] = id
+	return id
+}
+
+func (b *profileBuilder) string(s string) int64 {
+	if i, ok := b.strings[s]; ok {
+		return i
+	}
+	i := int64(len(b.profile.StringTable))
+	b.profile.StringTable = append(b.profile.StringTable, s)
+	b.strings[s] = i
+	return i
+}
@@ -0,0 +1,109 @@
+package perf
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/og/ingestion"
+	"github.com/grafana/pyroscope/pkg/og/storage/segment"
+)
+
+const testScript = "java 12688 [002] 6544038.708352: cpu-clock:\n" +
+	"        ffffffffb37b3618 foo+0x28 (/usr/bin/java)\n" +
+	"        ffffffffb37105ed main+0x10d (/usr/bin/java)\n" +
+	"\n" +
+	"java 12688/12764 [002] 6544038.718352: cpu-clock:\n" +
+	"        ffffffffb37b3618 foo+0x28 (/usr/bin/java)\n" +
+	"        ffffffffb37105ed main+0x10d (/usr/bin/java)\n" +
+	"\n" +
+	"java 12688/12764 [002] 6544038.728352: cpu-clock:\n" +
+	"        ffffffffb37b3618 bar+0x28 (/usr/bin/java)\n" +
+	"        ffffffffb37105ed main+0x10d (/usr/bin/java)\n" +
+	"\n" +
+	"V8 WorkerThread 25607 [000] 4794564.109216: cycles:\n" +
+	"                  3fa48f [unknown] ([unknown])\n" +
+	"\n"
+
+func Test_RawProfile_ParseToPprof(t *testing.T) {
+	key, err := segment.ParseKey("app{env=test,pid=1}")
+	require.NoError(t, err)
+	start := time.Unix(1700000000, 0)
+	md := ingestion.Metadata{
+		StartTime:  start,
+		EndTime:    start.Add(10 * time.Second),
+		Key:        key,
+		SpyName:    "perf_script",
+		SampleRate: 100,
+	}
+
+	p := &RawProfile{RawData: []byte(testScript)}
+	req, err := p.ParseToPprof(context.Background(), md)
+	require.NoError(t, err)
+	require.Len(t, req.Series, 2)
+	assert.Equal(t, len(testScript), req.RawProfileSize)
+
+	expectedLabels := []string{
+		`{__delta__="false", __name__="process_cpu", comm="java", env="test", pid="12688", pyroscope_spy="perf_script", service_name="app"}`,
+		`{__delta__="false", __name__="process_cpu", comm="V8 WorkerThread", env="test", pid="25607", pyroscope_spy="perf_script", service_name="app"}`,
+	}
+	for i, s := range req.Series {
+		ls := phlaremodel.Labels(s.Labels).Clone()
+		sort.Sort(ls)
+		assert.Equal(t, expectedLabels[i], ls.ToPrometheusLabels().String())
+	}
+
+	java := req.Series[0].Samples[0].Profile.Profile
+	assert.Equal(t, start.UnixNano(), java.TimeNanos)
+	assert.Equal(t, int64(10*time.Millisecond), java.Period)
+	assert.Equal(t, "cpu", java.StringTable[java.SampleType[0].Type])
+	assert.Equal(t, "nanoseconds", java.StringTable[java.SampleType[0].Unit])
+	stacks := make(map[string]int64)
+	for _, s := range java.Sample {
+		var stack string
+		for _, id := range s.LocationId {
+			fn := java.Function[java.Location[id-1].Line[0].FunctionId-1]
+			stack += java.StringTable[fn.Name] + ";"
+		}
+		stacks[stack] += s.Value[0]
+	}
+	assert.Equal(t, map[string]int64{
+		"foo;main;": int64(20 * time.Millisecond),
+		"bar;main;": int64(10 * time.Millisecond),
+	}, stacks)
+}
+
+func Test_RawProfile_ParseToPprof_EventPeriod(t *testing.T) {
+	key, err := segment.ParseKey("app")
+	require.NoError(t, err)
+	md := ingestion.Metadata{Key: key, SpyName: "perf_script", SampleRate: 100}
+	script := "java 12688 [002] 6544038.708352:     250000 cpu-clock:pppH:\n" +
+		"        ffffffffb37b3618 foo+0x28 (/usr/bin/java)\n" +
+		"\n" +
+		"java 12688 [002] 6544038.718352:     750000 cpu-clock:pppH:\n" +
+		"        ffffffffb37b3620 foo+0x30 (/usr/bin/java)\n" +
+		"\n"
+
+	p := &RawProfile{RawData: []byte(script)}
+	req, err := p.ParseToPprof(context.Background(), md)
+	require.NoError(t, err)
+	require.Len(t, req.Series, 1)
+	java := req.Series[0].Samples[0].Profile.Profile
+	require.Len(t, java.Sample, 1)
+	require.Len(t, java.Function, 1)
+	assert.Equal(t, "foo", java.StringTable[java.Function[0].Name])
+	assert.Equal(t, []int64{1000000}, java.Sample[0].Value)
+}
+
+func Test_RawProfile_ParseToPprof_Invalid(t *testing.T) {
+	key, err := segment.ParseKey("app")
+	require.NoError(t, err)
+	p := &RawProfile{RawData: []byte("java 12688 [002] 6544038.708352: cpu-clock:\nfoo\n\n")}
+	_, err = p.ParseToPprof(context.Background(), ingestion.Metadata{Key: key})
+	assert.Error(t, err)
+}
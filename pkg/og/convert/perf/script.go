@@ -10,6 +10,7 @@ import (
 
 var reEventStart = regexp.MustCompile("^(\\S.+?)\\s+(\\d+)/*(\\d+)*\\s+\\S.+")
 var errEventStartRegexMismatch = fmt.Errorf("reEventStart mismatch")
+var reEventPeriod = regexp.MustCompile("\\s(\\d+)\\s+[A-Za-z][^\\s:]*(?::[^\\s:]*)*:(?:\\s|$)")
 var reStackFrame = regexp.MustCompile("^\\s*(\\w+)\\s*(.+) \\((\\S*)\\)")
 var errStackFrameRegexMismatch = fmt.Errorf("reStackFrame mismatch")
 var sep = []byte{'\n'}
@@ -50,38 +51,60 @@ func (p *ScriptParser) ParseEvents() ([][][]byte, error) {
 }
 
 func (p *ScriptParser) ParseEvent() ([][]byte, error) {
-	line, err := p.nextLine()
+	e, err := p.parseEvent()
 	if err != nil {
 		return nil, err
 	}
-	stack := make([][]byte, 0, 16)
-	comm, _, _, err := parseEventStart(line)
+	stack := append(e.stack, e.comm)
+	for i, j := 0, len(stack)-1; i < j; i, j = i+1, j-1 {
+		stack[i], stack[j] = stack[j], stack[i]
+	}
+	return stack, nil
+}
+
+// event is a single sample of the perf script output.
+// The stack is ordered from the leaf frame to the root.
+type event struct {
+	comm   []byte
+	pid    int
+	period int64 // Zero, if perf script does not print the period.
+	stack  [][]byte
+}
+
+func (p *ScriptParser) parseEvent() (event, error) {
+	line, err := p.nextLine()
+	if err != nil {
+		return event{}, err
+	}
+	comm, pid, _, err := parseEventStart(line)
 	if err != nil {
 		if len(line) == 0 && p.lineIndex >= len(p.lines) {
-			return nil, io.EOF
+			return event{}, io.EOF
 		}
-		return nil, err
+		return event{}, err
+	}
+	e := event{
+		comm:   comm,
+		pid:    pid,
+		period: parseEventPeriod(line),
+		stack:  make([][]byte, 0, 16),
 	}
 	var sym []byte
 	for {
 		line, err = p.nextLine()
 		if err != nil {
-			return nil, err
+			return event{}, err
 		}
 		if parseEventEnd(line) {
 			break
 		}
 		_, sym, _, err = parseStackFrame(line)
 		if err != nil {
-			return nil, err
+			return event{}, err
 		}
-		stack = append(stack, sym)
+		e.stack = append(e.stack, sym)
 	}
-	stack = append(stack, comm)
-	for i, j := 0, len(stack)-1; i < j; i, j = i+1, j-1 {
-		stack[i], stack[j] = stack[j], stack[i]
-	}
-	return stack, nil
+	return e, nil
 }
 
 func IsPerfScript(buf []byte) bool {
@@ -114,6 +137,20 @@ func parseEventStart(line []byte) ([]byte, int, int, error) {
 	return comm, pid, tid, nil
 }
 
+// parseEventPeriod returns the sample period printed before the event
+// name, e.g. 250000 in "java 12688 [002] 6544038.708352: 250000 cpu-clock:".
+func parseEventPeriod(line []byte) int64 {
+	res := reEventPeriod.FindSubmatch(line)
+	if res == nil {
+		return 0
+	}
+	period, err := strconv.ParseInt(string(res[1]), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return period
+}
+
 func parseEventEnd(line []byte) bool {
 	return len(line) == 0
 }
@@ -128,3 +165,18 @@ func parseStackFrame(line []byte) ([]byte, []byte, []byte, error) {
 	mod := res[3]
 	return adr, sym, mod, nil
 }
+
+// trimSymbolOffset removes the "+0x..." offset perf script appends to
+// symbol names, so that all the samples of a function share the frame.
+func trimSymbolOffset(sym []byte) []byte {
+	i := bytes.LastIndex(sym, []byte("+0x"))
+	if i <= 0 || i+3 == len(sym) {
+		return sym
+	}
+	for _, c := range sym[i+3:] {
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F') {
+			return sym
+		}
+	}
+	return sym[:i]
+}
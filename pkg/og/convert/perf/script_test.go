@@ -40,6 +40,35 @@ func TestParseEventStart(t *testing.T) {
 	test("swapper;start_kernel;rest_init;cpu_idle;default_idle;native_safe_halt 1\n", "", 0, 0, false)
 }
 
+func TestParseEventPeriod(t *testing.T) {
+	for line, period := range map[string]int64{
+		"java 12688 [002] 6544038.708352: cpu-clock:":                          0,
+		"V8 WorkerThread 25607 4794564.109216: cycles:":                        0,
+		"perf 617960 [004] 116825.359144:         16   cycles: ":               16,
+		"java 12688/12764 [002] 6544038.708352:     250000 cpu-clock:pppH:":    250000,
+		"V8 WorkerThread 24636/25607 94564.109216: 10101 cpu/cycles/u: 3fa48f": 10101,
+	} {
+		if p := parseEventPeriod([]byte(line)); p != period {
+			t.Errorf("%q: period %v != %v", line, p, period)
+		}
+	}
+}
+
+func TestTrimSymbolOffset(t *testing.T) {
+	for sym, expected := range map[string]string{
+		"update_curr+0x10d":                 "update_curr",
+		"__evlist__enable.constprop.0+0x97": "__evlist__enable.constprop.0",
+		"[unknown]":                         "[unknown]",
+		"operator+":                         "operator+",
+		"foo+0x":                            "foo+0x",
+		"+0x10":                             "+0x10",
+	} {
+		if actual := string(trimSymbolOffset([]byte(sym))); actual != expected {
+			t.Errorf("%q: %v != %v", sym, actual, expected)
+		}
+	}
+}
+
 func TestParseStackFrame(t *testing.T) {
 	test := func(s string, addr, sym, mod string, ok bool) {
 		t.Run(s, func(t *testing.T) {
@@ -0,0 +1,65 @@
+package speedscope
+
+import (
+	"encoding/json"
+	"io"
+	"slices"
+
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+)
+
+const exporter = "pyroscope"
+
+// ExportTree writes the tree as a speedscope file with a single sampled
+// profile: every stack trace of the tree is a sample weighted by its self
+// value. The sample unit is the unit of the profile type, e.g. nanoseconds
+// or bytes; units not known to speedscope are exported as "none".
+func ExportTree(dst io.Writer, t *phlaremodel.Tree, name string, sampleUnit string) error {
+	var (
+		frames  []frame
+		indexes = make(map[string]int)
+		samples []sample
+		weights []float64
+		total   float64
+	)
+	t.IterateStacks(func(_ string, self int64, stack []string) {
+		slices.Reverse(stack)
+		s := make(sample, len(stack))
+		for i, fn := range stack {
+			idx, ok := indexes[fn]
+			if !ok {
+				idx = len(frames)
+				indexes[fn] = idx
+				frames = append(frames, frame{Name: fn})
+			}
+			s[i] = float64(idx)
+		}
+		samples = append(samples, s)
+		weights = append(weights, float64(self))
+		total += float64(self)
+	})
+
+	file := speedscopeFile{
+		Schema: schema,
+		Shared: shared{Frames: frames},
+		Profiles: []profile{{
+			Type:     profileSampled,
+			Name:     name,
+			Unit:     exportUnit(sampleUnit),
+			EndValue: total,
+			Samples:  samples,
+			Weights:  weights,
+		}},
+		Name:     name,
+		Exporter: exporter,
+	}
+	return json.NewEncoder(dst).Encode(file)
+}
+
+func exportUnit(sampleUnit string) unit {
+	switch u := unit(sampleUnit); u {
+	case unitNanoseconds, unitMicroseconds, unitMilliseconds, unitSeconds, unitBytes:
+		return u
+	}
+	return unitNone
+}
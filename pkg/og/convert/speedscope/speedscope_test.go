@@ -1,11 +1,13 @@
 package speedscope
 
 import (
+	"bytes"
 	"context"
 	"os"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
 	"github.com/grafana/pyroscope/pkg/og/ingestion"
 	"github.com/grafana/pyroscope/pkg/og/storage/metadata"
 	"github.com/grafana/pyroscope/pkg/og/storage/segment"
@@ -74,4 +76,33 @@ a;b;d 400
 		Expect(input.Val.String()).To(Equal(expectedResult))
 		Expect(input.SampleRate).To(Equal(uint32(100)))
 	})
+
+	It("Can export a tree as a sample-format profile", func() {
+		t := new(phlaremodel.Tree)
+		t.InsertStack(5, "a", "b")
+		t.InsertStack(5, "a", "b", "c")
+		t.InsertStack(4, "a", "b", "d")
+
+		var buf bytes.Buffer
+		Expect(ExportTree(&buf, t, "foo", "bytes")).To(Succeed())
+
+		key, err := segment.ParseKey("foo")
+		Expect(err).ToNot(HaveOccurred())
+
+		ingester := new(mockIngester)
+		profile := &RawProfile{RawData: buf.Bytes()}
+
+		md := ingestion.Metadata{Key: key, SampleRate: 100}
+		err = profile.Parse(context.Background(), ingester, nil, md)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(ingester.actual).To(HaveLen(1))
+		input := ingester.actual[0]
+		Expect(input.Units).To(Equal(metadata.BytesUnits))
+		expectedResult := `a;b 5
+a;b;c 5
+a;b;d 4
+`
+		Expect(input.Val.String()).To(Equal(expectedResult))
+	})
 })
@@ -0,0 +1,95 @@
+package heatmap
+
+import (
+	"math"
+	"time"
+)
+
+// Builder builds a Heatmap from individual observations.
+type Builder struct {
+	heatmap Heatmap
+}
+
+func NewBuilder(params HeatmapParams) *Builder {
+	h := Heatmap{
+		Values:       make([][]uint64, params.TimeBuckets),
+		TimeBuckets:  params.TimeBuckets,
+		ValueBuckets: params.ValueBuckets,
+		StartTime:    params.StartTime,
+		EndTime:      params.EndTime,
+		MinValue:     params.MinValue,
+		MaxValue:     params.MaxValue,
+	}
+	for x := range h.Values {
+		h.Values[x] = make([]uint64, params.ValueBuckets)
+	}
+	return &Builder{heatmap: h}
+}
+
+// Add accounts the value observed at the given time.
+// Observations outside the boundaries are ignored.
+func (b *Builder) Add(t time.Time, v uint64) {
+	if x, y, ok := b.heatmap.HeatmapParams().Bucket(t, v); ok {
+		b.heatmap.Values[x][y]++
+	}
+}
+
+// Build returns the heatmap with the depth boundaries calculated.
+func (b *Builder) Build() *Heatmap {
+	h := b.heatmap
+	h.MinDepth, h.MaxDepth = 0, 0
+	for _, column := range h.Values {
+		for _, n := range column {
+			if n == 0 {
+				continue
+			}
+			if h.MinDepth == 0 || n < h.MinDepth {
+				h.MinDepth = n
+			}
+			if n > h.MaxDepth {
+				h.MaxDepth = n
+			}
+		}
+	}
+	return &h
+}
+
+func (h *Heatmap) HeatmapParams() HeatmapParams {
+	return HeatmapParams{
+		TimeBuckets:  h.TimeBuckets,
+		ValueBuckets: h.ValueBuckets,
+		StartTime:    h.StartTime,
+		EndTime:      h.EndTime,
+		MinValue:     h.MinValue,
+		MaxValue:     h.MaxValue,
+	}
+}
+
+// Bucket returns the coordinates of the cell the observation belongs to.
+// The time axis buckets are [StartTime:EndTime), and the value axis buckets
+// are (MinValue:MaxValue]; MinValue itself belongs to the first value bucket.
+func (p HeatmapParams) Bucket(t time.Time, v uint64) (x, y int64, ok bool) {
+	if p.TimeBuckets <= 0 || p.ValueBuckets <= 0 {
+		return 0, 0, false
+	}
+	if t.Before(p.StartTime) || !t.Before(p.EndTime) {
+		return 0, 0, false
+	}
+	if v < p.MinValue || v > p.MaxValue {
+		return 0, 0, false
+	}
+	x = int64(float64(t.Sub(p.StartTime)) / float64(p.EndTime.Sub(p.StartTime)) * float64(p.TimeBuckets))
+	if x >= p.TimeBuckets {
+		x = p.TimeBuckets - 1
+	}
+	if width := p.MaxValue - p.MinValue; width > 0 {
+		y = int64(math.Ceil(float64(v-p.MinValue)/float64(width)*float64(p.ValueBuckets))) - 1
+	}
+	if y < 0 {
+		y = 0
+	}
+	if y >= p.ValueBuckets {
+		y = p.ValueBuckets - 1
+	}
+	return x, y, true
+}
@@ -0,0 +1,72 @@
+package heatmap_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/grafana/pyroscope/pkg/og/storage/heatmap"
+	"github.com/grafana/pyroscope/pkg/og/testing"
+)
+
+var _ = Describe("heatmap", func() {
+	params := heatmap.HeatmapParams{
+		TimeBuckets:  2,
+		ValueBuckets: 2,
+		StartTime:    testing.SimpleTime(0),
+		EndTime:      testing.SimpleTime(100),
+		MinValue:     10,
+		MaxValue:     30,
+	}
+
+	Context("Bucket", func() {
+		It("returns correct cells", func() {
+			for _, tc := range []struct {
+				t    time.Time
+				v    uint64
+				x, y int64
+				ok   bool
+			}{
+				{testing.SimpleTime(0), 10, 0, 0, true},
+				{testing.SimpleTime(49), 20, 0, 0, true},
+				{testing.SimpleTime(50), 21, 1, 1, true},
+				{testing.SimpleTime(99), 30, 1, 1, true},
+				{testing.SimpleTime(100), 30, 0, 0, false},
+				{testing.SimpleTime(0), 9, 0, 0, false},
+				{testing.SimpleTime(0), 31, 0, 0, false},
+			} {
+				x, y, ok := params.Bucket(tc.t, tc.v)
+				Expect(ok).To(Equal(tc.ok))
+				Expect(x).To(Equal(tc.x))
+				Expect(y).To(Equal(tc.y))
+			}
+		})
+
+		It("puts all the values in the first bucket if the value range is empty", func() {
+			p := params
+			p.MaxValue = p.MinValue
+			x, y, ok := p.Bucket(testing.SimpleTime(60), p.MinValue)
+			Expect(ok).To(BeTrue())
+			Expect(x).To(Equal(int64(1)))
+			Expect(y).To(Equal(int64(0)))
+		})
+	})
+
+	Context("Builder", func() {
+		It("counts the observations", func() {
+			b := heatmap.NewBuilder(params)
+			b.Add(testing.SimpleTime(0), 10)
+			b.Add(testing.SimpleTime(10), 15)
+			b.Add(testing.SimpleTime(10), 25)
+			b.Add(testing.SimpleTime(60), 25)
+			b.Add(testing.SimpleTime(200), 25)
+
+			h := b.Build()
+			Expect(h.Values).To(Equal([][]uint64{{2, 1}, {0, 1}}))
+			Expect(h.MinDepth).To(Equal(uint64(1)))
+			Expect(h.MaxDepth).To(Equal(uint64(2)))
+			Expect(h.HeatmapParams()).To(Equal(params))
+		})
+	})
+})
@@ -0,0 +1,17 @@
+package heatmap_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	testing2 "github.com/grafana/pyroscope/pkg/og/testing"
+)
+
+func TestHeatmap(t *testing.T) {
+	testing2.SetupLogging()
+
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Heatmap Suite")
+}
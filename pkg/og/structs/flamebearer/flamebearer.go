@@ -40,6 +40,19 @@ type FlamebearerProfileV1 struct {
 	LeftTicks uint64 `json:"leftTicks,omitempty"`
 	// Number of samples in the right / diff profile. Only used in "double" format.
 	RightTicks uint64 `json:"rightTicks,omitempty"`
+	// Per-node statistics of the diff. Only used in "double" format.
+	DiffStats *FlamebearerDiffStatsV1 `json:"diffStats,omitempty"`
+}
+
+// FlamebearerDiffStatsV1 defines per-node statistics of a diff.
+// Each level contains one value per node of the corresponding flamebearer level.
+type FlamebearerDiffStatsV1 struct {
+	// Factor the left profile values have been multiplied by.
+	LeftFactor float64 `json:"leftFactor"`
+	// Relative change of the node total: (right - left) / max(left, right).
+	RelativeChange [][]float64 `json:"relativeChange"`
+	// Significance of the change, in the range [0, 1].
+	Significance [][]float64 `json:"significance"`
 }
 
 // swagger:model
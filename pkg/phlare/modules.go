@@ -32,10 +32,13 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 	"gopkg.in/yaml.v3"
 
+	"github.com/grafana/pyroscope/api/gen/proto/go/querier/v1/querierv1connect"
 	statusv1 "github.com/grafana/pyroscope/api/gen/proto/go/status/v1"
 	"github.com/grafana/pyroscope/pkg/adhocprofiles"
+	connectapi "github.com/grafana/pyroscope/pkg/api/connect"
 	apiversion "github.com/grafana/pyroscope/pkg/api/version"
 	"github.com/grafana/pyroscope/pkg/compactor"
+	"github.com/grafana/pyroscope/pkg/debuginfo"
 	"github.com/grafana/pyroscope/pkg/distributor"
 	"github.com/grafana/pyroscope/pkg/frontend"
 	"github.com/grafana/pyroscope/pkg/ingester"
@@ -43,8 +46,10 @@ import (
 	"github.com/grafana/pyroscope/pkg/objstore/providers/filesystem"
 	"github.com/grafana/pyroscope/pkg/operations"
 	phlarecontext "github.com/grafana/pyroscope/pkg/phlare/context"
+	"github.com/grafana/pyroscope/pkg/phlaredb"
 	"github.com/grafana/pyroscope/pkg/querier"
 	"github.com/grafana/pyroscope/pkg/querier/worker"
+	"github.com/grafana/pyroscope/pkg/ruler"
 	"github.com/grafana/pyroscope/pkg/scheduler"
 	"github.com/grafana/pyroscope/pkg/settings"
 	"github.com/grafana/pyroscope/pkg/storegateway"
@@ -79,6 +84,9 @@ const (
 	Admin             string = "admin"
 	TenantSettings    string = "tenant-settings"
 	AdHocProfiles     string = "ad-hoc-profiles"
+	DebugInfo         string = "debuginfo"
+	Symbolizer        string = "symbolizer"
+	Ruler             string = "ruler"
 
 	// QueryFrontendTripperware string = "query-frontend-tripperware"
 	// IndexGateway             string = "index-gateway"
@@ -100,6 +108,7 @@ func (f *Phlare) initQueryFrontend() (services.Service, error) {
 	if f.Cfg.Frontend.Port == 0 {
 		f.Cfg.Frontend.Port = f.Cfg.Server.HTTPListenPort
 	}
+	f.Cfg.Frontend.QueryStoreAfter = f.Cfg.Querier.QueryStoreAfter
 
 	frontendSvc, err := frontend.NewFrontend(f.Cfg.Frontend, f.Overrides, log.With(f.logger, "component", "frontend"), f.reg)
 	if err != nil {
@@ -176,6 +185,54 @@ func (f *Phlare) initAdHocProfiles() (services.Service, error) {
 	return a, nil
 }
 
+// initSymbolizer sets up the symbolizer used by the components querying
+// the profiles. The debug info upload and download API is served by the
+// debuginfo target only.
+func (f *Phlare) initSymbolizer() (services.Service, error) {
+	logger := log.With(f.logger, "component", Symbolizer)
+	symbolizer, err := debuginfo.NewSymbolizer(f.Cfg.DebugInfo, f.storageBucket, logger)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to init symbolizer")
+	}
+	if symbolizer != nil {
+		f.symbolizer = symbolizer
+	}
+	return nil, nil
+}
+
+func (f *Phlare) initDebugInfo() (services.Service, error) {
+	if f.storageBucket == nil {
+		level.Warn(f.logger).Log("msg", "no storage bucket configured, debug info upload is disabled")
+		return nil, nil
+	}
+	logger := log.With(f.logger, "component", DebugInfo)
+	d := debuginfo.New(f.Cfg.DebugInfo, f.storageBucket, logger)
+	f.API.RegisterDebugInfo(d)
+	return d, nil
+}
+
+func (f *Phlare) initRuler() (services.Service, error) {
+	if f.storageBucket == nil {
+		return nil, errors.New("storage bucket configuration is required to run the ruler")
+	}
+	f.Cfg.Ruler.Ring.ListenPort = f.Cfg.Server.HTTPListenPort
+	queryAddress := f.Cfg.Ruler.QueryAddress
+	if queryAddress == "" {
+		queryAddress = fmt.Sprintf("http://127.0.0.1:%d", f.Cfg.Server.HTTPListenPort)
+	}
+	querierClient := querierv1connect.NewQuerierServiceClient(
+		&http.Client{Timeout: f.Cfg.Ruler.QueryTimeout},
+		queryAddress,
+		append(connectapi.DefaultClientOptions(), f.auth)...,
+	)
+	r, err := ruler.New(f.Cfg.Ruler, f.storageBucket, querierClient, log.With(f.logger, "component", Ruler), f.reg)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to init ruler")
+	}
+	f.API.RegisterRuler(r)
+	return r, nil
+}
+
 func (f *Phlare) initOverrides() (serv services.Service, err error) {
 	f.Overrides, err = validation.NewOverrides(f.Cfg.LimitsConfig, f.TenantLimits)
 	// overrides don't have operational state, nor do they need to do anything more in starting/stopping phase,
@@ -266,11 +323,16 @@ func (f *Phlare) initQuerier() (services.Service, error) {
 		return nil, err
 	}
 
+	var svc querier.QuerierSvc = querierSvc
+	if f.Cfg.Querier.TenantFederation.Enabled {
+		svc = querier.NewTenantFederation(f.Cfg.Querier.TenantFederation, querierSvc, f.Overrides)
+	}
+
 	if !f.isModuleActive(QueryFrontend) {
-		f.API.RegisterPyroscopeHandlers(querierSvc)
-		f.API.RegisterQuerier(querierSvc)
+		f.API.RegisterPyroscopeHandlers(svc)
+		f.API.RegisterQuerier(svc)
 	}
-	qWorker, err := worker.NewQuerierWorker(f.Cfg.Worker, querier.NewGRPCHandler(querierSvc), log.With(f.logger, "component", "querier-worker"), f.reg)
+	qWorker, err := worker.NewQuerierWorker(f.Cfg.Worker, querier.NewGRPCHandler(svc), log.With(f.logger, "component", "querier-worker"), f.reg)
 	if err != nil {
 		return nil, err
 	}
@@ -323,7 +385,7 @@ func (f *Phlare) initDistributor() (services.Service, error) {
 		return nil, err
 	}
 
-	f.API.RegisterDistributor(d)
+	f.API.RegisterDistributor(d, f.Cfg.Server.GRPCServerMaxRecvMsgSize)
 	return d, nil
 }
 
@@ -351,6 +413,7 @@ func (f *Phlare) initMemberlistKV() (services.Service, error) {
 	f.Cfg.OverridesExporter.Ring.Ring.KVStore.MemberlistKV = f.MemberlistKV.GetMemberlistKV
 	f.Cfg.StoreGateway.ShardingRing.Ring.KVStore.MemberlistKV = f.MemberlistKV.GetMemberlistKV
 	f.Cfg.Compactor.ShardingRing.Common.KVStore.MemberlistKV = f.MemberlistKV.GetMemberlistKV
+	f.Cfg.Ruler.Ring.KVStore.MemberlistKV = f.MemberlistKV.GetMemberlistKV
 	f.Cfg.Frontend.QuerySchedulerDiscovery = f.Cfg.QueryScheduler.ServiceDiscovery
 	f.Cfg.Worker.QuerySchedulerDiscovery = f.Cfg.QueryScheduler.ServiceDiscovery
 
@@ -403,7 +466,11 @@ func (f *Phlare) context() context.Context {
 func (f *Phlare) initIngester() (_ services.Service, err error) {
 	f.Cfg.Ingester.LifecyclerConfig.ListenPort = f.Cfg.Server.HTTPListenPort
 
-	svc, err := ingester.New(f.context(), f.Cfg.Ingester, f.Cfg.PhlareDB, f.storageBucket, f.Overrides, f.Cfg.Querier.QueryStoreAfter)
+	phlarectx := f.context()
+	if f.symbolizer != nil {
+		phlarectx = phlaredb.ContextWithSymbolizer(phlarectx, f.symbolizer)
+	}
+	svc, err := ingester.New(phlarectx, f.Cfg.Ingester, f.Cfg.PhlareDB, f.storageBucket, f.Overrides, f.Cfg.Querier.QueryStoreAfter)
 	if err != nil {
 		return nil, err
 	}
@@ -420,7 +487,7 @@ func (f *Phlare) initStoreGateway() (serv services.Service, err error) {
 		return nil, nil
 	}
 
-	svc, err := storegateway.NewStoreGateway(f.Cfg.StoreGateway, f.storageBucket, f.Overrides, f.logger, f.reg)
+	svc, err := storegateway.NewStoreGateway(f.Cfg.StoreGateway, f.storageBucket, f.Overrides, f.symbolizer, f.logger, f.reg)
 	if err != nil {
 		return nil, err
 	}
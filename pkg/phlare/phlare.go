@@ -11,6 +11,7 @@ import (
 	"os"
 	"runtime"
 	"runtime/debug"
+	"slices"
 	"sort"
 	"strings"
 
@@ -41,6 +42,7 @@ import (
 	apiversion "github.com/grafana/pyroscope/pkg/api/version"
 	"github.com/grafana/pyroscope/pkg/cfg"
 	"github.com/grafana/pyroscope/pkg/compactor"
+	"github.com/grafana/pyroscope/pkg/debuginfo"
 	"github.com/grafana/pyroscope/pkg/distributor"
 	"github.com/grafana/pyroscope/pkg/frontend"
 	"github.com/grafana/pyroscope/pkg/ingester"
@@ -49,8 +51,10 @@ import (
 	"github.com/grafana/pyroscope/pkg/operations"
 	phlarecontext "github.com/grafana/pyroscope/pkg/phlare/context"
 	"github.com/grafana/pyroscope/pkg/phlaredb"
+	"github.com/grafana/pyroscope/pkg/phlaredb/symdb"
 	"github.com/grafana/pyroscope/pkg/querier"
 	"github.com/grafana/pyroscope/pkg/querier/worker"
+	"github.com/grafana/pyroscope/pkg/ruler"
 	"github.com/grafana/pyroscope/pkg/scheduler"
 	"github.com/grafana/pyroscope/pkg/scheduler/schedulerdiscovery"
 	"github.com/grafana/pyroscope/pkg/storegateway"
@@ -81,6 +85,8 @@ type Config struct {
 	OverridesExporter exporter.Config        `yaml:"overrides_exporter" doc:"hidden"`
 	RuntimeConfig     runtimeconfig.Config   `yaml:"runtime_config"`
 	Compactor         compactor.Config       `yaml:"compactor"`
+	DebugInfo         debuginfo.Config       `yaml:"debuginfo"`
+	Ruler             ruler.Config           `yaml:"ruler"`
 
 	Storage       StorageConfig       `yaml:"storage"`
 	SelfProfiling SelfProfilingConfig `yaml:"self_profiling,omitempty"`
@@ -148,6 +154,8 @@ func (c *Config) RegisterFlagsWithContext(ctx context.Context, f *flag.FlagSet)
 	c.Analytics.RegisterFlags(f)
 	c.LimitsConfig.RegisterFlags(f)
 	c.Compactor.RegisterFlags(f, log.NewLogfmtLogger(os.Stderr))
+	c.DebugInfo.RegisterFlags(f)
+	c.Ruler.RegisterFlags(f, log.NewLogfmtLogger(os.Stderr))
 	c.API.RegisterFlags(f)
 }
 
@@ -186,6 +194,21 @@ func (c *Config) Validate() error {
 	if err := c.Compactor.Validate(c.PhlareDB.MaxBlockDuration); err != nil {
 		return err
 	}
+	if err := c.Frontend.ResultsCache.Validate(); err != nil {
+		return err
+	}
+	if err := c.StoreGateway.BucketStoreConfig.Validate(util.Logger); err != nil {
+		return err
+	}
+	if slices.Contains(c.Target, Ruler) {
+		if err := c.Ruler.Validate(); err != nil {
+			return err
+		}
+		// The local server only serves the queries in single-binary mode.
+		if c.Ruler.QueryAddress == "" && !slices.Contains(c.Target, All) {
+			return errors.New("ruler query address is required, unless the ruler runs in single-binary mode")
+		}
+	}
 	return c.Ingester.Validate()
 }
 
@@ -236,6 +259,7 @@ type Phlare struct {
 	TenantLimits validation.TenantLimits
 
 	storageBucket phlareobj.Bucket
+	symbolizer    symdb.Symbolizer
 
 	grpcGatewayMux *grpcgw.ServeMux
 
@@ -308,10 +332,13 @@ func (f *Phlare) setupModuleManager() error {
 	mm.RegisterModule(All, nil)
 	mm.RegisterModule(TenantSettings, f.initTenantSettings)
 	mm.RegisterModule(AdHocProfiles, f.initAdHocProfiles)
+	mm.RegisterModule(DebugInfo, f.initDebugInfo)
+	mm.RegisterModule(Symbolizer, f.initSymbolizer, modules.UserInvisibleModule)
+	mm.RegisterModule(Ruler, f.initRuler)
 
 	// Add dependencies
 	deps := map[string][]string{
-		All: {Ingester, Distributor, QueryScheduler, QueryFrontend, Querier, StoreGateway, Admin, TenantSettings, Compactor, AdHocProfiles},
+		All: {Ingester, Distributor, QueryScheduler, QueryFrontend, Querier, StoreGateway, Admin, TenantSettings, Compactor, AdHocProfiles, DebugInfo},
 
 		Server:            {GRPCGateway},
 		API:               {Server},
@@ -319,8 +346,8 @@ func (f *Phlare) setupModuleManager() error {
 		Querier:           {Overrides, API, MemberlistKV, Ring, UsageReport, Version},
 		QueryFrontend:     {OverridesExporter, API, MemberlistKV, UsageReport, Version},
 		QueryScheduler:    {Overrides, API, MemberlistKV, UsageReport},
-		Ingester:          {Overrides, API, MemberlistKV, Storage, UsageReport, Version},
-		StoreGateway:      {API, Storage, Overrides, MemberlistKV, UsageReport, Admin, Version},
+		Ingester:          {Overrides, API, MemberlistKV, Storage, Symbolizer, UsageReport, Version},
+		StoreGateway:      {API, Storage, Symbolizer, Overrides, MemberlistKV, UsageReport, Admin, Version},
 		Compactor:         {API, Storage, Overrides, MemberlistKV, UsageReport},
 		UsageReport:       {Storage, MemberlistKV},
 		Overrides:         {RuntimeConfig},
@@ -332,6 +359,9 @@ func (f *Phlare) setupModuleManager() error {
 		Version:           {API, MemberlistKV},
 		TenantSettings:    {API, Storage},
 		AdHocProfiles:     {API, Overrides, Storage},
+		DebugInfo:         {API, Storage},
+		Symbolizer:        {Storage},
+		Ruler:             {API, Storage, MemberlistKV},
 	}
 
 	for mod, targets := range deps {
@@ -80,3 +80,28 @@ func TestConfigDiff(t *testing.T) {
 		require.Equal(t, "limits:\n    max_label_name_length: 123\n", string(result.Data))
 	})
 }
+
+func TestConfigValidate_Ruler(t *testing.T) {
+	newConfig := func(targets ...string) *Config {
+		c := &Config{}
+		c.RegisterFlags(flag.NewFlagSet("test", flag.PanicOnError))
+		c.Target = targets
+		return c
+	}
+
+	c := newConfig(All)
+	require.NoError(t, c.Validate())
+
+	// The ruler configuration is only validated, if the ruler is enabled.
+	c = newConfig(All, Ruler)
+	require.ErrorContains(t, c.Validate(), "remote write URL")
+	c.Ruler.RemoteWriteURL = "http://mimir:8080/api/v1/push"
+	require.NoError(t, c.Validate())
+
+	// In microservices mode, the query address can't default to the local server.
+	c = newConfig(Ruler)
+	c.Ruler.RemoteWriteURL = "http://mimir:8080/api/v1/push"
+	require.ErrorContains(t, c.Validate(), "query address")
+	c.Ruler.QueryAddress = "http://query-frontend:4040"
+	require.NoError(t, c.Validate())
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/go-kit/log"
 	"github.com/go-kit/log/level"
 	"github.com/gogo/status"
+	"github.com/google/uuid"
 	"github.com/grafana/dskit/multierror"
 	"github.com/grafana/dskit/runutil"
 	"github.com/oklog/ulid"
@@ -25,7 +26,6 @@ import (
 	"github.com/parquet-go/parquet-go"
 	"github.com/pkg/errors"
 	"github.com/prometheus/common/model"
-	"github.com/prometheus/prometheus/promql/parser"
 	"github.com/prometheus/prometheus/storage"
 	"github.com/samber/lo"
 	"golang.org/x/sync/errgroup"
@@ -300,6 +300,9 @@ type singleBlockQuerier struct {
 	index    *index.Reader
 	profiles map[profileTableKey]*parquetReader[*schemav1.ProfilePersister]
 	symbols  symbolsResolver
+
+	symbolizer symdb.Symbolizer
+	tombstones TombstonesProvider
 }
 
 type profileTableKey struct {
@@ -314,6 +317,9 @@ func NewSingleBlockQuerierFromMeta(phlarectx context.Context, bucketReader phlar
 		profiles: make(map[profileTableKey]*parquetReader[*schemav1.ProfilePersister], 3),
 		bucket:   phlareobj.NewPrefixedBucket(bucketReader, meta.ULID.String()),
 		meta:     meta,
+
+		symbolizer: symbolizerFromContext(phlarectx),
+		tombstones: tombstonesFromContext(phlarectx),
 	}
 	for _, f := range meta.Files {
 		k, ok := parseProfileTableName(f.RelPath)
@@ -380,6 +386,13 @@ func (b *singleBlockQuerier) LabelValues(ctx context.Context, req *connect.Reque
 	defer sp.Finish()
 
 	params := req.Msg
+	if t := b.seriesTombstones(params.Start, params.End); t != nil {
+		resp, err := labelValuesWithTombstones(ctx, blockSeries{b}, t, params)
+		if err != nil {
+			return nil, err
+		}
+		return connect.NewResponse(resp), nil
+	}
 
 	if err := b.Open(ctx); err != nil {
 		return nil, err
@@ -448,6 +461,13 @@ func (b *singleBlockQuerier) LabelNames(ctx context.Context, req *connect.Reques
 	defer sp.Finish()
 
 	params := req.Msg
+	if t := b.seriesTombstones(params.Start, params.End); t != nil {
+		resp, err := labelNamesWithTombstones(ctx, blockSeries{b}, t, params)
+		if err != nil {
+			return nil, err
+		}
+		return connect.NewResponse(resp), nil
+	}
 
 	if err := b.Open(ctx); err != nil {
 		return nil, err
@@ -566,14 +586,18 @@ type Querier interface {
 	Open(ctx context.Context) error
 	Sort([]Profile) []Profile
 
-	MergeByStacktraces(ctx context.Context, rows iter.Iterator[Profile], maxNodes int64) (*phlaremodel.Tree, error)
+	MergeByStacktraces(ctx context.Context, rows iter.Iterator[Profile], maxNodes int64, sts *typesv1.StackTraceSelector) (*phlaremodel.Tree, error)
 	MergeBySpans(ctx context.Context, rows iter.Iterator[Profile], spans phlaremodel.SpanSelector) (*phlaremodel.Tree, error)
 	MergeByLabels(ctx context.Context, rows iter.Iterator[Profile], s *typesv1.StackTraceSelector, by ...string) ([]*typesv1.Series, error)
 	MergePprof(ctx context.Context, rows iter.Iterator[Profile], maxNodes int64, s *typesv1.StackTraceSelector) (*profilev1.Profile, error)
+	MergeTopFunctions(ctx context.Context, rows iter.Iterator[Profile], grouping typesv1.TopFunctionsGrouping, filter *typesv1.TopFunctionsFilter, s *typesv1.StackTraceSelector) ([]*typesv1.TopFunction, error)
+	MergeProfileValues(ctx context.Context, rows iter.Iterator[Profile]) ([]*ingestv1.ProfileValues, error)
+	// FindProfileByID returns the profile with the given ID, or nil if none of the rows matches.
+	FindProfileByID(ctx context.Context, rows iter.Iterator[Profile], id uuid.UUID) (Profile, error)
 	Series(ctx context.Context, params *ingestv1.SeriesRequest) ([]*typesv1.Labels, error)
 
 	SelectMatchingProfiles(ctx context.Context, params *ingestv1.SelectProfilesRequest) (iter.Iterator[Profile], error)
-	SelectMergeByStacktraces(ctx context.Context, params *ingestv1.SelectProfilesRequest, maxNodes int64) (*phlaremodel.Tree, error)
+	SelectMergeByStacktraces(ctx context.Context, params *ingestv1.SelectProfilesRequest, maxNodes int64, sts *typesv1.StackTraceSelector) (*phlaremodel.Tree, error)
 	SelectMergeByLabels(ctx context.Context, params *ingestv1.SelectProfilesRequest, s *typesv1.StackTraceSelector, by ...string) ([]*typesv1.Series, error)
 	SelectMergeBySpans(ctx context.Context, params *ingestv1.SelectSpanProfileRequest) (*phlaremodel.Tree, error)
 	SelectMergePprof(ctx context.Context, params *ingestv1.SelectProfilesRequest, maxNodes int64, s *typesv1.StackTraceSelector) (*profilev1.Profile, error)
@@ -607,6 +631,9 @@ type ReadAPI interface {
 	MergeProfilesLabels(context.Context, *connect.BidiStream[ingestv1.MergeProfilesLabelsRequest, ingestv1.MergeProfilesLabelsResponse]) error
 	MergeProfilesPprof(context.Context, *connect.BidiStream[ingestv1.MergeProfilesPprofRequest, ingestv1.MergeProfilesPprofResponse]) error
 	MergeSpanProfile(context.Context, *connect.BidiStream[ingestv1.MergeSpanProfileRequest, ingestv1.MergeSpanProfileResponse]) error
+	MergeProfilesTopFunctions(context.Context, *connect.BidiStream[ingestv1.MergeProfilesTopFunctionsRequest, ingestv1.MergeProfilesTopFunctionsResponse]) error
+	MergeProfilesValues(context.Context, *connect.BidiStream[ingestv1.MergeProfilesValuesRequest, ingestv1.MergeProfilesValuesResponse]) error
+	GetProfileByID(context.Context, *connect.Request[ingestv1.GetProfileByIDRequest]) (*connect.Response[ingestv1.GetProfileByIDResponse], error)
 }
 
 var _ ReadAPI = make(Queriers, 0)
@@ -713,6 +740,22 @@ func (queriers Queriers) MergeSpanProfile(ctx context.Context, stream *connect.B
 	return MergeSpanProfile(ctx, stream, queriers.forTimeRange)
 }
 
+func (queriers Queriers) MergeProfilesTopFunctions(ctx context.Context, stream *connect.BidiStream[ingestv1.MergeProfilesTopFunctionsRequest, ingestv1.MergeProfilesTopFunctionsResponse]) error {
+	return MergeProfilesTopFunctions(ctx, stream, queriers.forTimeRange)
+}
+
+func (queriers Queriers) MergeProfilesValues(ctx context.Context, stream *connect.BidiStream[ingestv1.MergeProfilesValuesRequest, ingestv1.MergeProfilesValuesResponse]) error {
+	return MergeProfilesValues(ctx, stream, queriers.forTimeRange)
+}
+
+func (queriers Queriers) GetProfileByID(ctx context.Context, req *connect.Request[ingestv1.GetProfileByIDRequest]) (*connect.Response[ingestv1.GetProfileByIDResponse], error) {
+	res, err := GetProfileByID(ctx, req.Msg, queriers.forTimeRange)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
 type BlockGetter func(ctx context.Context, start, end model.Time, hints *ingestv1.Hints) (Queriers, error)
 
 func (queriers Queriers) forTimeRange(_ context.Context, start, end model.Time, hints *ingestv1.Hints) (Queriers, error) {
@@ -835,7 +878,7 @@ func MergeProfilesStacktraces(ctx context.Context, stream *connect.BidiStream[in
 			querier := querier
 			g.Go(util.RecoverPanic(func() error {
 				// TODO(simonswine): Split profiles per row group and run the MergeByStacktraces in parallel.
-				merge, err := querier.SelectMergeByStacktraces(ctx, request, r.GetMaxNodes())
+				merge, err := querier.SelectMergeByStacktraces(ctx, request, r.GetMaxNodes(), r.GetStackTraceSelector())
 				if err != nil {
 					return err
 				}
@@ -871,7 +914,7 @@ func MergeProfilesStacktraces(ctx context.Context, stream *connect.BidiStream[in
 			// Sort profiles for better read locality.
 			// Merge async the result so we can continue streaming profiles.
 			g.Go(util.RecoverPanic(func() error {
-				merge, err := querier.MergeByStacktraces(ctx, iter.NewSliceIterator(querier.Sort(selectedProfiles[i])), r.GetMaxNodes())
+				merge, err := querier.MergeByStacktraces(ctx, iter.NewSliceIterator(querier.Sort(selectedProfiles[i])), r.GetMaxNodes(), r.GetStackTraceSelector())
 				if err != nil {
 					return err
 				}
@@ -1314,6 +1357,207 @@ func MergeProfilesPprof(ctx context.Context, stream *connect.BidiStream[ingestv1
 	return nil
 }
 
+func MergeProfilesTopFunctions(ctx context.Context, stream *connect.BidiStream[ingestv1.MergeProfilesTopFunctionsRequest, ingestv1.MergeProfilesTopFunctionsResponse], blockGetter BlockGetter) error {
+	sp, ctx := opentracing.StartSpanFromContext(ctx, "MergeProfilesTopFunctions")
+	defer sp.Finish()
+
+	r, err := stream.Receive()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return connect.NewError(connect.CodeCanceled, errors.New("client closed stream"))
+		}
+		return err
+	}
+
+	if r.Request == nil {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("missing initial select request"))
+	}
+	sp.SetTag("grouping", r.Grouping.String())
+
+	result := phlaremodel.NewTopFunctionsMerger()
+	err = mergeSelectedProfiles[*ingestv1.MergeProfilesTopFunctionsResponse, *ingestv1.MergeProfilesTopFunctionsRequest](
+		ctx, stream, &ingestv1.MergeProfilesTopFunctionsResponse{}, r.Request, blockGetter,
+		func(ctx context.Context, querier Querier, profiles iter.Iterator[Profile]) error {
+			functions, err := querier.MergeTopFunctions(ctx, profiles, r.Grouping, r.Filter, r.StackTraceSelector)
+			if err != nil {
+				return err
+			}
+			result.MergeTopFunctions(functions)
+			return nil
+		})
+	if err != nil {
+		return err
+	}
+
+	// sends the final result to the client.
+	functions := result.TopFunctions()
+	sp.LogFields(
+		otlog.String("msg", "sending the final result to the client"),
+		otlog.Int("functions", len(functions)),
+	)
+	err = stream.Send(&ingestv1.MergeProfilesTopFunctionsResponse{
+		Result: &ingestv1.MergeProfilesTopFunctionsResult{Functions: functions},
+	})
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return connect.NewError(connect.CodeCanceled, errors.New("client closed stream"))
+		}
+		return err
+	}
+
+	return nil
+}
+
+func MergeProfilesValues(ctx context.Context, stream *connect.BidiStream[ingestv1.MergeProfilesValuesRequest, ingestv1.MergeProfilesValuesResponse], blockGetter BlockGetter) error {
+	sp, ctx := opentracing.StartSpanFromContext(ctx, "MergeProfilesValues")
+	defer sp.Finish()
+
+	r, err := stream.Receive()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return connect.NewError(connect.CodeCanceled, errors.New("client closed stream"))
+		}
+		return err
+	}
+
+	if r.Request == nil {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("missing initial select request"))
+	}
+	sp.SetTag("max_profiles", r.MaxProfiles)
+
+	var (
+		resultMu sync.Mutex
+		result   []*ingestv1.ProfileValues
+		profiles int64
+	)
+	err = mergeSelectedProfiles[*ingestv1.MergeProfilesValuesResponse, *ingestv1.MergeProfilesValuesRequest](
+		ctx, stream, &ingestv1.MergeProfilesValuesResponse{}, r.Request, blockGetter,
+		func(ctx context.Context, querier Querier, rows iter.Iterator[Profile]) error {
+			values, err := querier.MergeProfileValues(ctx, rows)
+			if err != nil {
+				return err
+			}
+			resultMu.Lock()
+			defer resultMu.Unlock()
+			for _, v := range values {
+				profiles += int64(len(v.Profiles))
+			}
+			if r.MaxProfiles > 0 && profiles > r.MaxProfiles {
+				return connect.NewError(connect.CodeResourceExhausted,
+					fmt.Errorf("the query matches more than %d profiles", r.MaxProfiles))
+			}
+			result = append(result, values...)
+			return nil
+		})
+	if err != nil {
+		return err
+	}
+
+	// sends the final result to the client.
+	sp.LogFields(
+		otlog.String("msg", "sending the final result to the client"),
+		otlog.Int("series", len(result)),
+		otlog.Int64("profiles", profiles),
+	)
+	err = stream.Send(&ingestv1.MergeProfilesValuesResponse{Result: result})
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return connect.NewError(connect.CodeCanceled, errors.New("client closed stream"))
+		}
+		return err
+	}
+
+	return nil
+}
+
+// mergeSelectedProfiles selects the profiles matching the request from the
+// queriers and calls merge concurrently for each of them. If the profiles need
+// to be deduplicated, the selection is streamed to the client for filtering.
+// The end of the profile streaming is signaled to the client with the empty
+// response; the final result is to be sent by the caller.
+func mergeSelectedProfiles[Res filterResponse, Req filterRequest](
+	ctx context.Context,
+	stream BidiServerMerge[Res, Req],
+	empty Res,
+	request *ingestv1.SelectProfilesRequest,
+	blockGetter BlockGetter,
+	merge func(context.Context, Querier, iter.Iterator[Profile]) error,
+) error {
+	sp := opentracing.SpanFromContext(ctx)
+	sp.SetTag("start", model.Time(request.Start).Time().String()).
+		SetTag("end", model.Time(request.End).Time().String()).
+		SetTag("selector", request.LabelSelector).
+		SetTag("profile_type", request.Type.ID)
+	sp.LogFields(otlog.Object("hints", request.Hints))
+
+	queriers, err := blockGetter(ctx, model.Time(request.Start), model.Time(request.End), request.Hints)
+	if err != nil {
+		return err
+	}
+
+	deduplicationNeeded := true
+	if request.Hints != nil && request.Hints.Block != nil {
+		deduplicationNeeded = request.Hints.Block.Deduplication
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+
+	// depending on if new need deduplication or not there are two different code paths.
+	if !deduplicationNeeded {
+		// signal the end of the profile streaming by sending an empty response.
+		sp.LogFields(otlog.String("msg", "no profile streaming as no deduplication needed"))
+		if err = stream.Send(empty); err != nil {
+			return err
+		}
+
+		// in this path we can just merge the profiles from each block and send the result to the client.
+		for _, querier := range queriers {
+			querier := querier
+			g.Go(util.RecoverPanic(func() error {
+				profiles, err := querier.SelectMatchingProfiles(ctx, request)
+				if err != nil {
+					return err
+				}
+				return merge(ctx, querier, profiles)
+			}))
+		}
+	} else {
+		// in this path we have to go thorugh every profile and deduplicate them.
+		iters, err := SelectMatchingProfiles(ctx, request, queriers)
+		if err != nil {
+			return err
+		}
+
+		// send batches of profiles to client and filter via bidi stream.
+		selectedProfiles, err := filterProfiles[BidiServerMerge[Res, Req], Res, Req](ctx, iters, defaultBatchSize, stream)
+		if err != nil {
+			return err
+		}
+
+		for i, querier := range queriers {
+			querier := querier
+			i := i
+			if len(selectedProfiles[i]) == 0 {
+				continue
+			}
+			// Sort profiles for better read locality.
+			// Merge async the result so we can continue streaming profiles.
+			g.Go(util.RecoverPanic(func() error {
+				return merge(ctx, querier, iter.NewSliceIterator(querier.Sort(selectedProfiles[i])))
+			}))
+		}
+
+		// Signals the end of the profile streaming by sending an empty response.
+		// This allows the client to not block other streaming ingesters.
+		sp.LogFields(otlog.String("msg", "signaling the end of the profile streaming"))
+		if err = stream.Send(empty); err != nil {
+			return err
+		}
+	}
+
+	return g.Wait()
+}
+
 func ProfileTypes(ctx context.Context, req *connect.Request[ingestv1.ProfileTypesRequest], blockGetter BlockGetter) (*connect.Response[ingestv1.ProfileTypesResponse], error) {
 	queriers, err := blockGetter(ctx, model.Time(req.Msg.Start), model.Time(req.Msg.End), nil)
 	if err != nil {
@@ -1424,6 +1668,59 @@ func LabelNames(ctx context.Context, req *connect.Request[typesv1.LabelNamesRequ
 	}, nil
 }
 
+// GetProfileByID looks up the profile with the given ID among the profiles
+// matching the request. The response has no profile if it is not found.
+func GetProfileByID(ctx context.Context, req *ingestv1.GetProfileByIDRequest, blockGetter BlockGetter) (*ingestv1.GetProfileByIDResponse, error) {
+	sp, ctx := opentracing.StartSpanFromContext(ctx, "GetProfileByID")
+	defer sp.Finish()
+
+	request := req.Request
+	if request == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("missing select request"))
+	}
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid profile id: %w", err))
+	}
+	sp.SetTag("start", model.Time(request.Start).Time().String()).
+		SetTag("end", model.Time(request.End).Time().String()).
+		SetTag("selector", request.LabelSelector).
+		SetTag("profile_type", request.Type.ID).
+		SetTag("id", req.Id)
+
+	queriers, err := blockGetter(ctx, model.Time(request.Start), model.Time(request.End), request.Hints)
+	if err != nil {
+		return nil, err
+	}
+	for _, q := range queriers {
+		p, err := getProfileByID(ctx, q, request, id)
+		if err != nil {
+			return nil, err
+		}
+		if p != nil {
+			return &ingestv1.GetProfileByIDResponse{Profile: p}, nil
+		}
+	}
+	return &ingestv1.GetProfileByIDResponse{}, nil
+}
+
+func getProfileByID(ctx context.Context, q Querier, req *ingestv1.SelectProfilesRequest, id uuid.UUID) (*profilev1.Profile, error) {
+	profiles, err := q.SelectMatchingProfiles(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	p, err := q.FindProfileByID(ctx, profiles, id)
+	if err != nil || p == nil {
+		return nil, err
+	}
+	result, err := q.MergePprof(ctx, iter.NewSliceIterator([]Profile{p}), 0, nil)
+	if err != nil {
+		return nil, err
+	}
+	pprof.SetProfileMetadata(result, req.Type, p.Timestamp().UnixNano(), 0)
+	return result, nil
+}
+
 func Series(ctx context.Context, req *ingestv1.SeriesRequest, blockGetter BlockGetter) (*ingestv1.SeriesResponse, error) {
 	queriers, err := blockGetter(ctx, model.Time(req.Start), model.Time(req.End), nil)
 	if err != nil {
@@ -1524,7 +1821,7 @@ func (b *singleBlockQuerier) SelectMatchingProfiles(ctx context.Context, params
 	b.queries.Add(1)
 	defer b.queries.Done()
 
-	matchers, err := parser.ParseMetricSelector(params.LabelSelector)
+	matchers, shard, err := parseShardedSelector(params.LabelSelector)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "failed to parse label selectors: "+err.Error())
 	}
@@ -1533,7 +1830,7 @@ func (b *singleBlockQuerier) SelectMatchingProfiles(ctx context.Context, params
 	}
 	matchers = append(matchers, phlaremodel.SelectorFromProfileType(params.Type))
 
-	postings, err := PostingsForMatchers(b.index, nil, matchers...)
+	postings, err := shardedPostingsForMatchers(b.index, shard, matchers...)
 	if err != nil {
 		return nil, err
 	}
@@ -1562,6 +1859,7 @@ func (b *singleBlockQuerier) SelectMatchingProfiles(ctx context.Context, params
 
 	}
 
+	deleted := b.deletedSeries()
 	var buf [][]parquet.Value
 
 	profiles := b.profileSourceTable()
@@ -1598,6 +1896,9 @@ func (b *singleBlockQuerier) SelectMatchingProfiles(ctx context.Context, params
 			}
 			currentSeriesSlice = make([]Profile, 0, 100)
 		}
+		if deleted != nil && deleted.tombstones.Deleted(lblsPerRef[seriesIndex].lbs, buf[1][0].Int64()) {
+			continue
+		}
 
 		currentSeriesSlice = append(currentSeriesSlice, BlockProfile{
 			labels:      lblsPerRef[seriesIndex].lbs,
@@ -1631,7 +1932,7 @@ func (b *singleBlockQuerier) SelectMergeByLabels(
 	b.queries.Add(1)
 	defer b.queries.Done()
 
-	matchers, err := parser.ParseMetricSelector(params.LabelSelector)
+	matchers, shard, err := parseShardedSelector(params.LabelSelector)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "failed to parse label selectors: "+err.Error())
 	}
@@ -1640,7 +1941,7 @@ func (b *singleBlockQuerier) SelectMergeByLabels(
 	}
 	matchers = append(matchers, phlaremodel.SelectorFromProfileType(params.Type))
 
-	postings, err := PostingsForMatchers(b.index, nil, matchers...)
+	postings, err := shardedPostingsForMatchers(b.index, shard, matchers...)
 	if err != nil {
 		return nil, err
 	}
@@ -1648,6 +1949,7 @@ func (b *singleBlockQuerier) SelectMergeByLabels(
 		chks       = make([]index.ChunkMeta, 1)
 		lblsPerRef = make(map[int64]labelsInfo)
 		lbls       = make(phlaremodel.Labels, 0, 6)
+		deleted    = b.deletedSeries()
 	)
 	// get all relevant labels/fingerprints
 	for postings.Next() {
@@ -1664,17 +1966,20 @@ func (b *singleBlockQuerier) SelectMergeByLabels(
 			}
 			copy(info.lbs, lbls)
 			lblsPerRef[int64(chks[0].SeriesIndex)] = info
+			if err = deleted.add(b.index, postings.At()); err != nil {
+				return nil, err
+			}
 		}
 	}
 
 	profiles := b.profileSourceTable()
-	it := query.NewBinaryJoinIterator(
+	it := deleted.filter(query.NewBinaryJoinIterator(
 		0,
 		profiles.columnIter(ctx, "SeriesIndex", query.NewMapPredicate(lblsPerRef), "SeriesIndex"),
 		profiles.columnIter(ctx, "TimeNanos", query.NewIntBetweenPredicate(model.Time(params.Start).UnixNano(), model.Time(params.End).UnixNano()), "TimeNanos"),
-	)
+	))
 
-	if len(sts.GetCallSite()) == 0 {
+	if !symdb.SelectsStackTraces(sts) {
 		columnName := "TotalValue"
 		if b.meta.Version == 1 {
 			columnName = "Samples.list.element.Value"
@@ -1699,7 +2004,7 @@ func (b *singleBlockQuerier) SelectMergeByLabels(
 	return mergeByLabelsWithStackTraceSelector[Profile](ctx, profiles.file, rows, r, by...)
 }
 
-func (b *singleBlockQuerier) SelectMergeByStacktraces(ctx context.Context, params *ingestv1.SelectProfilesRequest, maxNodes int64) (tree *phlaremodel.Tree, err error) {
+func (b *singleBlockQuerier) SelectMergeByStacktraces(ctx context.Context, params *ingestv1.SelectProfilesRequest, maxNodes int64, sts *typesv1.StackTraceSelector) (tree *phlaremodel.Tree, err error) {
 	sp, ctx := opentracing.StartSpanFromContext(ctx, "SelectMergeByStacktraces - Block")
 	defer sp.Finish()
 	sp.SetTag("block ULID", b.meta.ULID.String())
@@ -1711,7 +2016,7 @@ func (b *singleBlockQuerier) SelectMergeByStacktraces(ctx context.Context, param
 	b.queries.Add(1)
 	defer b.queries.Done()
 
-	matchers, err := parser.ParseMetricSelector(params.LabelSelector)
+	matchers, shard, err := parseShardedSelector(params.LabelSelector)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "failed to parse label selectors: "+err.Error())
 	}
@@ -1720,7 +2025,7 @@ func (b *singleBlockQuerier) SelectMergeByStacktraces(ctx context.Context, param
 	}
 	matchers = append(matchers, phlaremodel.SelectorFromProfileType(params.Type))
 
-	postings, err := PostingsForMatchers(b.index, nil, matchers...)
+	postings, err := shardedPostingsForMatchers(b.index, shard, matchers...)
 	if err != nil {
 		return nil, err
 	}
@@ -1728,6 +2033,7 @@ func (b *singleBlockQuerier) SelectMergeByStacktraces(ctx context.Context, param
 	var (
 		chks       = make([]index.ChunkMeta, 1)
 		lblsPerRef = make(map[int64]struct{})
+		deleted    = b.deletedSeries()
 	)
 
 	// get all relevant labels/fingerprints
@@ -1737,19 +2043,24 @@ func (b *singleBlockQuerier) SelectMergeByStacktraces(ctx context.Context, param
 			return nil, err
 		}
 		lblsPerRef[int64(chks[0].SeriesIndex)] = struct{}{}
+		if err = deleted.add(b.index, postings.At()); err != nil {
+			return nil, err
+		}
 	}
-	r := symdb.NewResolver(ctx, b.symbols, symdb.WithResolverMaxNodes(maxNodes))
+	r := symdb.NewResolver(ctx, b.symbols,
+		symdb.WithResolverMaxNodes(maxNodes),
+		symdb.WithResolverStackTraceSelector(sts))
 	defer r.Release()
 
 	g, ctx := errgroup.WithContext(ctx)
 	util.SplitTimeRangeByResolution(time.UnixMilli(params.Start), time.UnixMilli(params.End), b.downsampleResolutions(), func(tr util.TimeRange) {
 		g.Go(func() error {
 			profiles := b.profileTable(tr.Resolution, params.GetAggregation())
-			it := query.NewBinaryJoinIterator(
+			it := deleted.filter(query.NewBinaryJoinIterator(
 				0,
-				profiles.columnIter(ctx, "SeriesIndex", query.NewMapPredicate(lblsPerRef), ""),
-				profiles.columnIter(ctx, "TimeNanos", query.NewIntBetweenPredicate(tr.Start.UnixNano(), tr.End.UnixNano()), ""),
-			)
+				profiles.columnIter(ctx, "SeriesIndex", query.NewMapPredicate(lblsPerRef), deleted.alias("SeriesIndex")),
+				profiles.columnIter(ctx, "TimeNanos", query.NewIntBetweenPredicate(tr.Start.UnixNano(), tr.End.UnixNano()), deleted.alias("TimeNanos")),
+			))
 
 			if b.meta.Version >= 2 {
 				it = query.NewBinaryJoinIterator(0,
@@ -1780,7 +2091,7 @@ func (b *singleBlockQuerier) SelectMergeBySpans(ctx context.Context, params *ing
 	b.queries.Add(1)
 	defer b.queries.Done()
 
-	matchers, err := parser.ParseMetricSelector(params.LabelSelector)
+	matchers, shard, err := parseShardedSelector(params.LabelSelector)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "failed to parse label selectors: "+err.Error())
 	}
@@ -1793,7 +2104,7 @@ func (b *singleBlockQuerier) SelectMergeBySpans(ctx context.Context, params *ing
 	}
 	matchers = append(matchers, phlaremodel.SelectorFromProfileType(params.Type))
 
-	postings, err := PostingsForMatchers(b.index, nil, matchers...)
+	postings, err := shardedPostingsForMatchers(b.index, shard, matchers...)
 	if err != nil {
 		return nil, err
 	}
@@ -1801,6 +2112,7 @@ func (b *singleBlockQuerier) SelectMergeBySpans(ctx context.Context, params *ing
 	var (
 		chks       = make([]index.ChunkMeta, 1)
 		lblsPerRef = make(map[int64]struct{})
+		deleted    = b.deletedSeries()
 	)
 
 	// get all relevant labels/fingerprints
@@ -1810,16 +2122,19 @@ func (b *singleBlockQuerier) SelectMergeBySpans(ctx context.Context, params *ing
 			return nil, err
 		}
 		lblsPerRef[int64(chks[0].SeriesIndex)] = struct{}{}
+		if err = deleted.add(b.index, postings.At()); err != nil {
+			return nil, err
+		}
 	}
 	r := symdb.NewResolver(ctx, b.symbols)
 	defer r.Release()
 
 	profiles := b.profileSourceTable()
-	it := query.NewBinaryJoinIterator(
+	it := deleted.filter(query.NewBinaryJoinIterator(
 		0,
-		profiles.columnIter(ctx, "SeriesIndex", query.NewMapPredicate(lblsPerRef), ""),
-		profiles.columnIter(ctx, "TimeNanos", query.NewIntBetweenPredicate(model.Time(params.Start).UnixNano(), model.Time(params.End).UnixNano()), ""),
-	)
+		profiles.columnIter(ctx, "SeriesIndex", query.NewMapPredicate(lblsPerRef), deleted.alias("SeriesIndex")),
+		profiles.columnIter(ctx, "TimeNanos", query.NewIntBetweenPredicate(model.Time(params.Start).UnixNano(), model.Time(params.End).UnixNano()), deleted.alias("TimeNanos")),
+	))
 
 	if b.meta.Version >= 2 {
 		it = query.NewBinaryJoinIterator(0,
@@ -1848,7 +2163,7 @@ func (b *singleBlockQuerier) SelectMergePprof(ctx context.Context, params *inges
 	b.queries.Add(1)
 	defer b.queries.Done()
 
-	matchers, err := parser.ParseMetricSelector(params.LabelSelector)
+	matchers, shard, err := parseShardedSelector(params.LabelSelector)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "failed to parse label selectors: "+err.Error())
 	}
@@ -1857,7 +2172,7 @@ func (b *singleBlockQuerier) SelectMergePprof(ctx context.Context, params *inges
 	}
 	matchers = append(matchers, phlaremodel.SelectorFromProfileType(params.Type))
 
-	postings, err := PostingsForMatchers(b.index, nil, matchers...)
+	postings, err := shardedPostingsForMatchers(b.index, shard, matchers...)
 	if err != nil {
 		return nil, err
 	}
@@ -1865,6 +2180,7 @@ func (b *singleBlockQuerier) SelectMergePprof(ctx context.Context, params *inges
 	var (
 		chks       = make([]index.ChunkMeta, 1)
 		lblsPerRef = make(map[int64]struct{})
+		deleted    = b.deletedSeries()
 	)
 
 	// get all relevant labels/fingerprints
@@ -1874,6 +2190,9 @@ func (b *singleBlockQuerier) SelectMergePprof(ctx context.Context, params *inges
 			return nil, err
 		}
 		lblsPerRef[int64(chks[0].SeriesIndex)] = struct{}{}
+		if err = deleted.add(b.index, postings.At()); err != nil {
+			return nil, err
+		}
 	}
 	r := symdb.NewResolver(ctx, b.symbols,
 		symdb.WithResolverMaxNodes(maxNodes),
@@ -1884,11 +2203,11 @@ func (b *singleBlockQuerier) SelectMergePprof(ctx context.Context, params *inges
 	util.SplitTimeRangeByResolution(time.UnixMilli(params.Start), time.UnixMilli(params.End), b.downsampleResolutions(), func(tr util.TimeRange) {
 		g.Go(func() error {
 			profiles := b.profileTable(tr.Resolution, params.GetAggregation())
-			it := query.NewBinaryJoinIterator(
+			it := deleted.filter(query.NewBinaryJoinIterator(
 				0,
-				profiles.columnIter(ctx, "SeriesIndex", query.NewMapPredicate(lblsPerRef), ""),
-				profiles.columnIter(ctx, "TimeNanos", query.NewIntBetweenPredicate(tr.Start.UnixNano(), tr.End.UnixNano()), ""),
-			)
+				profiles.columnIter(ctx, "SeriesIndex", query.NewMapPredicate(lblsPerRef), deleted.alias("SeriesIndex")),
+				profiles.columnIter(ctx, "TimeNanos", query.NewIntBetweenPredicate(tr.Start.UnixNano(), tr.End.UnixNano()), deleted.alias("TimeNanos")),
+			))
 
 			if b.meta.Version >= 2 {
 				it = query.NewBinaryJoinIterator(0,
@@ -1910,11 +2229,19 @@ func (b *singleBlockQuerier) SelectMergePprof(ctx context.Context, params *inges
 // Series selects the series labels from this block.
 //
 // Note: It will select ALL the labels in the block, not necessarily just the
-// subset in the time range SeriesRequest.Start to SeriesRequest.End.
+// subset in the time range SeriesRequest.Start to SeriesRequest.End. Series
+// deleted within the whole time range are not selected.
 func (b *singleBlockQuerier) Series(ctx context.Context, params *ingestv1.SeriesRequest) ([]*typesv1.Labels, error) {
 	sp, ctx := opentracing.StartSpanFromContext(ctx, "Series Block")
 	defer sp.Finish()
 
+	if t := b.seriesTombstones(params.Start, params.End); t != nil {
+		return seriesWithTombstones(ctx, blockSeries{b}, t, params)
+	}
+	return b.series(ctx, params)
+}
+
+func (b *singleBlockQuerier) series(ctx context.Context, params *ingestv1.SeriesRequest) ([]*typesv1.Labels, error) {
 	if err := b.Open(ctx); err != nil {
 		return nil, err
 	}
@@ -2117,6 +2444,12 @@ func (q *singleBlockQuerier) openFiles(ctx context.Context) error {
 		default:
 			panic(fmt.Errorf("unsupported block version %d id %s", q.meta.Version, q.meta.ULID.String()))
 		}
+		if err == nil && q.symbolizer != nil {
+			q.symbols = &symbolizingResolver{
+				symbolsResolver: q.symbols,
+				reader:          symdb.NewSymbolizingReader(q.symbols, q.symbolizer),
+			}
+		}
 		return err
 	}))
 
@@ -2158,6 +2491,9 @@ func (b *singleBlockQuerier) downsampleResolutions() []time.Duration {
 	return resolutions
 }
 
+// downsampleAggregation returns the name of the downsampled table aggregation.
+// Tables are only downsampled with sum: other aggregations require values of
+// individual profiles, therefore, they are served from the source table.
 func downsampleAggregation(v typesv1.TimeSeriesAggregationType) string {
 	switch v {
 	case typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_SUM:
@@ -1163,7 +1163,7 @@ func TestSelectMergeStacktraces(t *testing.T) {
 		},
 		Start: 0,
 		End:   int64(model.TimeFromUnixNano(math.MaxInt64)),
-	}, 16<<10)
+	}, 16<<10, nil)
 	require.NoError(t, err)
 	expected := phlaremodel.Tree{}
 	expected.InsertStack(1000, "baz", "bar", "foo")
@@ -1281,6 +1281,64 @@ func TestSelectMergeLabels_StackTraceSelector(t *testing.T) {
 	require.NoError(t, querier.Close())
 }
 
+func TestSelectMergeLabels_FunctionSelector(t *testing.T) {
+	ctx := context.Background()
+
+	querier := newBlock(t, func() (res []*testhelper.ProfileBuilder) {
+		for i := int64(1); i < 7; i++ {
+			// Keep in mind that leaf is at location[0].
+			res = append(res, testhelper.NewProfileBuilder(int64(time.Second)*i).
+				CPUProfile().
+				WithLabels("job", "a").
+				ForStacktraceString("foo").AddSamples(1).
+				ForStacktraceString("baz", "bar", "foo").AddSamples(1).
+				ForStacktraceString("baz", "foo").AddSamples(1),
+			)
+		}
+		return res
+	})
+
+	err := querier.Open(ctx)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		function *typesv1.FunctionSelector
+		value    float64
+	}{
+		{function: &typesv1.FunctionSelector{Name: "foo"}, value: 3},
+		{function: &typesv1.FunctionSelector{Name: "foo", Self: true}, value: 1},
+		{function: &typesv1.FunctionSelector{Name: "ba.", Regex: true}, value: 2},
+		{function: &typesv1.FunctionSelector{Name: "bar", Self: true}, value: 0},
+	} {
+		merge, err := querier.SelectMergeByLabels(ctx, &ingesterv1.SelectProfilesRequest{
+			LabelSelector: `{}`,
+			Type: &typesv1.ProfileType{
+				ID:         "process_cpu:cpu:nanoseconds:cpu:nanoseconds",
+				Name:       "process_cpu",
+				SampleType: "cpu",
+				SampleUnit: "nanoseconds",
+				PeriodType: "cpu",
+				PeriodUnit: "nanoseconds",
+			},
+			Start: 0,
+			End:   int64(model.TimeFromUnixNano(math.MaxInt64)),
+		}, &typesv1.StackTraceSelector{Function: tc.function}, "job")
+		require.NoError(t, err)
+		points := genPoints(6)
+		for _, p := range points {
+			p.Value = tc.value
+		}
+		expected := []*typesv1.Series{
+			{
+				Labels: phlaremodel.LabelsFromStrings("job", "a"),
+				Points: points,
+			},
+		}
+		require.Equal(t, expected, merge, "function: %+v", tc.function)
+	}
+	require.NoError(t, querier.Close())
+}
+
 func genPoints(count int) []*typesv1.Point {
 	points := make([]*typesv1.Point, 0, count)
 	for i := 1; i < count+1; i++ {
@@ -1372,7 +1430,7 @@ func testSelectMergeByStacktracesRace(t testing.TB, times int) {
 				},
 				Start: 0,
 				End:   int64(model.TimeFromUnixNano(math.MaxInt64)),
-			}, 16<<10)
+			}, 16<<10, nil)
 			if err != nil {
 				return err
 			}
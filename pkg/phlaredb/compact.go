@@ -60,8 +60,16 @@ type CompactWithSplittingOpts struct {
 	SplitBy            SplitByFunc
 	DownsamplerEnabled bool
 	Logger             log.Logger
+	// Profiles matching the filter are not written to the
+	// output blocks. If set, a single source block may be
+	// compacted: the block is rewritten without the profiles.
+	Delete ProfileFilter
 }
 
+// ProfileFilter reports whether the profile of the
+// series taken at the given time is matched.
+type ProfileFilter func(lbs phlaremodel.Labels, timeNanos int64) bool
+
 func Compact(ctx context.Context, src []BlockReader, dst string) (meta block.Meta, err error) {
 	metas, err := CompactWithSplitting(ctx, CompactWithSplittingOpts{
 		Src:                src,
@@ -81,7 +89,7 @@ func Compact(ctx context.Context, src []BlockReader, dst string) (meta block.Met
 func CompactWithSplitting(ctx context.Context, opts CompactWithSplittingOpts) (
 	[]block.Meta, error,
 ) {
-	if len(opts.Src) <= 1 && opts.SplitCount == 1 {
+	if len(opts.Src) <= 1 && opts.SplitCount == 1 && opts.Delete == nil {
 		return nil, errors.New("not enough blocks to compact")
 	}
 	if opts.SplitCount == 0 {
@@ -120,7 +128,7 @@ func CompactWithSplitting(ctx context.Context, opts CompactWithSplittingOpts) (
 		}
 		var metas []block.Meta
 		sp, ctx := opentracing.StartSpanFromContext(ctx, "compact.Stage", opentracing.Tag{Key: "stage", Value: stage})
-		if metas, err = compact(ctx, writers, opts.Src, opts.SplitBy, opts.SplitCount); err != nil {
+		if metas, err = compact(ctx, writers, opts.Src, opts.SplitBy, opts.SplitCount, opts.Delete); err != nil {
 			sp.Finish()
 			ext.LogError(sp, err)
 			return nil, err
@@ -166,7 +174,7 @@ func createBlockWriter(opts blockWriterOpts) (*blockWriter, error) {
 	return newBlockWriter(opts)
 }
 
-func compact(ctx context.Context, writers []*blockWriter, readers []BlockReader, splitBy SplitByFunc, splitCount uint64) ([]block.Meta, error) {
+func compact(ctx context.Context, writers []*blockWriter, readers []BlockReader, splitBy SplitByFunc, splitCount uint64, del ProfileFilter) ([]block.Meta, error) {
 	rowsIt, err := newMergeRowProfileIterator(readers)
 	if err != nil {
 		return nil, err
@@ -175,6 +183,9 @@ func compact(ctx context.Context, writers []*blockWriter, readers []BlockReader,
 	// iterate and splits the rows into series.
 	for rowsIt.Next() {
 		r := rowsIt.At()
+		if del != nil && del(r.labels, r.timeNanos) {
+			continue
+		}
 		shard := int(splitBy(r, splitCount))
 		w := writers[shard]
 		if w == nil {
@@ -81,7 +81,7 @@ func TestCompact(t *testing.T) {
 
 	it, err = querier.SelectMatchingProfiles(ctx, matchAll)
 	require.NoError(t, err)
-	res, err := querier.MergeByStacktraces(ctx, it, 0)
+	res, err := querier.MergeByStacktraces(ctx, it, 0, nil)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
@@ -90,6 +90,47 @@ func TestCompact(t *testing.T) {
 	require.Equal(t, expected.String(), res.String())
 }
 
+func TestCompactWithDelete(t *testing.T) {
+	ctx := context.Background()
+	b := newBlock(t, func() []*testhelper.ProfileBuilder {
+		return append(
+			profileSeriesGenerator(t, time.Unix(1, 0), time.Unix(10, 0), time.Second, "job", "a"),
+			profileSeriesGenerator(t, time.Unix(1, 0), time.Unix(10, 0), time.Second, "job", "b")...,
+		)
+	})
+	dst := t.TempDir()
+	compacted, err := CompactWithSplitting(ctx, CompactWithSplittingOpts{
+		Src:        []BlockReader{b},
+		Dst:        dst,
+		SplitCount: 1,
+		SplitBy:    SplitByFingerprint,
+		Logger:     log.NewNopLogger(),
+		Delete: func(lbs phlaremodel.Labels, timeNanos int64) bool {
+			// Profiles of job "a" before 6s and of job "b" after 5s.
+			if lbs.Get("job") == "a" {
+				return timeNanos < int64(6*time.Second)
+			}
+			return timeNanos > int64(5*time.Second)
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, compacted, 1)
+	require.Equal(t, uint64(10), compacted[0].Stats.NumProfiles)
+
+	querier := blockQuerierFromMeta(t, dst, compacted[0])
+	series, err := querier.SelectMergeByLabels(ctx, &ingesterv1.SelectProfilesRequest{
+		LabelSelector: "{}",
+		Type:          mustParseProfileSelector(t, "process_cpu:cpu:nanoseconds:cpu:nanoseconds"),
+		Start:         0,
+		End:           40000,
+	}, nil, "job")
+	require.NoError(t, err)
+	require.Equal(t, []*typesv1.Series{
+		{Labels: phlaremodel.LabelsFromStrings("job", "a"), Points: generatePoints(t, model.TimeFromUnix(6), model.TimeFromUnix(10))},
+		{Labels: phlaremodel.LabelsFromStrings("job", "b"), Points: generatePoints(t, model.TimeFromUnix(1), model.TimeFromUnix(5))},
+	}, series)
+}
+
 func TestCompactWithDownsampling(t *testing.T) {
 	ctx := context.Background()
 	b := newBlock(t, func() []*testhelper.ProfileBuilder {
@@ -148,7 +189,7 @@ func TestCompactWithDownsampling(t *testing.T) {
 
 	it, err = querier.SelectMatchingProfiles(ctx, matchAll)
 	require.NoError(t, err)
-	res, err := querier.MergeByStacktraces(ctx, it, 0)
+	res, err := querier.MergeByStacktraces(ctx, it, 0, nil)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
@@ -156,7 +197,7 @@ func TestCompactWithDownsampling(t *testing.T) {
 	expected.InsertStack(3, "baz", "bar", "foo")
 	require.Equal(t, expected.String(), res.String())
 
-	res, err = querier.SelectMergeByStacktraces(ctx, matchAll, 0)
+	res, err = querier.SelectMergeByStacktraces(ctx, matchAll, 0, nil)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expected.String(), res.String())
@@ -281,7 +322,7 @@ func TestCompactWithSplitting(t *testing.T) {
 	// Finally test some stacktraces resolution.
 	it, err = queriers[1].SelectMatchingProfiles(ctx, matchAll)
 	require.NoError(t, err)
-	res, err := queriers[1].MergeByStacktraces(ctx, it, 0)
+	res, err := queriers[1].MergeByStacktraces(ctx, it, 0, nil)
 	require.NoError(t, err)
 
 	expected := new(phlaremodel.Tree)
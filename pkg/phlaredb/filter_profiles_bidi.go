@@ -40,14 +40,18 @@ type filterRequest interface {
 	*ingestv1.MergeProfilesStacktracesRequest |
 		*ingestv1.MergeProfilesLabelsRequest |
 		*ingestv1.MergeProfilesPprofRequest |
-		*ingestv1.MergeSpanProfileRequest
+		*ingestv1.MergeSpanProfileRequest |
+		*ingestv1.MergeProfilesTopFunctionsRequest |
+		*ingestv1.MergeProfilesValuesRequest
 }
 
 type filterResponse interface {
 	*ingestv1.MergeProfilesStacktracesResponse |
 		*ingestv1.MergeProfilesLabelsResponse |
 		*ingestv1.MergeProfilesPprofResponse |
-		*ingestv1.MergeSpanProfileResponse
+		*ingestv1.MergeSpanProfileResponse |
+		*ingestv1.MergeProfilesTopFunctionsResponse |
+		*ingestv1.MergeProfilesValuesResponse
 }
 
 func rewriteEOFError(err error) error {
@@ -124,6 +128,14 @@ func filterProfiles[B BidiServerMerge[Res, Req], Res filterResponse, Req filterR
 			err = s.Send(&ingestv1.MergeSpanProfileResponse{
 				SelectedProfiles: selectProfileResult,
 			})
+		case BidiServerMerge[*ingestv1.MergeProfilesTopFunctionsResponse, *ingestv1.MergeProfilesTopFunctionsRequest]:
+			err = s.Send(&ingestv1.MergeProfilesTopFunctionsResponse{
+				SelectedProfiles: selectProfileResult,
+			})
+		case BidiServerMerge[*ingestv1.MergeProfilesValuesResponse, *ingestv1.MergeProfilesValuesRequest]:
+			err = s.Send(&ingestv1.MergeProfilesValuesResponse{
+				SelectedProfiles: selectProfileResult,
+			})
 		}
 		// read a batch of profiles and sends it.
 
@@ -161,6 +173,18 @@ func filterProfiles[B BidiServerMerge[Res, Req], Res filterResponse, Req filterR
 				return rewriteEOFError(err)
 			}
 			selected = selectionResponse.Profiles
+		case BidiServerMerge[*ingestv1.MergeProfilesTopFunctionsResponse, *ingestv1.MergeProfilesTopFunctionsRequest]:
+			selectionResponse, err := s.Receive()
+			if err != nil {
+				return rewriteEOFError(err)
+			}
+			selected = selectionResponse.Profiles
+		case BidiServerMerge[*ingestv1.MergeProfilesValuesResponse, *ingestv1.MergeProfilesValuesRequest]:
+			selectionResponse, err := s.Receive()
+			if err != nil {
+				return rewriteEOFError(err)
+			}
+			selected = selectionResponse.Profiles
 		}
 		sp.LogFields(otlog.String("msg", "selection received"))
 		for i, k := range selected {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"sync"
 	"time"
@@ -66,10 +67,12 @@ type Head struct {
 
 	parquetConfig *ParquetConfig
 	symdb         *symdb.SymDB
+	symbols       symdb.SymbolsReader
 	profiles      *profileStore
 	totalSamples  *atomic.Uint64
 	tables        []Table
 	delta         *deltaProfiles
+	wal           *headWAL // nil if the WAL is disabled.
 
 	limiter   TenantLimiter
 	updatedAt *atomic.Time
@@ -78,6 +81,7 @@ type Head struct {
 const (
 	pathHead          = "head"
 	PathLocal         = "local"
+	PathWAL           = "wal"
 	defaultFolderMode = 0o755
 )
 
@@ -137,6 +141,14 @@ func NewHead(phlarectx context.Context, cfg Config, limiter TenantLimiter) (*Hea
 	}
 
 	h.symdb = symdb.NewSymDB(symdbConfig)
+	h.symbols = symdb.NewSymbolizingReader(h.symdb, symbolizerFromContext(phlarectx))
+
+	if cfg.WALEnabled {
+		walPath := filepath.Join(cfg.DataPath, PathWAL, h.meta.ULID.String())
+		if h.wal, err = openHeadWAL(h.logger, walPath, cfg.WALSegmentSize); err != nil {
+			return nil, err
+		}
+	}
 
 	h.wg.Add(1)
 	go h.loop()
@@ -192,6 +204,13 @@ func (h *Head) Ingest(ctx context.Context, p *profilev1.Profile, id uuid.UUID, e
 		return nil
 	}
 
+	var walLabels []*typesv1.LabelPair
+	if h.wal != nil {
+		// The labels are modified in place below: the WAL
+		// record keeps them as received.
+		walLabels = slices.Clone(externalLabels)
+	}
+
 	delta := phlaremodel.Labels(externalLabels).Get(phlaremodel.LabelNameDelta) != "false"
 	externalLabels = phlaremodel.Labels(externalLabels).Delete(phlaremodel.LabelNameDelta)
 
@@ -206,6 +225,15 @@ func (h *Head) Ingest(ctx context.Context, p *profilev1.Profile, id uuid.UUID, e
 		}
 	}
 
+	// The profile is only logged once it has been accepted:
+	// rejected profiles must not come back on replay.
+	if h.wal != nil {
+		if err := h.wal.Log(p, id, walLabels); err != nil {
+			h.metrics.walWriteFailures.Inc()
+			return err
+		}
+	}
+
 	// determine the stacktraces partition ID
 	partition := phlaremodel.StacktracePartitionFromProfile(lbls, p)
 
@@ -535,8 +563,18 @@ func (h *Head) flush(ctx context.Context) error {
 	// It must be guaranteed that no new inserts will happen
 	// after the call start.
 	h.inFlightProfiles.Wait()
+	if h.wal != nil {
+		if err := h.wal.Close(); err != nil {
+			return errors.Wrap(err, "closing WAL")
+		}
+	}
 	if h.profiles.index.totalProfiles.Load() == 0 {
 		level.Info(h.logger).Log("msg", "head empty - no block written")
+		if h.wal != nil {
+			if err := h.wal.Remove(); err != nil {
+				return errors.Wrap(err, "removing WAL")
+			}
+		}
 		return os.RemoveAll(h.headPath)
 	}
 
@@ -637,6 +675,13 @@ func (h *Head) Move() error {
 	if err := fileutil.Rename(h.headPath, h.localPath); err != nil {
 		return err
 	}
+	// The block is now persisted in the local directory,
+	// therefore the WAL is not needed anymore.
+	if h.wal != nil {
+		if err := h.wal.Remove(); err != nil {
+			return err
+		}
+	}
 
 	level.Info(h.logger).Log("msg", "head successfully written to block", "block_path", h.localPath)
 	return nil
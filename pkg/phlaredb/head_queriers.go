@@ -6,6 +6,7 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/go-kit/log/level"
+	"github.com/google/uuid"
 	"github.com/opentracing/opentracing-go"
 	"github.com/parquet-go/parquet-go"
 	"github.com/pkg/errors"
@@ -111,7 +112,7 @@ func (q *headOnDiskQuerier) SelectMatchingProfiles(ctx context.Context, params *
 	return iter.NewSliceIterator(profiles), nil
 }
 
-func (q *headOnDiskQuerier) SelectMergeByStacktraces(ctx context.Context, params *ingestv1.SelectProfilesRequest, maxNodes int64) (*phlaremodel.Tree, error) {
+func (q *headOnDiskQuerier) SelectMergeByStacktraces(ctx context.Context, params *ingestv1.SelectProfilesRequest, maxNodes int64, sts *typesv1.StackTraceSelector) (*phlaremodel.Tree, error) {
 	sp, ctx := opentracing.StartSpanFromContext(ctx, "SelectMergeByStacktraces - HeadOnDisk")
 	defer sp.Finish()
 
@@ -137,7 +138,9 @@ func (q *headOnDiskQuerier) SelectMergeByStacktraces(ctx context.Context, params
 	rows := profileRowBatchIterator(it)
 	defer rows.Close()
 
-	r := symdb.NewResolver(ctx, q.head.symdb, symdb.WithResolverMaxNodes(maxNodes))
+	r := symdb.NewResolver(ctx, q.head.symbols,
+		symdb.WithResolverMaxNodes(maxNodes),
+		symdb.WithResolverStackTraceSelector(sts))
 	defer r.Release()
 
 	if err := mergeByStacktraces[rowProfile](ctx, q.rowGroup(), rows, r); err != nil {
@@ -182,7 +185,7 @@ func (q *headOnDiskQuerier) SelectMergeBySpans(ctx context.Context, params *inge
 	rows := profileRowBatchIterator(it)
 	defer rows.Close()
 
-	r := symdb.NewResolver(ctx, q.head.symdb)
+	r := symdb.NewResolver(ctx, q.head.symbols)
 	defer r.Release()
 
 	if err = mergeBySpans[rowProfile](ctx, q.rowGroup(), rows, r, spans); err != nil {
@@ -217,7 +220,7 @@ func (q *headOnDiskQuerier) SelectMergePprof(ctx context.Context, params *ingest
 	rows := profileRowBatchIterator(it)
 	defer rows.Close()
 
-	r := symdb.NewResolver(ctx, q.head.symdb,
+	r := symdb.NewResolver(ctx, q.head.symbols,
 		symdb.WithResolverMaxNodes(maxNodes),
 		symdb.WithResolverStackTraceSelector(sts))
 	defer r.Release()
@@ -245,10 +248,12 @@ func (q *headOnDiskQuerier) LabelNames(ctx context.Context, req *connect.Request
 	return connect.NewResponse(&typesv1.LabelNamesResponse{}), nil
 }
 
-func (q *headOnDiskQuerier) MergeByStacktraces(ctx context.Context, rows iter.Iterator[Profile], maxNodes int64) (*phlaremodel.Tree, error) {
+func (q *headOnDiskQuerier) MergeByStacktraces(ctx context.Context, rows iter.Iterator[Profile], maxNodes int64, sts *typesv1.StackTraceSelector) (*phlaremodel.Tree, error) {
 	sp, ctx := opentracing.StartSpanFromContext(ctx, "MergeByStacktraces")
 	defer sp.Finish()
-	r := symdb.NewResolver(ctx, q.head.symdb, symdb.WithResolverMaxNodes(maxNodes))
+	r := symdb.NewResolver(ctx, q.head.symbols,
+		symdb.WithResolverMaxNodes(maxNodes),
+		symdb.WithResolverStackTraceSelector(sts))
 	defer r.Release()
 	if err := mergeByStacktraces(ctx, q.rowGroup(), rows, r); err != nil {
 		return nil, err
@@ -259,7 +264,7 @@ func (q *headOnDiskQuerier) MergeByStacktraces(ctx context.Context, rows iter.It
 func (q *headOnDiskQuerier) MergePprof(ctx context.Context, rows iter.Iterator[Profile], maxNodes int64, sts *typesv1.StackTraceSelector) (*profilev1.Profile, error) {
 	sp, ctx := opentracing.StartSpanFromContext(ctx, "MergePprof")
 	defer sp.Finish()
-	r := symdb.NewResolver(ctx, q.head.symdb,
+	r := symdb.NewResolver(ctx, q.head.symbols,
 		symdb.WithResolverMaxNodes(maxNodes),
 		symdb.WithResolverStackTraceSelector(sts))
 	defer r.Release()
@@ -269,13 +274,37 @@ func (q *headOnDiskQuerier) MergePprof(ctx context.Context, rows iter.Iterator[P
 	return r.Pprof()
 }
 
+func (q *headOnDiskQuerier) MergeTopFunctions(ctx context.Context, rows iter.Iterator[Profile], grouping typesv1.TopFunctionsGrouping, filter *typesv1.TopFunctionsFilter, sts *typesv1.StackTraceSelector) ([]*typesv1.TopFunction, error) {
+	sp, ctx := opentracing.StartSpanFromContext(ctx, "MergeTopFunctions - HeadOnDisk")
+	defer sp.Finish()
+	r := symdb.NewResolver(ctx, q.head.symbols,
+		symdb.WithResolverStackTraceSelector(sts))
+	defer r.Release()
+	if err := mergeByStacktraces(ctx, q.rowGroup(), rows, r); err != nil {
+		return nil, err
+	}
+	return r.TopFunctions(grouping, filter)
+}
+
+func (q *headOnDiskQuerier) MergeProfileValues(ctx context.Context, rows iter.Iterator[Profile]) ([]*ingestv1.ProfileValues, error) {
+	sp, ctx := opentracing.StartSpanFromContext(ctx, "MergeProfileValues - HeadOnDisk")
+	defer sp.Finish()
+	return mergeProfileValues(ctx, q.rowGroup(), "TotalValue", rows)
+}
+
+func (q *headOnDiskQuerier) FindProfileByID(ctx context.Context, rows iter.Iterator[Profile], id uuid.UUID) (Profile, error) {
+	sp, ctx := opentracing.StartSpanFromContext(ctx, "FindProfileByID - HeadOnDisk")
+	defer sp.Finish()
+	return findProfileByID(ctx, q.rowGroup(), rows, id)
+}
+
 func (q *headOnDiskQuerier) MergeByLabels(ctx context.Context, rows iter.Iterator[Profile], sts *typesv1.StackTraceSelector, by ...string) ([]*typesv1.Series, error) {
 	sp, ctx := opentracing.StartSpanFromContext(ctx, "MergeByLabels - HeadOnDisk")
 	defer sp.Finish()
-	if len(sts.GetCallSite()) == 0 {
+	if !symdb.SelectsStackTraces(sts) {
 		return mergeByLabels(ctx, q.rowGroup(), "TotalValue", rows, by...)
 	}
-	r := symdb.NewResolver(ctx, q.head.symdb,
+	r := symdb.NewResolver(ctx, q.head.symbols,
 		symdb.WithResolverStackTraceSelector(sts))
 	defer r.Release()
 	return mergeByLabelsWithStackTraceSelector(ctx, q.rowGroup(), rows, r, by...)
@@ -302,13 +331,13 @@ func (q *headOnDiskQuerier) SelectMergeByLabels(ctx context.Context, params *ing
 		q.rowGroup().columnIter(ctx, "TimeNanos", query.NewIntBetweenPredicate(start, end), "TimeNanos"),
 	)
 
-	if len(sts.GetCallSite()) == 0 {
+	if !symdb.SelectsStackTraces(sts) {
 		rows := profileBatchIteratorByFingerprints(it, labelsPerFP)
 		defer rows.Close()
 		return mergeByLabels[Profile](ctx, q.rowGroup(), "TotalValue", rows, by...)
 	}
 
-	r := symdb.NewResolver(ctx, q.head.symdb,
+	r := symdb.NewResolver(ctx, q.head.symbols,
 		symdb.WithResolverStackTraceSelector(sts))
 	defer r.Release()
 
@@ -327,7 +356,7 @@ func (q *headOnDiskQuerier) Series(ctx context.Context, params *ingestv1.SeriesR
 func (q *headOnDiskQuerier) MergeBySpans(ctx context.Context, rows iter.Iterator[Profile], spanSelector phlaremodel.SpanSelector) (*phlaremodel.Tree, error) {
 	sp, ctx := opentracing.StartSpanFromContext(ctx, "MergeBySpans")
 	defer sp.Finish()
-	r := symdb.NewResolver(ctx, q.head.symdb)
+	r := symdb.NewResolver(ctx, q.head.symbols)
 	defer r.Release()
 	if err := mergeBySpans(ctx, q.rowGroup(), rows, r, spanSelector); err != nil {
 		return nil, err
@@ -403,10 +432,12 @@ func (q *headInMemoryQuerier) SelectMatchingProfiles(ctx context.Context, params
 	return iter.NewMergeIterator(maxBlockProfile, false, iters...), nil
 }
 
-func (q *headInMemoryQuerier) SelectMergeByStacktraces(ctx context.Context, params *ingestv1.SelectProfilesRequest, maxNodes int64) (*phlaremodel.Tree, error) {
+func (q *headInMemoryQuerier) SelectMergeByStacktraces(ctx context.Context, params *ingestv1.SelectProfilesRequest, maxNodes int64, sts *typesv1.StackTraceSelector) (*phlaremodel.Tree, error) {
 	sp, ctx := opentracing.StartSpanFromContext(ctx, "SelectMergeByStacktraces - HeadInMemory")
 	defer sp.Finish()
-	r := symdb.NewResolver(ctx, q.head.symdb, symdb.WithResolverMaxNodes(maxNodes))
+	r := symdb.NewResolver(ctx, q.head.symbols,
+		symdb.WithResolverMaxNodes(maxNodes),
+		symdb.WithResolverStackTraceSelector(sts))
 	defer r.Release()
 	index := q.head.profiles.index
 
@@ -445,7 +476,7 @@ func (q *headInMemoryQuerier) SelectMergeByStacktraces(ctx context.Context, para
 func (q *headInMemoryQuerier) SelectMergeBySpans(ctx context.Context, params *ingestv1.SelectSpanProfileRequest) (*phlaremodel.Tree, error) {
 	sp, ctx := opentracing.StartSpanFromContext(ctx, "SelectMergeBySpans - HeadInMemory")
 	defer sp.Finish()
-	r := symdb.NewResolver(ctx, q.head.symdb)
+	r := symdb.NewResolver(ctx, q.head.symbols)
 	defer r.Release()
 	index := q.head.profiles.index
 
@@ -496,7 +527,7 @@ func (q *headInMemoryQuerier) SelectMergeBySpans(ctx context.Context, params *in
 func (q *headInMemoryQuerier) SelectMergePprof(ctx context.Context, params *ingestv1.SelectProfilesRequest, maxNodes int64, sts *typesv1.StackTraceSelector) (*profilev1.Profile, error) {
 	sp, ctx := opentracing.StartSpanFromContext(ctx, "SelectMergePprof - HeadInMemory")
 	defer sp.Finish()
-	r := symdb.NewResolver(ctx, q.head.symdb,
+	r := symdb.NewResolver(ctx, q.head.symbols,
 		symdb.WithResolverMaxNodes(maxNodes),
 		symdb.WithResolverStackTraceSelector(sts))
 	defer r.Release()
@@ -551,10 +582,12 @@ func (q *headInMemoryQuerier) LabelNames(ctx context.Context, req *connect.Reque
 	return q.head.LabelNames(ctx, req)
 }
 
-func (q *headInMemoryQuerier) MergeByStacktraces(ctx context.Context, rows iter.Iterator[Profile], maxNodes int64) (*phlaremodel.Tree, error) {
+func (q *headInMemoryQuerier) MergeByStacktraces(ctx context.Context, rows iter.Iterator[Profile], maxNodes int64, sts *typesv1.StackTraceSelector) (*phlaremodel.Tree, error) {
 	sp, _ := opentracing.StartSpanFromContext(ctx, "MergeByStacktraces - HeadInMemory")
 	defer sp.Finish()
-	r := symdb.NewResolver(ctx, q.head.symdb, symdb.WithResolverMaxNodes(maxNodes))
+	r := symdb.NewResolver(ctx, q.head.symbols,
+		symdb.WithResolverMaxNodes(maxNodes),
+		symdb.WithResolverStackTraceSelector(sts))
 	defer r.Release()
 	for rows.Next() {
 		p, ok := rows.At().(ProfileWithLabels)
@@ -572,7 +605,7 @@ func (q *headInMemoryQuerier) MergeByStacktraces(ctx context.Context, rows iter.
 func (q *headInMemoryQuerier) MergePprof(ctx context.Context, rows iter.Iterator[Profile], maxNodes int64, sts *typesv1.StackTraceSelector) (*profilev1.Profile, error) {
 	sp, _ := opentracing.StartSpanFromContext(ctx, "MergePprof - HeadInMemory")
 	defer sp.Finish()
-	r := symdb.NewResolver(ctx, q.head.symdb,
+	r := symdb.NewResolver(ctx, q.head.symbols,
 		symdb.WithResolverMaxNodes(maxNodes),
 		symdb.WithResolverStackTraceSelector(sts))
 	defer r.Release()
@@ -589,6 +622,58 @@ func (q *headInMemoryQuerier) MergePprof(ctx context.Context, rows iter.Iterator
 	return r.Pprof()
 }
 
+func (q *headInMemoryQuerier) MergeTopFunctions(ctx context.Context, rows iter.Iterator[Profile], grouping typesv1.TopFunctionsGrouping, filter *typesv1.TopFunctionsFilter, sts *typesv1.StackTraceSelector) ([]*typesv1.TopFunction, error) {
+	sp, _ := opentracing.StartSpanFromContext(ctx, "MergeTopFunctions - HeadInMemory")
+	defer sp.Finish()
+	r := symdb.NewResolver(ctx, q.head.symbols,
+		symdb.WithResolverStackTraceSelector(sts))
+	defer r.Release()
+	for rows.Next() {
+		p, ok := rows.At().(ProfileWithLabels)
+		if !ok {
+			return nil, errors.New("expected ProfileWithLabels")
+		}
+		r.AddSamples(p.StacktracePartition(), p.Samples())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return r.TopFunctions(grouping, filter)
+}
+
+func (q *headInMemoryQuerier) MergeProfileValues(ctx context.Context, rows iter.Iterator[Profile]) ([]*ingestv1.ProfileValues, error) {
+	sp, _ := opentracing.StartSpanFromContext(ctx, "MergeProfileValues - HeadInMemory")
+	defer sp.Finish()
+	var b profileValuesBuilder
+	b.init()
+	for rows.Next() {
+		p, ok := rows.At().(ProfileWithLabels)
+		if !ok {
+			return nil, errors.New("expected ProfileWithLabels")
+		}
+		b.add(p.Fingerprint(), p.Labels(), int64(p.Timestamp()), p.profile.ID, p.Total())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return b.build(), nil
+}
+
+func (q *headInMemoryQuerier) FindProfileByID(ctx context.Context, rows iter.Iterator[Profile], id uuid.UUID) (Profile, error) {
+	sp, _ := opentracing.StartSpanFromContext(ctx, "FindProfileByID - HeadInMemory")
+	defer sp.Finish()
+	for rows.Next() {
+		p, ok := rows.At().(ProfileWithLabels)
+		if !ok {
+			return nil, errors.New("expected ProfileWithLabels")
+		}
+		if p.profile.ID == id {
+			return p, nil
+		}
+	}
+	return nil, rows.Err()
+}
+
 func (q *headInMemoryQuerier) MergeByLabels(
 	ctx context.Context,
 	rows iter.Iterator[Profile],
@@ -601,7 +686,7 @@ func (q *headInMemoryQuerier) MergeByLabels(
 	seriesBuilder := seriesBuilder{}
 	seriesBuilder.init(by...)
 
-	if len(sts.GetCallSite()) == 0 {
+	if !symdb.SelectsStackTraces(sts) {
 		for rows.Next() {
 			p, ok := rows.At().(ProfileWithLabels)
 			if !ok {
@@ -610,7 +695,7 @@ func (q *headInMemoryQuerier) MergeByLabels(
 			seriesBuilder.add(p.Fingerprint(), p.Labels(), int64(p.Timestamp()), float64(p.Total()))
 		}
 	} else {
-		r := symdb.NewResolver(ctx, q.head.symdb,
+		r := symdb.NewResolver(ctx, q.head.symbols,
 			symdb.WithResolverStackTraceSelector(sts))
 		defer r.Release()
 		var v symdb.CallSiteValues
@@ -660,7 +745,7 @@ func (q *headInMemoryQuerier) SelectMergeByLabels(
 	index.mutex.RLock()
 	defer index.mutex.RUnlock()
 
-	if len(sts.GetCallSite()) == 0 {
+	if !symdb.SelectsStackTraces(sts) {
 		for _, fp := range ids {
 			profileSeries, ok := index.profilesPerFP[fp]
 			if !ok {
@@ -677,7 +762,7 @@ func (q *headInMemoryQuerier) SelectMergeByLabels(
 			}
 		}
 	} else {
-		r := symdb.NewResolver(ctx, q.head.symdb,
+		r := symdb.NewResolver(ctx, q.head.symbols,
 			symdb.WithResolverStackTraceSelector(sts))
 		defer r.Release()
 		var v symdb.CallSiteValues
@@ -714,7 +799,7 @@ func (q *headInMemoryQuerier) Series(ctx context.Context, params *ingestv1.Serie
 func (q *headInMemoryQuerier) MergeBySpans(ctx context.Context, rows iter.Iterator[Profile], spanSelector phlaremodel.SpanSelector) (*phlaremodel.Tree, error) {
 	sp, _ := opentracing.StartSpanFromContext(ctx, "MergeBySpans - HeadInMemory")
 	defer sp.Finish()
-	r := symdb.NewResolver(ctx, q.head.symdb)
+	r := symdb.NewResolver(ctx, q.head.symbols)
 	defer r.Release()
 	for rows.Next() {
 		p, ok := rows.At().(ProfileWithLabels)
@@ -15,6 +15,8 @@ type contextKey uint8
 const (
 	headMetricsContextKey contextKey = iota
 	blockMetricsContextKey
+	symbolizerContextKey
+	tombstonesContextKey
 )
 
 type headMetrics struct {
@@ -42,6 +44,11 @@ type headMetrics struct {
 	flushedBlocksReasons        *prometheus.CounterVec
 	writtenProfileSegments      *prometheus.CounterVec
 	writtenProfileSegmentsBytes prometheus.Histogram
+
+	walReplayDuration   prometheus.Histogram
+	walReplayedProfiles prometheus.Counter
+	walCorruptedRecords prometheus.Counter
+	walWriteFailures    prometheus.Counter
 }
 
 func newHeadMetrics(reg prometheus.Registerer) *headMetrics {
@@ -150,6 +157,24 @@ func newHeadMetrics(reg prometheus.Registerer) *headMetrics {
 			Name: "pyroscope_head_samples",
 			Help: "Number of samples in the head.",
 		}),
+		walReplayDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name: "pyroscope_head_wal_replay_duration_seconds",
+			Help: "Time taken to replay the head WAL on startup.",
+			// [1s, 2s, 4s, 8s, 16s, 32s, 64s, 128s, 256s, 512s]
+			Buckets: prometheus.ExponentialBuckets(1, 2, 10),
+		}),
+		walReplayedProfiles: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "pyroscope_head_wal_replayed_profiles_total",
+			Help: "Total number of profiles replayed from the head WAL.",
+		}),
+		walCorruptedRecords: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "pyroscope_head_wal_corruptions_total",
+			Help: "Total number of corrupted records found in the head WAL during replay.",
+		}),
+		walWriteFailures: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "pyroscope_head_wal_write_failures_total",
+			Help: "Total number of profiles that failed to be written to the head WAL.",
+		}),
 	}
 
 	m.register(reg)
@@ -179,6 +204,10 @@ func (m *headMetrics) register(reg prometheus.Registerer) {
 	m.flushedBlocksReasons = util.RegisterOrGet(reg, m.flushedBlocksReasons)
 	m.writtenProfileSegments = util.RegisterOrGet(reg, m.writtenProfileSegments)
 	m.writtenProfileSegmentsBytes = util.RegisterOrGet(reg, m.writtenProfileSegmentsBytes)
+	m.walReplayDuration = util.RegisterOrGet(reg, m.walReplayDuration)
+	m.walReplayedProfiles = util.RegisterOrGet(reg, m.walReplayedProfiles)
+	m.walCorruptedRecords = util.RegisterOrGet(reg, m.walCorruptedRecords)
+	m.walWriteFailures = util.RegisterOrGet(reg, m.walWriteFailures)
 }
 
 func contextWithHeadMetrics(ctx context.Context, m *headMetrics) context.Context {
@@ -59,6 +59,9 @@ type Config struct {
 	MinDiskAvailablePercentage float64       `yaml:"min_disk_available_percentage"`
 	EnforcementInterval        time.Duration `yaml:"enforcement_interval"`
 	DisableEnforcement         bool          `yaml:"disable_enforcement"`
+
+	WALEnabled     bool `yaml:"wal_enabled"`
+	WALSegmentSize int  `yaml:"wal_segment_size" category:"advanced"`
 }
 
 type ParquetConfig struct {
@@ -75,6 +78,8 @@ func (cfg *Config) RegisterFlags(f *flag.FlagSet) {
 	f.Float64Var(&cfg.MinDiskAvailablePercentage, "pyroscopedb.retention-policy-min-disk-available-percentage", DefaultMinDiskAvailablePercentage, "Which percentage of free disk space to keep")
 	f.DurationVar(&cfg.EnforcementInterval, "pyroscopedb.retention-policy-enforcement-interval", DefaultRetentionPolicyEnforcementInterval, "How often to enforce disk retention")
 	f.BoolVar(&cfg.DisableEnforcement, "pyroscopedb.retention-policy-disable", false, "Disable retention policy enforcement")
+	f.BoolVar(&cfg.WALEnabled, "pyroscopedb.wal-enabled", false, "Write ingested profiles to a write-ahead log, which is replayed on startup to recover heads that were not flushed.")
+	f.IntVar(&cfg.WALSegmentSize, "pyroscopedb.wal-segment-size", DefaultWALSegmentSize, "Size of a single write-ahead log segment file in bytes.")
 }
 
 type TenantLimiter interface {
@@ -110,6 +115,9 @@ type PhlareDB struct {
 	blockQuerier *BlockQuerier
 	limiter      TenantLimiter
 	evictCh      chan *blockEviction
+	// Tombstones of the deleted profiles: blocks
+	// get them from the context they're created with.
+	tombstones TombstonesProvider
 }
 
 func New(phlarectx context.Context, cfg Config, limiter TenantLimiter, fs phlareobj.Bucket) (*PhlareDB, error) {
@@ -122,6 +130,8 @@ func New(phlarectx context.Context, cfg Config, limiter TenantLimiter, fs phlare
 		metrics: newHeadMetrics(reg),
 		limiter: limiter,
 		heads:   make(map[int64]*Head),
+
+		tombstones: tombstonesFromContext(phlarectx),
 	}
 
 	if err := os.MkdirAll(f.LocalDataPath(), 0o777); err != nil {
@@ -134,6 +144,12 @@ func New(phlarectx context.Context, cfg Config, limiter TenantLimiter, fs phlare
 	f.wg.Add(1)
 	go f.loop()
 
+	if cfg.WALEnabled {
+		if err := f.recoverHeads(); err != nil {
+			return nil, fmt.Errorf("recovering heads: %w", err)
+		}
+	}
+
 	f.blockQuerier = NewBlockQuerier(phlarectx, phlareobj.NewPrefixedBucket(fs, PathLocal))
 
 	// do an initial querier sync
@@ -141,6 +157,12 @@ func New(phlarectx context.Context, cfg Config, limiter TenantLimiter, fs phlare
 	if err := f.blockQuerier.Sync(ctx); err != nil {
 		return nil, err
 	}
+
+	if cfg.WALEnabled {
+		if err := f.replayWAL(ctx); err != nil {
+			return nil, err
+		}
+	}
 	return f, nil
 }
 
@@ -319,7 +341,7 @@ func (f *PhlareDB) headQueriers() Queriers {
 	for _, h := range f.flushing {
 		res = append(res, h.Queriers()...)
 	}
-	return res
+	return withTombstones(res, f.tombstones)
 }
 
 func (f *PhlareDB) Ingest(ctx context.Context, p *profilev1.Profile, id uuid.UUID, externalLabels ...*typesv1.LabelPair) (err error) {
@@ -471,6 +493,27 @@ func (f *PhlareDB) MergeSpanProfile(ctx context.Context, stream *connect.BidiStr
 	return f.queriers().MergeSpanProfile(ctx, stream)
 }
 
+func (f *PhlareDB) MergeProfilesTopFunctions(ctx context.Context, stream *connect.BidiStream[ingestv1.MergeProfilesTopFunctionsRequest, ingestv1.MergeProfilesTopFunctionsResponse]) error {
+	f.headLock.RLock()
+	defer f.headLock.RUnlock()
+
+	return f.queriers().MergeProfilesTopFunctions(ctx, stream)
+}
+
+func (f *PhlareDB) MergeProfilesValues(ctx context.Context, stream *connect.BidiStream[ingestv1.MergeProfilesValuesRequest, ingestv1.MergeProfilesValuesResponse]) error {
+	f.headLock.RLock()
+	defer f.headLock.RUnlock()
+
+	return f.queriers().MergeProfilesValues(ctx, stream)
+}
+
+func (f *PhlareDB) GetProfileByID(ctx context.Context, req *connect.Request[ingestv1.GetProfileByIDRequest]) (*connect.Response[ingestv1.GetProfileByIDResponse], error) {
+	f.headLock.RLock()
+	defer f.headLock.RUnlock()
+
+	return f.queriers().GetProfileByID(ctx, req)
+}
+
 type blockEviction struct {
 	blockID ulid.ULID
 	err     error
@@ -114,6 +114,22 @@ func (i *ingesterHandlerPhlareDB) MergeSpanProfile(ctx context.Context, stream *
 	return MergeSpanProfile(ctx, stream, i.forTimeRange)
 }
 
+func (i *ingesterHandlerPhlareDB) MergeProfilesTopFunctions(ctx context.Context, stream *connect.BidiStream[ingestv1.MergeProfilesTopFunctionsRequest, ingestv1.MergeProfilesTopFunctionsResponse]) error {
+	return MergeProfilesTopFunctions(ctx, stream, i.forTimeRange)
+}
+
+func (i *ingesterHandlerPhlareDB) MergeProfilesValues(ctx context.Context, stream *connect.BidiStream[ingestv1.MergeProfilesValuesRequest, ingestv1.MergeProfilesValuesResponse]) error {
+	return MergeProfilesValues(ctx, stream, i.forTimeRange)
+}
+
+func (i *ingesterHandlerPhlareDB) GetProfileByID(ctx context.Context, req *connect.Request[ingestv1.GetProfileByIDRequest]) (*connect.Response[ingestv1.GetProfileByIDResponse], error) {
+	res, err := GetProfileByID(ctx, req.Msg, i.forTimeRange)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
 func (i *ingesterHandlerPhlareDB) Push(context.Context, *connect.Request[pushv1.PushRequest]) (*connect.Response[pushv1.PushResponse], error) {
 	return nil, errors.New("not implemented")
 }
@@ -458,6 +474,89 @@ func TestMergeProfilesPprof(t *testing.T) {
 	})
 }
 
+func TestMergeProfilesTopFunctions(t *testing.T) {
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+
+	var (
+		ctx     = testContext(t)
+		testDir = contextDataDir(ctx)
+		end     = time.Unix(0, int64(time.Hour))
+		start   = end.Add(-time.Minute)
+		step    = 15 * time.Second
+	)
+
+	db, err := New(ctx, Config{
+		DataPath:         testDir,
+		MaxBlockDuration: time.Duration(100000) * time.Minute, // we will manually flush
+	}, NoLimit, ctx.localBucketClient)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	ingestProfiles(t, db, cpuProfileGenerator, start.UnixNano(), end.UnixNano(), step,
+		&typesv1.LabelPair{Name: "namespace", Value: "my-namespace"},
+		&typesv1.LabelPair{Name: "pod", Value: "my-pod"},
+	)
+
+	client, cleanup := db.queriers().ingesterClient()
+	defer cleanup()
+
+	request := &ingestv1.SelectProfilesRequest{
+		LabelSelector: `{pod="my-pod"}`,
+		Type:          mustParseProfileSelector(t, "process_cpu:cpu:nanoseconds:cpu:nanoseconds"),
+		Start:         start.UnixMilli(),
+		End:           end.UnixMilli(),
+	}
+
+	// The self values must match the leaf values of the merged pprof profile.
+	pprofBidi := client.MergeProfilesPprof(ctx)
+	require.NoError(t, pprofBidi.Send(&ingestv1.MergeProfilesPprofRequest{Request: request}))
+	pprofResp, err := pprofBidi.Receive()
+	require.NoError(t, err)
+	keep := make([]bool, len(pprofResp.SelectedProfiles.Profiles))
+	for i := range keep {
+		keep[i] = true
+	}
+	require.NoError(t, pprofBidi.Send(&ingestv1.MergeProfilesPprofRequest{Profiles: keep}))
+	_, err = pprofBidi.Receive()
+	require.NoError(t, err)
+	pprofResp, err = pprofBidi.Receive()
+	require.NoError(t, err)
+	p, err := profile.ParseUncompressed(pprofResp.Result)
+	require.NoError(t, err)
+	expected := make(map[string]int64)
+	for _, s := range p.Sample {
+		expected[s.Location[0].Line[0].Function.Name] += s.Value[0]
+	}
+
+	bidi := client.MergeProfilesTopFunctions(ctx)
+	require.NoError(t, bidi.Send(&ingestv1.MergeProfilesTopFunctionsRequest{Request: request}))
+	resp, err := bidi.Receive()
+	require.NoError(t, err)
+	require.Nil(t, resp.Result)
+	require.Len(t, resp.SelectedProfiles.Profiles, len(keep))
+	require.NoError(t, bidi.Send(&ingestv1.MergeProfilesTopFunctionsRequest{Profiles: keep}))
+
+	// expect empty resp to signal it is finished
+	resp, err = bidi.Receive()
+	require.NoError(t, err)
+	require.Nil(t, resp.Result)
+
+	resp, err = bidi.Receive()
+	require.NoError(t, err)
+	require.NotNil(t, resp.Result)
+	require.NotEmpty(t, resp.Result.Functions)
+	actual := make(map[string]int64)
+	for _, f := range resp.Result.Functions {
+		require.GreaterOrEqual(t, f.Total, f.Self)
+		if f.Self > 0 {
+			actual[f.Name] = f.Self
+		}
+	}
+	require.Equal(t, expected, actual)
+}
+
 func Test_QueryNotInitializedHead(t *testing.T) {
 	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
 
@@ -531,6 +630,117 @@ func Test_QueryNotInitializedHead(t *testing.T) {
 	})
 }
 
+func TestMergeProfilesValues_GetProfileByID(t *testing.T) {
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+
+	var (
+		ctx     = testContext(t)
+		testDir = contextDataDir(ctx)
+		end     = time.Unix(0, int64(time.Hour))
+		start   = end.Add(-time.Minute)
+		step    = 15 * time.Second
+	)
+
+	db, err := New(ctx, Config{
+		DataPath:         testDir,
+		MaxBlockDuration: time.Duration(100000) * time.Minute, // we will manually flush
+	}, NoLimit, ctx.localBucketClient)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	ingestProfiles(t, db, cpuProfileGenerator, start.UnixNano(), end.UnixNano(), step,
+		&typesv1.LabelPair{Name: "namespace", Value: "my-namespace"},
+		&typesv1.LabelPair{Name: "pod", Value: "my-pod"},
+	)
+
+	request := &ingestv1.SelectProfilesRequest{
+		LabelSelector: `{pod="my-pod"}`,
+		Type:          mustParseProfileSelector(t, "process_cpu:cpu:nanoseconds:cpu:nanoseconds"),
+		Start:         start.UnixMilli(),
+		End:           end.UnixMilli(),
+	}
+
+	selectValues := func(maxProfiles int64) ([]*ingestv1.ProfileValues, error) {
+		client, cleanup := db.queriers().ingesterClient()
+		defer cleanup()
+
+		bidi := client.MergeProfilesValues(ctx)
+		require.NoError(t, bidi.Send(&ingestv1.MergeProfilesValuesRequest{Request: request, MaxProfiles: maxProfiles}))
+		resp, err := bidi.Receive()
+		require.NoError(t, err)
+		require.Nil(t, resp.Result)
+		keep := make([]bool, len(resp.SelectedProfiles.Profiles))
+		for i := range keep {
+			keep[i] = true
+		}
+		require.NoError(t, bidi.Send(&ingestv1.MergeProfilesValuesRequest{Profiles: keep}))
+
+		// expect empty resp to signal it is finished
+		resp, err = bidi.Receive()
+		require.NoError(t, err)
+		require.Nil(t, resp.Result)
+
+		resp, err = bidi.Receive()
+		if err != nil {
+			return nil, err
+		}
+		return resp.Result, nil
+	}
+
+	values, err := selectValues(0)
+	require.NoError(t, err)
+	require.Len(t, values, 1)
+	require.Len(t, values[0].Profiles, 5)
+	for i, p := range values[0].Profiles {
+		_, err = uuid.Parse(p.Id)
+		require.NoError(t, err)
+		require.Equal(t, start.Add(time.Duration(i)*step).UnixMilli(), p.Timestamp)
+		require.Positive(t, p.Value)
+	}
+
+	getProfile := func(id string) *googlev1.Profile {
+		client, cleanup := db.queriers().ingesterClient()
+		defer cleanup()
+
+		resp, err := client.GetProfileByID(ctx, connect.NewRequest(&ingestv1.GetProfileByIDRequest{
+			Request: request,
+			Id:      id,
+		}))
+		require.NoError(t, err)
+		return resp.Msg.Profile
+	}
+
+	expectProfiles := func() {
+		for _, v := range values[0].Profiles {
+			p := getProfile(v.Id)
+			require.NotNil(t, p)
+			require.Equal(t, v.Timestamp, time.Unix(0, p.TimeNanos).UnixMilli())
+			var total int64
+			for _, s := range p.Sample {
+				total += s.Value[0]
+			}
+			require.Equal(t, v.Value, total)
+		}
+		require.Nil(t, getProfile(uuid.NewString()))
+	}
+	expectProfiles()
+
+	// The values must be preserved in the blocks.
+	require.NoError(t, db.Flush(ctx, true, ""))
+	require.NoError(t, db.blockQuerier.Sync(ctx))
+	require.Len(t, db.blockQuerier.Queriers(), 1)
+	flushed, err := selectValues(5)
+	require.NoError(t, err)
+	require.Equal(t, values, flushed)
+	expectProfiles()
+
+	// The number of profiles in the result is limited.
+	_, err = selectValues(4)
+	require.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+}
+
 func Test_FlushNotInitializedHead(t *testing.T) {
 	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
 
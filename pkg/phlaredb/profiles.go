@@ -11,7 +11,6 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prometheus/common/model"
 	"github.com/prometheus/prometheus/model/labels"
-	"github.com/prometheus/prometheus/promql/parser"
 	"github.com/prometheus/prometheus/storage"
 	"github.com/samber/lo"
 	"go.uber.org/atomic"
@@ -211,7 +210,7 @@ func (pi *profilesIndex) Add(ps *schemav1.InMemoryProfile, lbs phlaremodel.Label
 func (pi *profilesIndex) selectMatchingFPs(ctx context.Context, params *ingestv1.SelectProfilesRequest) ([]model.Fingerprint, error) {
 	sp, _ := opentracing.StartSpanFromContext(ctx, "selectMatchingFPs - Index")
 	defer sp.Finish()
-	selectors, err := parser.ParseMetricSelector(params.LabelSelector)
+	selectors, shard, err := parseShardedSelector(params.LabelSelector)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "failed to parse label selectors: "+err.Error())
 	}
@@ -225,6 +224,7 @@ func (pi *profilesIndex) selectMatchingFPs(ctx context.Context, params *ingestv1
 	if err != nil {
 		return nil, err
 	}
+	ids = filterShard(ids, shard)
 
 	pi.mutex.RLock()
 	defer pi.mutex.RUnlock()
@@ -0,0 +1,114 @@
+package phlaredb
+
+import (
+	"fmt"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/storage"
+
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/phlaredb/sharding"
+	"github.com/grafana/pyroscope/pkg/phlaredb/tsdb/index"
+)
+
+// parseShardedSelector parses the label selector of a query and removes
+// the query shard matcher (__query_shard__), if present. The shard is
+// returned separately: series are assigned to shards by fingerprint.
+// Because the shard is determined by the fingerprint prefix, the shard
+// count must be a power of 2.
+func parseShardedSelector(selector string) ([]*labels.Matcher, *index.ShardAnnotation, error) {
+	matchers, err := parser.ParseMetricSelector(selector)
+	if err != nil {
+		return nil, nil, err
+	}
+	shard, matchers, err := sharding.RemoveShardFromMatchers(matchers)
+	if err != nil || shard == nil || shard.ShardCount == 1 {
+		return matchers, nil, err
+	}
+	if shard.ShardCount&(shard.ShardCount-1) != 0 {
+		return nil, nil, fmt.Errorf("query shard count must be a power of 2: %q", shard.LabelValue())
+	}
+	s := index.NewShard(uint32(shard.ShardIndex), uint32(shard.ShardCount))
+	return matchers, &s, nil
+}
+
+// shardedPostingsForMatchers is identical to PostingsForMatchers, except
+// that the series are filtered by the fingerprint. Unlike the index shard
+// postings, it does not require the series to be ordered by fingerprint:
+// series of a block are ordered by labels.
+func shardedPostingsForMatchers(ix IndexReader, shard *index.ShardAnnotation, ms ...*labels.Matcher) (index.Postings, error) {
+	postings, err := PostingsForMatchers(ix, nil, ms...)
+	if err != nil || shard == nil {
+		return postings, err
+	}
+	return &shardedPostings{
+		Postings: postings,
+		ix:       ix,
+		shard:    *shard,
+		chks:     make([]index.ChunkMeta, 1),
+	}, nil
+}
+
+type shardedPostings struct {
+	index.Postings
+	ix    IndexReader
+	shard index.ShardAnnotation
+	lbls  phlaremodel.Labels
+	chks  []index.ChunkMeta
+	err   error
+}
+
+func (p *shardedPostings) Next() bool {
+	for p.err == nil && p.Postings.Next() {
+		if p.match() {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *shardedPostings) Seek(v storage.SeriesRef) bool {
+	if !p.Postings.Seek(v) {
+		return false
+	}
+	if p.match() {
+		return true
+	}
+	return p.Next()
+}
+
+func (p *shardedPostings) match() bool {
+	if p.err != nil {
+		return false
+	}
+	fp, err := p.ix.Series(p.Postings.At(), &p.lbls, &p.chks)
+	if err != nil {
+		p.err = err
+		return false
+	}
+	return p.shard.Match(model.Fingerprint(fp))
+}
+
+func (p *shardedPostings) Err() error {
+	if p.err != nil {
+		return p.err
+	}
+	return p.Postings.Err()
+}
+
+// filterShard removes the fingerprints that don't belong to the shard.
+func filterShard(fps []model.Fingerprint, shard *index.ShardAnnotation) []model.Fingerprint {
+	if shard == nil {
+		return fps
+	}
+	var j int
+	for _, fp := range fps {
+		if shard.Match(fp) {
+			fps[j] = fp
+			j++
+		}
+	}
+	return fps[:j]
+}
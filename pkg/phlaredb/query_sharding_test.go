@@ -0,0 +1,99 @@
+package phlaredb
+
+import (
+	"context"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ingestv1 "github.com/grafana/pyroscope/api/gen/proto/go/ingester/v1"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+	"github.com/grafana/pyroscope/pkg/iter"
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/objstore/providers/filesystem"
+	"github.com/grafana/pyroscope/pkg/phlaredb/sharding"
+	"github.com/grafana/pyroscope/pkg/phlaredb/tsdb/index"
+)
+
+func Test_parseShardedSelector(t *testing.T) {
+	matchers, shard, err := parseShardedSelector(`{service_name="foo", __query_shard__="2_of_4"}`)
+	require.NoError(t, err)
+	require.Len(t, matchers, 1)
+	assert.Equal(t, "service_name", matchers[0].Name)
+	assert.Equal(t, &index.ShardAnnotation{Shard: 1, Of: 4}, shard)
+
+	_, shard, err = parseShardedSelector(`{service_name="foo", __query_shard__="1_of_1"}`)
+	require.NoError(t, err)
+	assert.Nil(t, shard)
+
+	_, _, err = parseShardedSelector(`{__query_shard__="1_of_3"}`)
+	require.Error(t, err)
+	_, _, err = parseShardedSelector(`{__query_shard__="5_of_4"}`)
+	require.Error(t, err)
+}
+
+func TestQuerySharding(t *testing.T) {
+	const (
+		series = 32
+		shards = 4
+	)
+	ctx := testContext(t)
+	head := newTestHead(t)
+	for i := 0; i < series; i++ {
+		require.NoError(t, head.Ingest(ctx, newProfileFoo(), uuid.New(), []*typesv1.LabelPair{
+			{Name: "job", Value: "foo"},
+			{Name: "x", Value: strconv.Itoa(i)},
+		}...))
+	}
+
+	typ, err := phlaremodel.ParseProfileTypeSelector(":type:unit:type:unit")
+	require.NoError(t, err)
+	selectShards := func(t *testing.T, q Querier) {
+		seen := make(map[string]struct{})
+		for i := uint64(0); i < shards; i++ {
+			s := sharding.ShardSelector{ShardIndex: i, ShardCount: shards}
+			it, err := q.SelectMatchingProfiles(ctx, &ingestv1.SelectProfilesRequest{
+				LabelSelector: `{job="foo",` + s.Matcher().String() + `}`,
+				Type:          typ,
+				End:           time.Now().Add(time.Hour).UnixMilli(),
+			})
+			require.NoError(t, err)
+			profiles, err := iter.Slice(it)
+			require.NoError(t, err)
+			assert.NotEmpty(t, profiles)
+			shard := index.NewShard(uint32(i), shards)
+			for _, p := range profiles {
+				assert.True(t, shard.Match(p.Fingerprint()))
+				x := p.Labels().Get("x")
+				assert.NotContains(t, seen, x)
+				seen[x] = struct{}{}
+			}
+		}
+		assert.Len(t, seen, series)
+	}
+
+	t.Run("head", func(t *testing.T) {
+		for _, q := range head.Queriers() {
+			selectShards(t, q)
+		}
+	})
+
+	require.NoError(t, head.Flush(ctx))
+	require.NoError(t, head.Move())
+	t.Run("block", func(t *testing.T) {
+		b, err := filesystem.NewBucket(filepath.Dir(head.localPath))
+		require.NoError(t, err)
+		metas, err := NewBlockQuerier(ctx, b).BlockMetas(ctx)
+		require.NoError(t, err)
+		require.Len(t, metas, 1)
+		q := NewSingleBlockQuerierFromMeta(context.Background(), b, metas[0])
+		require.NoError(t, q.Open(ctx))
+		defer q.Close()
+		selectShards(t, q)
+	})
+}
@@ -1,10 +1,12 @@
 package phlaredb
 
 import (
+	"bytes"
 	"context"
 	"sort"
 	"strings"
 
+	"github.com/google/uuid"
 	"github.com/grafana/dskit/runutil"
 	"github.com/opentracing/opentracing-go"
 	"github.com/parquet-go/parquet-go"
@@ -12,6 +14,7 @@ import (
 	"github.com/samber/lo"
 
 	profilev1 "github.com/grafana/pyroscope/api/gen/proto/go/google/v1"
+	ingestv1 "github.com/grafana/pyroscope/api/gen/proto/go/ingester/v1"
 	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
 	"github.com/grafana/pyroscope/pkg/iter"
 	phlaremodel "github.com/grafana/pyroscope/pkg/model"
@@ -20,7 +23,7 @@ import (
 	"github.com/grafana/pyroscope/pkg/phlaredb/symdb"
 )
 
-func (b *singleBlockQuerier) MergeByStacktraces(ctx context.Context, rows iter.Iterator[Profile], maxNodes int64) (*phlaremodel.Tree, error) {
+func (b *singleBlockQuerier) MergeByStacktraces(ctx context.Context, rows iter.Iterator[Profile], maxNodes int64, sts *typesv1.StackTraceSelector) (*phlaremodel.Tree, error) {
 	sp, ctx := opentracing.StartSpanFromContext(ctx, "MergeByStacktraces - Block")
 	defer sp.Finish()
 	sp.SetTag("block ULID", b.meta.ULID.String())
@@ -32,7 +35,9 @@ func (b *singleBlockQuerier) MergeByStacktraces(ctx context.Context, rows iter.I
 	defer b.queries.Done()
 
 	ctx = query.AddMetricsToContext(ctx, b.metrics.query)
-	r := symdb.NewResolver(ctx, b.symbols, symdb.WithResolverMaxNodes(maxNodes))
+	r := symdb.NewResolver(ctx, b.symbols,
+		symdb.WithResolverMaxNodes(maxNodes),
+		symdb.WithResolverStackTraceSelector(sts))
 	defer r.Release()
 	if err := mergeByStacktraces(ctx, b.profileSourceTable().file, rows, r); err != nil {
 		return nil, err
@@ -62,6 +67,61 @@ func (b *singleBlockQuerier) MergePprof(ctx context.Context, rows iter.Iterator[
 	return r.Pprof()
 }
 
+func (b *singleBlockQuerier) MergeTopFunctions(ctx context.Context, rows iter.Iterator[Profile], grouping typesv1.TopFunctionsGrouping, filter *typesv1.TopFunctionsFilter, sts *typesv1.StackTraceSelector) ([]*typesv1.TopFunction, error) {
+	sp, ctx := opentracing.StartSpanFromContext(ctx, "MergeTopFunctions - Block")
+	defer sp.Finish()
+	sp.SetTag("block ULID", b.meta.ULID.String())
+
+	if err := b.Open(ctx); err != nil {
+		return nil, err
+	}
+	b.queries.Add(1)
+	defer b.queries.Done()
+
+	ctx = query.AddMetricsToContext(ctx, b.metrics.query)
+	r := symdb.NewResolver(ctx, b.symbols,
+		symdb.WithResolverStackTraceSelector(sts))
+	defer r.Release()
+	if err := mergeByStacktraces(ctx, b.profileSourceTable().file, rows, r); err != nil {
+		return nil, err
+	}
+	return r.TopFunctions(grouping, filter)
+}
+
+func (b *singleBlockQuerier) MergeProfileValues(ctx context.Context, rows iter.Iterator[Profile]) ([]*ingestv1.ProfileValues, error) {
+	sp, ctx := opentracing.StartSpanFromContext(ctx, "MergeProfileValues - Block")
+	defer sp.Finish()
+	sp.SetTag("block ULID", b.meta.ULID.String())
+
+	if err := b.Open(ctx); err != nil {
+		return nil, err
+	}
+	b.queries.Add(1)
+	defer b.queries.Done()
+
+	ctx = query.AddMetricsToContext(ctx, b.metrics.query)
+	columnName := "TotalValue"
+	if b.meta.Version == 1 {
+		columnName = "Samples.list.element.Value"
+	}
+	return mergeProfileValues(ctx, b.profileSourceTable().file, columnName, rows)
+}
+
+func (b *singleBlockQuerier) FindProfileByID(ctx context.Context, rows iter.Iterator[Profile], id uuid.UUID) (Profile, error) {
+	sp, ctx := opentracing.StartSpanFromContext(ctx, "FindProfileByID - Block")
+	defer sp.Finish()
+	sp.SetTag("block ULID", b.meta.ULID.String())
+
+	if err := b.Open(ctx); err != nil {
+		return nil, err
+	}
+	b.queries.Add(1)
+	defer b.queries.Done()
+
+	ctx = query.AddMetricsToContext(ctx, b.metrics.query)
+	return findProfileByID(ctx, b.profileSourceTable().file, rows, id)
+}
+
 func (b *singleBlockQuerier) MergeByLabels(ctx context.Context, rows iter.Iterator[Profile], sts *typesv1.StackTraceSelector, by ...string) ([]*typesv1.Series, error) {
 	sp, ctx := opentracing.StartSpanFromContext(ctx, "MergeByLabels - Block")
 	defer sp.Finish()
@@ -74,7 +134,7 @@ func (b *singleBlockQuerier) MergeByLabels(ctx context.Context, rows iter.Iterat
 	defer b.queries.Done()
 
 	ctx = query.AddMetricsToContext(ctx, b.metrics.query)
-	if len(sts.GetCallSite()) == 0 {
+	if !symdb.SelectsStackTraces(sts) {
 		columnName := "TotalValue"
 		if b.meta.Version == 1 {
 			columnName = "Samples.list.element.Value"
@@ -252,6 +312,104 @@ func mergeByLabels[T Profile](
 	return seriesBuilder.build(), profiles.Err()
 }
 
+// mergeProfileValues collects the ID and the total value of each
+// profile. The values of the column are summed up, which allows
+// using sample values for blocks that have no total value column.
+func mergeProfileValues[T Profile](
+	ctx context.Context,
+	profileSource Source,
+	columnName string,
+	rows iter.Iterator[T],
+) (_ []*ingestv1.ProfileValues, err error) {
+	idColumn, err := v1.ResolveColumnByPath(profileSource.Schema(), []string{"ID"})
+	if err != nil {
+		return nil, err
+	}
+	valueColumn, err := v1.ResolveColumnByPath(profileSource.Schema(), strings.Split(columnName, "."))
+	if err != nil {
+		return nil, err
+	}
+	profiles := query.NewRepeatedRowIterator(ctx, rows, profileSource.RowGroups(),
+		idColumn.ColumnIndex,
+		valueColumn.ColumnIndex,
+	)
+	defer runutil.CloseWithErrCapture(&err, profiles, "failed to close profile stream")
+
+	var b profileValuesBuilder
+	b.init()
+	for profiles.Next() {
+		row := profiles.At()
+		p := row.Row
+		var id uuid.UUID
+		if len(row.Values[0]) > 0 {
+			copy(id[:], row.Values[0][0].ByteArray())
+		}
+		var total int64
+		for _, e := range row.Values[1] {
+			total += e.Int64()
+		}
+		b.add(p.Fingerprint(), p.Labels(), int64(p.Timestamp()), id, total)
+	}
+	return b.build(), profiles.Err()
+}
+
+func findProfileByID[T Profile](
+	ctx context.Context,
+	profileSource Source,
+	rows iter.Iterator[T],
+	id uuid.UUID,
+) (_ Profile, err error) {
+	column, err := v1.ResolveColumnByPath(profileSource.Schema(), []string{"ID"})
+	if err != nil {
+		return nil, err
+	}
+	profiles := query.NewRepeatedRowIterator(ctx, rows, profileSource.RowGroups(), column.ColumnIndex)
+	defer runutil.CloseWithErrCapture(&err, profiles, "failed to close profile stream")
+
+	for profiles.Next() {
+		row := profiles.At()
+		if v := row.Values[0]; len(v) > 0 && bytes.Equal(v[0].ByteArray(), id[:]) {
+			return row.Row, nil
+		}
+	}
+	return nil, profiles.Err()
+}
+
+// profileValuesBuilder groups profile values by series.
+type profileValuesBuilder struct {
+	series map[model.Fingerprint]*ingestv1.ProfileValues
+}
+
+func (b *profileValuesBuilder) init() {
+	b.series = make(map[model.Fingerprint]*ingestv1.ProfileValues)
+}
+
+func (b *profileValuesBuilder) add(fp model.Fingerprint, lbs phlaremodel.Labels, ts int64, id uuid.UUID, value int64) {
+	s, ok := b.series[fp]
+	if !ok {
+		s = &ingestv1.ProfileValues{Labels: lbs}
+		b.series[fp] = s
+	}
+	s.Profiles = append(s.Profiles, &ingestv1.ProfileValue{
+		Id:        id.String(),
+		Timestamp: ts,
+		Value:     value,
+	})
+}
+
+func (b *profileValuesBuilder) build() []*ingestv1.ProfileValues {
+	result := lo.Values(b.series)
+	sort.Slice(result, func(i, j int) bool {
+		return phlaremodel.CompareLabelPairs(result[i].Labels, result[j].Labels) < 0
+	})
+	for _, s := range result {
+		sort.Slice(s.Profiles, func(i, j int) bool {
+			return s.Profiles[i].Timestamp < s.Profiles[j].Timestamp
+		})
+	}
+	return result
+}
+
 func mergeByLabelsWithStackTraceSelector[T Profile](
 	ctx context.Context,
 	profileSource Source,
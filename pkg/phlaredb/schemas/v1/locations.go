@@ -67,6 +67,11 @@ func (LocationPersister) Reconstruct(row parquet.Row) (InMemoryLocation, error)
 		IsFolded:  row[len(row)-1].Boolean(),
 	}
 	lines := row[3 : len(row)-1]
+	if len(lines) == 2 && lines[0].IsNull() {
+		// The location has no lines: each of the
+		// line columns holds a single null value.
+		lines = nil
+	}
 	loc.Line = make([]InMemoryLine, len(lines)/2)
 	for i, v := range lines[:len(lines)/2] {
 		loc.Line[i].FunctionId = uint32(v.Uint64())
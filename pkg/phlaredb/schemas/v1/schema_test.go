@@ -199,6 +199,11 @@ func TestLocationsRoundTrip(t *testing.T) {
 			},
 			IsFolded: false,
 		},
+		{
+			Id:        15,
+			Address:   16,
+			MappingId: 17,
+		},
 	}
 
 	mem := []InMemoryLocation{
@@ -234,6 +239,12 @@ func TestLocationsRoundTrip(t *testing.T) {
 			},
 			IsFolded: false,
 		},
+		{
+			Id:        15,
+			Address:   16,
+			MappingId: 17,
+			Line:      []InMemoryLine{},
+		},
 	}
 
 	var buf bytes.Buffer
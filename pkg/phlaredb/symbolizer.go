@@ -0,0 +1,29 @@
+package phlaredb
+
+import (
+	"context"
+
+	"github.com/grafana/pyroscope/pkg/phlaredb/symdb"
+)
+
+// ContextWithSymbolizer returns a context that makes heads and blocks
+// created with it resolve unsymbolized locations at query time.
+func ContextWithSymbolizer(ctx context.Context, s symdb.Symbolizer) context.Context {
+	return context.WithValue(ctx, symbolizerContextKey, s)
+}
+
+func symbolizerFromContext(ctx context.Context) symdb.Symbolizer {
+	s, _ := ctx.Value(symbolizerContextKey).(symdb.Symbolizer)
+	return s
+}
+
+// symbolizingResolver serves partitions of the block
+// symbols with the unsymbolized locations resolved.
+type symbolizingResolver struct {
+	symbolsResolver
+	reader symdb.SymbolsReader
+}
+
+func (r *symbolizingResolver) Partition(ctx context.Context, partition uint64) (symdb.PartitionReader, error) {
+	return r.reader.Partition(ctx, partition)
+}
@@ -0,0 +1,100 @@
+package phlaredb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	googlev1 "github.com/grafana/pyroscope/api/gen/proto/go/google/v1"
+	ingestv1 "github.com/grafana/pyroscope/api/gen/proto/go/ingester/v1"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+	"github.com/grafana/pyroscope/pkg/phlaredb/symdb"
+)
+
+type fakeSymbolizer struct{}
+
+func (fakeSymbolizer) Symbolize(_ context.Context, m symdb.SymbolizerMapping, addresses []uint64) ([][]symdb.SymbolizedFrame, error) {
+	frames := make([][]symdb.SymbolizedFrame, len(addresses))
+	for i, addr := range addresses {
+		if m.BuildID == "build-id" && addr == 0x1010 {
+			frames[i] = []symdb.SymbolizedFrame{{FunctionName: "foo", FileName: "foo.c", Line: 10}}
+		}
+	}
+	return frames, nil
+}
+
+func Test_Symbolization(t *testing.T) {
+	ctx := testContext(t)
+	ctx.Context = ContextWithSymbolizer(ctx.Context, fakeSymbolizer{})
+
+	db, err := New(ctx, Config{
+		DataPath:         contextDataDir(ctx),
+		MaxBlockDuration: time.Duration(100000) * time.Minute, // we will manually flush
+	}, NoLimit, ctx.localBucketClient)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	ts := time.Unix(0, int64(time.Hour))
+	id := uuid.New()
+	require.NoError(t, db.Ingest(ctx, &googlev1.Profile{
+		SampleType:  []*googlev1.ValueType{{Type: 1, Unit: 2}},
+		PeriodType:  &googlev1.ValueType{Type: 1, Unit: 2},
+		Period:      1,
+		StringTable: []string{"", "cpu", "nanoseconds", "libfoo.so", "build-id"},
+		Mapping:     []*googlev1.Mapping{{Id: 1, MemoryStart: 0x1000, MemoryLimit: 0x2000, Filename: 3, BuildId: 4}},
+		Location: []*googlev1.Location{
+			{Id: 1, MappingId: 1, Address: 0x1010},
+			{Id: 2, MappingId: 1, Address: 0x1020},
+		},
+		Sample:    []*googlev1.Sample{{LocationId: []uint64{1, 2}, Value: []int64{100}}},
+		TimeNanos: ts.UnixNano(),
+	}, id, &typesv1.LabelPair{Name: model.MetricNameLabel, Value: "process_cpu"}))
+
+	getProfile := func() *googlev1.Profile {
+		client, cleanup := db.queriers().ingesterClient()
+		defer cleanup()
+		resp, err := client.GetProfileByID(ctx, connect.NewRequest(&ingestv1.GetProfileByIDRequest{
+			Request: &ingestv1.SelectProfilesRequest{
+				LabelSelector: "{}",
+				Type:          mustParseProfileSelector(t, "process_cpu:cpu:nanoseconds:cpu:nanoseconds"),
+				Start:         ts.Add(-time.Minute).UnixMilli(),
+				End:           ts.Add(time.Minute).UnixMilli(),
+			},
+			Id: id.String(),
+		}))
+		require.NoError(t, err)
+		require.NotNil(t, resp.Msg.Profile)
+		return resp.Msg.Profile
+	}
+
+	expectSymbolized := func(p *googlev1.Profile) {
+		require.Len(t, p.Function, 1)
+		require.Equal(t, "foo", p.StringTable[p.Function[0].Name])
+		require.Equal(t, "foo.c", p.StringTable[p.Function[0].Filename])
+		require.Len(t, p.Location, 2)
+		for _, loc := range p.Location {
+			switch loc.Address {
+			case 0x1010:
+				require.Equal(t, []*googlev1.Line{{FunctionId: p.Function[0].Id, Line: 10}}, loc.Line)
+			case 0x1020:
+				require.Empty(t, loc.Line)
+			default:
+				t.Fatalf("unexpected location address %x", loc.Address)
+			}
+		}
+	}
+
+	expectSymbolized(getProfile())
+	// Locations in blocks are symbolized as well.
+	require.NoError(t, db.Flush(ctx, true, ""))
+	require.NoError(t, db.blockQuerier.Sync(ctx))
+	require.Len(t, db.blockQuerier.Queriers(), 1)
+	expectSymbolized(getProfile())
+}
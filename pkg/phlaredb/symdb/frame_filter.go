@@ -0,0 +1,131 @@
+package symdb
+
+import (
+	"fmt"
+	"regexp"
+
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+)
+
+// frameMatch is a bitmask of the frame filter options a frame matches.
+type frameMatch uint8
+
+const (
+	frameMatchFocus frameMatch = 1 << iota
+	frameMatchIgnore
+	frameMatchHide
+	frameMatchShowFrom
+	frameMatchPruneFrom
+)
+
+// frameFilter transforms stack traces according to the FrameFilter.
+// A stack trace is given as a slice of locations, leaf first.
+type frameFilter struct {
+	// Options specified.
+	options frameMatch
+	// Location ID => options the location matches.
+	locations []frameMatch
+}
+
+func newFrameFilter(symbols *Symbols, f *typesv1.FrameFilter) *frameFilter {
+	if f == nil {
+		return nil
+	}
+	x := new(frameFilter)
+	matchers := make(map[frameMatch]*regexp.Regexp, 5)
+	for m, expr := range frameFilterOptions(f) {
+		if expr == "" {
+			continue
+		}
+		x.options |= m
+		// An invalid expression matches no frames.
+		if re, err := regexp.Compile(expr); err == nil {
+			matchers[m] = re
+		}
+	}
+	if x.options == 0 {
+		return nil
+	}
+	functions := make([]frameMatch, len(symbols.Functions))
+	for i, fn := range symbols.Functions {
+		name := symbols.Strings[fn.Name]
+		for m, re := range matchers {
+			if re.MatchString(name) {
+				functions[i] |= m
+			}
+		}
+	}
+	x.locations = make([]frameMatch, len(symbols.Locations))
+	for i, loc := range symbols.Locations {
+		for _, line := range loc.Line {
+			x.locations[i] |= functions[line.FunctionId]
+		}
+	}
+	return x
+}
+
+func frameFilterOptions(f *typesv1.FrameFilter) map[frameMatch]string {
+	return map[frameMatch]string{
+		frameMatchFocus:     f.Focus,
+		frameMatchIgnore:    f.Ignore,
+		frameMatchHide:      f.Hide,
+		frameMatchShowFrom:  f.ShowFrom,
+		frameMatchPruneFrom: f.PruneFrom,
+	}
+}
+
+func validateFrameFilter(f *typesv1.FrameFilter) error {
+	for _, expr := range frameFilterOptions(f) {
+		if _, err := regexp.Compile(expr); err != nil {
+			return fmt.Errorf("invalid frame filter regular expression: %w", err)
+		}
+	}
+	return nil
+}
+
+// filterFrames appends the transformed stack trace to dst. The function
+// reports false, if the stack trace should be dropped.
+//
+// The transformations are applied in the following order: focus,
+// ignore, show_from, hide, prune_from.
+func filterFrames[T int32 | uint64](x *frameFilter, dst, locations []T) ([]T, bool) {
+	dst = dst[:0]
+	var seen frameMatch
+	showFrom := -1
+	for i, loc := range locations {
+		m := x.locations[loc]
+		seen |= m
+		// The last match is the one closest to the root.
+		if m&frameMatchShowFrom != 0 {
+			showFrom = i
+		}
+	}
+	if x.options&frameMatchFocus != 0 && seen&frameMatchFocus == 0 {
+		return dst, false
+	}
+	if seen&frameMatchIgnore != 0 {
+		return dst, false
+	}
+	if x.options&frameMatchShowFrom != 0 {
+		if showFrom < 0 {
+			return dst, false
+		}
+		locations = locations[:showFrom+1]
+	}
+	// Only the frames kept by show_from are pruned from.
+	pruneFrom := 0
+	if x.options&frameMatchPruneFrom != 0 {
+		for i := len(locations) - 1; i >= 0; i-- {
+			if x.locations[locations[i]]&frameMatchPruneFrom != 0 {
+				pruneFrom = i
+				break
+			}
+		}
+	}
+	for i := pruneFrom; i < len(locations); i++ {
+		if x.locations[locations[i]]&frameMatchHide == 0 {
+			dst = append(dst, locations[i])
+		}
+	}
+	return dst, len(dst) > 0
+}
@@ -0,0 +1,172 @@
+package symdb
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	googlev1 "github.com/grafana/pyroscope/api/gen/proto/go/google/v1"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+)
+
+func Test_FrameFilter(t *testing.T) {
+	profile := &googlev1.Profile{
+		StringTable: []string{"", "main", "a", "b", "c", "runtime.gc"},
+		Function: []*googlev1.Function{
+			{Id: 1, Name: 1},
+			{Id: 2, Name: 2},
+			{Id: 3, Name: 3},
+			{Id: 4, Name: 4},
+			{Id: 5, Name: 5},
+		},
+		Mapping: []*googlev1.Mapping{{Id: 1}},
+		Location: []*googlev1.Location{
+			{Id: 1, MappingId: 1, Line: []*googlev1.Line{{FunctionId: 1}}}, // main
+			{Id: 2, MappingId: 1, Line: []*googlev1.Line{{FunctionId: 2}}}, // a
+			{Id: 3, MappingId: 1, Line: []*googlev1.Line{{FunctionId: 3}}}, // b
+			{Id: 4, MappingId: 1, Line: []*googlev1.Line{{FunctionId: 4}}}, // c
+			{Id: 5, MappingId: 1, Line: []*googlev1.Line{{FunctionId: 5}}}, // runtime.gc
+		},
+		Sample: []*googlev1.Sample{
+			{LocationId: []uint64{4, 3, 2, 1}, Value: []int64{1}}, // main;a;b;c
+			{LocationId: []uint64{3, 2, 1}, Value: []int64{2}},    // main;a;b
+			{LocationId: []uint64{4, 1}, Value: []int64{4}},       // main;c
+			{LocationId: []uint64{5, 1}, Value: []int64{8}},       // main;runtime.gc
+		},
+	}
+
+	db := NewSymDB(DefaultConfig().WithDirectory(t.TempDir()))
+	w := db.WriteProfileSymbols(0, profile)
+
+	testCases := []struct {
+		name     string
+		filter   *typesv1.FrameFilter
+		expected []string
+	}{
+		{
+			name:     "empty",
+			filter:   &typesv1.FrameFilter{},
+			expected: []string{"main;a;b 2", "main;a;b;c 1", "main;c 4", "main;runtime.gc 8"},
+		},
+		{
+			name:     "focus",
+			filter:   &typesv1.FrameFilter{Focus: "^c$"},
+			expected: []string{"main;a;b;c 1", "main;c 4"},
+		},
+		{
+			name:     "ignore",
+			filter:   &typesv1.FrameFilter{Ignore: "^runtime\\."},
+			expected: []string{"main;a;b 2", "main;a;b;c 1", "main;c 4"},
+		},
+		{
+			name:     "hide",
+			filter:   &typesv1.FrameFilter{Hide: "^(a|b)$"},
+			expected: []string{"main 2", "main;c 5", "main;runtime.gc 8"},
+		},
+		{
+			name:     "show_from",
+			filter:   &typesv1.FrameFilter{ShowFrom: "^(a|c)$"},
+			expected: []string{"a;b 2", "a;b;c 1", "c 4"},
+		},
+		{
+			name:     "prune_from",
+			filter:   &typesv1.FrameFilter{PruneFrom: "^a$"},
+			expected: []string{"main;a 3", "main;c 4", "main;runtime.gc 8"},
+		},
+		{
+			name:     "combined",
+			filter:   &typesv1.FrameFilter{Focus: "b", ShowFrom: "^a$", PruneFrom: "^b$"},
+			expected: []string{"a;b 3"},
+		},
+		{
+			// main matches prune_from, but it is removed by show_from.
+			name:     "prune_from after show_from",
+			filter:   &typesv1.FrameFilter{ShowFrom: "^a$", PruneFrom: "^(main|b)$"},
+			expected: []string{"a;b 3"},
+		},
+		{
+			name:     "show_from, hide and prune_from",
+			filter:   &typesv1.FrameFilter{ShowFrom: "^a$", Hide: "^b$", PruneFrom: "^(main|c)$"},
+			expected: []string{"a 2", "a;c 1"},
+		},
+		{
+			name:     "hide all",
+			filter:   &typesv1.FrameFilter{Hide: "."},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			sts := &typesv1.StackTraceSelector{FrameFilter: tc.filter}
+			require.NoError(t, ValidateStackTraceSelector(sts))
+
+			r := NewResolver(context.Background(), db, WithResolverStackTraceSelector(sts))
+			defer r.Release()
+			r.AddSamples(0, w[0].Samples)
+			tree, err := r.Tree()
+			require.NoError(t, err)
+			var buf bytes.Buffer
+			tree.WriteCollapsed(&buf)
+			assert.Equal(t, tc.expected, collapsedLines(buf.String()), "tree")
+
+			r = NewResolver(context.Background(), db, WithResolverStackTraceSelector(sts))
+			defer r.Release()
+			r.AddSamples(0, w[0].Samples)
+			p, err := r.Pprof()
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, pprofCollapsed(p), "pprof")
+		})
+	}
+}
+
+func Test_ValidateStackTraceSelector_FrameFilter(t *testing.T) {
+	require.NoError(t, ValidateStackTraceSelector(&typesv1.StackTraceSelector{FrameFilter: &typesv1.FrameFilter{Focus: "foo.*"}}))
+	require.Error(t, ValidateStackTraceSelector(&typesv1.StackTraceSelector{FrameFilter: &typesv1.FrameFilter{Hide: "("}}))
+}
+
+func collapsedLines(s string) []string {
+	var lines []string
+	for _, line := range strings.Split(s, "\n") {
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	sort.Strings(lines)
+	return lines
+}
+
+func pprofCollapsed(p *googlev1.Profile) []string {
+	locations := make(map[uint64]*googlev1.Location, len(p.Location))
+	for _, loc := range p.Location {
+		locations[loc.Id] = loc
+	}
+	functions := make(map[uint64]*googlev1.Function, len(p.Function))
+	for _, fn := range p.Function {
+		functions[fn.Id] = fn
+	}
+	values := make(map[string]int64)
+	for _, s := range p.Sample {
+		var names []string
+		for i := len(s.LocationId) - 1; i >= 0; i-- {
+			lines := locations[s.LocationId[i]].Line
+			for j := len(lines) - 1; j >= 0; j-- {
+				names = append(names, p.StringTable[functions[lines[j].FunctionId].Name])
+			}
+		}
+		values[strings.Join(names, ";")] += s.Value[0]
+	}
+	var lines []string
+	for stack, v := range values {
+		lines = append(lines, stack+" "+strconv.FormatInt(v, 10))
+	}
+	sort.Strings(lines)
+	return lines
+}
@@ -61,6 +61,8 @@ func WithResolverMaxNodes(n int64) ResolverOption {
 // Only stack traces that belong to the callSite (have the prefix provided)
 // will be selected. If empty, the filter is ignored.
 // Subtree root location is the last element.
+// The frame filter of the selector applies to both trees and pprof
+// profiles, while the rest of the selector only applies to the latter.
 func WithResolverStackTraceSelector(sts *typesv1.StackTraceSelector) ResolverOption {
 	return func(r *Resolver) {
 		r.sts = sts
@@ -235,7 +237,12 @@ func (r *Resolver) Tree() (*model.Tree, error) {
 	var lock sync.Mutex
 	tree := new(model.Tree)
 	err := r.withSymbols(ctx, func(symbols *Symbols, appender *SampleAppender) error {
-		resolved, err := symbols.Tree(ctx, appender, r.maxNodes)
+		var selection *SelectedStackTraces
+		if r.sts.GetFrameFilter() != nil {
+			// Only the frame filter is applicable to trees.
+			selection = SelectStackTraces(symbols, r.sts)
+		}
+		resolved, err := symbols.Tree(ctx, appender, r.maxNodes, selection)
 		if err != nil {
 			return err
 		}
@@ -267,6 +274,27 @@ func (r *Resolver) Pprof() (*googlev1.Profile, error) {
 	return p.Profile(), nil
 }
 
+// TopFunctions returns the self and total values of the functions found
+// in the stack traces, in no particular order. Only the call site
+// stack trace selector is respected. The filter is optional.
+func (r *Resolver) TopFunctions(grouping typesv1.TopFunctionsGrouping, filter *typesv1.TopFunctionsFilter) ([]*typesv1.TopFunction, error) {
+	span, ctx := opentracing.StartSpanFromContext(r.ctx, "Resolver.TopFunctions")
+	defer span.Finish()
+	m := model.NewTopFunctionsMerger()
+	err := r.withSymbols(ctx, func(symbols *Symbols, appender *SampleAppender) error {
+		resolved, err := symbols.TopFunctions(ctx, appender, grouping, filter, SelectStackTraces(symbols, r.sts))
+		if err != nil {
+			return err
+		}
+		m.MergeTopFunctions(resolved)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return m.TopFunctions(), nil
+}
+
 func (r *Resolver) withSymbols(ctx context.Context, fn func(*Symbols, *SampleAppender) error) error {
 	g, _ := errgroup.WithContext(ctx)
 	g.SetLimit(r.c)
@@ -291,10 +319,21 @@ func (r *Symbols) Pprof(
 	return buildPprof(ctx, r, appender.Samples(), maxNodes, selection)
 }
 
+func (r *Symbols) TopFunctions(
+	ctx context.Context,
+	appender *SampleAppender,
+	grouping typesv1.TopFunctionsGrouping,
+	filter *typesv1.TopFunctionsFilter,
+	selection *SelectedStackTraces,
+) ([]*typesv1.TopFunction, error) {
+	return buildTopFunctions(ctx, r, appender.Samples(), grouping, filter, selection)
+}
+
 func (r *Symbols) Tree(
 	ctx context.Context,
 	appender *SampleAppender,
 	maxNodes int64,
+	selection *SelectedStackTraces,
 ) (*model.Tree, error) {
-	return buildTree(ctx, r, appender, maxNodes)
+	return buildTree(ctx, r, appender, maxNodes, selection)
 }
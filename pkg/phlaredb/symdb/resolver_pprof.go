@@ -37,8 +37,9 @@ func buildPprof(
 		return b.buildPprof(), nil
 	// Truncation is applicable when there is an explicit
 	// limit on the number of the nodes in the profile, or
-	// if stack traces should be filtered by the call site.
-	case maxNodes > 0 || len(selection.callSite) > 0:
+	// if stack traces should be filtered by the call site,
+	// or transformed by the frame filter.
+	case maxNodes > 0 || len(selection.callSite) > 0 || selection.frames != nil:
 		b = &pprofTree{maxNodes: maxNodes, selection: selection}
 	}
 	b.init(symbols, samples)
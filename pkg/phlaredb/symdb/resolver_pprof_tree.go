@@ -30,6 +30,9 @@ type pprofTree struct {
 	// stacks simultaneously.
 	functionsBuf []int32
 	locationsBuf []uint64
+	// Buffers for the stack traces transformed by the frame filter.
+	framesBuf         []int32
+	filteredLocations []uint64
 
 	selection *SelectedStackTraces
 	fnNames   func(locations []int32) ([]int32, bool)
@@ -67,6 +70,13 @@ func (r *pprofTree) init(symbols *Symbols, samples schemav1.Samples) {
 func (r *pprofTree) InsertStacktrace(stacktraceID uint32, locations []int32) {
 	value := int64(r.samples.Values[r.cur])
 	r.cur++
+	if r.selection != nil && r.selection.frames != nil {
+		var ok bool
+		if r.framesBuf, ok = filterFrames(r.selection.frames, r.framesBuf, locations); !ok {
+			return
+		}
+		locations = r.framesBuf
+	}
 	functions, ok := r.fnNames(locations)
 	if ok {
 		functionNodeIdx := r.functionTree.Insert(functions, value)
@@ -156,6 +166,11 @@ func (r *pprofTree) addSample(n truncatedStacktraceSample) {
 		return
 	}
 	r.locationsBuf = r.symbols.Stacktraces.LookupLocations(r.locationsBuf, n.stacktraceID)
+	if r.selection != nil && r.selection.frames != nil {
+		// The stack trace is known to be kept by the filter.
+		r.filteredLocations, _ = filterFrames(r.selection.frames, r.filteredLocations, r.locationsBuf)
+		r.locationsBuf, r.filteredLocations = r.filteredLocations, r.locationsBuf
+	}
 	if off > 0 {
 		// Some functions were truncated.
 		r.locationsBuf = truncateLocations(r.locationsBuf, r.functionsBuf, off, r.symbols)
@@ -0,0 +1,162 @@
+package symdb
+
+import (
+	"context"
+
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+	schemav1 "github.com/grafana/pyroscope/pkg/phlaredb/schemas/v1"
+)
+
+func buildTopFunctions(
+	ctx context.Context,
+	symbols *Symbols,
+	samples schemav1.Samples,
+	grouping typesv1.TopFunctionsGrouping,
+	filter *typesv1.TopFunctionsFilter,
+	selection *SelectedStackTraces,
+) ([]*typesv1.TopFunction, error) {
+	// If a stack trace selector is specified, check if
+	// the call site can exist in the partition.
+	if !selection.HasValidCallSite() {
+		return nil, nil
+	}
+	b := &topFunctions{
+		symbols:   symbols,
+		samples:   &samples,
+		grouping:  grouping,
+		selection: selection,
+		functions: make(map[topFunctionKey]*topFunctionValues),
+	}
+	if filter != nil {
+		b.matches = make([]bool, len(symbols.Functions))
+		for i, f := range symbols.Functions {
+			b.matches[i] = (filter.FunctionName == "" || symbols.Strings[f.Name] == filter.FunctionName) &&
+				(filter.Filename == "" || symbols.Strings[f.Filename] == filter.Filename)
+		}
+		// Lines of a file are not told apart by the function.
+		b.byLine = filter.FunctionName == "" && filter.Filename != ""
+	}
+	if err := symbols.Stacktraces.ResolveStacktraceLocations(ctx, b, samples.StacktraceIDs); err != nil {
+		return nil, err
+	}
+	return b.topFunctions(), nil
+}
+
+// topFunctionKey identifies a function according to the grouping:
+// filename and line are only set when they are part of the key.
+type topFunctionKey struct {
+	name     uint32
+	filename uint32
+	line     int32
+}
+
+type topFunctionValues struct {
+	self  int64
+	total int64
+	// The number of the sample the function was last seen in:
+	// recursive calls must not be accounted in total twice.
+	seen int
+}
+
+type topFunctions struct {
+	symbols   *Symbols
+	samples   *schemav1.Samples
+	grouping  typesv1.TopFunctionsGrouping
+	selection *SelectedStackTraces
+	functions map[topFunctionKey]*topFunctionValues
+	cur       int
+
+	// Whether the functions match the filter, by function ID:
+	// nil, if there is no filter.
+	matches []bool
+	// Whether the functions are grouped by file name and line only.
+	byLine bool
+}
+
+func (r *topFunctions) InsertStacktrace(_ uint32, locations []int32) {
+	value := int64(r.samples.Values[r.cur])
+	r.cur++
+	if len(locations) == 0 || !r.selected(locations) {
+		return
+	}
+	for i := 0; i < len(locations); i++ {
+		lines := r.symbols.Locations[locations[i]].Line
+		for j := 0; j < len(lines); j++ {
+			if r.matches != nil && !r.matches[lines[j].FunctionId] {
+				if i == 0 && j == 0 {
+					// The self value of the functions not matching
+					// the filter is attributed to a function without
+					// name, which is the zero key.
+					r.value(topFunctionKey{}).self += value
+				}
+				continue
+			}
+			v := r.values(lines[j])
+			if i == 0 && j == 0 {
+				v.self += value
+			}
+			if v.seen != r.cur {
+				v.seen = r.cur
+				v.total += value
+			}
+		}
+	}
+}
+
+// selected reports whether the stack trace belongs
+// to the call site specified by the selector, if any.
+func (r *topFunctions) selected(locations []int32) bool {
+	if r.selection == nil || r.selection.depth == 0 {
+		return true
+	}
+	var pos uint32
+	for i := len(locations) - 1; i >= 0 && pos < r.selection.depth; i-- {
+		lines := r.symbols.Locations[locations[i]].Line
+		for j := len(lines) - 1; j >= 0 && pos < r.selection.depth; j-- {
+			if r.selection.callSite[pos] != r.selection.funcNames[lines[j].FunctionId] {
+				return false
+			}
+			pos++
+		}
+	}
+	return pos == r.selection.depth
+}
+
+func (r *topFunctions) values(line schemav1.InMemoryLine) *topFunctionValues {
+	f := r.symbols.Functions[line.FunctionId]
+	if r.byLine {
+		return r.value(topFunctionKey{filename: f.Filename, line: line.Line})
+	}
+	k := topFunctionKey{name: f.Name}
+	switch r.grouping {
+	case typesv1.TopFunctionsGrouping_TOP_FUNCTIONS_GROUPING_FILE:
+		k.filename = f.Filename
+	case typesv1.TopFunctionsGrouping_TOP_FUNCTIONS_GROUPING_LINE:
+		k.filename = f.Filename
+		k.line = line.Line
+	}
+	return r.value(k)
+}
+
+func (r *topFunctions) value(k topFunctionKey) *topFunctionValues {
+	v, ok := r.functions[k]
+	if !ok {
+		v = new(topFunctionValues)
+		r.functions[k] = v
+	}
+	return v
+}
+
+func (r *topFunctions) topFunctions() []*typesv1.TopFunction {
+	functions := make([]*typesv1.TopFunction, 0, len(r.functions))
+	for k, v := range r.functions {
+		functions = append(functions, &typesv1.TopFunction{
+			Name:     r.symbols.Strings[k.name],
+			Filename: r.symbols.Strings[k.filename],
+			Line:     int64(k.line),
+			Self:     v.self,
+			Total:    v.total,
+		})
+	}
+	return functions
+}
@@ -0,0 +1,150 @@
+package symdb
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	googlev1 "github.com/grafana/pyroscope/api/gen/proto/go/google/v1"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+	schemav1 "github.com/grafana/pyroscope/pkg/phlaredb/schemas/v1"
+)
+
+func Test_Resolver_TopFunctions(t *testing.T) {
+	profile := &googlev1.Profile{
+		StringTable: []string{"", "a", "b", "c", "d", "b.go"},
+		Function: []*googlev1.Function{
+			{Id: 1, Name: 1},
+			{Id: 2, Name: 2, Filename: 5},
+			{Id: 3, Name: 3},
+			{Id: 4, Name: 4},
+		},
+		Mapping: []*googlev1.Mapping{{Id: 1}},
+		Location: []*googlev1.Location{
+			{Id: 1, MappingId: 1, Line: []*googlev1.Line{{FunctionId: 1, Line: 1}}}, // a
+			{Id: 2, MappingId: 1, Line: []*googlev1.Line{{FunctionId: 2, Line: 1}}}, // b:1
+			{Id: 3, MappingId: 1, Line: []*googlev1.Line{{FunctionId: 2, Line: 2}}}, // b:2
+			{Id: 4, MappingId: 1, Line: []*googlev1.Line{{FunctionId: 3, Line: 1}}}, // c
+			{Id: 5, MappingId: 1, Line: []*googlev1.Line{{FunctionId: 4, Line: 1}}}, // d
+		},
+		Sample: []*googlev1.Sample{
+			{LocationId: []uint64{4, 2, 1}, Value: []int64{1}}, // a, b:1, c
+			{LocationId: []uint64{3, 1}, Value: []int64{2}},    // a, b:2
+			{LocationId: []uint64{4, 1}, Value: []int64{3}},    // a, c
+			{LocationId: []uint64{5}, Value: []int64{4}},       // d
+			{LocationId: []uint64{1, 1}, Value: []int64{5}},    // a, a
+		},
+	}
+
+	db := NewSymDB(DefaultConfig().WithDirectory(t.TempDir()))
+	w := db.WriteProfileSymbols(0, profile)
+
+	topFunctions := func(grouping typesv1.TopFunctionsGrouping, sts *typesv1.StackTraceSelector) []*typesv1.TopFunction {
+		return resolveTopFunctions(t, db, w[0].Samples, grouping, nil, sts)
+	}
+
+	t.Run("by function", func(t *testing.T) {
+		require.Equal(t, []*typesv1.TopFunction{
+			{Name: "a", Self: 5, Total: 11},
+			{Name: "b", Self: 2, Total: 3},
+			{Name: "c", Self: 4, Total: 4},
+			{Name: "d", Self: 4, Total: 4},
+		}, topFunctions(typesv1.TopFunctionsGrouping_TOP_FUNCTIONS_GROUPING_FUNCTION, nil))
+	})
+
+	t.Run("by line", func(t *testing.T) {
+		require.Equal(t, []*typesv1.TopFunction{
+			{Name: "a", Line: 1, Self: 5, Total: 11},
+			{Name: "b", Filename: "b.go", Line: 1, Self: 0, Total: 1},
+			{Name: "b", Filename: "b.go", Line: 2, Self: 2, Total: 2},
+			{Name: "c", Line: 1, Self: 4, Total: 4},
+			{Name: "d", Line: 1, Self: 4, Total: 4},
+		}, topFunctions(typesv1.TopFunctionsGrouping_TOP_FUNCTIONS_GROUPING_LINE, nil))
+	})
+
+	t.Run("call site", func(t *testing.T) {
+		require.Equal(t, []*typesv1.TopFunction{
+			{Name: "a", Filename: "", Self: 0, Total: 3},
+			{Name: "b", Filename: "b.go", Self: 2, Total: 3},
+			{Name: "c", Filename: "", Self: 1, Total: 1},
+		}, topFunctions(typesv1.TopFunctionsGrouping_TOP_FUNCTIONS_GROUPING_FILE, &typesv1.StackTraceSelector{
+			CallSite: []*typesv1.Location{{Name: "a"}, {Name: "b"}},
+		}))
+	})
+
+	t.Run("function filter", func(t *testing.T) {
+		require.Equal(t, []*typesv1.TopFunction{
+			{Name: "", Self: 13, Total: 0},
+			{Name: "b", Filename: "b.go", Line: 1, Self: 0, Total: 1},
+			{Name: "b", Filename: "b.go", Line: 2, Self: 2, Total: 2},
+		}, resolveTopFunctions(t, db, w[0].Samples, typesv1.TopFunctionsGrouping_TOP_FUNCTIONS_GROUPING_LINE, &typesv1.TopFunctionsFilter{
+			FunctionName: "b",
+		}, nil))
+	})
+
+	t.Run("invalid call site", func(t *testing.T) {
+		require.Empty(t, topFunctions(typesv1.TopFunctionsGrouping_TOP_FUNCTIONS_GROUPING_FUNCTION, &typesv1.StackTraceSelector{
+			CallSite: []*typesv1.Location{{Name: "x"}},
+		}))
+	})
+}
+
+func Test_Resolver_TopFunctions_FileFilter(t *testing.T) {
+	profile := &googlev1.Profile{
+		StringTable: []string{"", "main", "main.func1", "other", "main.go", "other.go"},
+		Function: []*googlev1.Function{
+			{Id: 1, Name: 1, Filename: 4},
+			{Id: 2, Name: 2, Filename: 4},
+			{Id: 3, Name: 3, Filename: 5},
+		},
+		Mapping: []*googlev1.Mapping{{Id: 1}},
+		Location: []*googlev1.Location{
+			{Id: 1, MappingId: 1, Line: []*googlev1.Line{{FunctionId: 1, Line: 10}}}, // main:10
+			{Id: 2, MappingId: 1, Line: []*googlev1.Line{{FunctionId: 2, Line: 10}}}, // main.func1:10
+			{Id: 3, MappingId: 1, Line: []*googlev1.Line{{FunctionId: 1, Line: 12}}}, // main:12
+			{Id: 4, MappingId: 1, Line: []*googlev1.Line{{FunctionId: 3, Line: 1}}},  // other:1
+		},
+		Sample: []*googlev1.Sample{
+			{LocationId: []uint64{2, 1}, Value: []int64{1}}, // main:10, main.func1:10
+			{LocationId: []uint64{4, 3}, Value: []int64{2}}, // main:12, other:1
+			{LocationId: []uint64{1}, Value: []int64{3}},    // main:10
+		},
+	}
+
+	db := NewSymDB(DefaultConfig().WithDirectory(t.TempDir()))
+	w := db.WriteProfileSymbols(0, profile)
+
+	// The lines are accounted once per sample, regardless of the function.
+	require.Equal(t, []*typesv1.TopFunction{
+		{Self: 2},
+		{Filename: "main.go", Line: 10, Self: 4, Total: 4},
+		{Filename: "main.go", Line: 12, Self: 0, Total: 2},
+	}, resolveTopFunctions(t, db, w[0].Samples, typesv1.TopFunctionsGrouping_TOP_FUNCTIONS_GROUPING_LINE, &typesv1.TopFunctionsFilter{
+		Filename: "main.go",
+	}, nil))
+}
+
+func resolveTopFunctions(
+	t *testing.T,
+	db *SymDB,
+	samples schemav1.Samples,
+	grouping typesv1.TopFunctionsGrouping,
+	filter *typesv1.TopFunctionsFilter,
+	sts *typesv1.StackTraceSelector,
+) []*typesv1.TopFunction {
+	t.Helper()
+	r := NewResolver(context.Background(), db, WithResolverStackTraceSelector(sts))
+	defer r.Release()
+	r.AddSamples(0, samples)
+	actual, err := r.TopFunctions(grouping, filter)
+	require.NoError(t, err)
+	sort.Slice(actual, func(i, j int) bool {
+		if actual[i].Name != actual[j].Name {
+			return actual[i].Name < actual[j].Name
+		}
+		return actual[i].Line < actual[j].Line
+	})
+	return actual
+}
@@ -18,15 +18,22 @@ func buildTree(
 	symbols *Symbols,
 	appender *SampleAppender,
 	maxNodes int64,
+	selection *SelectedStackTraces,
 ) (*model.Tree, error) {
+	var frames *frameFilter
+	if selection != nil {
+		frames = selection.frames
+	}
 	// If the number of samples is large (> 128K) and the StacktraceResolver
 	// implements the range iterator, we will be building the tree based on
 	// the parent pointer tree of the partition (a copy of). The only exception
 	// is when the number of nodes is not limited, or is close to the number of
 	// nodes in the original tree: the optimization is still beneficial in terms
-	// of CPU, but is very expensive in terms of memory.
+	// of CPU, but is very expensive in terms of memory. Stack traces
+	// transformed by the frame filter can't be taken from the source
+	// tree either.
 	iterator, ok := symbols.Stacktraces.(StacktraceIDRangeIterator)
-	if ok && shouldCopyTree(appender, maxNodes) {
+	if ok && frames == nil && shouldCopyTree(appender, maxNodes) {
 		ranges := iterator.SplitStacktraceIDRanges(appender)
 		return buildTreeFromParentPointerTrees(ctx, ranges, symbols, maxNodes)
 	}
@@ -36,7 +43,7 @@ func buildTree(
 	samples := appender.Samples()
 	t := treeSymbolsFromPool()
 	defer t.reset()
-	t.init(symbols, samples)
+	t.init(symbols, samples, frames)
 	if err := symbols.Stacktraces.ResolveStacktraceLocations(ctx, t, samples.StacktraceIDs); err != nil {
 		return nil, err
 	}
@@ -55,6 +62,9 @@ type treeSymbols struct {
 	tree    *model.StacktraceTree
 	lines   []int32
 	cur     int
+
+	frames    *frameFilter
+	framesBuf []int32
 }
 
 var treeSymbolsPool = sync.Pool{
@@ -71,12 +81,14 @@ func (r *treeSymbols) reset() {
 	r.tree.Reset()
 	r.lines = r.lines[:0]
 	r.cur = 0
+	r.frames = nil
 	treeSymbolsPool.Put(r)
 }
 
-func (r *treeSymbols) init(symbols *Symbols, samples schemav1.Samples) {
+func (r *treeSymbols) init(symbols *Symbols, samples schemav1.Samples, frames *frameFilter) {
 	r.symbols = symbols
 	r.samples = &samples
+	r.frames = frames
 	if r.tree == nil {
 		// Branching factor.
 		r.tree = model.NewStacktraceTree(samples.Len() * 2)
@@ -84,6 +96,15 @@ func (r *treeSymbols) init(symbols *Symbols, samples schemav1.Samples) {
 }
 
 func (r *treeSymbols) InsertStacktrace(_ uint32, locations []int32) {
+	value := int64(r.samples.Values[r.cur])
+	r.cur++
+	if r.frames != nil {
+		var ok bool
+		if r.framesBuf, ok = filterFrames(r.frames, r.framesBuf, locations); !ok {
+			return
+		}
+		locations = r.framesBuf
+	}
 	r.lines = r.lines[:0]
 	for i := 0; i < len(locations); i++ {
 		lines := r.symbols.Locations[locations[i]].Line
@@ -92,8 +113,7 @@ func (r *treeSymbols) InsertStacktrace(_ uint32, locations []int32) {
 			r.lines = append(r.lines, int32(f.Name))
 		}
 	}
-	r.tree.Insert(r.lines, int64(r.samples.Values[r.cur]))
-	r.cur++
+	r.tree.Insert(r.lines, value)
 }
 
 func buildTreeFromParentPointerTrees(
@@ -1,6 +1,10 @@
 package symdb
 
 import (
+	"errors"
+	"fmt"
+	"regexp"
+
 	"github.com/parquet-go/parquet-go"
 
 	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
@@ -50,7 +54,13 @@ type SelectedStackTraces struct {
 	callSite         []string // call site strings in the original order.
 	location         string   // stack trace leaf function.
 	depth            uint32
-	buf              []uint64
+	// Function filter. Function ID => whether
+	// the function matches the selector.
+	function      *typesv1.FunctionSelector
+	functionMatch []bool
+	buf           []uint64
+	// Frame filter transformations.
+	frames *frameFilter
 	// Function ID => name. The lookup table is used to
 	// avoid unnecessary indirect accesses through the
 	// strings[functions[id].Name] path. Instead, the
@@ -72,9 +82,54 @@ func SelectStackTraces(symbols *Symbols, selector *typesv1.StackTraceSelector) *
 	for i, f := range symbols.Functions {
 		x.funcNames[i] = symbols.Strings[f.Name]
 	}
+	if x.function = selector.GetFunction(); x.function != nil {
+		x.callSiteSelector, x.callSite, x.depth = nil, nil, 0
+		x.functionMatch = make([]bool, len(x.funcNames))
+		// An invalid selector matches no functions.
+		if match, err := functionMatcher(x.function); err == nil {
+			for i, name := range x.funcNames {
+				x.functionMatch[i] = match(name)
+			}
+		}
+	}
+	x.frames = newFrameFilter(symbols, selector.GetFrameFilter())
 	return x
 }
 
+// SelectsStackTraces reports whether the selector filters stack traces
+// by the call site or the function, which requires stack traces to be
+// resolved.
+func SelectsStackTraces(selector *typesv1.StackTraceSelector) bool {
+	return len(selector.GetCallSite()) > 0 || selector.GetFunction() != nil
+}
+
+// ValidateStackTraceSelector checks whether the selector is valid.
+func ValidateStackTraceSelector(selector *typesv1.StackTraceSelector) error {
+	if f := selector.GetFunction(); f != nil {
+		if f.Name == "" {
+			return errors.New("function name must not be empty")
+		}
+		if _, err := functionMatcher(f); err != nil {
+			return err
+		}
+	}
+	if f := selector.GetFrameFilter(); f != nil {
+		return validateFrameFilter(f)
+	}
+	return nil
+}
+
+func functionMatcher(f *typesv1.FunctionSelector) (func(string) bool, error) {
+	if !f.Regex {
+		return func(name string) bool { return name == f.Name }, nil
+	}
+	re, err := regexp.Compile("^(?:" + f.Name + ")$")
+	if err != nil {
+		return nil, fmt.Errorf("invalid function name regular expression: %w", err)
+	}
+	return re.MatchString, nil
+}
+
 // HasValidCallSite reports whether any stack traces match the selector.
 // An empty selector results in a valid empty selection.
 func (x *SelectedStackTraces) HasValidCallSite() bool {
@@ -85,7 +140,7 @@ func (x *SelectedStackTraces) HasValidCallSite() bool {
 // the selected stack traces and the given set of samples.
 func (x *SelectedStackTraces) CallSiteValues(values *CallSiteValues, samples schemav1.Samples) {
 	*values = CallSiteValues{}
-	if x.depth == 0 {
+	if x.depth == 0 && x.function == nil {
 		return
 	}
 	if x.relations == nil {
@@ -110,7 +165,7 @@ func (x *SelectedStackTraces) CallSiteValues(values *CallSiteValues, samples sch
 // but accepts raw parquet values instead of samples.
 func (x *SelectedStackTraces) CallSiteValuesParquet(values *CallSiteValues, stacktraceID, value []parquet.Value) {
 	*values = CallSiteValues{}
-	if x.depth == 0 {
+	if x.depth == 0 && x.function == nil {
 		return
 	}
 	if x.relations == nil {
@@ -146,6 +201,9 @@ func (x *SelectedStackTraces) appendStackTrace(locations []uint64) stackTraceLoc
 	if len(locations) == 0 {
 		return 0
 	}
+	if x.function != nil {
+		return x.appendFunctionStackTrace(locations)
+	}
 	var n uint32 // Number of times callSite root function seen.
 	var pos uint32
 	var l uint32
@@ -177,6 +235,36 @@ func (x *SelectedStackTraces) appendStackTrace(locations []uint64) stackTraceLoc
 	return stackTraceLocationRelation(inSubtree | isLeaf<<1 | (1-isLeaf)<<2)
 }
 
+// appendFunctionStackTrace returns the relation of the stack trace to the
+// function selector. The stack trace belongs to the selection (subtree),
+// if it includes the function at any depth, or, if only the self values
+// are attributed, if the function is the stack trace leaf.
+func (x *SelectedStackTraces) appendFunctionStackTrace(locations []uint64) stackTraceLocationRelation {
+	var isLeaf uint32
+	if lines := x.symbols.Locations[locations[0]].Line; len(lines) > 0 && x.functionMatch[lines[0].FunctionId] {
+		isLeaf = 1
+	}
+	if isLeaf == 0 && !x.includesFunction(locations) {
+		return 0
+	}
+	inSubtree := isLeaf
+	if !x.function.Self {
+		inSubtree = 1
+	}
+	return stackTraceLocationRelation(inSubtree | isLeaf<<1 | (1-isLeaf)<<2)
+}
+
+func (x *SelectedStackTraces) includesFunction(locations []uint64) bool {
+	for _, loc := range locations {
+		for _, line := range x.symbols.Locations[loc].Line {
+			if x.functionMatch[line.FunctionId] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func callSiteFunctions(locations []*typesv1.Location) []string {
 	callSite := make([]string, len(locations))
 	for i, loc := range locations {
@@ -110,6 +110,51 @@ func Test_StackTraceFilter(t *testing.T) {
 				LocationTotal: 1,
 			},
 		},
+		{
+			selector: &typesv1.StackTraceSelector{
+				Function: &typesv1.FunctionSelector{Name: "bar"},
+			},
+			expected: CallSiteValues{
+				Flat:          3,
+				Total:         6,
+				LocationFlat:  3,
+				LocationTotal: 6,
+			},
+		},
+		{
+			selector: &typesv1.StackTraceSelector{
+				Function: &typesv1.FunctionSelector{Name: "bar", Self: true},
+			},
+			expected: CallSiteValues{
+				Flat:          3,
+				Total:         3,
+				LocationFlat:  3,
+				LocationTotal: 6,
+			},
+		},
+		{
+			selector: &typesv1.StackTraceSelector{
+				// The function selector takes precedence.
+				CallSite: []*typesv1.Location{{Name: "qux"}},
+				Function: &typesv1.FunctionSelector{Name: "ba.", Regex: true},
+			},
+			expected: CallSiteValues{
+				Flat:          5,
+				Total:         7,
+				LocationFlat:  5,
+				LocationTotal: 7,
+			},
+		},
+		{
+			selector: &typesv1.StackTraceSelector{
+				Function: &typesv1.FunctionSelector{Name: "ba", Regex: true},
+			},
+		},
+		{
+			selector: &typesv1.StackTraceSelector{
+				Function: &typesv1.FunctionSelector{Name: "(", Regex: true},
+			},
+		},
 		{selector: &typesv1.StackTraceSelector{}},
 		{},
 	}
@@ -122,6 +167,24 @@ func Test_StackTraceFilter(t *testing.T) {
 	}
 }
 
+func Test_ValidateStackTraceSelector(t *testing.T) {
+	assert.NoError(t, ValidateStackTraceSelector(nil))
+	assert.NoError(t, ValidateStackTraceSelector(&typesv1.StackTraceSelector{
+		Function: &typesv1.FunctionSelector{Name: "foo.*", Regex: true},
+	}))
+	assert.Error(t, ValidateStackTraceSelector(&typesv1.StackTraceSelector{
+		Function: &typesv1.FunctionSelector{Name: "(", Regex: true},
+	}))
+	assert.Error(t, ValidateStackTraceSelector(&typesv1.StackTraceSelector{
+		Function: &typesv1.FunctionSelector{},
+	}))
+
+	assert.False(t, SelectsStackTraces(nil))
+	assert.False(t, SelectsStackTraces(&typesv1.StackTraceSelector{GoPgo: &typesv1.GoPGO{}}))
+	assert.True(t, SelectsStackTraces(&typesv1.StackTraceSelector{CallSite: []*typesv1.Location{{Name: "foo"}}}))
+	assert.True(t, SelectsStackTraces(&typesv1.StackTraceSelector{Function: &typesv1.FunctionSelector{Name: "foo"}}))
+}
+
 func Benchmark_StackTraceFilter(b *testing.B) {
 	s := memSuite{t: b, files: [][]string{{"testdata/big-profile.pb.gz"}}}
 	s.config = DefaultConfig().WithDirectory(b.TempDir())
@@ -0,0 +1,214 @@
+package symdb
+
+import (
+	"context"
+
+	schemav1 "github.com/grafana/pyroscope/pkg/phlaredb/schemas/v1"
+)
+
+// Symbolizer resolves addresses of native code into source code
+// functions and lines, using debug information of the binary.
+type Symbolizer interface {
+	// Symbolize resolves the addresses of the binary mapping.
+	// The result must include an entry for every address given:
+	// empty entries denote addresses that could not be resolved.
+	// Frames of an address are ordered from the innermost one,
+	// the same way as pprof location lines are.
+	Symbolize(ctx context.Context, m SymbolizerMapping, addresses []uint64) ([][]SymbolizedFrame, error)
+}
+
+// SymbolizerMapping describes the binary the addresses belong to.
+type SymbolizerMapping struct {
+	BuildID     string
+	MemoryStart uint64
+	MemoryLimit uint64
+	FileOffset  uint64
+}
+
+type SymbolizedFrame struct {
+	FunctionName string
+	FileName     string
+	StartLine    int64
+	Line         int64
+}
+
+// NewSymbolizingReader returns a SymbolsReader that resolves unsymbolized
+// locations of the partitions with the symbolizer given. The source
+// symbols are never modified: partitions that have unsymbolized locations
+// are served with a symbolized copy of the symbols.
+func NewSymbolizingReader(r SymbolsReader, s Symbolizer) SymbolsReader {
+	if s == nil {
+		return r
+	}
+	return &symbolizingReader{reader: r, symbolizer: s}
+}
+
+type symbolizingReader struct {
+	reader     SymbolsReader
+	symbolizer Symbolizer
+}
+
+func (r *symbolizingReader) Partition(ctx context.Context, partition uint64) (PartitionReader, error) {
+	p, err := r.reader.Partition(ctx, partition)
+	if err != nil {
+		return nil, err
+	}
+	symbols, err := Symbolize(ctx, p.Symbols(), r.symbolizer)
+	if err != nil {
+		p.Release()
+		return nil, err
+	}
+	return &symbolizedPartition{PartitionReader: p, symbols: symbols}, nil
+}
+
+type symbolizedPartition struct {
+	PartitionReader
+	symbols *Symbols
+}
+
+func (p *symbolizedPartition) Symbols() *Symbols { return p.symbols }
+
+// Symbolize resolves the locations that have an address but no lines,
+// and belong to a mapping with a build ID. If there is nothing to resolve,
+// or none of the addresses can be resolved, the source symbols are returned.
+// Otherwise, a copy that shares stack traces with the source is returned.
+func Symbolize(ctx context.Context, s *Symbols, symbolizer Symbolizer) (*Symbols, error) {
+	// Mapping ID => indices of the locations to be resolved.
+	var unresolved map[uint32][]int
+	for i, loc := range s.Locations {
+		if len(loc.Line) > 0 || loc.Address == 0 || int(loc.MappingId) >= len(s.Mappings) {
+			continue
+		}
+		m := s.Mappings[loc.MappingId]
+		if m.BuildId == 0 || int(m.BuildId) >= len(s.Strings) || s.Strings[m.BuildId] == "" {
+			continue
+		}
+		if unresolved == nil {
+			unresolved = make(map[uint32][]int)
+		}
+		unresolved[loc.MappingId] = append(unresolved[loc.MappingId], i)
+	}
+	if len(unresolved) == 0 {
+		return s, nil
+	}
+
+	b := symbolsBuilder{src: s}
+	addresses := make([]uint64, 0, 64)
+	for mappingID, locations := range unresolved {
+		m := s.Mappings[mappingID]
+		addresses = addresses[:0]
+		for _, i := range locations {
+			addresses = append(addresses, s.Locations[i].Address)
+		}
+		frames, err := symbolizer.Symbolize(ctx, SymbolizerMapping{
+			BuildID:     s.Strings[m.BuildId],
+			MemoryStart: m.MemoryStart,
+			MemoryLimit: m.MemoryLimit,
+			FileOffset:  m.FileOffset,
+		}, addresses)
+		if err != nil {
+			return nil, err
+		}
+		var resolved bool
+		for j, i := range locations {
+			if j < len(frames) && len(frames[j]) > 0 {
+				b.setLines(i, frames[j])
+				resolved = true
+			}
+		}
+		if resolved {
+			b.setMappingSymbolized(mappingID)
+		}
+	}
+
+	return b.symbols(), nil
+}
+
+// symbolsBuilder lazily copies the source symbols
+// upon the first modification.
+type symbolsBuilder struct {
+	src *Symbols
+	dst *Symbols
+
+	mappingsCopied bool
+	functions      map[schemav1.InMemoryFunction]uint32
+	strings        map[string]uint32
+}
+
+func (b *symbolsBuilder) init() {
+	if b.dst != nil {
+		return
+	}
+	b.dst = &Symbols{
+		Stacktraces: b.src.Stacktraces,
+		Locations:   make([]schemav1.InMemoryLocation, len(b.src.Locations)),
+		Mappings:    b.src.Mappings,
+		// Functions and strings are only appended:
+		// the capacity is limited to never modify the source.
+		Functions: b.src.Functions[:len(b.src.Functions):len(b.src.Functions)],
+		Strings:   b.src.Strings[:len(b.src.Strings):len(b.src.Strings)],
+	}
+	copy(b.dst.Locations, b.src.Locations)
+	b.functions = make(map[schemav1.InMemoryFunction]uint32)
+	b.strings = make(map[string]uint32)
+}
+
+func (b *symbolsBuilder) setLines(location int, frames []SymbolizedFrame) {
+	b.init()
+	lines := make([]schemav1.InMemoryLine, len(frames))
+	for i, f := range frames {
+		lines[i] = schemav1.InMemoryLine{
+			FunctionId: b.function(f),
+			Line:       int32(f.Line),
+		}
+	}
+	b.dst.Locations[location].Line = lines
+}
+
+func (b *symbolsBuilder) setMappingSymbolized(mappingID uint32) {
+	b.init()
+	if !b.mappingsCopied {
+		b.dst.Mappings = make([]schemav1.InMemoryMapping, len(b.src.Mappings))
+		copy(b.dst.Mappings, b.src.Mappings)
+		b.mappingsCopied = true
+	}
+	m := &b.dst.Mappings[mappingID]
+	m.HasFunctions = true
+	m.HasFilenames = true
+	m.HasLineNumbers = true
+}
+
+func (b *symbolsBuilder) function(f SymbolizedFrame) uint32 {
+	name := b.string(f.FunctionName)
+	fn := schemav1.InMemoryFunction{
+		Name:       name,
+		SystemName: name,
+		Filename:   b.string(f.FileName),
+		StartLine:  uint32(f.StartLine),
+	}
+	if id, ok := b.functions[fn]; ok {
+		return id
+	}
+	id := uint32(len(b.dst.Functions))
+	b.functions[fn] = id
+	fn.Id = uint64(id)
+	b.dst.Functions = append(b.dst.Functions, fn)
+	return id
+}
+
+func (b *symbolsBuilder) string(s string) uint32 {
+	if id, ok := b.strings[s]; ok {
+		return id
+	}
+	id := uint32(len(b.dst.Strings))
+	b.strings[s] = id
+	b.dst.Strings = append(b.dst.Strings, s)
+	return id
+}
+
+func (b *symbolsBuilder) symbols() *Symbols {
+	if b.dst == nil {
+		return b.src
+	}
+	return b.dst
+}
@@ -0,0 +1,87 @@
+package symdb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	schemav1 "github.com/grafana/pyroscope/pkg/phlaredb/schemas/v1"
+)
+
+type fakeSymbolizer struct {
+	requests []SymbolizerMapping
+}
+
+func (f *fakeSymbolizer) Symbolize(_ context.Context, m SymbolizerMapping, addresses []uint64) ([][]SymbolizedFrame, error) {
+	f.requests = append(f.requests, m)
+	frames := make([][]SymbolizedFrame, len(addresses))
+	for i, addr := range addresses {
+		switch addr {
+		case 0x10:
+			frames[i] = []SymbolizedFrame{{FunctionName: "foo", FileName: "foo.c", StartLine: 1, Line: 2}}
+		case 0x20:
+			frames[i] = []SymbolizedFrame{
+				{FunctionName: "inlined", FileName: "foo.c", StartLine: 10, Line: 12},
+				{FunctionName: "foo", FileName: "foo.c", StartLine: 1, Line: 3},
+			}
+		}
+	}
+	return frames, nil
+}
+
+func Test_Symbolize(t *testing.T) {
+	src := &Symbols{
+		Strings: []string{"", "main", "main.go", "build-id", "libc.so"},
+		Mappings: []schemav1.InMemoryMapping{
+			{},
+			{Id: 1, Filename: 4, BuildId: 3, MemoryStart: 0x1000, MemoryLimit: 0x2000},
+			{Id: 2, HasFunctions: true},
+		},
+		Functions: []schemav1.InMemoryFunction{
+			{},
+			{Id: 1, Name: 1, SystemName: 1, Filename: 2},
+		},
+		Locations: []schemav1.InMemoryLocation{
+			{},
+			{Id: 1, MappingId: 2, Line: []schemav1.InMemoryLine{{FunctionId: 1, Line: 1}}},
+			{Id: 2, MappingId: 1, Address: 0x10},
+			{Id: 3, MappingId: 1, Address: 0x20},
+			{Id: 4, MappingId: 1, Address: 0x30},
+		},
+	}
+
+	s := new(fakeSymbolizer)
+	resolved, err := Symbolize(context.Background(), src, s)
+	require.NoError(t, err)
+	require.Equal(t, []SymbolizerMapping{{BuildID: "build-id", MemoryStart: 0x1000, MemoryLimit: 0x2000}}, s.requests)
+
+	require.Equal(t, []string{"", "main", "main.go", "build-id", "libc.so", "foo", "foo.c", "inlined"}, resolved.Strings)
+	require.Equal(t, []schemav1.InMemoryFunction{
+		{},
+		{Id: 1, Name: 1, SystemName: 1, Filename: 2},
+		{Id: 2, Name: 5, SystemName: 5, Filename: 6, StartLine: 1},
+		{Id: 3, Name: 7, SystemName: 7, Filename: 6, StartLine: 10},
+	}, resolved.Functions)
+	require.Equal(t, []schemav1.InMemoryLocation{
+		{},
+		{Id: 1, MappingId: 2, Line: []schemav1.InMemoryLine{{FunctionId: 1, Line: 1}}},
+		{Id: 2, MappingId: 1, Address: 0x10, Line: []schemav1.InMemoryLine{{FunctionId: 2, Line: 2}}},
+		{Id: 3, MappingId: 1, Address: 0x20, Line: []schemav1.InMemoryLine{{FunctionId: 3, Line: 12}, {FunctionId: 2, Line: 3}}},
+		{Id: 4, MappingId: 1, Address: 0x30},
+	}, resolved.Locations)
+	require.True(t, resolved.Mappings[1].HasFunctions)
+
+	// The source symbols must not be modified.
+	require.Len(t, src.Strings, 5)
+	require.Len(t, src.Functions, 2)
+	require.Empty(t, src.Locations[2].Line)
+	require.False(t, src.Mappings[1].HasFunctions)
+
+	// Nothing to resolve.
+	s = new(fakeSymbolizer)
+	again, err := Symbolize(context.Background(), resolved, s)
+	require.NoError(t, err)
+	require.Len(t, s.requests, 1) // Location 4 is still unresolved.
+	require.Equal(t, resolved.Locations, again.Locations)
+}
@@ -0,0 +1,358 @@
+package phlaredb
+
+import (
+	"context"
+	"slices"
+
+	"connectrpc.com/connect"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/samber/lo"
+
+	profilev1 "github.com/grafana/pyroscope/api/gen/proto/go/google/v1"
+	ingestv1 "github.com/grafana/pyroscope/api/gen/proto/go/ingester/v1"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+	"github.com/grafana/pyroscope/pkg/iter"
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/phlaredb/query"
+	schemav1 "github.com/grafana/pyroscope/pkg/phlaredb/schemas/v1"
+	"github.com/grafana/pyroscope/pkg/phlaredb/tombstones"
+	"github.com/grafana/pyroscope/pkg/phlaredb/tsdb/index"
+)
+
+// TombstonesProvider returns the current tombstones of the tenant.
+type TombstonesProvider interface {
+	Tombstones() *tombstones.Tombstones
+}
+
+// ContextWithTombstones returns a context that makes blocks created
+// with it skip the profiles deleted by the tombstones at query time.
+func ContextWithTombstones(ctx context.Context, p TombstonesProvider) context.Context {
+	return context.WithValue(ctx, tombstonesContextKey, p)
+}
+
+func tombstonesFromContext(ctx context.Context) TombstonesProvider {
+	p, _ := ctx.Value(tombstonesContextKey).(TombstonesProvider)
+	return p
+}
+
+// deletedSeries collects the series of a block that
+// are matched by tombstones overlapping the block.
+type deletedSeries struct {
+	tombstones *tombstones.Tombstones
+	series     map[int64]phlaremodel.Labels
+	lbls       phlaremodel.Labels
+	chks       []index.ChunkMeta
+}
+
+// deletedSeries returns nil, if no tombstones
+// overlap the block.
+func (b *singleBlockQuerier) deletedSeries() *deletedSeries {
+	if b.tombstones == nil {
+		return nil
+	}
+	t := b.tombstones.Tombstones().Overlapping(b.meta.MinTime, b.meta.MaxTime)
+	if t == nil {
+		return nil
+	}
+	return &deletedSeries{
+		tombstones: t,
+		series:     make(map[int64]phlaremodel.Labels),
+		chks:       make([]index.ChunkMeta, 1),
+	}
+}
+
+// seriesTombstones returns the tombstones overlapping the block
+// and the time range, if set, or nil, if there are none.
+func (b *singleBlockQuerier) seriesTombstones(start, end int64) *tombstones.Tombstones {
+	if b.tombstones == nil {
+		return nil
+	}
+	minT, maxT := b.meta.MinTime, b.meta.MaxTime
+	if start != 0 && end != 0 {
+		minT = max(minT, model.Time(start))
+		maxT = min(maxT, model.Time(end))
+	}
+	return b.tombstones.Tombstones().Overlapping(minT, maxT)
+}
+
+// blockSeries selects the series of the block
+// regardless of the tombstones.
+type blockSeries struct{ *singleBlockQuerier }
+
+func (b blockSeries) Series(ctx context.Context, params *ingestv1.SeriesRequest) ([]*typesv1.Labels, error) {
+	return b.series(ctx, params)
+}
+
+// add looks up the series labels and adds the
+// series, if it is matched by the tombstones.
+func (d *deletedSeries) add(r *index.Reader, ref storage.SeriesRef) error {
+	if d == nil {
+		return nil
+	}
+	if _, err := r.Series(ref, &d.lbls, &d.chks); err != nil {
+		return err
+	}
+	if d.tombstones.Matches(d.lbls) {
+		lbs := make(phlaremodel.Labels, len(d.lbls))
+		copy(lbs, d.lbls)
+		d.series[int64(d.chks[0].SeriesIndex)] = lbs
+	}
+	return nil
+}
+
+func (d *deletedSeries) deleted(seriesIndex, timeNanos int64) bool {
+	if d == nil {
+		return false
+	}
+	lbs, ok := d.series[seriesIndex]
+	return ok && d.tombstones.Deleted(lbs, timeNanos)
+}
+
+// alias returns the alias the column must be selected as,
+// for the rows to be filtered: the deleted profiles are
+// identified by the series index and the timestamp.
+func (d *deletedSeries) alias(column string) string {
+	if d == nil {
+		return ""
+	}
+	return column
+}
+
+// filter returns an iterator that skips the deleted profiles.
+// SeriesIndex and TimeNanos columns must be selected.
+func (d *deletedSeries) filter(it query.Iterator) query.Iterator {
+	if d == nil || len(d.series) == 0 {
+		return it
+	}
+	return &deletedProfilesFilter{Iterator: it, deleted: d}
+}
+
+type deletedProfilesFilter struct {
+	query.Iterator
+	deleted *deletedSeries
+}
+
+func (it *deletedProfilesFilter) Next() bool {
+	for it.Iterator.Next() {
+		if !it.skip() {
+			return true
+		}
+	}
+	return false
+}
+
+func (it *deletedProfilesFilter) Seek(to query.RowNumberWithDefinitionLevel) bool {
+	if !it.Iterator.Seek(to) {
+		return false
+	}
+	if !it.skip() {
+		return true
+	}
+	return it.Next()
+}
+
+func (it *deletedProfilesFilter) skip() bool {
+	r := it.Iterator.At()
+	return it.deleted.deleted(
+		r.ColumnValue(schemav1.SeriesIndexColumnName).Int64(),
+		r.ColumnValue(schemav1.TimeNanosColumnName).Int64(),
+	)
+}
+
+// withTombstones makes the queriers that overlap the tombstones skip
+// the deleted profiles. Unlike blocks, the queriers of the heads do not
+// filter out the deleted profiles on their own.
+func withTombstones(queriers Queriers, p TombstonesProvider) Queriers {
+	if p == nil {
+		return queriers
+	}
+	t := p.Tombstones()
+	if t == nil {
+		return queriers
+	}
+	for i, q := range queriers {
+		if o := t.Overlapping(q.Bounds()); o != nil {
+			queriers[i] = &deletedProfilesQuerier{Querier: q, tombstones: o}
+		}
+	}
+	return queriers
+}
+
+// deletedProfilesQuerier skips the profiles deleted by the tombstones.
+// Merge queries fall back to the selection of the profiles to be merged,
+// and the label queries fall back to the selection of the series.
+type deletedProfilesQuerier struct {
+	Querier
+	tombstones *tombstones.Tombstones
+}
+
+func (q *deletedProfilesQuerier) SelectMatchingProfiles(ctx context.Context, params *ingestv1.SelectProfilesRequest) (iter.Iterator[Profile], error) {
+	it, err := q.Querier.SelectMatchingProfiles(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return &deletedProfilesIterator{Iterator: it, tombstones: q.tombstones}, nil
+}
+
+func (q *deletedProfilesQuerier) SelectMergeByStacktraces(ctx context.Context, params *ingestv1.SelectProfilesRequest, maxNodes int64, sts *typesv1.StackTraceSelector) (*phlaremodel.Tree, error) {
+	it, err := q.SelectMatchingProfiles(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return q.MergeByStacktraces(ctx, it, maxNodes, sts)
+}
+
+func (q *deletedProfilesQuerier) SelectMergeByLabels(ctx context.Context, params *ingestv1.SelectProfilesRequest, sts *typesv1.StackTraceSelector, by ...string) ([]*typesv1.Series, error) {
+	it, err := q.SelectMatchingProfiles(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return q.MergeByLabels(ctx, it, sts, by...)
+}
+
+func (q *deletedProfilesQuerier) SelectMergeBySpans(ctx context.Context, params *ingestv1.SelectSpanProfileRequest) (*phlaremodel.Tree, error) {
+	spans, err := phlaremodel.NewSpanSelector(params.SpanSelector)
+	if err != nil {
+		return nil, err
+	}
+	it, err := q.SelectMatchingProfiles(ctx, &ingestv1.SelectProfilesRequest{
+		LabelSelector: params.LabelSelector,
+		Type:          params.Type,
+		Start:         params.Start,
+		End:           params.End,
+		Hints:         params.Hints,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return q.MergeBySpans(ctx, it, spans)
+}
+
+func (q *deletedProfilesQuerier) SelectMergePprof(ctx context.Context, params *ingestv1.SelectProfilesRequest, maxNodes int64, sts *typesv1.StackTraceSelector) (*profilev1.Profile, error) {
+	it, err := q.SelectMatchingProfiles(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return q.MergePprof(ctx, it, maxNodes, sts)
+}
+
+func (q *deletedProfilesQuerier) LabelValues(ctx context.Context, req *connect.Request[typesv1.LabelValuesRequest]) (*connect.Response[typesv1.LabelValuesResponse], error) {
+	resp, err := labelValuesWithTombstones(ctx, q.Querier, q.tombstones, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (q *deletedProfilesQuerier) LabelNames(ctx context.Context, req *connect.Request[typesv1.LabelNamesRequest]) (*connect.Response[typesv1.LabelNamesResponse], error) {
+	resp, err := labelNamesWithTombstones(ctx, q.Querier, q.tombstones, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (q *deletedProfilesQuerier) Series(ctx context.Context, params *ingestv1.SeriesRequest) ([]*typesv1.Labels, error) {
+	return seriesWithTombstones(ctx, q.Querier, q.tombstones, params)
+}
+
+type deletedProfilesIterator struct {
+	iter.Iterator[Profile]
+	tombstones *tombstones.Tombstones
+}
+
+func (it *deletedProfilesIterator) Next() bool {
+	for it.Iterator.Next() {
+		p := it.Iterator.At()
+		if !it.tombstones.Deleted(p.Labels(), profileTimeNanos(p)) {
+			return true
+		}
+	}
+	return false
+}
+
+func profileTimeNanos(p Profile) int64 {
+	if x, ok := p.(ProfileWithLabels); ok {
+		return x.profile.TimeNanos
+	}
+	return p.Timestamp().UnixNano()
+}
+
+// seriesQuerier selects all the labels of the series.
+type seriesQuerier interface {
+	TimeBounded
+	Series(ctx context.Context, params *ingestv1.SeriesRequest) ([]*typesv1.Labels, error)
+}
+
+// liveSeries returns the series matching the selectors, except
+// the ones all the profiles of which are deleted within the time
+// range, or within the querier bounds, if the range is not set.
+func liveSeries(ctx context.Context, q seriesQuerier, t *tombstones.Tombstones, matchers []string, start, end int64) ([]*typesv1.Labels, error) {
+	series, err := q.Series(ctx, &ingestv1.SeriesRequest{Matchers: matchers, Start: start, End: end})
+	if err != nil {
+		return nil, err
+	}
+	minT, maxT := q.Bounds()
+	if start != 0 && end != 0 {
+		minT = max(minT, model.Time(start))
+		maxT = min(maxT, model.Time(end))
+	}
+	live := series[:0]
+	for _, s := range series {
+		if !t.Covers(s.Labels, minT.UnixNano(), maxT.UnixNano()) {
+			live = append(live, s)
+		}
+	}
+	return live, nil
+}
+
+func labelValuesWithTombstones(ctx context.Context, q seriesQuerier, t *tombstones.Tombstones, req *typesv1.LabelValuesRequest) (*typesv1.LabelValuesResponse, error) {
+	series, err := liveSeries(ctx, q, t, req.Matchers, req.Start, req.End)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]struct{})
+	for _, s := range series {
+		if v := phlaremodel.Labels(s.Labels).Get(req.Name); v != "" {
+			values[v] = struct{}{}
+		}
+	}
+	names := lo.Keys(values)
+	slices.Sort(names)
+	return &typesv1.LabelValuesResponse{Names: names}, nil
+}
+
+func labelNamesWithTombstones(ctx context.Context, q seriesQuerier, t *tombstones.Tombstones, req *typesv1.LabelNamesRequest) (*typesv1.LabelNamesResponse, error) {
+	series, err := liveSeries(ctx, q, t, req.Matchers, req.Start, req.End)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]struct{})
+	for _, s := range series {
+		for _, l := range s.Labels {
+			values[l.Name] = struct{}{}
+		}
+	}
+	names := lo.Keys(values)
+	slices.Sort(names)
+	return &typesv1.LabelNamesResponse{Names: names}, nil
+}
+
+func seriesWithTombstones(ctx context.Context, q seriesQuerier, t *tombstones.Tombstones, params *ingestv1.SeriesRequest) ([]*typesv1.Labels, error) {
+	series, err := liveSeries(ctx, q, t, params.Matchers, params.Start, params.End)
+	if err != nil || len(params.LabelNames) == 0 {
+		return series, err
+	}
+	unique := make(map[uint64]struct{}, len(series))
+	result := series[:0]
+	for _, s := range series {
+		lbs := phlaremodel.Labels(s.Labels).WithLabels(params.LabelNames...)
+		if _, ok := unique[lbs.Hash()]; ok {
+			continue
+		}
+		unique[lbs.Hash()] = struct{}{}
+		result = append(result, &typesv1.Labels{Labels: lbs})
+	}
+	return result, nil
+}
@@ -0,0 +1,124 @@
+package tombstones
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid"
+
+	"github.com/grafana/pyroscope/pkg/objstore"
+)
+
+var ErrDeleteRequestNotFound = errors.New("delete request not found")
+
+const deleteRequestExt = ".json"
+
+// Store keeps delete requests in the object storage,
+// one object per request, under the tenant prefix.
+type Store struct {
+	bucket objstore.Bucket
+}
+
+func NewStore(bucket objstore.Bucket) *Store {
+	return &Store{bucket: bucket}
+}
+
+func (s *Store) tenantBucket(tenantID string) objstore.Bucket {
+	return objstore.NewPrefixedBucket(s.bucket, tenantID+"/tombstones")
+}
+
+// List returns the delete requests of the tenant, ordered by ID,
+// which is the order the requests were created in.
+func (s *Store) List(ctx context.Context, tenantID string) ([]*DeleteRequest, error) {
+	b := s.tenantBucket(tenantID)
+	var ids []string
+	err := b.Iter(ctx, "", func(name string) error {
+		if strings.HasSuffix(name, deleteRequestExt) {
+			ids = append(ids, strings.TrimSuffix(name, deleteRequestExt))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing delete requests: %w", err)
+	}
+	sort.Strings(ids)
+	requests := make([]*DeleteRequest, 0, len(ids))
+	for _, id := range ids {
+		r, err := s.Get(ctx, tenantID, id)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, r)
+	}
+	return requests, nil
+}
+
+// Tombstones returns tombstones of all the delete requests
+// of the tenant, including the processed ones: blocks uploaded
+// after the request has been processed still may include
+// the deleted profiles.
+func (s *Store) Tombstones(ctx context.Context, tenantID string) (*Tombstones, error) {
+	requests, err := s.List(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return New(requests...)
+}
+
+func (s *Store) Get(ctx context.Context, tenantID, id string) (*DeleteRequest, error) {
+	if _, err := ulid.Parse(id); err != nil {
+		return nil, ErrDeleteRequestNotFound
+	}
+	b := s.tenantBucket(tenantID)
+	r, err := b.Get(ctx, id+deleteRequestExt)
+	if err != nil {
+		if b.IsObjNotFoundErr(err) {
+			return nil, ErrDeleteRequestNotFound
+		}
+		return nil, fmt.Errorf("fetching delete request %s: %w", id, err)
+	}
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading delete request %s: %w", id, err)
+	}
+	var req DeleteRequest
+	if err = json.Unmarshal(data, &req); err != nil {
+		return nil, fmt.Errorf("decoding delete request %s: %w", id, err)
+	}
+	if err = req.Validate(); err != nil {
+		return nil, err
+	}
+	return &req, nil
+}
+
+// Set creates or replaces the delete request.
+func (s *Store) Set(ctx context.Context, tenantID string, r *DeleteRequest) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	data, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	return s.tenantBucket(tenantID).Upload(ctx, r.ID+deleteRequestExt, bytes.NewReader(data))
+}
+
+// MarkProcessed marks the delete request processed.
+func (s *Store) MarkProcessed(ctx context.Context, tenantID string, r *DeleteRequest, now time.Time) error {
+	p := *r
+	p.Status = StatusProcessed
+	p.ProcessedAt = now.UnixMilli()
+	if err := s.Set(ctx, tenantID, &p); err != nil {
+		return err
+	}
+	*r = p
+	return nil
+}
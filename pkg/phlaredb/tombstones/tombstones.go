@@ -0,0 +1,208 @@
+package tombstones
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/oklog/ulid"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+)
+
+var ErrInvalidDeleteRequest = errors.New("invalid delete request")
+
+type Status string
+
+const (
+	// StatusPending requests are applied at query time,
+	// the profiles are still present in the blocks.
+	StatusPending Status = "pending"
+	// StatusProcessed requests have been applied by the compactor:
+	// the profiles have been removed from the blocks.
+	StatusProcessed Status = "processed"
+)
+
+// DeleteRequest describes profiles to be deleted: profiles of
+// the series matching the selector within the time range.
+type DeleteRequest struct {
+	ID       string `json:"id"`
+	Selector string `json:"selector"`
+	// Start and end of the time range in Unix milliseconds, inclusive.
+	Start int64 `json:"start"`
+	End   int64 `json:"end"`
+
+	Status      Status `json:"status"`
+	CreatedAt   int64  `json:"created_at"`
+	ProcessedAt int64  `json:"processed_at,omitempty"`
+}
+
+func NewDeleteRequest(selector string, start, end, now time.Time) (*DeleteRequest, error) {
+	r := &DeleteRequest{
+		ID:        ulid.MustNew(ulid.Timestamp(now), rand.Reader).String(),
+		Selector:  selector,
+		Start:     start.UnixMilli(),
+		End:       end.UnixMilli(),
+		Status:    StatusPending,
+		CreatedAt: now.UnixMilli(),
+	}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *DeleteRequest) Validate() error {
+	if _, err := ulid.Parse(r.ID); err != nil {
+		return fmt.Errorf("%w: invalid id %q", ErrInvalidDeleteRequest, r.ID)
+	}
+	matchers, err := parser.ParseMetricSelector(r.Selector)
+	if err != nil {
+		return fmt.Errorf("%w: invalid selector: %v", ErrInvalidDeleteRequest, err)
+	}
+	// Prevent accidental deletion of all the series, e.g. with '{}'.
+	if !hasNonEmptyMatcher(matchers) {
+		return fmt.Errorf("%w: selector must contain at least one matcher not matching empty values", ErrInvalidDeleteRequest)
+	}
+	if r.Start > r.End {
+		return fmt.Errorf("%w: start must not be after end", ErrInvalidDeleteRequest)
+	}
+	switch r.Status {
+	case StatusPending, StatusProcessed:
+	default:
+		return fmt.Errorf("%w: unknown status %q", ErrInvalidDeleteRequest, r.Status)
+	}
+	return nil
+}
+
+func hasNonEmptyMatcher(matchers []*labels.Matcher) bool {
+	for _, m := range matchers {
+		if !m.Matches("") {
+			return true
+		}
+	}
+	return false
+}
+
+// Tombstones is a set of delete requests prepared for matching
+// profiles. A nil *Tombstones does not match any profiles.
+type Tombstones struct {
+	tombstones []tombstone
+}
+
+type tombstone struct {
+	// Time range in Unix nanoseconds, inclusive.
+	start, end int64
+	matchers   []*labels.Matcher
+}
+
+// New returns tombstones of the delete requests, or nil
+// if there are no requests.
+func New(requests ...*DeleteRequest) (*Tombstones, error) {
+	if len(requests) == 0 {
+		return nil, nil
+	}
+	t := &Tombstones{tombstones: make([]tombstone, 0, len(requests))}
+	for _, r := range requests {
+		matchers, err := parser.ParseMetricSelector(r.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidDeleteRequest, r.ID, err)
+		}
+		t.tombstones = append(t.tombstones, tombstone{
+			start:    time.UnixMilli(r.Start).UnixNano(),
+			end:      time.UnixMilli(r.End).UnixNano(),
+			matchers: matchers,
+		})
+	}
+	return t, nil
+}
+
+// Overlapping returns tombstones that overlap the time range
+// given in milliseconds, or nil, if there are none.
+func (t *Tombstones) Overlapping(minT, maxT model.Time) *Tombstones {
+	if t == nil {
+		return nil
+	}
+	var o Tombstones
+	for _, x := range t.tombstones {
+		if x.start <= maxT.UnixNano() && x.end >= minT.UnixNano() {
+			o.tombstones = append(o.tombstones, x)
+		}
+	}
+	if len(o.tombstones) == 0 {
+		return nil
+	}
+	return &o
+}
+
+// Matches reports whether any of the tombstones
+// matches the series, regardless of the time range.
+func (t *Tombstones) Matches(lbs phlaremodel.Labels) bool {
+	if t == nil {
+		return false
+	}
+	for _, x := range t.tombstones {
+		if x.matches(lbs) {
+			return true
+		}
+	}
+	return false
+}
+
+// Deleted reports whether the profile of the
+// series taken at the given time is deleted.
+func (t *Tombstones) Deleted(lbs phlaremodel.Labels, timeNanos int64) bool {
+	if t == nil {
+		return false
+	}
+	for _, x := range t.tombstones {
+		if timeNanos >= x.start && timeNanos <= x.end && x.matches(lbs) {
+			return true
+		}
+	}
+	return false
+}
+
+// Covers reports whether all the profiles of the series within
+// the time range given in Unix nanoseconds, inclusive, are deleted.
+func (t *Tombstones) Covers(lbs phlaremodel.Labels, start, end int64) bool {
+	if t == nil {
+		return false
+	}
+	var ranges [][2]int64
+	for _, x := range t.tombstones {
+		if x.start <= end && x.end >= start && x.matches(lbs) {
+			ranges = append(ranges, [2]int64{x.start, x.end})
+		}
+	}
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i][0] < ranges[j][0]
+	})
+	// The ranges of the matching tombstones may cover
+	// the time range only together.
+	for _, r := range ranges {
+		if r[0] > start {
+			return false
+		}
+		if r[1] >= end {
+			return true
+		}
+		start = max(start, r[1]+1)
+	}
+	return false
+}
+
+func (x *tombstone) matches(lbs phlaremodel.Labels) bool {
+	for _, m := range x.matchers {
+		// Missing labels are matched as empty ones.
+		if !m.Matches(lbs.Get(m.Name)) {
+			return false
+		}
+	}
+	return true
+}
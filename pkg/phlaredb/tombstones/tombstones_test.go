@@ -0,0 +1,132 @@
+package tombstones
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/objstore/testutil"
+)
+
+func Test_NewDeleteRequest(t *testing.T) {
+	now := time.UnixMilli(5000)
+	r, err := NewDeleteRequest(`{service_name="checkout"}`, time.UnixMilli(1000), time.UnixMilli(2000), now)
+	require.NoError(t, err)
+	assert.Equal(t, StatusPending, r.Status)
+	assert.Equal(t, int64(5000), r.CreatedAt)
+
+	for name, selector := range map[string]string{
+		"empty":                     ``,
+		"match all":                 `{}`,
+		"invalid":                   `{service_name=}`,
+		"matches empty values only": `{service_name=""}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err = NewDeleteRequest(selector, time.UnixMilli(1000), time.UnixMilli(2000), now)
+			require.ErrorIs(t, err, ErrInvalidDeleteRequest)
+		})
+	}
+
+	_, err = NewDeleteRequest(`{service_name="checkout"}`, time.UnixMilli(2000), time.UnixMilli(1000), now)
+	require.ErrorIs(t, err, ErrInvalidDeleteRequest)
+}
+
+func Test_Tombstones(t *testing.T) {
+	ts, err := New(
+		&DeleteRequest{Selector: `{service_name="checkout"}`, Start: 1000, End: 2000},
+		&DeleteRequest{Selector: `{service_name=~"cart|auth", env!="prod"}`, Start: 5000, End: 6000},
+	)
+	require.NoError(t, err)
+
+	checkout := phlaremodel.LabelsFromStrings("service_name", "checkout")
+	cart := phlaremodel.LabelsFromStrings("service_name", "cart", "env", "dev")
+	auth := phlaremodel.LabelsFromStrings("service_name", "auth")
+	prod := phlaremodel.LabelsFromStrings("service_name", "cart", "env", "prod")
+
+	assert.True(t, ts.Matches(checkout))
+	assert.True(t, ts.Matches(cart))
+	assert.True(t, ts.Matches(auth))
+	assert.False(t, ts.Matches(prod))
+
+	sec := int64(time.Second)
+	assert.False(t, ts.Deleted(checkout, sec-1))
+	assert.True(t, ts.Deleted(checkout, sec))
+	assert.True(t, ts.Deleted(checkout, 2*sec))
+	assert.False(t, ts.Deleted(checkout, 2*sec+1))
+	assert.False(t, ts.Deleted(cart, 2*sec))
+	assert.True(t, ts.Deleted(cart, 5*sec))
+	assert.True(t, ts.Deleted(auth, 6*sec))
+	assert.False(t, ts.Deleted(prod, 5*sec))
+
+	assert.Nil(t, ts.Overlapping(model.Time(3000), model.Time(4000)))
+	o := ts.Overlapping(model.Time(0), model.Time(1000))
+	require.NotNil(t, o)
+	assert.True(t, o.Deleted(checkout, sec))
+	assert.False(t, o.Matches(cart))
+
+	assert.True(t, ts.Covers(checkout, sec, 2*sec))
+	assert.False(t, ts.Covers(checkout, sec, 2*sec+1))
+	assert.False(t, ts.Covers(cart, sec, 2*sec))
+
+	// The series is deleted by adjacent tombstones.
+	adjacent, err := New(
+		&DeleteRequest{Selector: `{service_name="checkout"}`, Start: 1000, End: 2000},
+		&DeleteRequest{Selector: `{service_name="checkout"}`, Start: 1500, End: 3000},
+		&DeleteRequest{Selector: `{service_name="checkout"}`, Start: 4000, End: 5000},
+	)
+	require.NoError(t, err)
+	assert.True(t, adjacent.Covers(checkout, sec, 3*sec))
+	assert.False(t, adjacent.Covers(checkout, sec, 4*sec))
+
+	var empty *Tombstones
+	assert.False(t, empty.Deleted(checkout, sec))
+	assert.False(t, empty.Covers(checkout, sec, 2*sec))
+	assert.Nil(t, empty.Overlapping(model.Time(0), model.Time(1000)))
+}
+
+func Test_Store(t *testing.T) {
+	ctx := context.Background()
+	bucket, _ := testutil.NewFilesystemBucket(t, ctx, t.TempDir())
+	s := NewStore(bucket)
+
+	requests, err := s.List(ctx, "tenant-a")
+	require.NoError(t, err)
+	assert.Empty(t, requests)
+	ts, err := s.Tombstones(ctx, "tenant-a")
+	require.NoError(t, err)
+	assert.Nil(t, ts)
+
+	r1, err := NewDeleteRequest(`{service_name="checkout"}`, time.UnixMilli(1000), time.UnixMilli(2000), time.UnixMilli(10000))
+	require.NoError(t, err)
+	r2, err := NewDeleteRequest(`{service_name="cart"}`, time.UnixMilli(1000), time.UnixMilli(2000), time.UnixMilli(20000))
+	require.NoError(t, err)
+	require.NoError(t, s.Set(ctx, "tenant-a", r2))
+	require.NoError(t, s.Set(ctx, "tenant-a", r1))
+
+	requests, err = s.List(ctx, "tenant-a")
+	require.NoError(t, err)
+	assert.Equal(t, []*DeleteRequest{r1, r2}, requests)
+	requests, err = s.List(ctx, "tenant-b")
+	require.NoError(t, err)
+	assert.Empty(t, requests)
+
+	require.NoError(t, s.MarkProcessed(ctx, "tenant-a", r1, time.UnixMilli(30000)))
+	r, err := s.Get(ctx, "tenant-a", r1.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StatusProcessed, r.Status)
+	assert.Equal(t, int64(30000), r.ProcessedAt)
+
+	_, err = s.Get(ctx, "tenant-a", "../foo")
+	require.ErrorIs(t, err, ErrDeleteRequestNotFound)
+	_, err = s.Get(ctx, "tenant-b", r1.ID)
+	require.ErrorIs(t, err, ErrDeleteRequestNotFound)
+
+	ts, err = s.Tombstones(ctx, "tenant-a")
+	require.NoError(t, err)
+	assert.True(t, ts.Matches(phlaremodel.LabelsFromStrings("service_name", "checkout")))
+}
@@ -0,0 +1,214 @@
+package phlaredb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/go-kit/log"
+	"github.com/stretchr/testify/require"
+
+	ingesterv1 "github.com/grafana/pyroscope/api/gen/proto/go/ingester/v1"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	phlarecontext "github.com/grafana/pyroscope/pkg/phlare/context"
+	"github.com/grafana/pyroscope/pkg/phlaredb/tombstones"
+	"github.com/grafana/pyroscope/pkg/pprof/testhelper"
+)
+
+type staticTombstones struct{ t *tombstones.Tombstones }
+
+func (s staticTombstones) Tombstones() *tombstones.Tombstones { return s.t }
+
+func Test_singleBlockQuerier_Tombstones(t *testing.T) {
+	ctx := context.Background()
+	querier := newBlock(t, func() (res []*testhelper.ProfileBuilder) {
+		for i := int64(1); i <= 10; i++ {
+			res = append(res,
+				testhelper.NewProfileBuilder(int64(time.Second)*i).
+					CPUProfile().
+					WithLabels("job", "a").
+					ForStacktraceString("foo", "bar").AddSamples(1),
+				testhelper.NewProfileBuilder(int64(time.Second)*i).
+					CPUProfile().
+					WithLabels("job", "b").
+					ForStacktraceString("foo", "baz").AddSamples(1))
+		}
+		return res
+	})
+
+	// Profiles of job "b" from 3s to 7s are deleted.
+	ts, err := tombstones.New(&tombstones.DeleteRequest{
+		ID:       "01HQ5W5Y6X8ZQ1Y2Z3A4B5C6D7",
+		Selector: `{job="b"}`,
+		Start:    3000,
+		End:      7000,
+		Status:   tombstones.StatusPending,
+	})
+	require.NoError(t, err)
+	querier.tombstones = staticTombstones{t: ts}
+
+	req := &ingesterv1.SelectProfilesRequest{
+		LabelSelector: `{}`,
+		Type:          mustParseProfileSelector(t, "process_cpu:cpu:nanoseconds:cpu:nanoseconds"),
+		Start:         0,
+		End:           20000,
+	}
+
+	it, err := querier.SelectMatchingProfiles(ctx, req)
+	require.NoError(t, err)
+	var n int
+	for it.Next() {
+		p := it.At()
+		ts := p.Timestamp().Time()
+		require.False(t, p.Labels().Get("job") == "b" && !ts.Before(time.Unix(3, 0)) && !ts.After(time.Unix(7, 0)))
+		n++
+	}
+	require.NoError(t, it.Err())
+	require.NoError(t, it.Close())
+	require.Equal(t, 15, n)
+
+	tree, err := querier.SelectMergeByStacktraces(ctx, req, 0, nil)
+	require.NoError(t, err)
+	expected := new(phlaremodel.Tree)
+	expected.InsertStack(10, "bar", "foo")
+	expected.InsertStack(5, "baz", "foo")
+	require.Equal(t, expected.String(), tree.String())
+
+	series, err := querier.SelectMergeByLabels(ctx, req, nil, "job")
+	require.NoError(t, err)
+	require.Len(t, series, 2)
+	require.Len(t, series[0].Points, 10)
+	require.Equal(t, []int64{1000, 2000, 8000, 9000, 10000}, timestamps(series[1].Points))
+
+	p, err := querier.SelectMergePprof(ctx, req, 0, nil)
+	require.NoError(t, err)
+	var total int64
+	for _, s := range p.Sample {
+		total += s.Value[0]
+	}
+	require.Equal(t, int64(15), total)
+
+	// Series deleted within the whole time range are not exposed.
+	values, err := querier.LabelValues(ctx, connect.NewRequest(&typesv1.LabelValuesRequest{
+		Name:  "job",
+		Start: 3000,
+		End:   7000,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, []string{"a"}, values.Msg.Names)
+	values, err = querier.LabelValues(ctx, connect.NewRequest(&typesv1.LabelValuesRequest{
+		Name:  "job",
+		Start: 3000,
+		End:   8000,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, values.Msg.Names)
+	labelsSets, err := querier.Series(ctx, &ingesterv1.SeriesRequest{
+		Matchers:   []string{`{job="b"}`},
+		LabelNames: []string{"job"},
+		Start:      4000,
+		End:        6000,
+	})
+	require.NoError(t, err)
+	require.Empty(t, labelsSets)
+	names, err := querier.LabelNames(ctx, connect.NewRequest(&typesv1.LabelNamesRequest{
+		Matchers: []string{`{job="b"}`},
+		Start:    4000,
+		End:      6000,
+	}))
+	require.NoError(t, err)
+	require.Empty(t, names.Msg.Names)
+
+	// Tombstones not overlapping the block are ignored.
+	ts, err = tombstones.New(&tombstones.DeleteRequest{
+		ID:       "01HQ5W5Y6X8ZQ1Y2Z3A4B5C6D7",
+		Selector: `{job="b"}`,
+		Start:    20000,
+		End:      30000,
+		Status:   tombstones.StatusPending,
+	})
+	require.NoError(t, err)
+	querier.tombstones = staticTombstones{t: ts}
+	require.Nil(t, querier.deletedSeries())
+}
+
+func Test_withTombstones_Head(t *testing.T) {
+	ctx := phlarecontext.WithLogger(context.Background(), log.NewNopLogger())
+	h, err := NewHead(ctx, Config{
+		DataPath:         t.TempDir(),
+		MaxBlockDuration: 24 * time.Hour,
+		Parquet:          defaultParquetConfig,
+	}, NoLimit)
+	require.NoError(t, err)
+	for i := int64(1); i <= 10; i++ {
+		for _, job := range []string{"a", "b"} {
+			p := testhelper.NewProfileBuilder(int64(time.Second)*i).
+				CPUProfile().
+				WithLabels("job", job).
+				ForStacktraceString("foo", job).AddSamples(1)
+			require.NoError(t, h.Ingest(ctx, p.Profile, p.UUID, p.Labels...))
+		}
+	}
+
+	// Profiles of job "b" from 3s to 7s are deleted.
+	ts, err := tombstones.New(&tombstones.DeleteRequest{
+		ID:       "01HQ5W5Y6X8ZQ1Y2Z3A4B5C6D7",
+		Selector: `{job="b"}`,
+		Start:    3000,
+		End:      7000,
+		Status:   tombstones.StatusPending,
+	})
+	require.NoError(t, err)
+	queriers := withTombstones(h.Queriers(), staticTombstones{t: ts})
+
+	req := &ingesterv1.SelectProfilesRequest{
+		LabelSelector: `{}`,
+		Type:          mustParseProfileSelector(t, "process_cpu:cpu:nanoseconds:cpu:nanoseconds"),
+		Start:         0,
+		End:           20000,
+	}
+	it, err := queriers.SelectMatchingProfiles(ctx, req)
+	require.NoError(t, err)
+	var n int
+	for it.Next() {
+		n++
+	}
+	require.NoError(t, it.Err())
+	require.Equal(t, 15, n)
+
+	expected := new(phlaremodel.Tree)
+	expected.InsertStack(10, "a", "foo")
+	expected.InsertStack(5, "b", "foo")
+	tree := new(phlaremodel.Tree)
+	for _, q := range queriers {
+		x, err := q.SelectMergeByStacktraces(ctx, req, 0, nil)
+		require.NoError(t, err)
+		tree.Merge(x)
+	}
+	require.Equal(t, expected.String(), tree.String())
+
+	values, err := queriers.LabelValues(ctx, connect.NewRequest(&typesv1.LabelValuesRequest{
+		Name:  "job",
+		Start: 3000,
+		End:   7000,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, []string{"a"}, values.Msg.Names)
+	values, err = queriers.LabelValues(ctx, connect.NewRequest(&typesv1.LabelValuesRequest{
+		Name:  "job",
+		Start: 1000,
+		End:   7000,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, values.Msg.Names)
+}
+
+func timestamps(points []*typesv1.Point) []int64 {
+	ts := make([]int64, len(points))
+	for i, p := range points {
+		ts[i] = p.Timestamp
+	}
+	return ts
+}
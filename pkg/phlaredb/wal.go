@@ -0,0 +1,286 @@
+package phlaredb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/google/uuid"
+	"github.com/oklog/ulid"
+	"github.com/prometheus/prometheus/tsdb/fileutil"
+	"github.com/prometheus/prometheus/tsdb/wlog"
+
+	profilev1 "github.com/grafana/pyroscope/api/gen/proto/go/google/v1"
+	pushv1 "github.com/grafana/pyroscope/api/gen/proto/go/push/v1"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+	"github.com/grafana/pyroscope/pkg/phlaredb/block"
+)
+
+// DefaultWALSegmentSize is the size of a single WAL segment file.
+const DefaultWALSegmentSize = wlog.DefaultSegmentSize
+
+// walRecordType is the first byte of every WAL record and
+// identifies the encoding of the rest of the record.
+type walRecordType byte
+
+const (
+	// walRecordProfile holds a single ingested profile encoded
+	// as pushv1.RawProfileSeries with exactly one sample.
+	walRecordProfile walRecordType = 1
+)
+
+// headWAL is a write-ahead log of a single head. Every profile is
+// appended to the log before it is ingested, so that the head can
+// be rebuilt after a crash by replaying the records. Once the head
+// has been flushed and moved to the local blocks, the WAL is no
+// longer needed and is removed: the block itself is the checkpoint.
+type headWAL struct {
+	logger log.Logger
+	dir    string
+	wl     *wlog.WL
+	closed bool
+}
+
+func openHeadWAL(logger log.Logger, dir string, segmentSize int) (*headWAL, error) {
+	if segmentSize <= 0 {
+		segmentSize = DefaultWALSegmentSize
+	}
+	// Metrics of the underlying WAL are not registered: there may
+	// be multiple heads (and thus WALs) open at the same time.
+	wl, err := wlog.NewSize(logger, nil, dir, segmentSize, wlog.CompressionSnappy)
+	if err != nil {
+		return nil, fmt.Errorf("opening head WAL %s: %w", dir, err)
+	}
+	return &headWAL{
+		logger: logger,
+		dir:    dir,
+		wl:     wl,
+	}, nil
+}
+
+// Log appends the profile to the WAL.
+func (w *headWAL) Log(p *profilev1.Profile, id uuid.UUID, externalLabels []*typesv1.LabelPair) error {
+	rec, err := encodeWALProfile(p, id, externalLabels)
+	if err != nil {
+		return err
+	}
+	return w.wl.Log(rec)
+}
+
+// Close closes the WAL. No records can be appended after the call.
+// The call is not thread-safe and must not be made concurrently
+// with Log.
+func (w *headWAL) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	return w.wl.Close()
+}
+
+// Remove closes the WAL and deletes all its segments.
+func (w *headWAL) Remove() error {
+	if err := w.Close(); err != nil {
+		level.Warn(w.logger).Log("msg", "failed to close head WAL", "dir", w.dir, "err", err)
+	}
+	return os.RemoveAll(w.dir)
+}
+
+func encodeWALProfile(p *profilev1.Profile, id uuid.UUID, externalLabels []*typesv1.LabelPair) ([]byte, error) {
+	raw, err := p.MarshalVT()
+	if err != nil {
+		return nil, err
+	}
+	s := pushv1.RawProfileSeries{
+		Labels: externalLabels,
+		Samples: []*pushv1.RawSample{{
+			RawProfile: raw,
+			ID:         id.String(),
+		}},
+	}
+	rec := make([]byte, 1+s.SizeVT())
+	rec[0] = byte(walRecordProfile)
+	if _, err = s.MarshalToSizedBufferVT(rec[1:]); err != nil {
+		return nil, err
+	}
+	return rec, nil
+}
+
+type walProfile struct {
+	profile        *profilev1.Profile
+	id             uuid.UUID
+	externalLabels []*typesv1.LabelPair
+}
+
+func decodeWALProfile(rec []byte) (*walProfile, error) {
+	if len(rec) == 0 {
+		return nil, errors.New("empty WAL record")
+	}
+	if t := walRecordType(rec[0]); t != walRecordProfile {
+		return nil, fmt.Errorf("unknown WAL record type %d", t)
+	}
+	var s pushv1.RawProfileSeries
+	if err := s.UnmarshalVT(rec[1:]); err != nil {
+		return nil, err
+	}
+	if len(s.Samples) != 1 {
+		return nil, fmt.Errorf("expected exactly one sample in WAL record, got %d", len(s.Samples))
+	}
+	id, err := uuid.Parse(s.Samples[0].ID)
+	if err != nil {
+		return nil, err
+	}
+	var p profilev1.Profile
+	if err = p.UnmarshalVT(s.Samples[0].RawProfile); err != nil {
+		return nil, err
+	}
+	return &walProfile{
+		profile:        &p,
+		id:             id,
+		externalLabels: s.Labels,
+	}, nil
+}
+
+func (f *PhlareDB) walPath() string {
+	return filepath.Join(f.cfg.DataPath, PathWAL)
+}
+
+func (f *PhlareDB) headPath() string {
+	return filepath.Join(f.cfg.DataPath, pathHead)
+}
+
+// recoverHeads restores the state of heads that were not moved to the
+// local blocks before the previous shutdown:
+//
+//   - Heads that have been flushed completely (meta.json is present)
+//     are moved to the local blocks, their WAL is discarded.
+//   - Heads that have not been flushed are removed, as they will be
+//     rebuilt from their WAL by replayWAL.
+func (f *PhlareDB) recoverHeads() error {
+	entries, err := os.ReadDir(f.headPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		if _, err = ulid.Parse(e.Name()); err != nil {
+			continue
+		}
+		headDir := filepath.Join(f.headPath(), e.Name())
+		if _, err = block.ReadMetaFromDir(headDir); err != nil {
+			level.Info(f.logger).Log("msg", "removing incomplete head", "path", headDir)
+			if err = os.RemoveAll(headDir); err != nil {
+				return err
+			}
+			continue
+		}
+		localDir := filepath.Join(f.LocalDataPath(), e.Name())
+		if err = fileutil.Rename(headDir, localDir); err != nil {
+			return err
+		}
+		if err = os.RemoveAll(filepath.Join(f.walPath(), e.Name())); err != nil {
+			return err
+		}
+		level.Info(f.logger).Log("msg", "moved flushed head to local blocks", "block_path", localDir)
+	}
+	return nil
+}
+
+// replayWAL ingests profiles from the WAL of heads that were not flushed
+// before the previous shutdown. Replayed profiles are written to new heads
+// (and their WAL); the replayed WAL is removed once all its records have
+// been ingested. Records following a corrupted one are lost.
+func (f *PhlareDB) replayWAL(ctx context.Context) error {
+	entries, err := os.ReadDir(f.walPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	start := time.Now()
+	defer func() {
+		f.metrics.walReplayDuration.Observe(time.Since(start).Seconds())
+	}()
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		if _, err = ulid.Parse(e.Name()); err != nil {
+			continue
+		}
+		dir := filepath.Join(f.walPath(), e.Name())
+		if err = f.replayHeadWAL(ctx, dir); err != nil {
+			return fmt.Errorf("replaying WAL %s: %w", dir, err)
+		}
+	}
+	// Ensure that the replayed profiles are persisted in the new WAL
+	// segments before the old ones are deleted.
+	f.headLock.RLock()
+	for _, h := range f.heads {
+		if h.wal != nil {
+			if err = h.wal.wl.Sync(); err != nil {
+				f.headLock.RUnlock()
+				return err
+			}
+		}
+	}
+	f.headLock.RUnlock()
+	for _, e := range entries {
+		if _, err = ulid.Parse(e.Name()); err != nil || !e.IsDir() {
+			continue
+		}
+		if err = os.RemoveAll(filepath.Join(f.walPath(), e.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *PhlareDB) replayHeadWAL(ctx context.Context, dir string) error {
+	segments, err := wlog.NewSegmentsReader(dir)
+	if err != nil {
+		return err
+	}
+	defer segments.Close()
+
+	var replayed, dropped int
+	r := wlog.NewReader(segments)
+	for r.Next() {
+		p, err := decodeWALProfile(r.Record())
+		if err != nil {
+			f.metrics.walCorruptedRecords.Inc()
+			level.Warn(f.logger).Log("msg", "skipping corrupted WAL record", "dir", dir, "segment", r.Segment(), "offset", r.Offset(), "err", err)
+			continue
+		}
+		if err = f.Ingest(ctx, p.profile, p.id, p.externalLabels...); err != nil {
+			// The profile might be rejected, e.g. if the limits have changed
+			// since it was accepted. This should not prevent the replay.
+			dropped++
+			level.Warn(f.logger).Log("msg", "failed to ingest profile from WAL", "dir", dir, "err", err)
+			continue
+		}
+		replayed++
+	}
+	if err = r.Err(); err != nil {
+		var cerr *wlog.CorruptionErr
+		if !errors.As(err, &cerr) {
+			return err
+		}
+		f.metrics.walCorruptedRecords.Inc()
+		level.Warn(f.logger).Log("msg", "WAL is corrupted, the remaining records are lost", "dir", dir, "err", err)
+	}
+	f.metrics.walReplayedProfiles.Add(float64(replayed))
+	level.Info(f.logger).Log("msg", "WAL replayed", "dir", dir, "profiles", replayed, "dropped", dropped)
+	return nil
+}
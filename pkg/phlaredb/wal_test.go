@@ -0,0 +1,207 @@
+package phlaredb
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+)
+
+func Test_WALRecord_EncodeDecode(t *testing.T) {
+	p := newProfileFoo()
+	id := uuid.New()
+	lbls := []*typesv1.LabelPair{{Name: "job", Value: "foo"}}
+
+	rec, err := encodeWALProfile(p, id, lbls)
+	require.NoError(t, err)
+
+	decoded, err := decodeWALProfile(rec)
+	require.NoError(t, err)
+	assert.Equal(t, id, decoded.id)
+	assert.True(t, p.EqualVT(decoded.profile))
+	assert.Equal(t, lbls[0].String(), decoded.externalLabels[0].String())
+
+	_, err = decodeWALProfile(rec[:len(rec)/2])
+	require.Error(t, err)
+	_, err = decodeWALProfile([]byte{42})
+	require.Error(t, err)
+}
+
+// crash stops the database without flushing the heads, as
+// if the process was terminated abruptly.
+func crash(t *testing.T, db *PhlareDB) {
+	close(db.stopCh)
+	db.wg.Wait()
+	for _, h := range db.heads {
+		close(h.stopCh)
+		h.wg.Wait()
+		require.NoError(t, h.wal.Close())
+	}
+	require.NoError(t, db.blockQuerier.Close())
+}
+
+func (f *PhlareDB) totalHeadProfiles() (n int64) {
+	f.headLock.RLock()
+	defer f.headLock.RUnlock()
+	for _, h := range f.heads {
+		n += h.profiles.index.totalProfiles.Load()
+	}
+	return n
+}
+
+func (f *PhlareDB) hasHead(id string) bool {
+	f.headLock.RLock()
+	defer f.headLock.RUnlock()
+	for _, h := range f.heads {
+		if h.BlockID() == id {
+			return true
+		}
+	}
+	return false
+}
+
+func Test_WAL_ReplayAfterCrash(t *testing.T) {
+	ctx := testContext(t)
+	cfg := Config{
+		DataPath:         contextDataDir(ctx),
+		MaxBlockDuration: time.Hour,
+		WALEnabled:       true,
+	}
+
+	db, err := New(ctx, cfg, NoLimit, ctx.localBucketClient)
+	require.NoError(t, err)
+	end := time.Unix(0, int64(time.Hour))
+	ingestProfiles(t, db, cpuProfileGenerator, end.Add(-time.Minute).UnixNano(), end.UnixNano(), 15*time.Second,
+		&typesv1.LabelPair{Name: "pod", Value: "my-pod"},
+	)
+	// Profiles span two block ranges.
+	require.Len(t, db.heads, 2)
+	// Each CPU profile has two sample types.
+	require.Equal(t, int64(10), db.totalHeadProfiles())
+	crash(t, db)
+
+	db, err = New(ctx, cfg, NoLimit, ctx.localBucketClient)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), db.totalHeadProfiles())
+	assert.Equal(t, float64(5), testutil.ToFloat64(db.metrics.walReplayedProfiles))
+	assert.Equal(t, float64(0), testutil.ToFloat64(db.metrics.walCorruptedRecords))
+
+	// Only the WAL of the new heads must remain.
+	entries, err := os.ReadDir(filepath.Join(cfg.DataPath, PathWAL))
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	for _, e := range entries {
+		assert.True(t, db.hasHead(e.Name()))
+	}
+
+	// Heads are flushed on close but not moved: the next
+	// start must move them to the local blocks.
+	require.NoError(t, db.Close())
+	db, err = New(ctx, cfg, NoLimit, ctx.localBucketClient)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), db.totalHeadProfiles())
+	assert.Len(t, db.blockQuerier.queriers, 2)
+	entries, err = os.ReadDir(filepath.Join(cfg.DataPath, PathWAL))
+	require.NoError(t, err)
+	assert.Len(t, entries, 0)
+	require.NoError(t, db.Close())
+}
+
+// rejectPodLimiter rejects the profiles of the given pod.
+type rejectPodLimiter struct {
+	noLimit
+	pod string
+}
+
+func (l rejectPodLimiter) AllowProfile(_ model.Fingerprint, lbs phlaremodel.Labels, _ int64) error {
+	if lbs.Get("pod") == l.pod {
+		return errors.New("profile rejected")
+	}
+	return nil
+}
+
+func Test_WAL_RejectedProfilesNotReplayed(t *testing.T) {
+	ctx := testContext(t)
+	cfg := Config{
+		DataPath:         contextDataDir(ctx),
+		MaxBlockDuration: time.Hour,
+		WALEnabled:       true,
+	}
+
+	db, err := New(ctx, cfg, rejectPodLimiter{pod: "rejected"}, ctx.localBucketClient)
+	require.NoError(t, err)
+	p, name := cpuProfileGenerator(0, t)
+	require.NoError(t, db.Ingest(context.Background(), p, uuid.New(),
+		&typesv1.LabelPair{Name: "pod", Value: "accepted"},
+		&typesv1.LabelPair{Name: model.MetricNameLabel, Value: name},
+	))
+	p, name = cpuProfileGenerator(0, t)
+	require.Error(t, db.Ingest(context.Background(), p, uuid.New(),
+		&typesv1.LabelPair{Name: "pod", Value: "rejected"},
+		&typesv1.LabelPair{Name: model.MetricNameLabel, Value: name},
+	))
+	require.Equal(t, int64(2), db.totalHeadProfiles())
+	crash(t, db)
+
+	db, err = New(ctx, cfg, NoLimit, ctx.localBucketClient)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), db.totalHeadProfiles())
+	assert.Equal(t, float64(1), testutil.ToFloat64(db.metrics.walReplayedProfiles))
+	require.NoError(t, db.Close())
+}
+
+func Test_WAL_RemovedAfterFlush(t *testing.T) {
+	ctx := testContext(t)
+	cfg := Config{
+		DataPath:         contextDataDir(ctx),
+		MaxBlockDuration: time.Hour,
+		WALEnabled:       true,
+	}
+	db, err := New(ctx, cfg, NoLimit, ctx.localBucketClient)
+	require.NoError(t, err)
+	ingestProfiles(t, db, cpuProfileGenerator, 0, int64(time.Minute), 15*time.Second)
+
+	require.NoError(t, db.Flush(context.Background(), true, ""))
+	entries, err := os.ReadDir(filepath.Join(cfg.DataPath, PathWAL))
+	require.NoError(t, err)
+	assert.Len(t, entries, 0)
+	require.NoError(t, db.Close())
+}
+
+func Test_WAL_CorruptedRecords(t *testing.T) {
+	ctx := testContext(t)
+	cfg := Config{
+		DataPath:         contextDataDir(ctx),
+		MaxBlockDuration: time.Hour,
+		WALEnabled:       true,
+	}
+
+	// The WAL contains a valid record, followed by a malformed one.
+	dir := filepath.Join(cfg.DataPath, PathWAL, "01HMWHBRCEE3A7XN4Q8ZSDV9NY")
+	w, err := openHeadWAL(log.NewNopLogger(), dir, 0)
+	require.NoError(t, err)
+	p, _ := cpuProfileGenerator(0, t)
+	require.NoError(t, w.Log(p, uuid.New(), []*typesv1.LabelPair{{Name: "__name__", Value: "process_cpu"}}))
+	require.NoError(t, w.wl.Log([]byte{byte(walRecordProfile), 0xff, 0xff}))
+	require.NoError(t, w.Close())
+
+	db, err := New(ctx, cfg, NoLimit, ctx.localBucketClient)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), db.totalHeadProfiles())
+	assert.Equal(t, float64(1), testutil.ToFloat64(db.metrics.walCorruptedRecords))
+	_, err = os.Stat(dir)
+	assert.True(t, os.IsNotExist(err))
+	require.NoError(t, db.Close())
+}
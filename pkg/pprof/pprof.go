@@ -404,20 +404,28 @@ func (p *Profile) Normalize() {
 	p.clearAddresses()
 }
 
-// Removes addresses from symbolized profiles.
+// Removes addresses from symbolized profiles. Addresses of locations
+// that have no lines are preserved along with the mapping address range:
+// they are required for the server-side symbolization.
 func (p *Profile) clearAddresses() {
-	for _, m := range p.Mapping {
-		if m.HasFunctions {
+	unsymbolized := make([]bool, len(p.Mapping))
+	for _, l := range p.Location {
+		if !p.Mapping[l.MappingId-1].HasFunctions {
+			continue
+		}
+		if len(l.Line) == 0 {
+			unsymbolized[l.MappingId-1] = true
+			continue
+		}
+		l.Address = 0
+	}
+	for i, m := range p.Mapping {
+		if m.HasFunctions && !unsymbolized[i] {
 			m.MemoryLimit = 0
 			m.FileOffset = 0
 			m.MemoryStart = 0
 		}
 	}
-	for _, l := range p.Location {
-		if p.Mapping[l.MappingId-1].HasFunctions {
-			l.Address = 0
-		}
-	}
 }
 
 func (p *Profile) clearSampleReferences(samples []*profilev1.Sample) {
@@ -171,6 +171,42 @@ func TestNormalizeProfile_SampleLabels(t *testing.T) {
 	})
 }
 
+func TestNormalizeProfile_UnsymbolizedLocations(t *testing.T) {
+	p := &profilev1.Profile{
+		SampleType: []*profilev1.ValueType{{Type: 1, Unit: 2}},
+		Sample: []*profilev1.Sample{
+			{LocationId: []uint64{1, 2}, Value: []int64{10}},
+			{LocationId: []uint64{3}, Value: []int64{10}},
+		},
+		Mapping: []*profilev1.Mapping{
+			{Id: 1, HasFunctions: true, MemoryStart: 100, MemoryLimit: 200, FileOffset: 200, BuildId: 5},
+			{Id: 2, HasFunctions: true, MemoryStart: 300, MemoryLimit: 400},
+		},
+		Location: []*profilev1.Location{
+			{Id: 1, MappingId: 1, Address: 150},
+			{Id: 2, MappingId: 1, Address: 160, Line: []*profilev1.Line{{FunctionId: 1, Line: 1}}},
+			{Id: 3, MappingId: 2, Address: 350, Line: []*profilev1.Line{{FunctionId: 1, Line: 2}}},
+		},
+		Function:    []*profilev1.Function{{Id: 1, Name: 3, SystemName: 3, Filename: 4}},
+		StringTable: []string{"", "cpu", "nanoseconds", "main", "main.c", "build-id"},
+		PeriodType:  &profilev1.ValueType{Type: 1, Unit: 2},
+	}
+
+	pf := &Profile{Profile: p}
+	pf.Normalize()
+	// Addresses of unsymbolized locations are required
+	// for symbolization, and therefore must be preserved.
+	require.Equal(t, []*profilev1.Mapping{
+		{Id: 1, HasFunctions: true, MemoryStart: 100, MemoryLimit: 200, FileOffset: 200, BuildId: 5},
+		{Id: 2, HasFunctions: true},
+	}, pf.Mapping)
+	require.Equal(t, []*profilev1.Location{
+		{Id: 1, MappingId: 1, Address: 150},
+		{Id: 2, MappingId: 1, Line: []*profilev1.Line{{FunctionId: 1, Line: 1}}},
+		{Id: 3, MappingId: 2, Line: []*profilev1.Line{{FunctionId: 1, Line: 2}}},
+	}, pf.Location)
+}
+
 func Test_sanitizeReferences(t *testing.T) {
 	type testCase struct {
 		name     string
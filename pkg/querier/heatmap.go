@@ -0,0 +1,177 @@
+package querier
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ingestv1 "github.com/grafana/pyroscope/api/gen/proto/go/ingester/v1"
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
+	"github.com/grafana/pyroscope/pkg/og/storage/heatmap"
+)
+
+const (
+	defaultHeatmapTimeBuckets   = 100
+	defaultHeatmapValueBuckets  = 50
+	defaultHeatmapProfilesLimit = 100
+	maxHeatmapBuckets           = 1000
+	// maxHeatmapProfiles limits the number of profile values
+	// fetched from ingesters and store-gateways for a heatmap.
+	maxHeatmapProfiles = 1 << 20
+)
+
+func validateHeatmapRequest(req *querierv1.SelectHeatmapRequest) error {
+	if req.TimeBuckets < 0 || req.ValueBuckets < 0 || req.Limit < 0 {
+		return errors.New("time_buckets, value_buckets and limit must not be negative")
+	}
+	if req.TimeBuckets == 0 {
+		req.TimeBuckets = defaultHeatmapTimeBuckets
+	}
+	if req.ValueBuckets == 0 {
+		req.ValueBuckets = defaultHeatmapValueBuckets
+	}
+	if req.Limit == 0 {
+		req.Limit = defaultHeatmapProfilesLimit
+	}
+	if req.TimeBuckets > maxHeatmapBuckets || req.ValueBuckets > maxHeatmapBuckets {
+		return fmt.Errorf("time_buckets and value_buckets must not exceed %d", maxHeatmapBuckets)
+	}
+	if req.MinValue < 0 || req.MaxValue < 0 || req.MinValue > req.MaxValue {
+		return errors.New("invalid value range")
+	}
+	if c := req.Cell; c != nil {
+		if c.TimeBucket < 0 || c.TimeBucket >= req.TimeBuckets || c.ValueBucket < 0 || c.ValueBucket >= req.ValueBuckets {
+			return errors.New("heatmap cell is out of range")
+		}
+	}
+	return nil
+}
+
+// profilesLimit limits the total number of profiles the values are
+// fetched for from ingesters and store-gateways. The profiles are
+// counted as they are selected, before their values are fetched.
+type profilesLimit struct {
+	max int64
+	n   atomic.Int64
+}
+
+func newProfilesLimit(max int64) *profilesLimit {
+	return &profilesLimit{max: max}
+}
+
+// add accounts for n more profiles and returns an error
+// if the limit is exceeded. A nil limit is never exceeded.
+func (l *profilesLimit) add(n int64) error {
+	if l == nil {
+		return nil
+	}
+	if l.n.Add(n) > l.max {
+		return connect.NewError(connect.CodeResourceExhausted,
+			fmt.Errorf("the query matches more than %d profiles", l.max))
+	}
+	return nil
+}
+
+// buildHeatmap distributes the profile values over the heatmap cells. If no value
+// range is specified, the range spans from the smallest to the largest value.
+// Profiles with negative values are not accounted.
+func buildHeatmap(req *querierv1.SelectHeatmapRequest, values []*ingestv1.ProfileValues) *querierv1.SelectHeatmapResponse {
+	params := heatmap.HeatmapParams{
+		TimeBuckets:  req.TimeBuckets,
+		ValueBuckets: req.ValueBuckets,
+		StartTime:    time.UnixMilli(req.Start),
+		EndTime:      time.UnixMilli(req.End),
+		MinValue:     uint64(req.MinValue),
+		MaxValue:     uint64(req.MaxValue),
+	}
+	if req.MaxValue == 0 {
+		params.MinValue, params.MaxValue = valueRange(values)
+	}
+
+	b := heatmap.NewBuilder(params)
+	var profiles []*querierv1.ProfileInfo
+	for _, s := range values {
+		for _, p := range s.Profiles {
+			if p.Value < 0 {
+				continue
+			}
+			t := time.UnixMilli(p.Timestamp)
+			b.Add(t, uint64(p.Value))
+			if req.Cell == nil {
+				continue
+			}
+			if x, y, ok := params.Bucket(t, uint64(p.Value)); ok && x == req.Cell.TimeBucket && y == req.Cell.ValueBucket {
+				profiles = append(profiles, &querierv1.ProfileInfo{
+					Id:        p.Id,
+					Timestamp: p.Timestamp,
+					Labels:    s.Labels,
+					Value:     p.Value,
+				})
+			}
+		}
+	}
+
+	sort.Slice(profiles, func(i, j int) bool {
+		if profiles[i].Timestamp != profiles[j].Timestamp {
+			return profiles[i].Timestamp < profiles[j].Timestamp
+		}
+		return profiles[i].Id < profiles[j].Id
+	})
+	if int64(len(profiles)) > req.Limit {
+		profiles = profiles[:req.Limit]
+	}
+
+	return &querierv1.SelectHeatmapResponse{
+		Heatmap:  heatmapToProto(b.Build()),
+		Profiles: profiles,
+	}
+}
+
+func valueRange(values []*ingestv1.ProfileValues) (min, max uint64) {
+	min = math.MaxUint64
+	for _, s := range values {
+		for _, p := range s.Profiles {
+			if p.Value < 0 {
+				continue
+			}
+			v := uint64(p.Value)
+			if v < min {
+				min = v
+			}
+			if v > max {
+				max = v
+			}
+		}
+	}
+	if min > max {
+		return 0, 0
+	}
+	return min, max
+}
+
+func heatmapToProto(h *heatmap.Heatmap) *querierv1.Heatmap {
+	columns := make([]*querierv1.HeatmapColumn, len(h.Values))
+	for x, values := range h.Values {
+		counts := make([]int64, len(values))
+		for y, n := range values {
+			counts[y] = int64(n)
+		}
+		columns[x] = &querierv1.HeatmapColumn{Counts: counts}
+	}
+	return &querierv1.Heatmap{
+		Start:        h.StartTime.UnixMilli(),
+		End:          h.EndTime.UnixMilli(),
+		MinValue:     int64(h.MinValue),
+		MaxValue:     int64(h.MaxValue),
+		TimeBuckets:  h.TimeBuckets,
+		ValueBuckets: h.ValueBuckets,
+		Columns:      columns,
+		MinDepth:     int64(h.MinDepth),
+		MaxDepth:     int64(h.MaxDepth),
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 
@@ -25,8 +26,11 @@ import (
 	"github.com/grafana/pyroscope/pkg/frontend/dot/graph"
 	"github.com/grafana/pyroscope/pkg/frontend/dot/report"
 	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/og/convert/speedscope"
 	"github.com/grafana/pyroscope/pkg/og/structs/flamebearer"
 	"github.com/grafana/pyroscope/pkg/og/util/attime"
+	"github.com/grafana/pyroscope/pkg/phlaredb/symdb"
+	"github.com/grafana/pyroscope/pkg/pprof"
 	"github.com/grafana/pyroscope/pkg/querier/timeline"
 	httputil "github.com/grafana/pyroscope/pkg/util/http"
 )
@@ -107,10 +111,16 @@ func (q *QueryHandlers) RenderDiff(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	res, err := q.client.Diff(req.Context(), connect.NewRequest(&querierv1.DiffRequest{
+	diffReq := &querierv1.DiffRequest{
 		Left:  leftSelectParams,
 		Right: rightSelectParams,
-	}))
+	}
+	if err = parseDiffOptions(diffReq, req); err != nil {
+		httputil.Error(w, connect.NewError(connect.CodeInvalidArgument, err))
+		return
+	}
+
+	res, err := q.client.Diff(req.Context(), connect.NewRequest(diffReq))
 	if err != nil {
 		httputil.Error(w, err)
 		return
@@ -123,6 +133,59 @@ func (q *QueryHandlers) RenderDiff(w http.ResponseWriter, req *http.Request) {
 	}
 }
 
+// parseDiffOptions parses the diff normalization and significance
+// parameters: normalization=total|profile-count|time-range and
+// significance=<number of individual profiles>.
+func parseDiffOptions(diffReq *querierv1.DiffRequest, req *http.Request) error {
+	v := req.URL.Query()
+	switch n := v.Get("normalization"); n {
+	case "", "none":
+		diffReq.Normalization = querierv1.DiffNormalization_DIFF_NORMALIZATION_NONE
+	case "total":
+		diffReq.Normalization = querierv1.DiffNormalization_DIFF_NORMALIZATION_TOTAL
+	case "profile-count":
+		diffReq.Normalization = querierv1.DiffNormalization_DIFF_NORMALIZATION_PROFILE_COUNT
+	case "time-range":
+		diffReq.Normalization = querierv1.DiffNormalization_DIFF_NORMALIZATION_TIME_RANGE
+	default:
+		return fmt.Errorf("unknown normalization %q", n)
+	}
+	if s := v.Get("significance"); s != "" {
+		profiles, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid significance: %w", err)
+		}
+		diffReq.SignificanceProfiles = profiles
+	}
+	return nil
+}
+
+// parseSeriesLimitOptions parses the limit of the number of timeline
+// series, and the order the series are ranked by.
+func parseSeriesLimitOptions(seriesReq *querierv1.SelectSeriesRequest, req *http.Request) error {
+	v := req.URL.Query()
+	if s := v.Get("limit"); s != "" {
+		limit, err := strconv.ParseInt(s, 10, 64)
+		if err != nil || limit < 0 {
+			return fmt.Errorf("invalid limit %q", s)
+		}
+		seriesReq.Limit = &limit
+	}
+	var order typesv1.SeriesOrder
+	switch o := v.Get("order"); o {
+	case "", "total":
+		order = typesv1.SeriesOrder_SERIES_ORDER_TOTAL
+	case "max":
+		order = typesv1.SeriesOrder_SERIES_ORDER_MAX
+	case "last":
+		order = typesv1.SeriesOrder_SERIES_ORDER_LAST
+	default:
+		return fmt.Errorf("unknown order %q", o)
+	}
+	seriesReq.Order = &order
+	return nil
+}
+
 func (q *QueryHandlers) Render(w http.ResponseWriter, req *http.Request) {
 	if err := req.ParseForm(); err != nil {
 		httputil.Error(w, connect.NewError(connect.CodeInvalidArgument, err))
@@ -143,37 +206,45 @@ func (q *QueryHandlers) Render(w http.ResponseWriter, req *http.Request) {
 			aggregation = typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_SUM
 		case "avg":
 			aggregation = typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_AVERAGE
+		case "min":
+			aggregation = typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_MIN
+		case "max":
+			aggregation = typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_MAX
+		case "count":
+			aggregation = typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_COUNT
+		case "p50":
+			aggregation = typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_P50
+		case "p90":
+			aggregation = typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_P90
+		case "p99":
+			aggregation = typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_P99
 		}
 	}
 
-	format := req.URL.Query().Get("format")
-	if format == "dot" {
-		// We probably should distinguish max nodes of the source pprof
-		// profile and max nodes value for the output profile in dot format.
-		sourceProfileMaxNodes := int64(512)
-		dotProfileMaxNodes := int64(100)
-		if selectParams.MaxNodes != nil {
-			if v := *selectParams.MaxNodes; v > 0 {
-				dotProfileMaxNodes = v
-			}
-			if dotProfileMaxNodes > sourceProfileMaxNodes {
-				sourceProfileMaxNodes = dotProfileMaxNodes
-			}
-		}
-		resp, err := q.client.SelectMergeProfile(req.Context(), connect.NewRequest(&querierv1.SelectMergeProfileRequest{
-			Start:         selectParams.Start,
-			End:           selectParams.End,
-			ProfileTypeID: selectParams.ProfileTypeID,
-			LabelSelector: selectParams.LabelSelector,
-			MaxNodes:      &sourceProfileMaxNodes,
-		}))
-		if err != nil {
-			httputil.Error(w, connect.NewError(connect.CodeInternal, err))
-			return
-		}
-		if err = pprofToDotProfile(w, resp.Msg, int(dotProfileMaxNodes)); err != nil {
-			httputil.Error(w, connect.NewError(connect.CodeInternal, err))
-		}
+	switch format := req.URL.Query().Get("format"); format {
+	case "dot":
+		q.renderDot(w, req, selectParams)
+		return
+	case "pprof":
+		q.renderPprof(w, req, selectParams)
+		return
+	case "collapsed", "speedscope", "svg", "html":
+		q.renderTree(w, req, selectParams, profileType, format)
+		return
+	}
+
+	timelineStep := timeline.CalcPointInterval(selectParams.Start, selectParams.End)
+	seriesReq := &querierv1.SelectSeriesRequest{
+		ProfileTypeID: selectParams.ProfileTypeID,
+		LabelSelector: selectParams.LabelSelector,
+		Start:         selectParams.Start,
+		End:           selectParams.End,
+		Step:          timelineStep,
+		GroupBy:       groupBy,
+		Aggregation:   &aggregation,
+	}
+	if err = parseSeriesLimitOptions(seriesReq, req); err != nil {
+		httputil.Error(w, connect.NewError(connect.CodeInvalidArgument, err))
 		return
 	}
 
@@ -186,21 +257,10 @@ func (q *QueryHandlers) Render(w http.ResponseWriter, req *http.Request) {
 		return err
 	})
 
-	timelineStep := timeline.CalcPointInterval(selectParams.Start, selectParams.End)
 	var resSeries *connect.Response[querierv1.SelectSeriesResponse]
 	g.Go(func() error {
 		var err error
-		resSeries, err = q.client.SelectSeries(req.Context(),
-			connect.NewRequest(&querierv1.SelectSeriesRequest{
-				ProfileTypeID: selectParams.ProfileTypeID,
-				LabelSelector: selectParams.LabelSelector,
-				Start:         selectParams.Start,
-				End:           selectParams.End,
-				Step:          timelineStep,
-				GroupBy:       groupBy,
-				Aggregation:   &aggregation,
-			}))
-
+		resSeries, err = q.client.SelectSeries(req.Context(), connect.NewRequest(seriesReq))
 		return err
 	})
 
@@ -228,6 +288,11 @@ func (q *QueryHandlers) Render(w http.ResponseWriter, req *http.Request) {
 					key = l.Value
 					break
 				}
+				// The series the others are merged into, if the number of series is limited.
+				if l.Name == phlaremodel.LabelNameOtherSeries {
+					key = l.Name
+					break
+				}
 			}
 			fb.Groups[key] = timeline.New(s, selectParams.Start, selectParams.End, int64(timelineStep))
 		}
@@ -240,6 +305,98 @@ func (q *QueryHandlers) Render(w http.ResponseWriter, req *http.Request) {
 	}
 }
 
+func (q *QueryHandlers) renderDot(w http.ResponseWriter, req *http.Request, selectParams *querierv1.SelectMergeStacktracesRequest) {
+	// We probably should distinguish max nodes of the source pprof
+	// profile and max nodes value for the output profile in dot format.
+	sourceProfileMaxNodes := int64(512)
+	dotProfileMaxNodes := int64(100)
+	if selectParams.MaxNodes != nil {
+		if v := *selectParams.MaxNodes; v > 0 {
+			dotProfileMaxNodes = v
+		}
+		if dotProfileMaxNodes > sourceProfileMaxNodes {
+			sourceProfileMaxNodes = dotProfileMaxNodes
+		}
+	}
+	resp, err := q.client.SelectMergeProfile(req.Context(), connect.NewRequest(&querierv1.SelectMergeProfileRequest{
+		Start:              selectParams.Start,
+		End:                selectParams.End,
+		ProfileTypeID:      selectParams.ProfileTypeID,
+		LabelSelector:      selectParams.LabelSelector,
+		MaxNodes:           &sourceProfileMaxNodes,
+		StackTraceSelector: selectParams.StackTraceSelector,
+	}))
+	if err != nil {
+		httputil.Error(w, connect.NewError(connect.CodeInternal, err))
+		return
+	}
+	if err = pprofToDotProfile(w, resp.Msg, int(dotProfileMaxNodes)); err != nil {
+		httputil.Error(w, connect.NewError(connect.CodeInternal, err))
+	}
+}
+
+// renderPprof writes the merged profile in the gzip-compressed pprof format.
+func (q *QueryHandlers) renderPprof(w http.ResponseWriter, req *http.Request, selectParams *querierv1.SelectMergeStacktracesRequest) {
+	resp, err := q.client.SelectMergeProfile(req.Context(), connect.NewRequest(&querierv1.SelectMergeProfileRequest{
+		Start:              selectParams.Start,
+		End:                selectParams.End,
+		ProfileTypeID:      selectParams.ProfileTypeID,
+		LabelSelector:      selectParams.LabelSelector,
+		MaxNodes:           selectParams.MaxNodes,
+		StackTraceSelector: selectParams.StackTraceSelector,
+	}))
+	if err != nil {
+		httputil.Error(w, err)
+		return
+	}
+	b, err := pprof.Marshal(resp.Msg, true)
+	if err != nil {
+		httputil.Error(w, connect.NewError(connect.CodeInternal, err))
+		return
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Disposition", `attachment; filename="profile.pb.gz"`)
+	_, _ = w.Write(b)
+}
+
+// renderTree writes the merged stack traces in one of the formats
+// produced from the tree: collapsed, speedscope, svg, or html.
+func (q *QueryHandlers) renderTree(w http.ResponseWriter, req *http.Request, selectParams *querierv1.SelectMergeStacktracesRequest, profileType *typesv1.ProfileType, format string) {
+	selectParams.Format = querierv1.ProfileFormat_PROFILE_FORMAT_TREE
+	resp, err := q.client.SelectMergeStacktraces(req.Context(), connect.NewRequest(selectParams))
+	if err != nil {
+		httputil.Error(w, err)
+		return
+	}
+	tree, err := phlaremodel.UnmarshalTree(resp.Msg.Tree)
+	if err != nil {
+		httputil.Error(w, connect.NewError(connect.CodeInternal, err))
+		return
+	}
+
+	title := fmt.Sprintf("%s%s", profileType.ID, selectParams.LabelSelector)
+	svgOptions := phlaremodel.FlameGraphSVGOptions{Title: title, Unit: profileType.SampleUnit}
+	switch format {
+	case "collapsed":
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Header().Set("Content-Disposition", `attachment; filename="profile.txt"`)
+		tree.WriteCollapsed(w)
+	case "speedscope":
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Disposition", `attachment; filename="profile.speedscope.json"`)
+		err = speedscope.ExportTree(w, tree, title, profileType.SampleUnit)
+	case "svg":
+		w.Header().Set("Content-Type", "image/svg+xml")
+		err = phlaremodel.WriteFlameGraphSVG(w, tree, svgOptions)
+	case "html":
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		err = phlaremodel.WriteFlameGraphHTML(w, tree, svgOptions)
+	}
+	if err != nil {
+		httputil.Error(w, connect.NewError(connect.CodeInternal, err))
+	}
+}
+
 func pprofToDotProfile(w io.Writer, p *profilev1.Profile, maxNodes int) error {
 	data, err := p.MarshalVT()
 	if err != nil {
@@ -297,9 +454,32 @@ func parseSelectProfilesRequest(fieldNames renderRequestFieldNames, req *http.Re
 	}
 	p.MaxNodes = &mn
 
+	if f := parseFrameFilter(v); f != nil {
+		p.StackTraceSelector = &typesv1.StackTraceSelector{FrameFilter: f}
+		if err = symdb.ValidateStackTraceSelector(p.StackTraceSelector); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	return p, ptype, nil
 }
 
+// parseFrameFilter parses the pprof-style frame filter options:
+// focus, ignore, hide, show_from, and prune_from.
+func parseFrameFilter(v url.Values) *typesv1.FrameFilter {
+	f := &typesv1.FrameFilter{
+		Focus:     v.Get("focus"),
+		Ignore:    v.Get("ignore"),
+		Hide:      v.Get("hide"),
+		ShowFrom:  v.Get("show_from"),
+		PruneFrom: v.Get("prune_from"),
+	}
+	if f.Focus == "" && f.Ignore == "" && f.Hide == "" && f.ShowFrom == "" && f.PruneFrom == "" {
+		return nil
+	}
+	return f
+}
+
 func parseQuery(fieldName string, req *http.Request) (string, *typesv1.ProfileType, error) {
 	q := req.Form.Get(fieldName)
 	if q == "" {
@@ -1,16 +1,23 @@
 package querier
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
 	"time"
 
+	"connectrpc.com/connect"
 	"github.com/prometheus/common/model"
 	"github.com/stretchr/testify/require"
 
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
+	"github.com/grafana/pyroscope/api/gen/proto/go/querier/v1/querierv1connect"
 	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
 )
 
 func Test_ParseQuery(t *testing.T) {
@@ -40,3 +47,108 @@ func Test_ParseQuery(t *testing.T) {
 
 	require.Equal(t, `{foo="bar",bar=~"buzz"}`, queryRequest.LabelSelector)
 }
+
+func Test_ParseDiffOptions(t *testing.T) {
+	req, err := http.NewRequest("GET", "http://localhost/pyroscope/render-diff?normalization=time-range&significance=10", nil)
+	require.NoError(t, err)
+	var diffReq querierv1.DiffRequest
+	require.NoError(t, parseDiffOptions(&diffReq, req))
+	require.Equal(t, querierv1.DiffNormalization_DIFF_NORMALIZATION_TIME_RANGE, diffReq.Normalization)
+	require.Equal(t, int64(10), diffReq.SignificanceProfiles)
+
+	req, err = http.NewRequest("GET", "http://localhost/pyroscope/render-diff?normalization=foo", nil)
+	require.NoError(t, err)
+	require.Error(t, parseDiffOptions(&diffReq, req))
+}
+
+func Test_ParseSeriesLimitOptions(t *testing.T) {
+	req, err := http.NewRequest("GET", "http://localhost/pyroscope/render?limit=5&order=max", nil)
+	require.NoError(t, err)
+	var seriesReq querierv1.SelectSeriesRequest
+	require.NoError(t, parseSeriesLimitOptions(&seriesReq, req))
+	require.Equal(t, int64(5), seriesReq.GetLimit())
+	require.Equal(t, typesv1.SeriesOrder_SERIES_ORDER_MAX, seriesReq.GetOrder())
+
+	for _, q := range []string{"limit=-1", "limit=foo", "order=foo"} {
+		req, err = http.NewRequest("GET", "http://localhost/pyroscope/render?"+q, nil)
+		require.NoError(t, err)
+		require.Error(t, parseSeriesLimitOptions(&seriesReq, req), q)
+	}
+}
+
+func Test_ParseQuery_FrameFilter(t *testing.T) {
+	q := url.Values{
+		"query":      []string{`process_cpu:cpu:nanoseconds:cpu:nanoseconds{}`},
+		"from":       []string{"now-6h"},
+		"until":      []string{"now"},
+		"focus":      []string{"^main$"},
+		"hide":       []string{"^runtime\\."},
+		"prune_from": []string{"^net/http\\."},
+	}
+	req, err := http.NewRequest("GET", fmt.Sprintf("http://localhost/render/render?%s", q.Encode()), nil)
+	require.NoError(t, err)
+	require.NoError(t, req.ParseForm())
+	queryRequest, _, err := parseSelectProfilesRequest(renderRequestFieldNames{}, req)
+	require.NoError(t, err)
+	require.Equal(t, &typesv1.FrameFilter{
+		Focus:     "^main$",
+		Hide:      "^runtime\\.",
+		PruneFrom: "^net/http\\.",
+	}, queryRequest.StackTraceSelector.GetFrameFilter())
+
+	q.Set("ignore", "(")
+	req, err = http.NewRequest("GET", fmt.Sprintf("http://localhost/render/render?%s", q.Encode()), nil)
+	require.NoError(t, err)
+	require.NoError(t, req.ParseForm())
+	_, _, err = parseSelectProfilesRequest(renderRequestFieldNames{}, req)
+	require.Error(t, err)
+}
+
+type mockRenderClient struct {
+	querierv1connect.QuerierServiceClient
+	tree *phlaremodel.Tree
+}
+
+func (m *mockRenderClient) SelectMergeStacktraces(_ context.Context, req *connect.Request[querierv1.SelectMergeStacktracesRequest]) (*connect.Response[querierv1.SelectMergeStacktracesResponse], error) {
+	if req.Msg.Format != querierv1.ProfileFormat_PROFILE_FORMAT_TREE {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unexpected format %v", req.Msg.Format))
+	}
+	return connect.NewResponse(&querierv1.SelectMergeStacktracesResponse{Tree: m.tree.Bytes(-1)}), nil
+}
+
+func Test_Render_TreeFormats(t *testing.T) {
+	tree := new(phlaremodel.Tree)
+	tree.InsertStack(5, "main", "foo")
+	tree.InsertStack(3, "main", "bar")
+	h := NewHTTPHandlers(&mockRenderClient{tree: tree})
+
+	render := func(format string) *httptest.ResponseRecorder {
+		q := url.Values{
+			"query":  []string{`process_cpu:cpu:nanoseconds:cpu:nanoseconds{service_name="foo"}`},
+			"from":   []string{"now-1h"},
+			"until":  []string{"now"},
+			"format": []string{format},
+		}
+		req := httptest.NewRequest("GET", "http://localhost/pyroscope/render?"+q.Encode(), nil)
+		w := httptest.NewRecorder()
+		h.Render(w, req)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		return w
+	}
+
+	w := render("collapsed")
+	require.Equal(t, "main;bar 3\nmain;foo 5\n", w.Body.String())
+
+	w = render("speedscope")
+	require.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	var file map[string]any
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &file))
+	require.Equal(t, "https://www.speedscope.app/file-format-schema.json", file["$schema"])
+
+	w = render("svg")
+	require.Equal(t, "image/svg+xml", w.Header().Get("Content-Type"))
+	require.Contains(t, w.Body.String(), "<title>main")
+
+	w = render("html")
+	require.Contains(t, w.Body.String(), "<svg")
+}
@@ -30,6 +30,9 @@ type IngesterQueryClient interface {
 	MergeProfilesLabels(ctx context.Context) clientpool.BidiClientMergeProfilesLabels
 	MergeProfilesPprof(ctx context.Context) clientpool.BidiClientMergeProfilesPprof
 	MergeSpanProfile(ctx context.Context) clientpool.BidiClientMergeSpanProfile
+	MergeProfilesTopFunctions(ctx context.Context) clientpool.BidiClientMergeProfilesTopFunctions
+	MergeProfilesValues(ctx context.Context) clientpool.BidiClientMergeProfilesValues
+	GetProfileByID(ctx context.Context, req *connect.Request[ingestv1.GetProfileByIDRequest]) (*connect.Response[ingestv1.GetProfileByIDResponse], error)
 	BlockMetadata(ctx context.Context, req *connect.Request[ingestv1.BlockMetadataRequest]) (*connect.Response[ingestv1.BlockMetadataResponse], error)
 	GetProfileStats(ctx context.Context, req *connect.Request[typesv1.GetProfileStatsRequest]) (*connect.Response[typesv1.GetProfileStatsResponse], error)
 	GetBlockStats(ctx context.Context, req *connect.Request[ingestv1.GetBlockStatsRequest]) (*connect.Response[ingestv1.GetBlockStatsResponse], error)
@@ -128,7 +131,8 @@ func (q *Querier) selectTreeFromIngesters(ctx context.Context, req *querierv1.Se
 					Type:          profileType,
 					Hints:         &ingestv1.Hints{Block: blockHints},
 				},
-				MaxNodes: req.MaxNodes,
+				MaxNodes:           req.MaxNodes,
+				StackTraceSelector: req.StackTraceSelector,
 			})
 		}))
 	}
@@ -199,6 +203,124 @@ func (q *Querier) selectProfileFromIngesters(ctx context.Context, req *querierv1
 	return selectMergePprofProfile(gCtx, profileType, responses)
 }
 
+func (q *Querier) selectTopFunctionsFromIngesters(ctx context.Context, req *querierv1.SelectTopFunctionsRequest, filter *typesv1.TopFunctionsFilter, plan blockPlan) ([]*typesv1.TopFunction, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "SelectTopFunctions Ingesters")
+	defer span.Finish()
+	profileType, err := phlaremodel.ParseProfileTypeSelector(req.ProfileTypeID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	_, err = parser.ParseMetricSelector(req.LabelSelector)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var responses []ResponseFromReplica[clientpool.BidiClientMergeProfilesTopFunctions]
+	if plan != nil {
+		responses, err = forAllPlannedIngesters(ctx, q.ingesterQuerier, plan, func(ctx context.Context, ic IngesterQueryClient, hints *ingestv1.Hints) (clientpool.BidiClientMergeProfilesTopFunctions, error) {
+			return ic.MergeProfilesTopFunctions(ctx), nil
+		})
+	} else {
+		responses, err = forAllIngesters(ctx, q.ingesterQuerier, func(ctx context.Context, ic IngesterQueryClient) (clientpool.BidiClientMergeProfilesTopFunctions, error) {
+			return ic.MergeProfilesTopFunctions(ctx), nil
+		})
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	// send the first initial request to all ingesters.
+	g, gCtx := errgroup.WithContext(ctx)
+	for idx := range responses {
+		r := responses[idx]
+		blockHints, err := BlockHints(plan, r.addr)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+
+		g.Go(util.RecoverPanic(func() error {
+			return r.response.Send(&ingestv1.MergeProfilesTopFunctionsRequest{
+				Request: &ingestv1.SelectProfilesRequest{
+					LabelSelector: req.LabelSelector,
+					Start:         req.Start,
+					End:           req.End,
+					Type:          profileType,
+					Hints:         &ingestv1.Hints{Block: blockHints},
+				},
+				Grouping:           req.Grouping,
+				StackTraceSelector: req.StackTraceSelector,
+				Filter:             filter,
+			})
+		}))
+	}
+	if err = g.Wait(); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// merge all profiles
+	span.LogFields(otlog.String("msg", "selectMergeTopFunctions"))
+	return selectMergeTopFunctions(gCtx, responses)
+}
+
+func (q *Querier) selectProfileValuesFromIngesters(ctx context.Context, req *querierv1.SelectProfilesRequest, plan blockPlan, limit *profilesLimit) ([]*ingestv1.ProfileValues, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "SelectProfileValues Ingesters")
+	defer span.Finish()
+	profileType, err := phlaremodel.ParseProfileTypeSelector(req.ProfileTypeID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	_, err = parser.ParseMetricSelector(req.LabelSelector)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var responses []ResponseFromReplica[clientpool.BidiClientMergeProfilesValues]
+	if plan != nil {
+		responses, err = forAllPlannedIngesters(ctx, q.ingesterQuerier, plan, func(ctx context.Context, ic IngesterQueryClient, hints *ingestv1.Hints) (clientpool.BidiClientMergeProfilesValues, error) {
+			return ic.MergeProfilesValues(ctx), nil
+		})
+	} else {
+		responses, err = forAllIngesters(ctx, q.ingesterQuerier, func(ctx context.Context, ic IngesterQueryClient) (clientpool.BidiClientMergeProfilesValues, error) {
+			return ic.MergeProfilesValues(ctx), nil
+		})
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	// send the first initial request to all ingesters.
+	g, gCtx := errgroup.WithContext(ctx)
+	for idx := range responses {
+		r := responses[idx]
+		blockHints, err := BlockHints(plan, r.addr)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+
+		g.Go(util.RecoverPanic(func() error {
+			return r.response.Send(&ingestv1.MergeProfilesValuesRequest{
+				Request: &ingestv1.SelectProfilesRequest{
+					LabelSelector: req.LabelSelector,
+					Start:         req.Start,
+					End:           req.End,
+					Type:          profileType,
+					Hints:         &ingestv1.Hints{Block: blockHints},
+				},
+				MaxProfiles: maxHeatmapProfiles,
+			})
+		}))
+	}
+	if err = g.Wait(); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// merge all profiles
+	span.LogFields(otlog.String("msg", "selectMergeProfileValues"))
+	return selectMergeProfileValues(gCtx, responses, limit)
+}
+
 func (q *Querier) selectSeriesFromIngesters(ctx context.Context, req *ingesterv1.MergeProfilesLabelsRequest, plan map[string]*blockPlanEntry) ([]ResponseFromReplica[clientpool.BidiClientMergeProfilesLabels], error) {
 	sp, ctx := opentracing.StartSpanFromContext(ctx, "SelectSeries Ingesters")
 	defer sp.Finish()
@@ -293,6 +415,23 @@ func (q *Querier) seriesFromIngesters(ctx context.Context, req *ingesterv1.Serie
 	return responses, nil
 }
 
+func (q *Querier) getProfileByIDFromIngesters(ctx context.Context, req *ingestv1.GetProfileByIDRequest) ([]ResponseFromReplica[*googlev1.Profile], error) {
+	sp, ctx := opentracing.StartSpanFromContext(ctx, "GetProfileByID Ingesters")
+	defer sp.Finish()
+
+	responses, err := forAllIngesters(ctx, q.ingesterQuerier, func(childCtx context.Context, ic IngesterQueryClient) (*googlev1.Profile, error) {
+		res, err := ic.GetProfileByID(childCtx, connect.NewRequest(req))
+		if err != nil {
+			return nil, err
+		}
+		return res.Msg.Profile, nil
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return responses, nil
+}
+
 func (q *Querier) selectSpanProfileFromIngesters(ctx context.Context, req *querierv1.SelectMergeSpanProfileRequest, plan map[string]*blockPlanEntry) (*phlaremodel.Tree, error) {
 	sp, ctx := opentracing.StartSpanFromContext(ctx, "SelectMergeSpanProfile Ingesters")
 	defer sp.Finish()
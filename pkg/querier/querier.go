@@ -13,6 +13,7 @@ import (
 	"connectrpc.com/connect"
 	"github.com/go-kit/log"
 	"github.com/go-kit/log/level"
+	"github.com/google/uuid"
 	"github.com/grafana/dskit/ring"
 	ring_client "github.com/grafana/dskit/ring/client"
 	"github.com/grafana/dskit/services"
@@ -38,27 +39,33 @@ import (
 	phlaremodel "github.com/grafana/pyroscope/pkg/model"
 	phlareobj "github.com/grafana/pyroscope/pkg/objstore"
 	"github.com/grafana/pyroscope/pkg/phlaredb/bucketindex"
+	"github.com/grafana/pyroscope/pkg/phlaredb/symdb"
 	"github.com/grafana/pyroscope/pkg/pprof"
 	"github.com/grafana/pyroscope/pkg/querier/vcs"
 	"github.com/grafana/pyroscope/pkg/storegateway"
 	pmath "github.com/grafana/pyroscope/pkg/util/math"
 	"github.com/grafana/pyroscope/pkg/util/spanlogger"
+	validationutil "github.com/grafana/pyroscope/pkg/util/validation"
 	"github.com/grafana/pyroscope/pkg/validation"
 )
 
 type Config struct {
 	PoolConfig      clientpool.PoolConfig `yaml:"pool_config,omitempty"`
 	QueryStoreAfter time.Duration         `yaml:"query_store_after" category:"advanced"`
+
+	TenantFederation TenantFederationConfig `yaml:"tenant_federation"`
 }
 
 // RegisterFlags registers distributor-related flags.
 func (cfg *Config) RegisterFlags(fs *flag.FlagSet) {
 	cfg.PoolConfig.RegisterFlagsWithPrefix("querier", fs)
 	fs.DurationVar(&cfg.QueryStoreAfter, "querier.query-store-after", 4*time.Hour, "The time after which a metric should be queried from storage and not just ingesters. 0 means all queries are sent to store. If this option is enabled, the time range of the query sent to the store-gateway will be manipulated to ensure the query end is not more recent than 'now - query-store-after'.")
+	cfg.TenantFederation.RegisterFlags(fs)
 }
 
 type Limits interface {
 	QueryAnalysisSeriesEnabled(string) bool
+	MaxTopFunctions(string) int
 }
 
 type Querier struct {
@@ -137,7 +144,9 @@ func New(params *NewQuerierParams) (*Querier, error) {
 		VCSServiceHandler:    vcs.New(params.Logger, params.Reg),
 		storageBucket:        params.StorageBucket,
 		tenantConfigProvider: params.CfgProvider,
-		limits:               params.Overrides,
+	}
+	if params.Overrides != nil {
+		q.limits = params.Overrides
 	}
 
 	svcs := []services.Service{q.ingesterQuerier.pool}
@@ -489,6 +498,11 @@ func (q *Querier) Diff(ctx context.Context, req *connect.Request[querierv1.DiffR
 		sp.Finish()
 	}()
 
+	if req.Msg.Normalization == querierv1.DiffNormalization_DIFF_NORMALIZATION_PROFILE_COUNT || req.Msg.SignificanceProfiles > 1 {
+		return nil, connect.NewError(connect.CodeUnimplemented,
+			errors.New("profile count normalization and significance are only supported by query-frontend"))
+	}
+
 	var leftTree, rightTree *phlaremodel.Tree
 	g, gCtx := errgroup.WithContext(ctx)
 
@@ -515,7 +529,10 @@ func (q *Querier) Diff(ctx context.Context, req *connect.Request[querierv1.DiffR
 		return nil, err
 	}
 
-	fd, err := phlaremodel.NewFlamegraphDiff(leftTree, rightTree, maxNodesDefault)
+	fd, err := phlaremodel.NewFlamegraphDiffWithOptions(leftTree, rightTree, maxNodesDefault, phlaremodel.DiffOptions{
+		LeftScale:  phlaremodel.DiffScale(req.Msg.Normalization, leftTree.Total(), 0, req.Msg.Left.Start, req.Msg.Left.End),
+		RightScale: phlaremodel.DiffScale(req.Msg.Normalization, rightTree.Total(), 0, req.Msg.Right.Start, req.Msg.Right.End),
+	})
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
@@ -660,6 +677,10 @@ func isEndpointNotExistingErr(err error) bool {
 }
 
 func (q *Querier) selectTree(ctx context.Context, req *querierv1.SelectMergeStacktracesRequest) (*phlaremodel.Tree, error) {
+	if err := symdb.ValidateStackTraceSelector(req.StackTraceSelector); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
 	// determine the block hints
 	plan, err := q.blockSelect(ctx, model.Time(req.Start), model.Time(req.End))
 	if isEndpointNotExistingErr(err) {
@@ -723,12 +744,13 @@ type storeQuery struct {
 
 func (sq storeQuery) MergeStacktracesRequest(req *querierv1.SelectMergeStacktracesRequest) *querierv1.SelectMergeStacktracesRequest {
 	return &querierv1.SelectMergeStacktracesRequest{
-		Start:         int64(sq.start),
-		End:           int64(sq.end),
-		LabelSelector: req.LabelSelector,
-		ProfileTypeID: req.ProfileTypeID,
-		MaxNodes:      req.MaxNodes,
-		Format:        req.Format,
+		Start:              int64(sq.start),
+		End:                int64(sq.end),
+		LabelSelector:      req.LabelSelector,
+		ProfileTypeID:      req.ProfileTypeID,
+		MaxNodes:           req.MaxNodes,
+		Format:             req.Format,
+		StackTraceSelector: req.StackTraceSelector,
 	}
 }
 
@@ -769,6 +791,26 @@ func (sq storeQuery) MergeProfileRequest(req *querierv1.SelectMergeProfileReques
 	}
 }
 
+func (sq storeQuery) TopFunctionsRequest(req *querierv1.SelectTopFunctionsRequest) *querierv1.SelectTopFunctionsRequest {
+	return &querierv1.SelectTopFunctionsRequest{
+		ProfileTypeID:      req.ProfileTypeID,
+		LabelSelector:      req.LabelSelector,
+		Start:              int64(sq.start),
+		End:                int64(sq.end),
+		Grouping:           req.Grouping,
+		StackTraceSelector: req.StackTraceSelector,
+	}
+}
+
+func (sq storeQuery) ProfilesRequest(req *querierv1.SelectProfilesRequest) *querierv1.SelectProfilesRequest {
+	return &querierv1.SelectProfilesRequest{
+		ProfileTypeID: req.ProfileTypeID,
+		LabelSelector: req.LabelSelector,
+		Start:         int64(sq.start),
+		End:           int64(sq.end),
+	}
+}
+
 func (sq storeQuery) SeriesRequest(req *querierv1.SeriesRequest) *ingestv1.SeriesRequest {
 	return &ingestv1.SeriesRequest{
 		Start:      int64(sq.start),
@@ -863,6 +905,10 @@ func (q *Querier) SelectMergeProfile(ctx context.Context, req *connect.Request[q
 }
 
 func (q *Querier) selectProfile(ctx context.Context, req *querierv1.SelectMergeProfileRequest) (*googlev1.Profile, error) {
+	if err := symdb.ValidateStackTraceSelector(req.StackTraceSelector); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
 	// determine the block hints
 	plan, err := q.blockSelect(ctx, model.Time(req.Start), model.Time(req.End))
 	if isEndpointNotExistingErr(err) {
@@ -922,6 +968,360 @@ func (q *Querier) selectProfile(ctx context.Context, req *querierv1.SelectMergeP
 	return merge.Profile(), nil
 }
 
+func (q *Querier) SelectTopFunctions(ctx context.Context, req *connect.Request[querierv1.SelectTopFunctionsRequest]) (*connect.Response[querierv1.SelectTopFunctionsResponse], error) {
+	sp, ctx := opentracing.StartSpanFromContext(ctx, "SelectTopFunctions")
+	sp.SetTag("start", model.Time(req.Msg.Start).Time().String()).
+		SetTag("end", model.Time(req.Msg.End).Time().String()).
+		SetTag("selector", req.Msg.LabelSelector).
+		SetTag("grouping", req.Msg.Grouping.String()).
+		SetTag("profile_type", req.Msg.ProfileTypeID)
+	defer sp.Finish()
+
+	if req.Msg.Limit < 0 || req.Msg.Offset < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("limit and offset must not be negative"))
+	}
+	// The page can not go beyond the tenant limit,
+	// regardless of whether the query-frontend is used.
+	offset, limit := req.Msg.Offset, req.Msg.Limit
+	var maxFunctions int
+	if q.limits != nil {
+		tenantIDs, err := tenant.TenantIDs(ctx)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		maxFunctions = validationutil.SmallestPositiveNonZeroIntPerTenant(tenantIDs, q.limits.MaxTopFunctions)
+		if maxFunctions > 0 && (limit == 0 || offset+limit > int64(maxFunctions)) {
+			limit = int64(maxFunctions) - offset
+		}
+	}
+	functions, err := q.selectTopFunctions(ctx, req.Msg, nil)
+	if err != nil {
+		return nil, err
+	}
+	phlaremodel.SortTopFunctions(functions, req.Msg.OrderBy)
+	resp := &querierv1.SelectTopFunctionsResponse{
+		TotalFunctions: int64(len(functions)),
+		Total:          phlaremodel.TopFunctionsTotal(functions),
+	}
+	if maxFunctions == 0 || limit > 0 {
+		resp.Functions = phlaremodel.PaginateTopFunctions(functions, offset, limit)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// SelectLineProfile aggregates the values of the functions grouped by
+// line into the values of the lines of the requested function or file.
+func (q *Querier) SelectLineProfile(ctx context.Context, req *connect.Request[querierv1.SelectLineProfileRequest]) (*connect.Response[querierv1.SelectLineProfileResponse], error) {
+	sp, ctx := opentracing.StartSpanFromContext(ctx, "SelectLineProfile")
+	sp.SetTag("start", model.Time(req.Msg.Start).Time().String()).
+		SetTag("end", model.Time(req.Msg.End).Time().String()).
+		SetTag("selector", req.Msg.LabelSelector).
+		SetTag("function_name", req.Msg.FunctionName).
+		SetTag("filename", req.Msg.Filename).
+		SetTag("profile_type", req.Msg.ProfileTypeID)
+	defer sp.Finish()
+
+	if req.Msg.FunctionName == "" && req.Msg.Filename == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("function name or file name must be specified"))
+	}
+	functions, err := q.selectTopFunctions(ctx, &querierv1.SelectTopFunctionsRequest{
+		ProfileTypeID:      req.Msg.ProfileTypeID,
+		LabelSelector:      req.Msg.LabelSelector,
+		Start:              req.Msg.Start,
+		End:                req.Msg.End,
+		Grouping:           typesv1.TopFunctionsGrouping_TOP_FUNCTIONS_GROUPING_LINE,
+		StackTraceSelector: req.Msg.StackTraceSelector,
+	}, &typesv1.TopFunctionsFilter{
+		FunctionName: req.Msg.FunctionName,
+		Filename:     req.Msg.Filename,
+	})
+	if err != nil {
+		return nil, err
+	}
+	m := phlaremodel.NewLineProfileMerger()
+	m.MergeTopFunctions(functions)
+	return connect.NewResponse(&querierv1.SelectLineProfileResponse{
+		Lines: m.Lines(),
+		Total: phlaremodel.TopFunctionsTotal(functions),
+	}), nil
+}
+
+// selectTopFunctions returns the functions of the matching profiles. If the
+// filter is set, only the functions matching the filter are returned, and the
+// self values of the others are attributed to a function without a name.
+func (q *Querier) selectTopFunctions(ctx context.Context, req *querierv1.SelectTopFunctionsRequest, filter *typesv1.TopFunctionsFilter) ([]*typesv1.TopFunction, error) {
+	// determine the block hints
+	plan, err := q.blockSelect(ctx, model.Time(req.Start), model.Time(req.End))
+	if isEndpointNotExistingErr(err) {
+		level.Warn(spanlogger.FromContext(ctx, q.logger)).Log(
+			"msg", "block select not supported on at least one component, fallback to use full dataset",
+			"err", err,
+		)
+		plan = nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error during block select: %w", err)
+	}
+
+	// no store gateways configured so just query the ingesters
+	if q.storeGatewayQuerier == nil {
+		return q.selectTopFunctionsFromIngesters(ctx, req, filter, plan)
+	}
+
+	storeQueries := splitQueryToStores(model.Time(req.Start), model.Time(req.End), model.Now(), q.cfg.QueryStoreAfter, plan)
+	if !storeQueries.ingester.shouldQuery && !storeQueries.storeGateway.shouldQuery {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("start and end time are outside of the ingester and store gateway retention"))
+	}
+
+	storeQueries.Log(level.Debug(spanlogger.FromContext(ctx, q.logger)))
+
+	if plan == nil && !storeQueries.ingester.shouldQuery {
+		return q.selectTopFunctionsFromStoreGateway(ctx, storeQueries.storeGateway.TopFunctionsRequest(req), filter, plan)
+	}
+	if plan == nil && !storeQueries.storeGateway.shouldQuery {
+		return q.selectTopFunctionsFromIngesters(ctx, storeQueries.ingester.TopFunctionsRequest(req), filter, plan)
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	m := phlaremodel.NewTopFunctionsMerger()
+	g.Go(func() error {
+		functions, err := q.selectTopFunctionsFromIngesters(ctx, storeQueries.ingester.TopFunctionsRequest(req), filter, plan)
+		if err != nil {
+			return err
+		}
+		m.MergeTopFunctions(functions)
+		return nil
+	})
+	g.Go(func() error {
+		functions, err := q.selectTopFunctionsFromStoreGateway(ctx, storeQueries.storeGateway.TopFunctionsRequest(req), filter, plan)
+		if err != nil {
+			return err
+		}
+		m.MergeTopFunctions(functions)
+		return nil
+	})
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return m.TopFunctions(), nil
+}
+
+func (q *Querier) SelectHeatmap(ctx context.Context, req *connect.Request[querierv1.SelectHeatmapRequest]) (*connect.Response[querierv1.SelectHeatmapResponse], error) {
+	sp, ctx := opentracing.StartSpanFromContext(ctx, "SelectHeatmap")
+	sp.SetTag("start", model.Time(req.Msg.Start).Time().String()).
+		SetTag("end", model.Time(req.Msg.End).Time().String()).
+		SetTag("selector", req.Msg.LabelSelector).
+		SetTag("profile_type", req.Msg.ProfileTypeID)
+	defer sp.Finish()
+
+	if err := validateHeatmapRequest(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	values, err := q.selectProfileValues(ctx, &querierv1.SelectProfilesRequest{
+		ProfileTypeID: req.Msg.ProfileTypeID,
+		LabelSelector: req.Msg.LabelSelector,
+		Start:         req.Msg.Start,
+		End:           req.Msg.End,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(buildHeatmap(req.Msg, values)), nil
+}
+
+const defaultSelectProfilesLimit = 100
+
+func (q *Querier) SelectProfiles(ctx context.Context, req *connect.Request[querierv1.SelectProfilesRequest]) (*connect.Response[querierv1.SelectProfilesResponse], error) {
+	sp, ctx := opentracing.StartSpanFromContext(ctx, "SelectProfiles")
+	sp.SetTag("start", model.Time(req.Msg.Start).Time().String()).
+		SetTag("end", model.Time(req.Msg.End).Time().String()).
+		SetTag("selector", req.Msg.LabelSelector).
+		SetTag("profile_type", req.Msg.ProfileTypeID)
+	defer sp.Finish()
+
+	if req.Msg.Limit < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("limit must not be negative"))
+	}
+	limit := req.Msg.Limit
+	if limit == 0 {
+		limit = defaultSelectProfilesLimit
+	}
+	values, err := q.selectProfileValues(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	var profiles []*querierv1.ProfileInfo
+	for _, s := range values {
+		for _, p := range s.Profiles {
+			profiles = append(profiles, &querierv1.ProfileInfo{
+				Id:        p.Id,
+				Timestamp: p.Timestamp,
+				Labels:    s.Labels,
+				Value:     p.Value,
+			})
+		}
+	}
+	sort.Slice(profiles, func(i, j int) bool {
+		if profiles[i].Timestamp != profiles[j].Timestamp {
+			return profiles[i].Timestamp > profiles[j].Timestamp
+		}
+		return profiles[i].Id < profiles[j].Id
+	})
+	total := int64(len(profiles))
+	if total > limit {
+		profiles = profiles[:limit]
+	}
+	return connect.NewResponse(&querierv1.SelectProfilesResponse{
+		Profiles: profiles,
+		Total:    total,
+	}), nil
+}
+
+func (q *Querier) GetProfileByID(ctx context.Context, req *connect.Request[querierv1.GetProfileByIDRequest]) (*connect.Response[googlev1.Profile], error) {
+	sp, ctx := opentracing.StartSpanFromContext(ctx, "GetProfileByID")
+	sp.SetTag("start", model.Time(req.Msg.Start).Time().String()).
+		SetTag("end", model.Time(req.Msg.End).Time().String()).
+		SetTag("selector", req.Msg.LabelSelector).
+		SetTag("profile_type", req.Msg.ProfileTypeID).
+		SetTag("id", req.Msg.Id)
+	defer sp.Finish()
+
+	profileType, err := phlaremodel.ParseProfileTypeSelector(req.Msg.ProfileTypeID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	selector := req.Msg.LabelSelector
+	if selector == "" {
+		selector = "{}"
+	}
+	if _, err = parser.ParseMetricSelector(selector); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if _, err = uuid.Parse(req.Msg.Id); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid profile id: %w", err))
+	}
+	request := func(start, end model.Time) *ingestv1.GetProfileByIDRequest {
+		return &ingestv1.GetProfileByIDRequest{
+			Request: &ingestv1.SelectProfilesRequest{
+				LabelSelector: selector,
+				Type:          profileType,
+				Start:         int64(start),
+				End:           int64(end),
+			},
+			Id: req.Msg.Id,
+		}
+	}
+
+	var responses []ResponseFromReplica[*googlev1.Profile]
+	if q.storeGatewayQuerier == nil {
+		responses, err = q.getProfileByIDFromIngesters(ctx, request(model.Time(req.Msg.Start), model.Time(req.Msg.End)))
+	} else {
+		storeQueries := splitQueryToStores(model.Time(req.Msg.Start), model.Time(req.Msg.End), model.Now(), q.cfg.QueryStoreAfter, nil)
+		if !storeQueries.ingester.shouldQuery && !storeQueries.storeGateway.shouldQuery {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("start and end time are outside of the ingester and store gateway retention"))
+		}
+		var lock sync.Mutex
+		g, gCtx := errgroup.WithContext(ctx)
+		if storeQueries.ingester.shouldQuery {
+			g.Go(func() error {
+				r, err := q.getProfileByIDFromIngesters(gCtx, request(storeQueries.ingester.start, storeQueries.ingester.end))
+				lock.Lock()
+				responses = append(responses, r...)
+				lock.Unlock()
+				return err
+			})
+		}
+		if storeQueries.storeGateway.shouldQuery {
+			g.Go(func() error {
+				r, err := q.getProfileByIDFromStoreGateway(gCtx, request(storeQueries.storeGateway.start, storeQueries.storeGateway.end))
+				lock.Lock()
+				responses = append(responses, r...)
+				lock.Unlock()
+				return err
+			})
+		}
+		err = g.Wait()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Replicas of the profile are identical, any of them will do.
+	for _, r := range responses {
+		if r.response != nil {
+			return connect.NewResponse(r.response), nil
+		}
+	}
+	return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("profile %s not found", req.Msg.Id))
+}
+
+func (q *Querier) selectProfileValues(ctx context.Context, req *querierv1.SelectProfilesRequest) ([]*ingestv1.ProfileValues, error) {
+	// determine the block hints
+	plan, err := q.blockSelect(ctx, model.Time(req.Start), model.Time(req.End))
+	if isEndpointNotExistingErr(err) {
+		level.Warn(spanlogger.FromContext(ctx, q.logger)).Log(
+			"msg", "block select not supported on at least one component, fallback to use full dataset",
+			"err", err,
+		)
+		plan = nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error during block select: %w", err)
+	}
+
+	// The limit is shared by ingesters and store-gateways.
+	limit := newProfilesLimit(maxHeatmapProfiles)
+
+	// no store gateways configured so just query the ingesters
+	if q.storeGatewayQuerier == nil {
+		return q.selectProfileValuesFromIngesters(ctx, req, plan, limit)
+	}
+
+	storeQueries := splitQueryToStores(model.Time(req.Start), model.Time(req.End), model.Now(), q.cfg.QueryStoreAfter, plan)
+	if !storeQueries.ingester.shouldQuery && !storeQueries.storeGateway.shouldQuery {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("start and end time are outside of the ingester and store gateway retention"))
+	}
+
+	storeQueries.Log(level.Debug(spanlogger.FromContext(ctx, q.logger)))
+
+	if plan == nil && !storeQueries.ingester.shouldQuery {
+		return q.selectProfileValuesFromStoreGateway(ctx, storeQueries.storeGateway.ProfilesRequest(req), plan, limit)
+	}
+	if plan == nil && !storeQueries.storeGateway.shouldQuery {
+		return q.selectProfileValuesFromIngesters(ctx, storeQueries.ingester.ProfilesRequest(req), plan, limit)
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	var (
+		m      sync.Mutex
+		values []*ingestv1.ProfileValues
+	)
+	g.Go(func() error {
+		v, err := q.selectProfileValuesFromIngesters(ctx, storeQueries.ingester.ProfilesRequest(req), plan, limit)
+		if err != nil {
+			return err
+		}
+		m.Lock()
+		values = append(values, v...)
+		m.Unlock()
+		return nil
+	})
+	g.Go(func() error {
+		v, err := q.selectProfileValuesFromStoreGateway(ctx, storeQueries.storeGateway.ProfilesRequest(req), plan, limit)
+		if err != nil {
+			return err
+		}
+		m.Lock()
+		values = append(values, v...)
+		m.Unlock()
+		return nil
+	})
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
 func (q *Querier) SelectSeries(ctx context.Context, req *connect.Request[querierv1.SelectSeriesRequest]) (*connect.Response[querierv1.SelectSeriesResponse], error) {
 	sp, ctx := opentracing.StartSpanFromContext(ctx, "SelectSeries")
 	defer func() {
@@ -949,6 +1349,14 @@ func (q *Querier) SelectSeries(ctx context.Context, req *connect.Request[querier
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("step must be non-zero"))
 	}
 
+	if req.Msg.GetLimit() < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("limit must not be negative"))
+	}
+
+	if err = symdb.ValidateStackTraceSelector(req.Msg.StackTraceSelector); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
 	stepMs := time.Duration(req.Msg.Step * float64(time.Second)).Milliseconds()
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
@@ -970,15 +1378,17 @@ func (q *Querier) SelectSeries(ctx context.Context, req *connect.Request[querier
 		return nil, err
 	}
 
-	it, err := selectMergeSeries(ctx, req.Msg.Aggregation, responses)
+	series, err := selectMergeSeries(ctx, req.Msg.Aggregation, responses)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
+	it := newSeriesSetIterator(series)
 	result := rangeSeries(it, req.Msg.Start, req.Msg.End, stepMs, req.Msg.Aggregation)
 	if it.Err() != nil {
 		return nil, connect.NewError(connect.CodeInternal, it.Err())
 	}
+	result = limitSeries(req.Msg, stepMs, result, series)
 
 	return connect.NewResponse(&querierv1.SelectSeriesResponse{
 		Series: result,
@@ -1200,10 +1610,40 @@ func NewTimeSeriesAggregator(aggregation *typesv1.TimeSeriesAggregationType) Tim
 			ts: -1,
 		}
 	}
-	if *aggregation == typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_AVERAGE {
+	switch *aggregation {
+	case typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_AVERAGE:
 		return &avgTimeSeriesAggregator{
 			ts: -1,
 		}
+	case typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_MIN:
+		return &minMaxTimeSeriesAggregator{
+			ts:      -1,
+			compare: func(a, b float64) bool { return a < b },
+		}
+	case typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_MAX:
+		return &minMaxTimeSeriesAggregator{
+			ts:      -1,
+			compare: func(a, b float64) bool { return a > b },
+		}
+	case typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_COUNT:
+		return &countTimeSeriesAggregator{
+			ts: -1,
+		}
+	case typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_P50:
+		return &quantileTimeSeriesAggregator{
+			ts: -1,
+			q:  0.5,
+		}
+	case typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_P90:
+		return &quantileTimeSeriesAggregator{
+			ts: -1,
+			q:  0.9,
+		}
+	case typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_P99:
+		return &quantileTimeSeriesAggregator{
+			ts: -1,
+			q:  0.99,
+		}
 	}
 	return &sumTimeSeriesAggregator{
 		ts: -1,
@@ -1270,3 +1710,113 @@ func (a *avgTimeSeriesAggregator) IsEmpty() bool {
 func (a *avgTimeSeriesAggregator) GetTimestamp() int64 {
 	return a.ts
 }
+
+// minMaxTimeSeriesAggregator keeps the value that compares
+// favorably to all the other values of the step.
+type minMaxTimeSeriesAggregator struct {
+	ts      int64
+	value   float64
+	compare func(a, b float64) bool
+}
+
+func (a *minMaxTimeSeriesAggregator) Add(ts int64, value float64) {
+	if a.ts == -1 || a.compare(value, a.value) {
+		a.value = value
+	}
+	a.ts = ts
+}
+
+func (a *minMaxTimeSeriesAggregator) GetAndReset() *typesv1.Point {
+	tsCopy := a.ts
+	valueCopy := a.value
+	a.ts = -1
+	a.value = 0
+	return &typesv1.Point{
+		Timestamp: tsCopy,
+		Value:     valueCopy,
+	}
+}
+
+func (a *minMaxTimeSeriesAggregator) IsEmpty() bool {
+	return a.ts == -1
+}
+
+func (a *minMaxTimeSeriesAggregator) GetTimestamp() int64 {
+	return a.ts
+}
+
+// countTimeSeriesAggregator counts the profiles of the step.
+type countTimeSeriesAggregator struct {
+	ts    int64
+	count int64
+}
+
+func (a *countTimeSeriesAggregator) Add(ts int64, _ float64) {
+	a.ts = ts
+	a.count++
+}
+
+func (a *countTimeSeriesAggregator) GetAndReset() *typesv1.Point {
+	tsCopy := a.ts
+	countCopy := a.count
+	a.ts = -1
+	a.count = 0
+	return &typesv1.Point{
+		Timestamp: tsCopy,
+		Value:     float64(countCopy),
+	}
+}
+
+func (a *countTimeSeriesAggregator) IsEmpty() bool {
+	return a.ts == -1
+}
+
+func (a *countTimeSeriesAggregator) GetTimestamp() int64 {
+	return a.ts
+}
+
+// quantileTimeSeriesAggregator calculates the q-quantile of the profile
+// values of the step. The value is linearly interpolated between the
+// two nearest ranks, the same way as Prometheus does.
+type quantileTimeSeriesAggregator struct {
+	ts     int64
+	q      float64
+	values []float64
+}
+
+func (a *quantileTimeSeriesAggregator) Add(ts int64, value float64) {
+	a.ts = ts
+	a.values = append(a.values, value)
+}
+
+func (a *quantileTimeSeriesAggregator) GetAndReset() *typesv1.Point {
+	tsCopy := a.ts
+	value := quantile(a.q, a.values)
+	a.ts = -1
+	a.values = a.values[:0]
+	return &typesv1.Point{
+		Timestamp: tsCopy,
+		Value:     value,
+	}
+}
+
+func (a *quantileTimeSeriesAggregator) IsEmpty() bool {
+	return a.ts == -1
+}
+
+func (a *quantileTimeSeriesAggregator) GetTimestamp() int64 {
+	return a.ts
+}
+
+// quantile returns the q-quantile of the values. The values are sorted in place.
+func quantile(q float64, values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sort.Float64s(values)
+	rank := q * float64(len(values)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	weight := rank - float64(lower)
+	return values[lower]*(1-weight) + values[upper]*weight
+}
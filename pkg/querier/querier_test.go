@@ -6,6 +6,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
 	"path"
 	"sort"
@@ -40,6 +41,7 @@ import (
 	"github.com/grafana/pyroscope/pkg/tenant"
 	"github.com/grafana/pyroscope/pkg/testhelper"
 	"github.com/grafana/pyroscope/pkg/util"
+	"github.com/grafana/pyroscope/pkg/validation"
 )
 
 type poolFactory struct {
@@ -497,6 +499,423 @@ func Test_SelectMergeProfiles(t *testing.T) {
 	}
 }
 
+func Test_SelectTopFunctions(t *testing.T) {
+	batch := func(labelIndexes ...int32) *ingestv1.ProfileSets {
+		ps := &ingestv1.ProfileSets{
+			LabelsSets: []*typesv1.Labels{
+				{Labels: []*typesv1.LabelPair{{Name: "app", Value: "foo"}}},
+				{Labels: []*typesv1.LabelPair{{Name: "app", Value: "bar"}}},
+			},
+		}
+		for _, i := range labelIndexes {
+			ps.Profiles = append(ps.Profiles, &ingestv1.SeriesProfile{Timestamp: 1, LabelIndex: i})
+		}
+		return ps
+	}
+	// Two distinct profiles replicated across the ingesters.
+	bidis := map[string]*fakeBidiClientTopFunctions{
+		"1": newFakeBidiClientTopFunctions(batch(0, 1)),
+		"2": newFakeBidiClientTopFunctions(batch(0, 1)),
+		"3": newFakeBidiClientTopFunctions(batch(0)),
+	}
+	for _, b := range bidis {
+		b.functions = []*typesv1.TopFunction{
+			{Name: "foo", Self: 1, Total: 3},
+			{Name: "bar", Self: 2, Total: 2},
+			{Name: "baz", Self: 0, Total: 3},
+		}
+	}
+
+	querier, err := New(&NewQuerierParams{
+		Cfg: Config{
+			PoolConfig: clientpool.PoolConfig{ClientCleanupPeriod: 1 * time.Millisecond},
+		},
+		IngestersRing: testhelper.NewMockRing([]ring.InstanceDesc{
+			{Addr: "1"},
+			{Addr: "2"},
+			{Addr: "3"},
+		}, 3),
+		PoolFactory: &poolFactory{f: func(addr string) (client.PoolClient, error) {
+			q := newFakeQuerier()
+			q.On("BlockMetadata", mock.Anything, mock.Anything).Once().Return(nil, endpointNotExistingErr)
+			q.On("MergeProfilesTopFunctions", mock.Anything).Once().Return(bidis[addr])
+			return q, nil
+		}},
+		Logger: log.NewLogfmtLogger(os.Stdout),
+	})
+	require.NoError(t, err)
+
+	res, err := querier.SelectTopFunctions(context.Background(), connect.NewRequest(&querierv1.SelectTopFunctionsRequest{
+		LabelSelector: `{app=~".+"}`,
+		ProfileTypeID: "memory:inuse_space:bytes:space:byte",
+		Start:         0,
+		End:           2,
+		OrderBy:       querierv1.TopFunctionsOrder_TOP_FUNCTIONS_ORDER_TOTAL,
+		Limit:         2,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, 2, bidis["1"].kept+bidis["2"].kept+bidis["3"].kept)
+	require.Equal(t, &querierv1.SelectTopFunctionsResponse{
+		Functions: []*typesv1.TopFunction{
+			{Name: "baz", Self: 0, Total: 6},
+			{Name: "foo", Self: 2, Total: 6},
+		},
+		TotalFunctions: 3,
+		Total:          6,
+	}, res.Msg)
+}
+
+func Test_SelectTopFunctions_MaxTopFunctions(t *testing.T) {
+	// A single profile replicated across the ingesters.
+	bidis := make(map[string]*fakeBidiClientTopFunctions)
+	for _, addr := range []string{"1", "2", "3"} {
+		bidis[addr] = newFakeBidiClientTopFunctions(&ingestv1.ProfileSets{
+			LabelsSets: []*typesv1.Labels{{Labels: []*typesv1.LabelPair{{Name: "app", Value: "foo"}}}},
+			Profiles:   []*ingestv1.SeriesProfile{{Timestamp: 1, LabelIndex: 0}},
+		},
+			&typesv1.TopFunction{Name: "foo", Self: 1, Total: 3},
+			&typesv1.TopFunction{Name: "bar", Self: 2, Total: 2},
+			&typesv1.TopFunction{Name: "baz", Self: 0, Total: 3},
+		)
+	}
+	querier, err := New(&NewQuerierParams{
+		Cfg: Config{
+			PoolConfig: clientpool.PoolConfig{ClientCleanupPeriod: 1 * time.Millisecond},
+		},
+		IngestersRing: testhelper.NewMockRing([]ring.InstanceDesc{
+			{Addr: "1"},
+			{Addr: "2"},
+			{Addr: "3"},
+		}, 3),
+		PoolFactory: &poolFactory{f: func(addr string) (client.PoolClient, error) {
+			q := newFakeQuerier()
+			q.On("BlockMetadata", mock.Anything, mock.Anything).Once().Return(nil, endpointNotExistingErr)
+			q.On("MergeProfilesTopFunctions", mock.Anything).Once().Return(bidis[addr])
+			return q, nil
+		}},
+		Logger: log.NewLogfmtLogger(os.Stdout),
+	})
+	require.NoError(t, err)
+	querier.limits = validation.MockLimits{MaxTopFunctionsValue: 2}
+
+	// The request has no limit, the tenant limit applies.
+	res, err := querier.SelectTopFunctions(tenant.InjectTenantID(context.Background(), "test"), connect.NewRequest(&querierv1.SelectTopFunctionsRequest{
+		LabelSelector: `{app=~".+"}`,
+		ProfileTypeID: "memory:inuse_space:bytes:space:byte",
+		Start:         0,
+		End:           2,
+		OrderBy:       querierv1.TopFunctionsOrder_TOP_FUNCTIONS_ORDER_SELF,
+		Offset:        1,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, &querierv1.SelectTopFunctionsResponse{
+		Functions:      []*typesv1.TopFunction{{Name: "foo", Self: 1, Total: 3}},
+		TotalFunctions: 3,
+		Total:          3,
+	}, res.Msg)
+}
+
+func Test_SelectLineProfile(t *testing.T) {
+	batch := func(labelIndexes ...int32) *ingestv1.ProfileSets {
+		ps := &ingestv1.ProfileSets{
+			LabelsSets: []*typesv1.Labels{
+				{Labels: []*typesv1.LabelPair{{Name: "app", Value: "foo"}}},
+				{Labels: []*typesv1.LabelPair{{Name: "app", Value: "bar"}}},
+			},
+		}
+		for _, i := range labelIndexes {
+			ps.Profiles = append(ps.Profiles, &ingestv1.SeriesProfile{Timestamp: 1, LabelIndex: i})
+		}
+		return ps
+	}
+	// Two distinct profiles replicated across the ingesters.
+	bidis := map[string]*fakeBidiClientTopFunctions{
+		"1": newFakeBidiClientTopFunctions(batch(0, 1)),
+		"2": newFakeBidiClientTopFunctions(batch(0, 1)),
+		"3": newFakeBidiClientTopFunctions(batch(0)),
+	}
+	for _, b := range bidis {
+		// The functions are filtered by the ingesters.
+		b.functions = []*typesv1.TopFunction{
+			{Name: "main", Filename: "main.go", Line: 12, Self: 1, Total: 3},
+			{Name: "main", Filename: "main.go", Line: 10, Self: 0, Total: 2},
+			{Self: 5},
+		}
+	}
+
+	querier, err := New(&NewQuerierParams{
+		Cfg: Config{
+			PoolConfig: clientpool.PoolConfig{ClientCleanupPeriod: 1 * time.Millisecond},
+		},
+		IngestersRing: testhelper.NewMockRing([]ring.InstanceDesc{
+			{Addr: "1"},
+			{Addr: "2"},
+			{Addr: "3"},
+		}, 3),
+		PoolFactory: &poolFactory{f: func(addr string) (client.PoolClient, error) {
+			q := newFakeQuerier()
+			q.On("BlockMetadata", mock.Anything, mock.Anything).Once().Return(nil, endpointNotExistingErr)
+			q.On("MergeProfilesTopFunctions", mock.Anything).Once().Return(bidis[addr])
+			return q, nil
+		}},
+		Logger: log.NewLogfmtLogger(os.Stdout),
+	})
+	require.NoError(t, err)
+
+	res, err := querier.SelectLineProfile(context.Background(), connect.NewRequest(&querierv1.SelectLineProfileRequest{
+		LabelSelector: `{app=~".+"}`,
+		ProfileTypeID: "process_cpu:cpu:nanoseconds:cpu:nanoseconds",
+		Start:         0,
+		End:           2,
+		FunctionName:  "main",
+		Filename:      "main.go",
+	}))
+	require.NoError(t, err)
+	require.Equal(t, 2, bidis["1"].kept+bidis["2"].kept+bidis["3"].kept)
+	for _, b := range bidis {
+		// Not all the replicas are necessarily queried.
+		if b.request != nil {
+			require.Equal(t, typesv1.TopFunctionsGrouping_TOP_FUNCTIONS_GROUPING_LINE, b.request.Grouping)
+			require.Equal(t, "main", b.request.Filter.GetFunctionName())
+			require.Equal(t, "main.go", b.request.Filter.GetFilename())
+		}
+	}
+	require.Equal(t, &querierv1.SelectLineProfileResponse{
+		Lines: []*querierv1.LineValues{
+			{Line: 10, Self: 0, Total: 4},
+			{Line: 12, Self: 2, Total: 6},
+		},
+		Total: 12,
+	}, res.Msg)
+
+	_, err = querier.SelectLineProfile(context.Background(), connect.NewRequest(&querierv1.SelectLineProfileRequest{
+		LabelSelector: `{app=~".+"}`,
+		ProfileTypeID: "process_cpu:cpu:nanoseconds:cpu:nanoseconds",
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func Test_selectMergeProfileValues_Limit(t *testing.T) {
+	batch := func(timestamps ...int64) *ingestv1.ProfileSets {
+		ps := &ingestv1.ProfileSets{
+			LabelsSets: []*typesv1.Labels{{Labels: []*typesv1.LabelPair{{Name: "app", Value: "foo"}}}},
+		}
+		for _, ts := range timestamps {
+			ps.Profiles = append(ps.Profiles, &ingestv1.SeriesProfile{Timestamp: ts})
+		}
+		return ps
+	}
+	responses := func() []ResponseFromReplica[clientpool.BidiClientMergeProfilesValues] {
+		// Four distinct profiles replicated across the ingesters.
+		return []ResponseFromReplica[clientpool.BidiClientMergeProfilesValues]{
+			{addr: "1", response: newFakeBidiClientProfileValues(batch(0, 1, 2, 3))},
+			{addr: "2", response: newFakeBidiClientProfileValues(batch(0, 1, 2, 3))},
+			{addr: "3", response: newFakeBidiClientProfileValues(batch(0, 2))},
+		}
+	}
+
+	values, err := selectMergeProfileValues(context.Background(), responses(), newProfilesLimit(4))
+	require.NoError(t, err)
+	var n int
+	for _, v := range values {
+		n += len(v.Profiles)
+	}
+	require.Equal(t, 4, n)
+
+	_, err = selectMergeProfileValues(context.Background(), responses(), newProfilesLimit(3))
+	require.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+}
+
+func Test_SelectHeatmap(t *testing.T) {
+	batch := func(timestamps ...int64) *ingestv1.ProfileSets {
+		ps := &ingestv1.ProfileSets{
+			LabelsSets: []*typesv1.Labels{
+				{Labels: []*typesv1.LabelPair{{Name: "app", Value: "foo"}}},
+				{Labels: []*typesv1.LabelPair{{Name: "app", Value: "bar"}}},
+			},
+		}
+		for _, ts := range timestamps {
+			ps.Profiles = append(ps.Profiles, &ingestv1.SeriesProfile{Timestamp: ts, LabelIndex: int32(ts % 2)})
+		}
+		return ps
+	}
+	// Four distinct profiles replicated across the ingesters.
+	bidis := map[string]*fakeBidiClientProfileValues{
+		"1": newFakeBidiClientProfileValues(batch(0, 1, 2, 3)),
+		"2": newFakeBidiClientProfileValues(batch(0, 1, 2, 3)),
+		"3": newFakeBidiClientProfileValues(batch(0, 2)),
+	}
+
+	querier, err := New(&NewQuerierParams{
+		Cfg: Config{
+			PoolConfig: clientpool.PoolConfig{ClientCleanupPeriod: 1 * time.Millisecond},
+		},
+		IngestersRing: testhelper.NewMockRing([]ring.InstanceDesc{
+			{Addr: "1"},
+			{Addr: "2"},
+			{Addr: "3"},
+		}, 3),
+		PoolFactory: &poolFactory{f: func(addr string) (client.PoolClient, error) {
+			q := newFakeQuerier()
+			q.On("BlockMetadata", mock.Anything, mock.Anything).Once().Return(nil, endpointNotExistingErr)
+			q.On("MergeProfilesValues", mock.Anything).Once().Return(bidis[addr])
+			return q, nil
+		}},
+		Logger: log.NewLogfmtLogger(os.Stdout),
+	})
+	require.NoError(t, err)
+
+	res, err := querier.SelectHeatmap(context.Background(), connect.NewRequest(&querierv1.SelectHeatmapRequest{
+		LabelSelector: `{app=~".+"}`,
+		ProfileTypeID: "memory:inuse_space:bytes:space:byte",
+		Start:         0,
+		End:           4,
+		TimeBuckets:   2,
+		ValueBuckets:  2,
+		Cell:          &querierv1.HeatmapCell{TimeBucket: 1, ValueBucket: 1},
+		Limit:         1,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, 4, bidis["1"].kept+bidis["2"].kept+bidis["3"].kept)
+	require.Equal(t, &querierv1.SelectHeatmapResponse{
+		Heatmap: &querierv1.Heatmap{
+			Start:        0,
+			End:          4,
+			MinValue:     0,
+			MaxValue:     30,
+			TimeBuckets:  2,
+			ValueBuckets: 2,
+			Columns: []*querierv1.HeatmapColumn{
+				{Counts: []int64{2, 0}},
+				{Counts: []int64{0, 2}},
+			},
+			MinDepth: 2,
+			MaxDepth: 2,
+		},
+		Profiles: []*querierv1.ProfileInfo{{
+			Id:        "profile-2",
+			Timestamp: 2,
+			Labels:    []*typesv1.LabelPair{{Name: "app", Value: "foo"}},
+			Value:     20,
+		}},
+	}, res.Msg)
+
+	_, err = querier.SelectHeatmap(context.Background(), connect.NewRequest(&querierv1.SelectHeatmapRequest{
+		TimeBuckets: 2,
+		Cell:        &querierv1.HeatmapCell{TimeBucket: 2},
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func Test_SelectProfiles(t *testing.T) {
+	batch := func(timestamps ...int64) *ingestv1.ProfileSets {
+		ps := &ingestv1.ProfileSets{
+			LabelsSets: []*typesv1.Labels{
+				{Labels: []*typesv1.LabelPair{{Name: "app", Value: "foo"}}},
+				{Labels: []*typesv1.LabelPair{{Name: "app", Value: "bar"}}},
+			},
+		}
+		for _, ts := range timestamps {
+			ps.Profiles = append(ps.Profiles, &ingestv1.SeriesProfile{Timestamp: ts, LabelIndex: int32(ts % 2)})
+		}
+		return ps
+	}
+	bidis := map[string]*fakeBidiClientProfileValues{
+		"1": newFakeBidiClientProfileValues(batch(0, 1, 2)),
+		"2": newFakeBidiClientProfileValues(batch(0, 1, 2)),
+		"3": newFakeBidiClientProfileValues(batch(1)),
+	}
+
+	querier, err := New(&NewQuerierParams{
+		Cfg: Config{
+			PoolConfig: clientpool.PoolConfig{ClientCleanupPeriod: 1 * time.Millisecond},
+		},
+		IngestersRing: testhelper.NewMockRing([]ring.InstanceDesc{
+			{Addr: "1"},
+			{Addr: "2"},
+			{Addr: "3"},
+		}, 3),
+		PoolFactory: &poolFactory{f: func(addr string) (client.PoolClient, error) {
+			q := newFakeQuerier()
+			q.On("BlockMetadata", mock.Anything, mock.Anything).Once().Return(nil, endpointNotExistingErr)
+			q.On("MergeProfilesValues", mock.Anything).Once().Return(bidis[addr])
+			return q, nil
+		}},
+		Logger: log.NewLogfmtLogger(os.Stdout),
+	})
+	require.NoError(t, err)
+
+	res, err := querier.SelectProfiles(context.Background(), connect.NewRequest(&querierv1.SelectProfilesRequest{
+		LabelSelector: `{app=~".+"}`,
+		ProfileTypeID: "memory:inuse_space:bytes:space:byte",
+		Start:         0,
+		End:           3,
+		Limit:         2,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, &querierv1.SelectProfilesResponse{
+		Profiles: []*querierv1.ProfileInfo{
+			{
+				Id:        "profile-2",
+				Timestamp: 2,
+				Labels:    []*typesv1.LabelPair{{Name: "app", Value: "foo"}},
+				Value:     20,
+			},
+			{
+				Id:        "profile-1",
+				Timestamp: 1,
+				Labels:    []*typesv1.LabelPair{{Name: "app", Value: "bar"}},
+				Value:     10,
+			},
+		},
+		Total: 3,
+	}, res.Msg)
+}
+
+func Test_GetProfileByID(t *testing.T) {
+	const id = "8d5b7c3e-2f0b-4c55-9d6e-1f8a4b2c9e10"
+	profile := &profilev1.Profile{TimeNanos: 1}
+	querier, err := New(&NewQuerierParams{
+		Cfg: Config{
+			PoolConfig: clientpool.PoolConfig{ClientCleanupPeriod: 1 * time.Millisecond},
+		},
+		IngestersRing: testhelper.NewMockRing([]ring.InstanceDesc{
+			{Addr: "1"},
+			{Addr: "2"},
+			{Addr: "3"},
+		}, 3),
+		PoolFactory: &poolFactory{f: func(addr string) (client.PoolClient, error) {
+			q := newFakeQuerier()
+			// The profile is not replicated to the first ingester.
+			res := &ingestv1.GetProfileByIDResponse{}
+			if addr != "1" {
+				res.Profile = profile
+			}
+			q.On("GetProfileByID", mock.Anything, mock.MatchedBy(func(req *connect.Request[ingestv1.GetProfileByIDRequest]) bool {
+				return req.Msg.Id == id && req.Msg.Request.LabelSelector == "{}"
+			})).Return(connect.NewResponse(res), nil)
+			return q, nil
+		}},
+		Logger: log.NewLogfmtLogger(os.Stdout),
+	})
+	require.NoError(t, err)
+
+	res, err := querier.GetProfileByID(context.Background(), connect.NewRequest(&querierv1.GetProfileByIDRequest{
+		ProfileTypeID: "memory:inuse_space:bytes:space:byte",
+		Id:            id,
+		Start:         0,
+		End:           2,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, profile, res.Msg)
+
+	_, err = querier.GetProfileByID(context.Background(), connect.NewRequest(&querierv1.GetProfileByIDRequest{
+		ProfileTypeID: "memory:inuse_space:bytes:space:byte",
+		Id:            "invalid",
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
 func TestSelectSeries(t *testing.T) {
 	// now := time.Now().UnixMilli()
 	for _, tc := range []struct {
@@ -700,6 +1119,22 @@ func (f *fakeQuerierIngester) ProfileTypes(ctx context.Context, req *connect.Req
 	return res, err
 }
 
+func (f *fakeQuerierIngester) GetProfileByID(ctx context.Context, req *connect.Request[ingestv1.GetProfileByIDRequest]) (*connect.Response[ingestv1.GetProfileByIDResponse], error) {
+	var (
+		args = f.Called(ctx, req)
+		res  *connect.Response[ingestv1.GetProfileByIDResponse]
+		err  error
+	)
+	if args[0] != nil {
+		res = args[0].(*connect.Response[ingestv1.GetProfileByIDResponse])
+	}
+	if args[1] != nil {
+		err = args.Get(1).(error)
+	}
+
+	return res, err
+}
+
 func (f *fakeQuerierIngester) Series(ctx context.Context, req *connect.Request[ingestv1.SeriesRequest]) (*connect.Response[ingestv1.SeriesResponse], error) {
 	var (
 		args = f.Called(ctx, req)
@@ -901,6 +1336,114 @@ func requireFakeMergeProfilesPprof(t *testing.T, n int64, r *profilev1.Profile)
 	require.Equal(t, x, r)
 }
 
+type fakeBidiClientTopFunctions struct {
+	profiles  chan *ingestv1.ProfileSets
+	functions []*typesv1.TopFunction
+	request   *ingestv1.MergeProfilesTopFunctionsRequest
+	kept      int
+}
+
+func newFakeBidiClientTopFunctions(batch *ingestv1.ProfileSets, functions ...*typesv1.TopFunction) *fakeBidiClientTopFunctions {
+	res := &fakeBidiClientTopFunctions{
+		profiles:  make(chan *ingestv1.ProfileSets, 1),
+		functions: functions,
+	}
+	res.profiles <- batch
+	return res
+}
+
+func (f *fakeBidiClientTopFunctions) Send(in *ingestv1.MergeProfilesTopFunctionsRequest) error {
+	if in.Request != nil {
+		f.request = in
+		return nil
+	}
+	for _, b := range in.Profiles {
+		if b {
+			f.kept++
+		}
+	}
+	close(f.profiles)
+	return nil
+}
+
+func (f *fakeBidiClientTopFunctions) Receive() (*ingestv1.MergeProfilesTopFunctionsResponse, error) {
+	profiles := <-f.profiles
+	if profiles == nil {
+		// Each kept profile contributes to the result.
+		functions := make([]*typesv1.TopFunction, len(f.functions))
+		for i, fn := range f.functions {
+			functions[i] = fn.CloneVT()
+			functions[i].Self *= int64(f.kept)
+			functions[i].Total *= int64(f.kept)
+		}
+		return &ingestv1.MergeProfilesTopFunctionsResponse{
+			Result: &ingestv1.MergeProfilesTopFunctionsResult{Functions: functions},
+		}, nil
+	}
+	return &ingestv1.MergeProfilesTopFunctionsResponse{
+		SelectedProfiles: profiles,
+	}, nil
+}
+func (f *fakeBidiClientTopFunctions) CloseRequest() error  { return nil }
+func (f *fakeBidiClientTopFunctions) CloseResponse() error { return nil }
+
+type fakeBidiClientProfileValues struct {
+	profiles chan *ingestv1.ProfileSets
+	batch    *ingestv1.ProfileSets
+	keep     []bool
+	kept     int
+}
+
+func newFakeBidiClientProfileValues(batch *ingestv1.ProfileSets) *fakeBidiClientProfileValues {
+	res := &fakeBidiClientProfileValues{
+		profiles: make(chan *ingestv1.ProfileSets, 1),
+		batch:    batch,
+	}
+	res.profiles <- batch
+	return res
+}
+
+func (f *fakeBidiClientProfileValues) Send(in *ingestv1.MergeProfilesValuesRequest) error {
+	if in.Request != nil {
+		return nil
+	}
+	f.keep = in.Profiles
+	for _, b := range in.Profiles {
+		if b {
+			f.kept++
+		}
+	}
+	close(f.profiles)
+	return nil
+}
+
+func (f *fakeBidiClientProfileValues) Receive() (*ingestv1.MergeProfilesValuesResponse, error) {
+	profiles := <-f.profiles
+	if profiles == nil {
+		// The value of a kept profile is derived from its timestamp.
+		var result []*ingestv1.ProfileValues
+		for i, p := range f.batch.Profiles {
+			if !f.keep[i] {
+				continue
+			}
+			result = append(result, &ingestv1.ProfileValues{
+				Labels: f.batch.LabelsSets[p.LabelIndex].Labels,
+				Profiles: []*ingestv1.ProfileValue{{
+					Id:        fmt.Sprintf("profile-%d", p.Timestamp),
+					Timestamp: p.Timestamp,
+					Value:     p.Timestamp * 10,
+				}},
+			})
+		}
+		return &ingestv1.MergeProfilesValuesResponse{Result: result}, nil
+	}
+	return &ingestv1.MergeProfilesValuesResponse{
+		SelectedProfiles: profiles,
+	}, nil
+}
+func (f *fakeBidiClientProfileValues) CloseRequest() error  { return nil }
+func (f *fakeBidiClientProfileValues) CloseResponse() error { return nil }
+
 type fakeBidiClientSeries struct {
 	profiles chan *ingestv1.ProfileSets
 	batches  []*ingestv1.ProfileSets
@@ -969,6 +1512,30 @@ func (f *fakeQuerierIngester) MergeSpanProfile(ctx context.Context) clientpool.B
 	return res
 }
 
+func (f *fakeQuerierIngester) MergeProfilesTopFunctions(ctx context.Context) clientpool.BidiClientMergeProfilesTopFunctions {
+	var (
+		args = f.Called(ctx)
+		res  clientpool.BidiClientMergeProfilesTopFunctions
+	)
+	if args[0] != nil {
+		res = args[0].(clientpool.BidiClientMergeProfilesTopFunctions)
+	}
+
+	return res
+}
+
+func (f *fakeQuerierIngester) MergeProfilesValues(ctx context.Context) clientpool.BidiClientMergeProfilesValues {
+	var (
+		args = f.Called(ctx)
+		res  clientpool.BidiClientMergeProfilesValues
+	)
+	if args[0] != nil {
+		res = args[0].(clientpool.BidiClientMergeProfilesValues)
+	}
+
+	return res
+}
+
 func (f *fakeQuerierIngester) MergeProfilesStacktraces(ctx context.Context) clientpool.BidiClientMergeProfilesStacktraces {
 	var (
 		args = f.Called(ctx)
@@ -1146,6 +1713,62 @@ func Test_RangeSeriesAvg(t *testing.T) {
 	}
 }
 
+func Test_RangeSeriesAggregation(t *testing.T) {
+	in := []ProfileValue{
+		{Ts: 1, Value: 4},
+		{Ts: 1, Value: 1},
+		{Ts: 1, Value: 3},
+		{Ts: 1, Value: 2},
+		{Ts: 2, Value: 5},
+		{Ts: 4, Value: 1},
+		{Ts: 4, Value: 100},
+	}
+	for _, tc := range []struct {
+		aggregation typesv1.TimeSeriesAggregationType
+		out         []*typesv1.Point
+	}{
+		{
+			aggregation: typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_MIN,
+			out:         []*typesv1.Point{{Timestamp: 1, Value: 1}, {Timestamp: 2, Value: 5}, {Timestamp: 4, Value: 1}},
+		},
+		{
+			aggregation: typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_MAX,
+			out:         []*typesv1.Point{{Timestamp: 1, Value: 4}, {Timestamp: 2, Value: 5}, {Timestamp: 4, Value: 100}},
+		},
+		{
+			aggregation: typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_COUNT,
+			out:         []*typesv1.Point{{Timestamp: 1, Value: 4}, {Timestamp: 2, Value: 1}, {Timestamp: 4, Value: 2}},
+		},
+		{
+			aggregation: typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_P50,
+			out:         []*typesv1.Point{{Timestamp: 1, Value: 2.5}, {Timestamp: 2, Value: 5}, {Timestamp: 4, Value: 50.5}},
+		},
+		{
+			aggregation: typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_P90,
+			out:         []*typesv1.Point{{Timestamp: 1, Value: 3.7}, {Timestamp: 2, Value: 5}, {Timestamp: 4, Value: 90.1}},
+		},
+	} {
+		tc := tc
+		t.Run(tc.aggregation.String(), func(t *testing.T) {
+			out := rangeSeries(iter.NewSliceIterator(in), 1, 5, 1, &tc.aggregation)
+			require.Len(t, out, 1)
+			require.Len(t, out[0].Points, len(tc.out))
+			for i, p := range tc.out {
+				assert.Equal(t, p.Timestamp, out[0].Points[i].Timestamp)
+				assert.InDelta(t, p.Value, out[0].Points[i].Value, 1e-9)
+			}
+		})
+	}
+}
+
+func Test_quantile(t *testing.T) {
+	assert.Equal(t, float64(0), quantile(0.5, nil))
+	assert.Equal(t, float64(7), quantile(0.99, []float64{7}))
+	assert.Equal(t, float64(1), quantile(0, []float64{3, 1, 2}))
+	assert.Equal(t, float64(3), quantile(1, []float64{3, 1, 2}))
+	assert.Equal(t, float64(2), quantile(0.5, []float64{3, 1, 2}))
+}
+
 func Test_splitQueryToStores(t *testing.T) {
 	for _, tc := range []struct {
 		name            string
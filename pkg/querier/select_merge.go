@@ -43,14 +43,18 @@ type Request interface {
 	*ingestv1.MergeProfilesStacktracesRequest |
 		*ingestv1.MergeProfilesLabelsRequest |
 		*ingestv1.MergeProfilesPprofRequest |
-		*ingestv1.MergeSpanProfileRequest
+		*ingestv1.MergeSpanProfileRequest |
+		*ingestv1.MergeProfilesTopFunctionsRequest |
+		*ingestv1.MergeProfilesValuesRequest
 }
 
 type Response interface {
 	*ingestv1.MergeProfilesStacktracesResponse |
 		*ingestv1.MergeProfilesLabelsResponse |
 		*ingestv1.MergeProfilesPprofResponse |
-		*ingestv1.MergeSpanProfileResponse
+		*ingestv1.MergeSpanProfileResponse |
+		*ingestv1.MergeProfilesTopFunctionsResponse |
+		*ingestv1.MergeProfilesValuesResponse
 }
 
 type MergeResult[R any] interface {
@@ -66,6 +70,8 @@ type keepResponse struct {
 	*ingestv1.MergeProfilesLabelsRequest
 	*ingestv1.MergeProfilesPprofRequest
 	*ingestv1.MergeSpanProfileRequest
+	*ingestv1.MergeProfilesTopFunctionsRequest
+	*ingestv1.MergeProfilesValuesRequest
 }
 type mergeIterator[R any, Req Request, Res Response] struct {
 	ctx  context.Context
@@ -99,10 +105,12 @@ func NewMergeIterator[
 		currentProfile: &ProfileWithLabels{IngesterAddr: r.addr},
 		currIdx:        -1,
 		response: keepResponse{
-			MergeProfilesStacktracesRequest: &ingestv1.MergeProfilesStacktracesRequest{},
-			MergeProfilesLabelsRequest:      &ingestv1.MergeProfilesLabelsRequest{},
-			MergeProfilesPprofRequest:       &ingestv1.MergeProfilesPprofRequest{},
-			MergeSpanProfileRequest:         &ingestv1.MergeSpanProfileRequest{},
+			MergeProfilesStacktracesRequest:  &ingestv1.MergeProfilesStacktracesRequest{},
+			MergeProfilesLabelsRequest:       &ingestv1.MergeProfilesLabelsRequest{},
+			MergeProfilesPprofRequest:        &ingestv1.MergeProfilesPprofRequest{},
+			MergeSpanProfileRequest:          &ingestv1.MergeSpanProfileRequest{},
+			MergeProfilesTopFunctionsRequest: &ingestv1.MergeProfilesTopFunctionsRequest{},
+			MergeProfilesValuesRequest:       &ingestv1.MergeProfilesValuesRequest{},
 		},
 	}
 	it.fetchBatch()
@@ -129,6 +137,12 @@ func (s *mergeIterator[R, Req, Res]) Next() bool {
 			case BidiClientMerge[*ingestv1.MergeSpanProfileRequest, *ingestv1.MergeSpanProfileResponse]:
 				s.response.MergeSpanProfileRequest.Profiles = s.keep
 				err = bidi.Send(s.response.MergeSpanProfileRequest)
+			case BidiClientMerge[*ingestv1.MergeProfilesTopFunctionsRequest, *ingestv1.MergeProfilesTopFunctionsResponse]:
+				s.response.MergeProfilesTopFunctionsRequest.Profiles = s.keep
+				err = bidi.Send(s.response.MergeProfilesTopFunctionsRequest)
+			case BidiClientMerge[*ingestv1.MergeProfilesValuesRequest, *ingestv1.MergeProfilesValuesResponse]:
+				s.response.MergeProfilesValuesRequest.Profiles = s.keep
+				err = bidi.Send(s.response.MergeProfilesValuesRequest)
 			}
 			if err != nil {
 				s.err = err
@@ -190,6 +204,20 @@ func (s *mergeIterator[R, Req, Res]) fetchBatch() {
 			return
 		}
 		selectedProfiles = res.SelectedProfiles
+	case BidiClientMerge[*ingestv1.MergeProfilesTopFunctionsRequest, *ingestv1.MergeProfilesTopFunctionsResponse]:
+		res, err := bidi.Receive()
+		if err != nil {
+			s.err = err
+			return
+		}
+		selectedProfiles = res.SelectedProfiles
+	case BidiClientMerge[*ingestv1.MergeProfilesValuesRequest, *ingestv1.MergeProfilesValuesResponse]:
+		res, err := bidi.Receive()
+		if err != nil {
+			s.err = err
+			return
+		}
+		selectedProfiles = res.SelectedProfiles
 	}
 	s.curr = selectedProfiles
 	if s.curr == nil {
@@ -229,6 +257,10 @@ func (s *mergeIterator[R, Req, Res]) Result() (R, error) {
 		return any(result.Result).(R), nil
 	case *ingestv1.MergeSpanProfileResponse:
 		return any(result.Result).(R), nil
+	case *ingestv1.MergeProfilesTopFunctionsResponse:
+		return any(result.Result).(R), nil
+	case *ingestv1.MergeProfilesValuesResponse:
+		return any(result.Result).(R), nil
 	default:
 		return *new(R), fmt.Errorf("unexpected response type %T", result)
 	}
@@ -249,6 +281,12 @@ func (s *mergeIterator[R, Req, Res]) Close() error {
 
 // skipDuplicates iterates through the iterator and skip duplicates.
 func skipDuplicates(ctx context.Context, its []MergeIterator) error {
+	return skipDuplicatesWithLimit(ctx, its, nil)
+}
+
+// skipDuplicatesWithLimit is identical to skipDuplicates, but it aborts
+// as soon as the number of the kept profiles exceeds the limit.
+func skipDuplicatesWithLimit(ctx context.Context, its []MergeIterator, limit *profilesLimit) error {
 	span, _ := opentracing.StartSpanFromContext(ctx, "skipDuplicates")
 	defer span.Finish()
 	var errors multierror.MultiError
@@ -284,6 +322,9 @@ func skipDuplicates(ctx context.Context, its []MergeIterator) error {
 			fingerprint = profile.Labels.Hash()
 		}
 		if fingerprints.keep(profile.Timestamp, fingerprint) {
+			if err := limit.add(1); err != nil {
+				return err
+			}
 			next.Keep()
 			continue
 		}
@@ -463,6 +504,99 @@ func selectMergePprofProfile(ctx context.Context, ty *typesv1.ProfileType, respo
 	return p, nil
 }
 
+// selectMergeTopFunctions selects the profile from each ingester by deduping them and request merges of the function values.
+func selectMergeTopFunctions(ctx context.Context, responses []ResponseFromReplica[clientpool.BidiClientMergeProfilesTopFunctions]) ([]*typesv1.TopFunction, error) {
+	mergeResults := make([]MergeResult[*ingestv1.MergeProfilesTopFunctionsResult], len(responses))
+	iters := make([]MergeIterator, len(responses))
+	var wg sync.WaitGroup
+	for i, resp := range responses {
+		wg.Add(1)
+		go func(i int, resp ResponseFromReplica[clientpool.BidiClientMergeProfilesTopFunctions]) {
+			defer wg.Done()
+			it := NewMergeIterator[*ingestv1.MergeProfilesTopFunctionsResult](
+				ctx, ResponseFromReplica[BidiClientMerge[*ingestv1.MergeProfilesTopFunctionsRequest, *ingestv1.MergeProfilesTopFunctionsResponse]]{
+					addr:     resp.addr,
+					response: resp.response,
+				})
+			iters[i] = it
+			mergeResults[i] = it
+		}(i, resp)
+	}
+	wg.Wait()
+
+	if err := skipDuplicates(ctx, iters); err != nil {
+		return nil, err
+	}
+
+	// Collects the results in parallel.
+	m := phlaremodel.NewTopFunctionsMerger()
+	g, _ := errgroup.WithContext(ctx)
+	for _, iter := range mergeResults {
+		iter := iter
+		g.Go(util.RecoverPanic(func() error {
+			result, err := iter.Result()
+			if err != nil || result == nil {
+				return err
+			}
+			m.MergeTopFunctions(result.Functions)
+			return nil
+		}))
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return m.TopFunctions(), nil
+}
+
+// selectMergeProfileValues selects the profile from each ingester by deduping them and request the values of the selected profiles.
+// The query is aborted once the total number of the selected profiles exceeds the limit.
+func selectMergeProfileValues(ctx context.Context, responses []ResponseFromReplica[clientpool.BidiClientMergeProfilesValues], limit *profilesLimit) ([]*ingestv1.ProfileValues, error) {
+	mergeResults := make([]MergeResult[[]*ingestv1.ProfileValues], len(responses))
+	iters := make([]MergeIterator, len(responses))
+	var wg sync.WaitGroup
+	for i, resp := range responses {
+		wg.Add(1)
+		go func(i int, resp ResponseFromReplica[clientpool.BidiClientMergeProfilesValues]) {
+			defer wg.Done()
+			it := NewMergeIterator[[]*ingestv1.ProfileValues](
+				ctx, ResponseFromReplica[BidiClientMerge[*ingestv1.MergeProfilesValuesRequest, *ingestv1.MergeProfilesValuesResponse]]{
+					addr:     resp.addr,
+					response: resp.response,
+				})
+			iters[i] = it
+			mergeResults[i] = it
+		}(i, resp)
+	}
+	wg.Wait()
+
+	if err := skipDuplicatesWithLimit(ctx, iters, limit); err != nil {
+		return nil, err
+	}
+
+	// Collects the results in parallel. As every profile is
+	// only kept once, the results can just be concatenated.
+	var results []*ingestv1.ProfileValues
+	s := lo.Synchronize()
+	g, _ := errgroup.WithContext(ctx)
+	for _, iter := range mergeResults {
+		iter := iter
+		g.Go(util.RecoverPanic(func() error {
+			result, err := iter.Result()
+			if err != nil || result == nil {
+				return err
+			}
+			s.Do(func() {
+				results = append(results, result...)
+			})
+			return nil
+		}))
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
 type ProfileValue struct {
 	Ts         int64
 	Lbs        []*typesv1.LabelPair
@@ -479,7 +613,7 @@ func (p ProfileValue) Timestamp() model.Time {
 }
 
 // selectMergeSeries selects the  profile from each ingester by deduping them and request merges of total values.
-func selectMergeSeries(ctx context.Context, aggregation *typesv1.TimeSeriesAggregationType, responses []ResponseFromReplica[clientpool.BidiClientMergeProfilesLabels]) (iter.Iterator[ProfileValue], error) {
+func selectMergeSeries(ctx context.Context, aggregation *typesv1.TimeSeriesAggregationType, responses []ResponseFromReplica[clientpool.BidiClientMergeProfilesLabels]) ([]*typesv1.Series, error) {
 	mergeResults := make([]MergeResult[[]*typesv1.Series], len(responses))
 	iters := make([]MergeIterator, len(responses))
 	var wg sync.WaitGroup
@@ -522,14 +656,18 @@ func selectMergeSeries(ctx context.Context, aggregation *typesv1.TimeSeriesAggre
 	if err := g.Wait(); err != nil {
 		return nil, err
 	}
-	var series = phlaremodel.MergeSeries(aggregation, results...)
+	return phlaremodel.MergeSeries(aggregation, results...), nil
+}
 
+// newSeriesSetIterator returns an iterator over the points
+// of the series given, ordered by timestamp.
+func newSeriesSetIterator(series []*typesv1.Series) iter.Iterator[ProfileValue] {
 	seriesIters := make([]iter.Iterator[ProfileValue], 0, len(series))
 	for _, s := range series {
 		s := s
 		seriesIters = append(seriesIters, newSeriesIterator(s.Labels, s.Points))
 	}
-	return iter.NewMergeIterator(ProfileValue{Ts: math.MaxInt64}, false, seriesIters...), nil
+	return iter.NewMergeIterator(ProfileValue{Ts: math.MaxInt64}, false, seriesIters...)
 }
 
 // selectMergeSpanProfile selects the  profile from each ingester by deduping them and
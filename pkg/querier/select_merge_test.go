@@ -200,7 +200,7 @@ func TestSelectMergeByLabels(t *testing.T) {
 		{Ts: 5, Labels: &typesv1.Labels{Labels: foobarlabels}},
 		{Ts: 6, Labels: &typesv1.Labels{Labels: foobarlabels}},
 	})
-	values, err := iter.Slice(res)
+	values, err := iter.Slice(newSeriesSetIterator(res))
 	require.NoError(t, err)
 	require.Equal(t, []ProfileValue{
 		{Ts: 1, Value: 1.0, Lbs: foobarlabels, LabelsHash: foobarlabels.Hash()},
@@ -0,0 +1,87 @@
+package querier
+
+import (
+	"math"
+	"sort"
+
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+)
+
+// limitSeries keeps the req.Limit series of the result that rank the highest
+// according to req.Order, and merges the rest into a single series. The merged
+// series is only labeled with the reserved phlaremodel.LabelNameOtherSeries
+// label, which can't be confused with any of the group by label values.
+//
+// Points of the merged series are aggregated from the source series,
+// the result has been built from, rather than from the result points:
+// this way the merged series is exact regardless of the aggregation.
+func limitSeries(req *querierv1.SelectSeriesRequest, stepMs int64, result, source []*typesv1.Series) []*typesv1.Series {
+	limit := int(req.GetLimit())
+	if limit <= 0 || len(result) <= limit {
+		return result
+	}
+
+	rank := seriesRankFunc(req.GetOrder())
+	ranks := make(map[*typesv1.Series]float64, len(result))
+	for _, s := range result {
+		ranks[s] = rank(s.Points)
+	}
+	ranked := make([]*typesv1.Series, len(result))
+	copy(ranked, result)
+	sort.Slice(ranked, func(i, j int) bool {
+		if ri, rj := ranks[ranked[i]], ranks[ranked[j]]; ri != rj {
+			return ri > rj
+		}
+		return phlaremodel.CompareLabelPairs(ranked[i].Labels, ranked[j].Labels) < 0
+	})
+	top := make(map[uint64]struct{}, limit)
+	for _, s := range ranked[:limit] {
+		top[phlaremodel.Labels(s.Labels).Hash()] = struct{}{}
+	}
+
+	otherLabels := phlaremodel.LabelsFromStrings(phlaremodel.LabelNameOtherSeries, phlaremodel.LabelOtherSeries)
+	other := make([]*typesv1.Series, 0, len(source))
+	for _, s := range source {
+		if _, ok := top[phlaremodel.Labels(s.Labels).Hash()]; !ok {
+			other = append(other, &typesv1.Series{Labels: otherLabels, Points: s.Points})
+		}
+	}
+
+	// The result order is preserved, the other series goes last.
+	limited := make([]*typesv1.Series, 0, limit+1)
+	for _, s := range result {
+		if _, ok := top[phlaremodel.Labels(s.Labels).Hash()]; ok {
+			limited = append(limited, s)
+		}
+	}
+	return append(limited, rangeSeries(newSeriesSetIterator(other), req.Start, req.End, stepMs, req.Aggregation)...)
+}
+
+func seriesRankFunc(order typesv1.SeriesOrder) func([]*typesv1.Point) float64 {
+	switch order {
+	case typesv1.SeriesOrder_SERIES_ORDER_MAX:
+		return func(points []*typesv1.Point) float64 {
+			m := math.Inf(-1)
+			for _, p := range points {
+				m = math.Max(m, p.Value)
+			}
+			return m
+		}
+	case typesv1.SeriesOrder_SERIES_ORDER_LAST:
+		return func(points []*typesv1.Point) float64 {
+			if len(points) == 0 {
+				return math.Inf(-1)
+			}
+			return points[len(points)-1].Value
+		}
+	}
+	return func(points []*typesv1.Point) float64 {
+		var total float64
+		for _, p := range points {
+			total += p.Value
+		}
+		return total
+	}
+}
@@ -0,0 +1,90 @@
+package querier
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/testhelper"
+)
+
+func Test_limitSeries(t *testing.T) {
+	podA := phlaremodel.LabelsFromStrings("pod", "a")
+	podB := phlaremodel.LabelsFromStrings("pod", "b")
+	// A pod named "other" must not be confused with the other series.
+	podC := phlaremodel.LabelsFromStrings("pod", "other")
+	other := phlaremodel.LabelsFromStrings(phlaremodel.LabelNameOtherSeries, phlaremodel.LabelOtherSeries)
+	// Points of individual profiles: pod a has two profiles in the first step.
+	// Pod a has the highest total, b has the max value, and c the last one.
+	source := []*typesv1.Series{
+		{Labels: podA, Points: []*typesv1.Point{{Timestamp: 1, Value: 1}, {Timestamp: 1, Value: 1}, {Timestamp: 2, Value: 6}}},
+		{Labels: podB, Points: []*typesv1.Point{{Timestamp: 1, Value: 7}, {Timestamp: 2, Value: 0.5}}},
+		{Labels: podC, Points: []*typesv1.Point{{Timestamp: 1, Value: 1}, {Timestamp: 2, Value: 6.5}}},
+	}
+
+	for _, tc := range []struct {
+		name        string
+		order       typesv1.SeriesOrder
+		aggregation typesv1.TimeSeriesAggregationType
+		expected    []*typesv1.Series
+	}{
+		{
+			name:        "total",
+			order:       typesv1.SeriesOrder_SERIES_ORDER_TOTAL,
+			aggregation: typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_SUM,
+			expected: []*typesv1.Series{
+				{Labels: podA, Points: []*typesv1.Point{{Timestamp: 1, Value: 2}, {Timestamp: 2, Value: 6}}},
+				{Labels: other, Points: []*typesv1.Point{{Timestamp: 1, Value: 8}, {Timestamp: 2, Value: 7}}},
+			},
+		},
+		{
+			name:        "max",
+			order:       typesv1.SeriesOrder_SERIES_ORDER_MAX,
+			aggregation: typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_SUM,
+			expected: []*typesv1.Series{
+				{Labels: podB, Points: []*typesv1.Point{{Timestamp: 1, Value: 7}, {Timestamp: 2, Value: 0.5}}},
+				{Labels: other, Points: []*typesv1.Point{{Timestamp: 1, Value: 3}, {Timestamp: 2, Value: 12.5}}},
+			},
+		},
+		{
+			name:        "last",
+			order:       typesv1.SeriesOrder_SERIES_ORDER_LAST,
+			aggregation: typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_SUM,
+			expected: []*typesv1.Series{
+				{Labels: podC, Points: []*typesv1.Point{{Timestamp: 1, Value: 1}, {Timestamp: 2, Value: 6.5}}},
+				{Labels: other, Points: []*typesv1.Point{{Timestamp: 1, Value: 9}, {Timestamp: 2, Value: 6.5}}},
+			},
+		},
+		{
+			// The other series is aggregated from individual profiles.
+			name:        "average",
+			order:       typesv1.SeriesOrder_SERIES_ORDER_MAX,
+			aggregation: typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_AVERAGE,
+			expected: []*typesv1.Series{
+				{Labels: podB, Points: []*typesv1.Point{{Timestamp: 1, Value: 7}, {Timestamp: 2, Value: 0.5}}},
+				{Labels: other, Points: []*typesv1.Point{{Timestamp: 1, Value: 1}, {Timestamp: 2, Value: 6.25}}},
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			req := &querierv1.SelectSeriesRequest{
+				Start:       1,
+				End:         2,
+				GroupBy:     []string{"pod"},
+				Aggregation: &tc.aggregation,
+				Order:       &tc.order,
+			}
+			result := rangeSeries(newSeriesSetIterator(source), req.Start, req.End, 1, req.Aggregation)
+			require.Len(t, result, 3)
+			testhelper.EqualProto(t, result, limitSeries(req, 1, result, source))
+
+			limit := int64(1)
+			req.Limit = &limit
+			testhelper.EqualProto(t, tc.expected, limitSeries(req, 1, result, source))
+		})
+	}
+}
@@ -0,0 +1,668 @@
+package querier
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"sort"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/grafana/dskit/tenant"
+	"github.com/grafana/dskit/user"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/samber/lo"
+	"golang.org/x/sync/errgroup"
+
+	googlev1 "github.com/grafana/pyroscope/api/gen/proto/go/google/v1"
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/pprof"
+	"github.com/grafana/pyroscope/pkg/validation"
+)
+
+type TenantFederationConfig struct {
+	Enabled       bool `yaml:"enabled"`
+	MaxConcurrent int  `yaml:"max_concurrent" category:"advanced"`
+}
+
+func (cfg *TenantFederationConfig) RegisterFlags(fs *flag.FlagSet) {
+	fs.BoolVar(&cfg.Enabled, "querier.tenant-federation.enabled", false, "If enabled, queries can span multiple tenants, separated by the '|' character in the X-Scope-OrgID header. Merge, series, top functions, profile, label and profile type queries are federated: the results of the tenants are merged, and series and profiles are labeled with the "+phlaremodel.LabelNameTenantID+" label. Other queries spanning multiple tenants are rejected.")
+	fs.IntVar(&cfg.MaxConcurrent, "querier.tenant-federation.max-concurrent", 16, "The maximum number of tenants queried concurrently by a federated query. 0 means no limit.")
+}
+
+type TenantFederationLimits interface {
+	validation.RangeRequestLimits
+}
+
+// NewTenantFederation returns a querier service that fans out queries
+// spanning multiple tenants to the given service, one query per tenant,
+// and merges the results. Queries of a single tenant are passed through.
+//
+// Tenants of a query can be narrowed down with __tenant_id__ matchers
+// in the label selectors. The time range of each tenant query is subject
+// to the tenant limits.
+func NewTenantFederation(cfg TenantFederationConfig, svc QuerierSvc, limits TenantFederationLimits) QuerierSvc {
+	return &tenantFederation{QuerierSvc: svc, cfg: cfg, limits: limits}
+}
+
+type tenantFederation struct {
+	QuerierSvc
+	cfg    TenantFederationConfig
+	limits TenantFederationLimits
+}
+
+type tenantResult[T any] struct {
+	tenantID string
+	result   T
+	// Whether the tenant was queried: the time range
+	// of the query may be outside the tenant limits.
+	ok bool
+}
+
+// federate calls fn for each tenant, in the context of the tenant.
+func federate[T any](ctx context.Context, maxConcurrent int, tenantIDs []string, fn func(context.Context, string) (T, bool, error)) ([]tenantResult[T], error) {
+	results := make([]tenantResult[T], len(tenantIDs))
+	g, ctx := errgroup.WithContext(ctx)
+	if maxConcurrent > 0 {
+		g.SetLimit(maxConcurrent)
+	}
+	for i, tenantID := range tenantIDs {
+		i, tenantID := i, tenantID
+		g.Go(func() error {
+			r, ok, err := fn(user.InjectOrgID(ctx, tenantID), tenantID)
+			results[i] = tenantResult[T]{tenantID: tenantID, result: r, ok: ok}
+			return err
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return lo.Filter(results, func(r tenantResult[T], _ int) bool { return r.ok }), nil
+}
+
+// tenantIDs returns the tenants of the query. The function reports
+// false, if the query should be passed through as is.
+func tenantIDs(ctx context.Context) ([]string, bool, error) {
+	tenantIDs, err := tenant.TenantIDs(ctx)
+	if err != nil {
+		return nil, false, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return tenantIDs, len(tenantIDs) > 1, nil
+}
+
+// tenantTimeRange returns the time range of the tenant query. The
+// function reports false, if the range is outside the tenant limits.
+func (f *tenantFederation) tenantTimeRange(tenantID string, start, end int64) (int64, int64, bool, error) {
+	validated, err := validation.ValidateRangeRequest(f.limits, []string{tenantID}, model.Interval{Start: model.Time(start), End: model.Time(end)}, model.Now())
+	if err != nil {
+		return 0, 0, false, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if validated.IsEmpty {
+		return 0, 0, false, nil
+	}
+	return int64(validated.Start), int64(validated.End), true, nil
+}
+
+// optionalTenantTimeRange is identical to tenantTimeRange, except that
+// an empty time range (legacy label queries) is not validated.
+func (f *tenantFederation) optionalTenantTimeRange(tenantID string, start, end int64) (int64, int64, bool, error) {
+	if start == 0 || end == 0 {
+		return start, end, true, nil
+	}
+	return f.tenantTimeRange(tenantID, start, end)
+}
+
+// selectTenants returns the tenants selected by the __tenant_id__
+// matchers of the label selector, and the selector without them.
+func selectTenants(tenantIDs []string, selector string) ([]string, string, error) {
+	matchers, err := parser.ParseMetricSelector(selector)
+	if err != nil {
+		return nil, "", connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	tenantMatchers, rest := splitTenantMatchers(matchers)
+	if len(tenantMatchers) == 0 {
+		return tenantIDs, selector, nil
+	}
+	selected := make([]string, 0, len(tenantIDs))
+	for _, tenantID := range tenantIDs {
+		if matchesTenant(tenantMatchers, tenantID) {
+			selected = append(selected, tenantID)
+		}
+	}
+	return selected, convertMatchersToString(rest), nil
+}
+
+// selectTenantsMatchers is identical to selectTenants, but handles
+// multiple selectors: a tenant is selected, if any of the selectors
+// selects it; selectors that don't select the tenant are excluded
+// from its query.
+func selectTenantsMatchers(tenantIDs []string, selectors []string) ([]string, map[string][]string, error) {
+	if len(selectors) == 0 {
+		return tenantIDs, nil, nil
+	}
+	perTenant := make(map[string][]string, len(tenantIDs))
+	for _, selector := range selectors {
+		selected, rewritten, err := selectTenants(tenantIDs, selector)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, tenantID := range selected {
+			perTenant[tenantID] = append(perTenant[tenantID], rewritten)
+		}
+	}
+	selected := lo.Filter(tenantIDs, func(tenantID string, _ int) bool {
+		_, ok := perTenant[tenantID]
+		return ok
+	})
+	return selected, perTenant, nil
+}
+
+func splitTenantMatchers(matchers []*labels.Matcher) (tenantMatchers, rest []*labels.Matcher) {
+	for _, m := range matchers {
+		if m.Name == phlaremodel.LabelNameTenantID {
+			tenantMatchers = append(tenantMatchers, m)
+		} else {
+			rest = append(rest, m)
+		}
+	}
+	return tenantMatchers, rest
+}
+
+func matchesTenant(matchers []*labels.Matcher, tenantID string) bool {
+	for _, m := range matchers {
+		if !m.Matches(tenantID) {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *tenantFederation) SelectMergeStacktraces(ctx context.Context, req *connect.Request[querierv1.SelectMergeStacktracesRequest]) (*connect.Response[querierv1.SelectMergeStacktracesResponse], error) {
+	ids, federated, err := tenantIDs(ctx)
+	if err != nil || !federated {
+		return f.QuerierSvc.SelectMergeStacktraces(ctx, req)
+	}
+	ids, selector, err := selectTenants(ids, req.Msg.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+	results, err := federate(ctx, f.cfg.MaxConcurrent, ids, func(ctx context.Context, tenantID string) (*phlaremodel.Tree, bool, error) {
+		start, end, ok, err := f.tenantTimeRange(tenantID, req.Msg.Start, req.Msg.End)
+		if !ok || err != nil {
+			return nil, false, err
+		}
+		r := req.Msg.CloneVT()
+		r.LabelSelector, r.Start, r.End = selector, start, end
+		r.Format = querierv1.ProfileFormat_PROFILE_FORMAT_TREE
+		resp, err := f.QuerierSvc.SelectMergeStacktraces(ctx, connect.NewRequest(r))
+		if err != nil {
+			return nil, false, err
+		}
+		t, err := phlaremodel.UnmarshalTree(resp.Msg.Tree)
+		return t, true, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	t := new(phlaremodel.Tree)
+	for _, r := range results {
+		t.Merge(r.result)
+	}
+	maxNodes := req.Msg.GetMaxNodes()
+	if maxNodes == 0 {
+		maxNodes = maxNodesDefault
+	}
+	var resp querierv1.SelectMergeStacktracesResponse
+	switch req.Msg.Format {
+	default:
+		resp.Flamegraph = phlaremodel.NewFlameGraph(t, maxNodes)
+	case querierv1.ProfileFormat_PROFILE_FORMAT_TREE:
+		resp.Tree = t.Bytes(maxNodes)
+	}
+	return connect.NewResponse(&resp), nil
+}
+
+func (f *tenantFederation) SelectMergeSpanProfile(ctx context.Context, req *connect.Request[querierv1.SelectMergeSpanProfileRequest]) (*connect.Response[querierv1.SelectMergeSpanProfileResponse], error) {
+	ids, federated, err := tenantIDs(ctx)
+	if err != nil || !federated {
+		return f.QuerierSvc.SelectMergeSpanProfile(ctx, req)
+	}
+	ids, selector, err := selectTenants(ids, req.Msg.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+	results, err := federate(ctx, f.cfg.MaxConcurrent, ids, func(ctx context.Context, tenantID string) (*phlaremodel.Tree, bool, error) {
+		start, end, ok, err := f.tenantTimeRange(tenantID, req.Msg.Start, req.Msg.End)
+		if !ok || err != nil {
+			return nil, false, err
+		}
+		r := req.Msg.CloneVT()
+		r.LabelSelector, r.Start, r.End = selector, start, end
+		r.Format = querierv1.ProfileFormat_PROFILE_FORMAT_TREE
+		resp, err := f.QuerierSvc.SelectMergeSpanProfile(ctx, connect.NewRequest(r))
+		if err != nil {
+			return nil, false, err
+		}
+		t, err := phlaremodel.UnmarshalTree(resp.Msg.Tree)
+		return t, true, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	t := new(phlaremodel.Tree)
+	for _, r := range results {
+		t.Merge(r.result)
+	}
+	maxNodes := req.Msg.GetMaxNodes()
+	if maxNodes == 0 {
+		maxNodes = maxNodesDefault
+	}
+	var resp querierv1.SelectMergeSpanProfileResponse
+	switch req.Msg.Format {
+	default:
+		resp.Flamegraph = phlaremodel.NewFlameGraph(t, maxNodes)
+	case querierv1.ProfileFormat_PROFILE_FORMAT_TREE:
+		resp.Tree = t.Bytes(maxNodes)
+	}
+	return connect.NewResponse(&resp), nil
+}
+
+func (f *tenantFederation) SelectMergeProfile(ctx context.Context, req *connect.Request[querierv1.SelectMergeProfileRequest]) (*connect.Response[googlev1.Profile], error) {
+	ids, federated, err := tenantIDs(ctx)
+	if err != nil || !federated {
+		return f.QuerierSvc.SelectMergeProfile(ctx, req)
+	}
+	ids, selector, err := selectTenants(ids, req.Msg.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+	results, err := federate(ctx, f.cfg.MaxConcurrent, ids, func(ctx context.Context, tenantID string) (*googlev1.Profile, bool, error) {
+		start, end, ok, err := f.tenantTimeRange(tenantID, req.Msg.Start, req.Msg.End)
+		if !ok || err != nil {
+			return nil, false, err
+		}
+		r := req.Msg.CloneVT()
+		r.LabelSelector, r.Start, r.End = selector, start, end
+		resp, err := f.QuerierSvc.SelectMergeProfile(ctx, connect.NewRequest(r))
+		if err != nil {
+			return nil, false, err
+		}
+		return resp.Msg, true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	var m pprof.ProfileMerge
+	for _, r := range results {
+		if err = m.Merge(r.result); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	profile := m.Profile()
+	profile.DurationNanos = model.Time(req.Msg.End).UnixNano() - model.Time(req.Msg.Start).UnixNano()
+	profile.TimeNanos = model.Time(req.Msg.End).UnixNano()
+	return connect.NewResponse(profile), nil
+}
+
+func (f *tenantFederation) SelectSeries(ctx context.Context, req *connect.Request[querierv1.SelectSeriesRequest]) (*connect.Response[querierv1.SelectSeriesResponse], error) {
+	ids, federated, err := tenantIDs(ctx)
+	if err != nil || !federated {
+		return f.QuerierSvc.SelectSeries(ctx, req)
+	}
+	// The other series can only be aggregated from
+	// the points of the tenant series for the sum.
+	if req.Msg.GetLimit() > 0 && req.Msg.GetAggregation() != typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_SUM {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			errors.New("series limit is only supported with the sum aggregation in federated queries"))
+	}
+	ids, selector, err := selectTenants(ids, req.Msg.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+	results, err := federate(ctx, f.cfg.MaxConcurrent, ids, func(ctx context.Context, tenantID string) ([]*typesv1.Series, bool, error) {
+		start, end, ok, err := f.tenantTimeRange(tenantID, req.Msg.Start, req.Msg.End)
+		if !ok || err != nil {
+			return nil, false, err
+		}
+		r := req.Msg.CloneVT()
+		r.LabelSelector, r.Start, r.End = selector, start, end
+		// The series are limited once the tenant series are merged.
+		r.Limit = nil
+		resp, err := f.QuerierSvc.SelectSeries(ctx, connect.NewRequest(r))
+		if err != nil {
+			return nil, false, err
+		}
+		return resp.Msg.Series, true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	var series []*typesv1.Series
+	for _, r := range results {
+		for _, s := range r.result {
+			s.Labels = phlaremodel.Labels(s.Labels).InsertSorted(phlaremodel.LabelNameTenantID, r.tenantID)
+			series = append(series, s)
+		}
+	}
+	sort.Slice(series, func(i, j int) bool {
+		return phlaremodel.CompareLabelPairs(series[i].Labels, series[j].Labels) < 0
+	})
+	// The series of all the tenants are ranked together. As the individual
+	// profile values are not available here, the other series is aggregated
+	// from the points of the tenant series.
+	stepMs := time.Duration(req.Msg.Step * float64(time.Second)).Milliseconds()
+	series = limitSeries(req.Msg, stepMs, series, series)
+	return connect.NewResponse(&querierv1.SelectSeriesResponse{Series: series}), nil
+}
+
+func (f *tenantFederation) SelectTopFunctions(ctx context.Context, req *connect.Request[querierv1.SelectTopFunctionsRequest]) (*connect.Response[querierv1.SelectTopFunctionsResponse], error) {
+	ids, federated, err := tenantIDs(ctx)
+	if err != nil || !federated {
+		return f.QuerierSvc.SelectTopFunctions(ctx, req)
+	}
+	ids, selector, err := selectTenants(ids, req.Msg.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+	results, err := federate(ctx, f.cfg.MaxConcurrent, ids, func(ctx context.Context, tenantID string) (*querierv1.SelectTopFunctionsResponse, bool, error) {
+		start, end, ok, err := f.tenantTimeRange(tenantID, req.Msg.Start, req.Msg.End)
+		if !ok || err != nil {
+			return nil, false, err
+		}
+		r := req.Msg.CloneVT()
+		r.LabelSelector, r.Start, r.End = selector, start, end
+		// The pagination is applied once the tenant functions are merged.
+		r.Offset = 0
+		if r.Limit > 0 {
+			r.Limit += req.Msg.Offset
+		}
+		resp, err := f.QuerierSvc.SelectTopFunctions(ctx, connect.NewRequest(r))
+		if err != nil {
+			return nil, false, err
+		}
+		return resp.Msg, true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	m := phlaremodel.NewTopFunctionsMerger()
+	var resp querierv1.SelectTopFunctionsResponse
+	for _, r := range results {
+		m.MergeTopFunctions(r.result.Functions)
+		resp.Total += r.result.Total
+		resp.TotalFunctions = max(resp.TotalFunctions, r.result.TotalFunctions)
+	}
+	functions := m.TopFunctions()
+	phlaremodel.SortTopFunctions(functions, req.Msg.OrderBy)
+	resp.TotalFunctions = max(resp.TotalFunctions, int64(len(functions)))
+	resp.Functions = phlaremodel.PaginateTopFunctions(functions, req.Msg.Offset, req.Msg.Limit)
+	return connect.NewResponse(&resp), nil
+}
+
+func (f *tenantFederation) SelectProfiles(ctx context.Context, req *connect.Request[querierv1.SelectProfilesRequest]) (*connect.Response[querierv1.SelectProfilesResponse], error) {
+	ids, federated, err := tenantIDs(ctx)
+	if err != nil || !federated {
+		return f.QuerierSvc.SelectProfiles(ctx, req)
+	}
+	ids, selector, err := selectTenants(ids, req.Msg.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+	results, err := federate(ctx, f.cfg.MaxConcurrent, ids, func(ctx context.Context, tenantID string) (*querierv1.SelectProfilesResponse, bool, error) {
+		start, end, ok, err := f.tenantTimeRange(tenantID, req.Msg.Start, req.Msg.End)
+		if !ok || err != nil {
+			return nil, false, err
+		}
+		r := req.Msg.CloneVT()
+		r.LabelSelector, r.Start, r.End = selector, start, end
+		resp, err := f.QuerierSvc.SelectProfiles(ctx, connect.NewRequest(r))
+		if err != nil {
+			return nil, false, err
+		}
+		return resp.Msg, true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	var resp querierv1.SelectProfilesResponse
+	for _, r := range results {
+		for _, p := range r.result.Profiles {
+			p.Labels = phlaremodel.Labels(p.Labels).InsertSorted(phlaremodel.LabelNameTenantID, r.tenantID)
+			resp.Profiles = append(resp.Profiles, p)
+		}
+		resp.Total += r.result.Total
+	}
+	sort.Slice(resp.Profiles, func(i, j int) bool {
+		if resp.Profiles[i].Timestamp != resp.Profiles[j].Timestamp {
+			return resp.Profiles[i].Timestamp > resp.Profiles[j].Timestamp
+		}
+		return resp.Profiles[i].Id < resp.Profiles[j].Id
+	})
+	limit := req.Msg.Limit
+	if limit == 0 {
+		limit = defaultSelectProfilesLimit
+	}
+	if int64(len(resp.Profiles)) > limit {
+		resp.Profiles = resp.Profiles[:limit]
+	}
+	return connect.NewResponse(&resp), nil
+}
+
+// GetProfileByID looks for the profile in all the tenants of the query.
+func (f *tenantFederation) GetProfileByID(ctx context.Context, req *connect.Request[querierv1.GetProfileByIDRequest]) (*connect.Response[googlev1.Profile], error) {
+	ids, federated, err := tenantIDs(ctx)
+	if err != nil || !federated {
+		return f.QuerierSvc.GetProfileByID(ctx, req)
+	}
+	selector := req.Msg.LabelSelector
+	if selector != "" {
+		if ids, selector, err = selectTenants(ids, selector); err != nil {
+			return nil, err
+		}
+	}
+	results, err := federate(ctx, f.cfg.MaxConcurrent, ids, func(ctx context.Context, tenantID string) (*googlev1.Profile, bool, error) {
+		start, end, ok, err := f.tenantTimeRange(tenantID, req.Msg.Start, req.Msg.End)
+		if !ok || err != nil {
+			return nil, false, err
+		}
+		r := req.Msg.CloneVT()
+		r.LabelSelector, r.Start, r.End = selector, start, end
+		resp, err := f.QuerierSvc.GetProfileByID(ctx, connect.NewRequest(r))
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			return nil, false, nil
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		return resp.Msg, true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("profile not found"))
+	}
+	return connect.NewResponse(results[0].result), nil
+}
+
+// The queries below can't be federated: their results
+// can't be merged, or they are served by the frontend.
+
+func (f *tenantFederation) SelectLineProfile(ctx context.Context, req *connect.Request[querierv1.SelectLineProfileRequest]) (*connect.Response[querierv1.SelectLineProfileResponse], error) {
+	if err := rejectFederated(ctx); err != nil {
+		return nil, err
+	}
+	return f.QuerierSvc.SelectLineProfile(ctx, req)
+}
+
+func (f *tenantFederation) SelectHeatmap(ctx context.Context, req *connect.Request[querierv1.SelectHeatmapRequest]) (*connect.Response[querierv1.SelectHeatmapResponse], error) {
+	if err := rejectFederated(ctx); err != nil {
+		return nil, err
+	}
+	return f.QuerierSvc.SelectHeatmap(ctx, req)
+}
+
+func (f *tenantFederation) Diff(ctx context.Context, req *connect.Request[querierv1.DiffRequest]) (*connect.Response[querierv1.DiffResponse], error) {
+	if err := rejectFederated(ctx); err != nil {
+		return nil, err
+	}
+	return f.QuerierSvc.Diff(ctx, req)
+}
+
+func rejectFederated(ctx context.Context) error {
+	_, federated, err := tenantIDs(ctx)
+	if err != nil {
+		return err
+	}
+	if federated {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("the query does not support multiple tenants"))
+	}
+	return nil
+}
+
+func (f *tenantFederation) Series(ctx context.Context, req *connect.Request[querierv1.SeriesRequest]) (*connect.Response[querierv1.SeriesResponse], error) {
+	ids, federated, err := tenantIDs(ctx)
+	if err != nil || !federated {
+		return f.QuerierSvc.Series(ctx, req)
+	}
+	ids, matchers, err := selectTenantsMatchers(ids, req.Msg.Matchers)
+	if err != nil {
+		return nil, err
+	}
+	results, err := federate(ctx, f.cfg.MaxConcurrent, ids, func(ctx context.Context, tenantID string) ([]*typesv1.Labels, bool, error) {
+		start, end, ok, err := f.optionalTenantTimeRange(tenantID, req.Msg.Start, req.Msg.End)
+		if !ok || err != nil {
+			return nil, false, err
+		}
+		r := req.Msg.CloneVT()
+		r.Matchers, r.Start, r.End = matchers[tenantID], start, end
+		resp, err := f.QuerierSvc.Series(ctx, connect.NewRequest(r))
+		if err != nil {
+			return nil, false, err
+		}
+		return resp.Msg.LabelsSet, true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	withTenantID := len(req.Msg.LabelNames) == 0 || lo.Contains(req.Msg.LabelNames, phlaremodel.LabelNameTenantID)
+	var labelsSet []*typesv1.Labels
+	for _, r := range results {
+		for _, ls := range r.result {
+			if withTenantID {
+				ls.Labels = phlaremodel.Labels(ls.Labels).InsertSorted(phlaremodel.LabelNameTenantID, r.tenantID)
+			}
+			labelsSet = append(labelsSet, ls)
+		}
+	}
+	return connect.NewResponse(&querierv1.SeriesResponse{LabelsSet: labelsSet}), nil
+}
+
+func (f *tenantFederation) LabelNames(ctx context.Context, req *connect.Request[typesv1.LabelNamesRequest]) (*connect.Response[typesv1.LabelNamesResponse], error) {
+	ids, federated, err := tenantIDs(ctx)
+	if err != nil || !federated {
+		return f.QuerierSvc.LabelNames(ctx, req)
+	}
+	ids, matchers, err := selectTenantsMatchers(ids, req.Msg.Matchers)
+	if err != nil {
+		return nil, err
+	}
+	results, err := federate(ctx, f.cfg.MaxConcurrent, ids, func(ctx context.Context, tenantID string) ([]string, bool, error) {
+		start, end, ok, err := f.optionalTenantTimeRange(tenantID, req.Msg.Start, req.Msg.End)
+		if !ok || err != nil {
+			return nil, false, err
+		}
+		r := req.Msg.CloneVT()
+		r.Matchers, r.Start, r.End = matchers[tenantID], start, end
+		resp, err := f.QuerierSvc.LabelNames(ctx, connect.NewRequest(r))
+		if err != nil {
+			return nil, false, err
+		}
+		return resp.Msg.Names, true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	names := []string{phlaremodel.LabelNameTenantID}
+	for _, r := range results {
+		names = append(names, r.result...)
+	}
+	return connect.NewResponse(&typesv1.LabelNamesResponse{Names: uniqueSorted(names)}), nil
+}
+
+func (f *tenantFederation) LabelValues(ctx context.Context, req *connect.Request[typesv1.LabelValuesRequest]) (*connect.Response[typesv1.LabelValuesResponse], error) {
+	ids, federated, err := tenantIDs(ctx)
+	if err != nil || !federated {
+		return f.QuerierSvc.LabelValues(ctx, req)
+	}
+	ids, matchers, err := selectTenantsMatchers(ids, req.Msg.Matchers)
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.Name == phlaremodel.LabelNameTenantID {
+		return connect.NewResponse(&typesv1.LabelValuesResponse{Names: ids}), nil
+	}
+	results, err := federate(ctx, f.cfg.MaxConcurrent, ids, func(ctx context.Context, tenantID string) ([]string, bool, error) {
+		start, end, ok, err := f.optionalTenantTimeRange(tenantID, req.Msg.Start, req.Msg.End)
+		if !ok || err != nil {
+			return nil, false, err
+		}
+		r := req.Msg.CloneVT()
+		r.Matchers, r.Start, r.End = matchers[tenantID], start, end
+		resp, err := f.QuerierSvc.LabelValues(ctx, connect.NewRequest(r))
+		if err != nil {
+			return nil, false, err
+		}
+		return resp.Msg.Names, true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, r := range results {
+		names = append(names, r.result...)
+	}
+	return connect.NewResponse(&typesv1.LabelValuesResponse{Names: uniqueSorted(names)}), nil
+}
+
+func (f *tenantFederation) ProfileTypes(ctx context.Context, req *connect.Request[querierv1.ProfileTypesRequest]) (*connect.Response[querierv1.ProfileTypesResponse], error) {
+	ids, federated, err := tenantIDs(ctx)
+	if err != nil || !federated {
+		return f.QuerierSvc.ProfileTypes(ctx, req)
+	}
+	results, err := federate(ctx, f.cfg.MaxConcurrent, ids, func(ctx context.Context, tenantID string) ([]*typesv1.ProfileType, bool, error) {
+		start, end, ok, err := f.optionalTenantTimeRange(tenantID, req.Msg.Start, req.Msg.End)
+		if !ok || err != nil {
+			return nil, false, err
+		}
+		resp, err := f.QuerierSvc.ProfileTypes(ctx, connect.NewRequest(&querierv1.ProfileTypesRequest{Start: start, End: end}))
+		if err != nil {
+			return nil, false, err
+		}
+		return resp.Msg.ProfileTypes, true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	profileTypes := make(map[string]*typesv1.ProfileType)
+	for _, r := range results {
+		for _, pt := range r.result {
+			profileTypes[pt.ID] = pt
+		}
+	}
+	resp := &querierv1.ProfileTypesResponse{ProfileTypes: lo.Values(profileTypes)}
+	sort.Slice(resp.ProfileTypes, func(i, j int) bool {
+		return resp.ProfileTypes[i].ID < resp.ProfileTypes[j].ID
+	})
+	return connect.NewResponse(resp), nil
+}
+
+func uniqueSorted(s []string) []string {
+	s = lo.Uniq(s)
+	sort.Strings(s)
+	return s
+}
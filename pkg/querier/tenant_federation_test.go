@@ -0,0 +1,384 @@
+package querier
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/grafana/dskit/tenant"
+	"github.com/grafana/dskit/user"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	googlev1 "github.com/grafana/pyroscope/api/gen/proto/go/google/v1"
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/validation"
+)
+
+// tenantQuerierSvc serves a fixed stack trace and series per tenant,
+// and records the queries it receives.
+type tenantQuerierSvc struct {
+	QuerierSvc
+
+	mu      sync.Mutex
+	queries map[string][]string
+	ranges  map[string][2]int64
+}
+
+func newTenantQuerierSvc() *tenantQuerierSvc {
+	return &tenantQuerierSvc{
+		queries: make(map[string][]string),
+		ranges:  make(map[string][2]int64),
+	}
+}
+
+func (s *tenantQuerierSvc) record(ctx context.Context, query string, start, end int64) string {
+	tenantID, err := tenant.TenantID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.queries[tenantID] = append(s.queries[tenantID], query)
+	s.ranges[tenantID] = [2]int64{start, end}
+	return tenantID
+}
+
+func (s *tenantQuerierSvc) SelectMergeStacktraces(ctx context.Context, req *connect.Request[querierv1.SelectMergeStacktracesRequest]) (*connect.Response[querierv1.SelectMergeStacktracesResponse], error) {
+	tenantID := s.record(ctx, req.Msg.LabelSelector, req.Msg.Start, req.Msg.End)
+	var tree phlaremodel.Tree
+	tree.InsertStack(1, "main", tenantID)
+	return connect.NewResponse(&querierv1.SelectMergeStacktracesResponse{Tree: tree.Bytes(-1)}), nil
+}
+
+func (s *tenantQuerierSvc) SelectSeries(ctx context.Context, req *connect.Request[querierv1.SelectSeriesRequest]) (*connect.Response[querierv1.SelectSeriesResponse], error) {
+	tenantID := s.record(ctx, req.Msg.LabelSelector, req.Msg.Start, req.Msg.End)
+	if req.Msg.Limit != nil {
+		panic("the series limit must be applied to the merged series")
+	}
+	series := []*typesv1.Series{{
+		Labels: phlaremodel.LabelsFromStrings("service_name", "svc"),
+		Points: []*typesv1.Point{{Timestamp: req.Msg.Start, Value: 1}},
+	}}
+	if len(req.Msg.GroupBy) > 0 {
+		// Each tenant has a single series, the value of which is
+		// the index of the tenant: ranked from the last tenant.
+		series[0].Points[0].Value = float64(tenantID[0] - 'a' + 1)
+	}
+	return connect.NewResponse(&querierv1.SelectSeriesResponse{Series: series}), nil
+}
+
+func (s *tenantQuerierSvc) SelectTopFunctions(ctx context.Context, req *connect.Request[querierv1.SelectTopFunctionsRequest]) (*connect.Response[querierv1.SelectTopFunctionsResponse], error) {
+	tenantID := s.record(ctx, req.Msg.LabelSelector, req.Msg.Start, req.Msg.End)
+	if req.Msg.Offset != 0 {
+		panic("the pagination must be applied to the merged functions")
+	}
+	return connect.NewResponse(&querierv1.SelectTopFunctionsResponse{
+		Functions: []*typesv1.TopFunction{
+			{Name: "main", Self: 1, Total: 3},
+			{Name: tenantID, Self: 2, Total: 2},
+		},
+		TotalFunctions: 2,
+		Total:          3,
+	}), nil
+}
+
+func (s *tenantQuerierSvc) SelectProfiles(ctx context.Context, req *connect.Request[querierv1.SelectProfilesRequest]) (*connect.Response[querierv1.SelectProfilesResponse], error) {
+	tenantID := s.record(ctx, req.Msg.LabelSelector, req.Msg.Start, req.Msg.End)
+	return connect.NewResponse(&querierv1.SelectProfilesResponse{
+		Profiles: []*querierv1.ProfileInfo{{
+			Id:        tenantID,
+			Timestamp: int64(tenantID[0]),
+			Labels:    phlaremodel.LabelsFromStrings("service_name", "svc"),
+		}},
+		Total: 1,
+	}), nil
+}
+
+func (s *tenantQuerierSvc) GetProfileByID(ctx context.Context, req *connect.Request[querierv1.GetProfileByIDRequest]) (*connect.Response[googlev1.Profile], error) {
+	tenantID := s.record(ctx, req.Msg.LabelSelector, req.Msg.Start, req.Msg.End)
+	if req.Msg.Id != tenantID {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("not found"))
+	}
+	return connect.NewResponse(&googlev1.Profile{StringTable: []string{"", tenantID}}), nil
+}
+
+func (s *tenantQuerierSvc) LabelNames(ctx context.Context, req *connect.Request[typesv1.LabelNamesRequest]) (*connect.Response[typesv1.LabelNamesResponse], error) {
+	tenantID := s.record(ctx, "", req.Msg.Start, req.Msg.End)
+	return connect.NewResponse(&typesv1.LabelNamesResponse{Names: []string{"service_name", "only_" + tenantID}}), nil
+}
+
+func (s *tenantQuerierSvc) LabelValues(ctx context.Context, req *connect.Request[typesv1.LabelValuesRequest]) (*connect.Response[typesv1.LabelValuesResponse], error) {
+	tenantID := s.record(ctx, "", req.Msg.Start, req.Msg.End)
+	return connect.NewResponse(&typesv1.LabelValuesResponse{Names: []string{"svc", tenantID}}), nil
+}
+
+func (s *tenantQuerierSvc) Series(ctx context.Context, req *connect.Request[querierv1.SeriesRequest]) (*connect.Response[querierv1.SeriesResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tenantID, _ := tenant.TenantID(ctx)
+	s.queries[tenantID] = append(s.queries[tenantID], req.Msg.Matchers...)
+	return connect.NewResponse(&querierv1.SeriesResponse{LabelsSet: []*typesv1.Labels{
+		{Labels: phlaremodel.LabelsFromStrings("service_name", "svc")},
+	}}), nil
+}
+
+func (s *tenantQuerierSvc) ProfileTypes(ctx context.Context, req *connect.Request[querierv1.ProfileTypesRequest]) (*connect.Response[querierv1.ProfileTypesResponse], error) {
+	tenantID := s.record(ctx, "", req.Msg.Start, req.Msg.End)
+	return connect.NewResponse(&querierv1.ProfileTypesResponse{ProfileTypes: []*typesv1.ProfileType{
+		{ID: "process_cpu:cpu:nanoseconds:cpu:nanoseconds"},
+		{ID: "memory:" + tenantID},
+	}}), nil
+}
+
+func newTestTenantFederation(t *testing.T, limits map[string]*validation.Limits) (QuerierSvc, *tenantQuerierSvc) {
+	t.Helper()
+	svc := newTenantQuerierSvc()
+	overrides := validation.MockOverrides(func(defaults *validation.Limits, tenantLimits map[string]*validation.Limits) {
+		for tenantID, l := range limits {
+			tenantLimits[tenantID] = l
+		}
+	})
+	return NewTenantFederation(TenantFederationConfig{Enabled: true, MaxConcurrent: 2}, svc, overrides), svc
+}
+
+func Test_TenantFederation_SingleTenant(t *testing.T) {
+	f, svc := newTestTenantFederation(t, nil)
+	ctx := user.InjectOrgID(context.Background(), "a")
+	resp, err := f.SelectSeries(ctx, connect.NewRequest(&querierv1.SelectSeriesRequest{
+		LabelSelector: `{service_name="svc"}`,
+		Start:         1,
+		End:           2,
+	}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Series, 1)
+	assert.Equal(t, phlaremodel.LabelsFromStrings("service_name", "svc"), phlaremodel.Labels(resp.Msg.Series[0].Labels))
+	assert.Equal(t, map[string][]string{"a": {`{service_name="svc"}`}}, svc.queries)
+}
+
+func Test_TenantFederation_SelectMergeStacktraces(t *testing.T) {
+	f, svc := newTestTenantFederation(t, nil)
+	ctx := user.InjectOrgID(context.Background(), "a|b|c")
+	now := time.Now().UnixMilli()
+	resp, err := f.SelectMergeStacktraces(ctx, connect.NewRequest(&querierv1.SelectMergeStacktracesRequest{
+		LabelSelector: `{service_name="svc", __tenant_id__=~"a|b"}`,
+		Start:         now - 1000,
+		End:           now,
+		Format:        querierv1.ProfileFormat_PROFILE_FORMAT_TREE,
+	}))
+	require.NoError(t, err)
+	var expected phlaremodel.Tree
+	expected.InsertStack(1, "main", "a")
+	expected.InsertStack(1, "main", "b")
+	tree, err := phlaremodel.UnmarshalTree(resp.Msg.Tree)
+	require.NoError(t, err)
+	assert.Equal(t, expected.String(), tree.String())
+	assert.Equal(t, map[string][]string{
+		"a": {`{service_name="svc"}`},
+		"b": {`{service_name="svc"}`},
+	}, svc.queries)
+}
+
+func Test_TenantFederation_SelectSeries(t *testing.T) {
+	now := time.Now()
+	f, svc := newTestTenantFederation(t, map[string]*validation.Limits{
+		"a": {MaxQueryLookback: 0},
+		"b": {MaxQueryLookback: model.Duration(time.Hour)},
+		"c": {MaxQueryLookback: model.Duration(time.Hour)},
+	})
+	ctx := user.InjectOrgID(context.Background(), "a|b|c")
+	resp, err := f.SelectSeries(ctx, connect.NewRequest(&querierv1.SelectSeriesRequest{
+		LabelSelector: `{service_name="svc", __tenant_id__!="c"}`,
+		Start:         now.Add(-2 * time.Hour).UnixMilli(),
+		End:           now.UnixMilli(),
+	}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Series, 2)
+	assert.Equal(t, phlaremodel.LabelsFromStrings("__tenant_id__", "a", "service_name", "svc"), phlaremodel.Labels(resp.Msg.Series[0].Labels))
+	assert.Equal(t, phlaremodel.LabelsFromStrings("__tenant_id__", "b", "service_name", "svc"), phlaremodel.Labels(resp.Msg.Series[1].Labels))
+
+	// The lookback limit of tenant b applies to its query only.
+	assert.Equal(t, now.Add(-2*time.Hour).UnixMilli(), svc.ranges["a"][0])
+	assert.Less(t, now.Add(-2*time.Hour).UnixMilli(), svc.ranges["b"][0])
+	assert.NotContains(t, svc.queries, "c")
+}
+
+func Test_TenantFederation_SelectSeries_OutsideLimits(t *testing.T) {
+	now := time.Now()
+	f, svc := newTestTenantFederation(t, map[string]*validation.Limits{
+		"b": {MaxQueryLookback: model.Duration(time.Hour)},
+	})
+	ctx := user.InjectOrgID(context.Background(), "a|b")
+	resp, err := f.SelectSeries(ctx, connect.NewRequest(&querierv1.SelectSeriesRequest{
+		LabelSelector: `{service_name="svc"}`,
+		Start:         now.Add(-3 * time.Hour).UnixMilli(),
+		End:           now.Add(-2 * time.Hour).UnixMilli(),
+	}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Series, 1)
+	assert.Equal(t, phlaremodel.LabelsFromStrings("__tenant_id__", "a", "service_name", "svc"), phlaremodel.Labels(resp.Msg.Series[0].Labels))
+	assert.NotContains(t, svc.queries, "b")
+}
+
+func Test_TenantFederation_SelectSeries_Limit(t *testing.T) {
+	f, _ := newTestTenantFederation(t, nil)
+	ctx := user.InjectOrgID(context.Background(), "a|b|c")
+	now := time.Now().Truncate(time.Second).UnixMilli()
+	limit := int64(1)
+	resp, err := f.SelectSeries(ctx, connect.NewRequest(&querierv1.SelectSeriesRequest{
+		LabelSelector: `{service_name="svc"}`,
+		Start:         now,
+		End:           now,
+		Step:          1,
+		GroupBy:       []string{"service_name"},
+		Limit:         &limit,
+	}))
+	require.NoError(t, err)
+	// The series of tenant c ranks the highest, the other
+	// series is merged from the series of tenants a and b.
+	require.Len(t, resp.Msg.Series, 2)
+	assert.Equal(t, phlaremodel.LabelsFromStrings("__tenant_id__", "c", "service_name", "svc"), phlaremodel.Labels(resp.Msg.Series[0].Labels))
+	assert.Equal(t, phlaremodel.LabelsFromStrings(phlaremodel.LabelNameOtherSeries, phlaremodel.LabelOtherSeries), phlaremodel.Labels(resp.Msg.Series[1].Labels))
+	assert.Equal(t, []*typesv1.Point{{Timestamp: now, Value: 3}}, resp.Msg.Series[1].Points)
+
+	// The other series can not be aggregated from the tenant series points.
+	_, err = f.SelectSeries(ctx, connect.NewRequest(&querierv1.SelectSeriesRequest{
+		LabelSelector: `{service_name="svc"}`,
+		Start:         now,
+		End:           now,
+		Step:          1,
+		GroupBy:       []string{"service_name"},
+		Limit:         &limit,
+		Aggregation:   typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_AVERAGE.Enum(),
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func Test_TenantFederation_SelectTopFunctions(t *testing.T) {
+	f, _ := newTestTenantFederation(t, nil)
+	ctx := user.InjectOrgID(context.Background(), "a|b")
+	now := time.Now().UnixMilli()
+	resp, err := f.SelectTopFunctions(ctx, connect.NewRequest(&querierv1.SelectTopFunctionsRequest{
+		LabelSelector: `{service_name="svc"}`,
+		Start:         now - 1000,
+		End:           now,
+		OrderBy:       querierv1.TopFunctionsOrder_TOP_FUNCTIONS_ORDER_SELF,
+		Offset:        1,
+		Limit:         2,
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, &querierv1.SelectTopFunctionsResponse{
+		Functions: []*typesv1.TopFunction{
+			{Name: "b", Self: 2, Total: 2},
+			{Name: "main", Self: 2, Total: 6},
+		},
+		TotalFunctions: 3,
+		Total:          6,
+	}, resp.Msg)
+}
+
+func Test_TenantFederation_SelectProfiles(t *testing.T) {
+	f, _ := newTestTenantFederation(t, nil)
+	ctx := user.InjectOrgID(context.Background(), "a|b|c")
+	now := time.Now().UnixMilli()
+	resp, err := f.SelectProfiles(ctx, connect.NewRequest(&querierv1.SelectProfilesRequest{
+		LabelSelector: `{service_name="svc"}`,
+		Start:         now - 1000,
+		End:           now,
+		Limit:         2,
+	}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Profiles, 2)
+	assert.Equal(t, int64(3), resp.Msg.Total)
+	assert.Equal(t, "c", resp.Msg.Profiles[0].Id)
+	assert.Equal(t, phlaremodel.LabelsFromStrings("__tenant_id__", "c", "service_name", "svc"), phlaremodel.Labels(resp.Msg.Profiles[0].Labels))
+	assert.Equal(t, "b", resp.Msg.Profiles[1].Id)
+
+	p, err := f.GetProfileByID(ctx, connect.NewRequest(&querierv1.GetProfileByIDRequest{
+		Id:    "b",
+		Start: now - 1000,
+		End:   now,
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"", "b"}, p.Msg.StringTable)
+
+	_, err = f.GetProfileByID(ctx, connect.NewRequest(&querierv1.GetProfileByIDRequest{
+		Id:            "b",
+		LabelSelector: `{__tenant_id__="a"}`,
+		Start:         now - 1000,
+		End:           now,
+	}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func Test_TenantFederation_Unsupported(t *testing.T) {
+	f, _ := newTestTenantFederation(t, nil)
+	ctx := user.InjectOrgID(context.Background(), "a|b")
+	_, err := f.SelectHeatmap(ctx, connect.NewRequest(&querierv1.SelectHeatmapRequest{}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	_, err = f.SelectLineProfile(ctx, connect.NewRequest(&querierv1.SelectLineProfileRequest{}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	_, err = f.Diff(ctx, connect.NewRequest(&querierv1.DiffRequest{}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func Test_TenantFederation_Series(t *testing.T) {
+	f, svc := newTestTenantFederation(t, nil)
+	ctx := user.InjectOrgID(context.Background(), "a|b")
+	resp, err := f.Series(ctx, connect.NewRequest(&querierv1.SeriesRequest{
+		Matchers: []string{`{__tenant_id__="a", service_name="x"}`, `{service_name="y"}`},
+	}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.LabelsSet, 2)
+	assert.Equal(t, map[string][]string{
+		"a": {`{service_name="x"}`, `{service_name="y"}`},
+		"b": {`{service_name="y"}`},
+	}, svc.queries)
+
+	resp, err = f.Series(ctx, connect.NewRequest(&querierv1.SeriesRequest{
+		Matchers:   []string{`{service_name="y"}`},
+		LabelNames: []string{"service_name"},
+	}))
+	require.NoError(t, err)
+	for _, ls := range resp.Msg.LabelsSet {
+		assert.Equal(t, phlaremodel.LabelsFromStrings("service_name", "svc"), phlaremodel.Labels(ls.Labels))
+	}
+}
+
+func Test_TenantFederation_Labels(t *testing.T) {
+	f, _ := newTestTenantFederation(t, nil)
+	ctx := user.InjectOrgID(context.Background(), "a|b|c")
+
+	names, err := f.LabelNames(ctx, connect.NewRequest(&typesv1.LabelNamesRequest{}))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"__tenant_id__", "only_a", "only_b", "only_c", "service_name"}, names.Msg.Names)
+
+	values, err := f.LabelValues(ctx, connect.NewRequest(&typesv1.LabelValuesRequest{Name: "service_name"}))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c", "svc"}, values.Msg.Names)
+
+	values, err = f.LabelValues(ctx, connect.NewRequest(&typesv1.LabelValuesRequest{
+		Name:     "__tenant_id__",
+		Matchers: []string{`{__tenant_id__=~"a|c"}`},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "c"}, values.Msg.Names)
+}
+
+func Test_TenantFederation_ProfileTypes(t *testing.T) {
+	f, _ := newTestTenantFederation(t, nil)
+	ctx := user.InjectOrgID(context.Background(), "a|b")
+	resp, err := f.ProfileTypes(ctx, connect.NewRequest(&querierv1.ProfileTypesRequest{}))
+	require.NoError(t, err)
+	var ids []string
+	for _, pt := range resp.Msg.ProfileTypes {
+		ids = append(ids, pt.ID)
+	}
+	assert.Equal(t, []string{"memory:a", "memory:b", "process_cpu:cpu:nanoseconds:cpu:nanoseconds"}, ids)
+}
@@ -0,0 +1,34 @@
+package vcs
+
+import (
+	"context"
+	"net/http"
+	"os"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/endpoints"
+
+	vcsv1 "github.com/grafana/pyroscope/api/gen/proto/go/vcs/v1"
+	"github.com/grafana/pyroscope/pkg/querier/vcs/client"
+)
+
+var (
+	bitbucketAppClientID     = os.Getenv("BITBUCKET_CLIENT_ID")
+	bitbucketAppClientSecret = os.Getenv("BITBUCKET_CLIENT_SECRET")
+)
+
+// newBitbucketProvider returns the provider of Bitbucket Cloud.
+func newBitbucketProvider() *provider {
+	return &provider{
+		kind:         vcsv1.Provider_PROVIDER_BITBUCKET,
+		name:         "Bitbucket",
+		envPrefix:    "BITBUCKET",
+		baseURL:      "https://bitbucket.org",
+		clientID:     bitbucketAppClientID,
+		clientSecret: bitbucketAppClientSecret,
+		endpoint:     endpoints.Bitbucket,
+		newClient: func(ctx context.Context, token *oauth2.Token, c *http.Client) (vcsClient, error) {
+			return client.BitbucketClient(ctx, token, c)
+		},
+	}
+}
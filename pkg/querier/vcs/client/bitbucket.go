@@ -0,0 +1,124 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	vcsv1 "github.com/grafana/pyroscope/api/gen/proto/go/vcs/v1"
+)
+
+const (
+	bitbucketAPIURL = "https://api.bitbucket.org"
+	bitbucketURL    = "https://bitbucket.org"
+)
+
+// BitbucketClient returns a Bitbucket Cloud client.
+func BitbucketClient(ctx context.Context, token *oauth2.Token, client *http.Client) (*bitbucketClient, error) {
+	return &bitbucketClient{
+		client:  client,
+		token:   token,
+		apiURL:  bitbucketAPIURL,
+		htmlURL: bitbucketURL,
+	}, nil
+}
+
+type bitbucketClient struct {
+	client  *http.Client
+	token   *oauth2.Token
+	apiURL  string
+	htmlURL string
+}
+
+type bitbucketRepository struct {
+	MainBranch struct {
+		Name string `json:"name"`
+	} `json:"mainbranch"`
+}
+
+type bitbucketCommit struct {
+	Hash    string    `json:"hash"`
+	Message string    `json:"message"`
+	Date    time.Time `json:"date"`
+	Author  struct {
+		Raw  string `json:"raw"`
+		User *struct {
+			Nickname string `json:"nickname"`
+			Links    struct {
+				Avatar struct {
+					Href string `json:"href"`
+				} `json:"avatar"`
+			} `json:"links"`
+		} `json:"user"`
+	} `json:"author"`
+	Links struct {
+		HTML struct {
+			Href string `json:"href"`
+		} `json:"html"`
+	} `json:"links"`
+}
+
+func (bb *bitbucketClient) repoURL(workspace, repo string) string {
+	return fmt.Sprintf("%s/2.0/repositories/%s/%s", bb.apiURL, url.PathEscape(workspace), url.PathEscape(repo))
+}
+
+// resolveRef resolves HEAD to the main branch of the repository:
+// unlike git, the Bitbucket API does not support symbolic refs.
+func (bb *bitbucketClient) resolveRef(ctx context.Context, workspace, repo, ref string) (string, error) {
+	if ref != "" && ref != "HEAD" {
+		return ref, nil
+	}
+	var r bitbucketRepository
+	if _, err := doRequest(ctx, bb.client, bb.token, bb.repoURL(workspace, repo), &r); err != nil {
+		return "", err
+	}
+	return r.MainBranch.Name, nil
+}
+
+func (bb *bitbucketClient) GetCommit(ctx context.Context, owner, repo, ref string) (*vcsv1.GetCommitResponse, error) {
+	ref, err := bb.resolveRef(ctx, owner, repo, ref)
+	if err != nil {
+		return nil, err
+	}
+	var commit bitbucketCommit
+	u := fmt.Sprintf("%s/commit/%s", bb.repoURL(owner, repo), url.PathEscape(ref))
+	if _, err = doRequest(ctx, bb.client, bb.token, u, &commit); err != nil {
+		return nil, err
+	}
+
+	// The commit author is not necessarily a Bitbucket user.
+	author := &vcsv1.CommitAuthor{Login: commit.Author.Raw}
+	if u := commit.Author.User; u != nil {
+		author.Login = u.Nickname
+		author.AvatarURL = u.Links.Avatar.Href
+	}
+	return &vcsv1.GetCommitResponse{
+		Sha:     commit.Hash,
+		Message: commit.Message,
+		Author:  author,
+		Date:    commit.Date.Format(time.RFC3339),
+		URL:     commit.Links.HTML.Href,
+	}, nil
+}
+
+func (bb *bitbucketClient) GetFile(ctx context.Context, req FileRequest) (File, error) {
+	ref, err := bb.resolveRef(ctx, req.Owner, req.Repo, req.Ref)
+	if err != nil {
+		return File{}, err
+	}
+	path := escapePath(req.Path)
+	u := fmt.Sprintf("%s/src/%s/%s", bb.repoURL(req.Owner, req.Repo), url.PathEscape(ref), path)
+	content, err := doRequest(ctx, bb.client, bb.token, u, nil)
+	if err != nil {
+		return File{}, err
+	}
+
+	return File{
+		Content: string(content),
+		URL:     fmt.Sprintf("%s/%s/%s/src/%s/%s", bb.htmlURL, req.Owner, req.Repo, ref, path),
+	}, nil
+}
@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+func Test_BitbucketClient(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/2.0/repositories/grafana/pyroscope", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"mainbranch":{"name":"main"}}`))
+	})
+	mux.HandleFunc("/2.0/repositories/grafana/pyroscope/src/main/pkg/main.go", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte("package main"))
+	})
+	mux.HandleFunc("/2.0/repositories/grafana/pyroscope/commit/main", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"hash":"abc","message":"fix","date":"2024-01-02T03:04:05Z","author":{"raw":"John <john@example.com>"},"links":{"html":{"href":"https://bitbucket.org/grafana/pyroscope/commits/abc"}}}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c, err := BitbucketClient(context.Background(), &oauth2.Token{AccessToken: "token"}, server.Client())
+	require.NoError(t, err)
+	c.apiURL = server.URL
+
+	t.Run("GetFile resolves HEAD to the main branch", func(t *testing.T) {
+		file, err := c.GetFile(context.Background(), FileRequest{Owner: "grafana", Repo: "pyroscope", Path: "pkg/main.go", Ref: "HEAD"})
+		require.NoError(t, err)
+		require.Equal(t, "package main", file.Content)
+		require.Equal(t, "https://bitbucket.org/grafana/pyroscope/src/main/pkg/main.go", file.URL)
+	})
+
+	t.Run("GetCommit falls back to the raw author", func(t *testing.T) {
+		commit, err := c.GetCommit(context.Background(), "grafana", "pyroscope", "main")
+		require.NoError(t, err)
+		require.Equal(t, "abc", commit.Sha)
+		require.Equal(t, "John <john@example.com>", commit.Author.Login)
+		require.Equal(t, "https://bitbucket.org/grafana/pyroscope/commits/abc", commit.URL)
+	})
+
+	t.Run("GetFile not found", func(t *testing.T) {
+		_, err := c.GetFile(context.Background(), FileRequest{Owner: "grafana", Repo: "pyroscope", Path: "missing.go", Ref: "main"})
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+}
@@ -1,7 +1,14 @@
 package client
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"golang.org/x/oauth2"
 )
 
 var ErrNotFound = errors.New("file not found")
@@ -17,3 +24,33 @@ type FileRequest struct {
 	Path  string
 	Ref   string
 }
+
+// doRequest sends an authenticated GET request to the provider API.
+// If v is nil, the response body is returned as is, otherwise it is
+// decoded as JSON into v.
+func doRequest(ctx context.Context, client *http.Client, token *oauth2.Token, url string, v any) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	token.SetAuthHeader(req)
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case res.StatusCode == http.StatusNotFound:
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, url)
+	case res.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("request to %s failed: %s", url, res.Status)
+	}
+	if v == nil {
+		return body, nil
+	}
+	return nil, json.Unmarshal(body, v)
+}
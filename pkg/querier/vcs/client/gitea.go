@@ -0,0 +1,119 @@
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"golang.org/x/oauth2"
+
+	vcsv1 "github.com/grafana/pyroscope/api/gen/proto/go/vcs/v1"
+)
+
+// GiteaClient returns a Gitea client. The base URL is the URL of
+// the Gitea instance, e.g. https://gitea.com.
+func GiteaClient(ctx context.Context, token *oauth2.Token, baseURL string, client *http.Client) (*giteaClient, error) {
+	return &giteaClient{
+		client:  client,
+		token:   token,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+	}, nil
+}
+
+type giteaClient struct {
+	client  *http.Client
+	token   *oauth2.Token
+	baseURL string
+}
+
+type giteaContents struct {
+	Type     string `json:"type"`
+	Encoding string `json:"encoding"`
+	Content  string `json:"content"`
+	HTMLURL  string `json:"html_url"`
+}
+
+type giteaCommit struct {
+	SHA     string `json:"sha"`
+	HTMLURL string `json:"html_url"`
+	Commit  struct {
+		Message string `json:"message"`
+		Author  struct {
+			Name string    `json:"name"`
+			Date time.Time `json:"date"`
+		} `json:"author"`
+	} `json:"commit"`
+	Author *struct {
+		Login     string `json:"login"`
+		AvatarURL string `json:"avatar_url"`
+	} `json:"author"`
+}
+
+func (gt *giteaClient) repoURL(owner, repo string) string {
+	return fmt.Sprintf("%s/api/v1/repos/%s/%s", gt.baseURL, url.PathEscape(owner), url.PathEscape(repo))
+}
+
+func (gt *giteaClient) GetCommit(ctx context.Context, owner, repo, ref string) (*vcsv1.GetCommitResponse, error) {
+	var commit giteaCommit
+	u := fmt.Sprintf("%s/git/commits/%s", gt.repoURL(owner, repo), url.PathEscape(ref))
+	if _, err := doRequest(ctx, gt.client, gt.token, u, &commit); err != nil {
+		return nil, err
+	}
+
+	// The commit author is not necessarily a Gitea user.
+	author := &vcsv1.CommitAuthor{Login: commit.Commit.Author.Name}
+	if commit.Author != nil {
+		author.Login = commit.Author.Login
+		author.AvatarURL = commit.Author.AvatarURL
+	}
+	return &vcsv1.GetCommitResponse{
+		Sha:     commit.SHA,
+		Message: commit.Commit.Message,
+		Author:  author,
+		Date:    commit.Commit.Author.Date.Format(time.RFC3339),
+		URL:     commit.HTMLURL,
+	}, nil
+}
+
+func (gt *giteaClient) GetFile(ctx context.Context, req FileRequest) (File, error) {
+	var file giteaContents
+	u := fmt.Sprintf("%s/contents/%s?ref=%s",
+		gt.repoURL(req.Owner, req.Repo), escapePath(req.Path), url.QueryEscape(req.Ref))
+	if _, err := doRequest(ctx, gt.client, gt.token, u, &file); err != nil {
+		return File{}, err
+	}
+
+	// We only support files retrieval.
+	if file.Type != "file" {
+		return File{}, connect.NewError(connect.CodeInvalidArgument, errors.New("path is not a file"))
+	}
+
+	content := file.Content
+	if file.Encoding == "base64" {
+		decoded, err := base64.StdEncoding.DecodeString(file.Content)
+		if err != nil {
+			return File{}, err
+		}
+		content = string(decoded)
+	}
+
+	return File{
+		Content: content,
+		URL:     file.HTMLURL,
+	}, nil
+}
+
+// escapePath escapes the path segments, keeping the separators.
+func escapePath(p string) string {
+	segments := strings.Split(p, "/")
+	for i := range segments {
+		segments[i] = url.PathEscape(segments[i])
+	}
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,91 @@
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	vcsv1 "github.com/grafana/pyroscope/api/gen/proto/go/vcs/v1"
+)
+
+// GitlabClient returns a GitLab client. The base URL is the URL of
+// the GitLab instance, e.g. https://gitlab.com.
+func GitlabClient(ctx context.Context, token *oauth2.Token, baseURL string, client *http.Client) (*gitlabClient, error) {
+	return &gitlabClient{
+		client:  client,
+		token:   token,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+	}, nil
+}
+
+type gitlabClient struct {
+	client  *http.Client
+	token   *oauth2.Token
+	baseURL string
+}
+
+type gitlabFile struct {
+	Content  string `json:"content"`
+	Encoding string `json:"encoding"`
+}
+
+type gitlabCommit struct {
+	ID           string    `json:"id"`
+	Message      string    `json:"message"`
+	AuthorName   string    `json:"author_name"`
+	AuthoredDate time.Time `json:"authored_date"`
+	WebURL       string    `json:"web_url"`
+}
+
+// projectURL returns the API URL of the project: GitLab identifies
+// projects by the URL-encoded path, which may include subgroups.
+func (gl *gitlabClient) projectURL(owner, repo string) string {
+	return fmt.Sprintf("%s/api/v4/projects/%s", gl.baseURL, url.PathEscape(owner+"/"+repo))
+}
+
+func (gl *gitlabClient) GetCommit(ctx context.Context, owner, repo, ref string) (*vcsv1.GetCommitResponse, error) {
+	var commit gitlabCommit
+	u := fmt.Sprintf("%s/repository/commits/%s", gl.projectURL(owner, repo), url.PathEscape(ref))
+	if _, err := doRequest(ctx, gl.client, gl.token, u, &commit); err != nil {
+		return nil, err
+	}
+
+	return &vcsv1.GetCommitResponse{
+		Sha:     commit.ID,
+		Message: commit.Message,
+		Author: &vcsv1.CommitAuthor{
+			Login: commit.AuthorName,
+		},
+		Date: commit.AuthoredDate.Format(time.RFC3339),
+		URL:  commit.WebURL,
+	}, nil
+}
+
+func (gl *gitlabClient) GetFile(ctx context.Context, req FileRequest) (File, error) {
+	var file gitlabFile
+	u := fmt.Sprintf("%s/repository/files/%s?ref=%s",
+		gl.projectURL(req.Owner, req.Repo), url.PathEscape(req.Path), url.QueryEscape(req.Ref))
+	if _, err := doRequest(ctx, gl.client, gl.token, u, &file); err != nil {
+		return File{}, err
+	}
+
+	content := file.Content
+	if file.Encoding == "base64" {
+		decoded, err := base64.StdEncoding.DecodeString(file.Content)
+		if err != nil {
+			return File{}, err
+		}
+		content = string(decoded)
+	}
+
+	return File{
+		Content: content,
+		URL:     fmt.Sprintf("%s/%s/%s/-/blob/%s/%s", gl.baseURL, req.Owner, req.Repo, req.Ref, req.Path),
+	}, nil
+}
@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+func Test_GitlabClient(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		switch r.URL.EscapedPath() {
+		case "/api/v4/projects/group%2Fsubgroup%2Frepo/repository/files/pkg%2Fmain.go":
+			require.Equal(t, "main", r.URL.Query().Get("ref"))
+			_, _ = w.Write([]byte(`{"content":"cGFja2FnZSBtYWlu","encoding":"base64"}`))
+		case "/api/v4/projects/group%2Fsubgroup%2Frepo/repository/commits/main":
+			_, _ = w.Write([]byte(`{"id":"abc","message":"fix","author_name":"john","authored_date":"2024-01-02T03:04:05Z","web_url":"https://gitlab.com/group/subgroup/repo/-/commit/abc"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c, err := GitlabClient(context.Background(), &oauth2.Token{AccessToken: "token"}, server.URL+"/", server.Client())
+	require.NoError(t, err)
+
+	file, err := c.GetFile(context.Background(), FileRequest{Owner: "group/subgroup", Repo: "repo", Path: "pkg/main.go", Ref: "main"})
+	require.NoError(t, err)
+	require.Equal(t, "package main", file.Content)
+	require.Equal(t, server.URL+"/group/subgroup/repo/-/blob/main/pkg/main.go", file.URL)
+
+	commit, err := c.GetCommit(context.Background(), "group/subgroup", "repo", "main")
+	require.NoError(t, err)
+	require.Equal(t, "abc", commit.Sha)
+	require.Equal(t, "fix", commit.Message)
+	require.Equal(t, "john", commit.Author.Login)
+	require.Equal(t, "2024-01-02T03:04:05Z", commit.Date)
+
+	_, err = c.GetFile(context.Background(), FileRequest{Owner: "group/subgroup", Repo: "repo", Path: "missing.go", Ref: "main"})
+	require.ErrorIs(t, err, ErrNotFound)
+}
@@ -27,6 +27,40 @@ var (
 		// Refresh auth token.
 		// https://docs.github.com/en/apps/creating-github-apps/authenticating-with-a-github-app/refreshing-user-access-tokens#refreshing-a-user-access-token-with-a-refresh-token
 		"/login/oauth/access_token": regexp.MustCompile(`^\/login\/oauth\/access_token$`),
+
+		// Get a repository file (GitLab).
+		// https://docs.gitlab.com/ee/api/repository_files.html#get-file-from-repository
+		"/api/v4/projects/{id}/repository/files/{path}": regexp.MustCompile(`^\/api\/v4\/projects\/\S+\/repository\/files\/\S+$`),
+
+		// Get a commit (GitLab).
+		// https://docs.gitlab.com/ee/api/commits.html#get-a-single-commit
+		"/api/v4/projects/{id}/repository/commits/{ref}": regexp.MustCompile(`^\/api\/v4\/projects\/\S+\/repository\/commits\/\S+$`),
+
+		// Refresh auth token (GitLab).
+		// https://docs.gitlab.com/ee/api/oauth2.html
+		"/oauth/token": regexp.MustCompile(`^\/oauth\/token$`),
+
+		// Get repository contents (Gitea).
+		"/api/v1/repos/{owner}/{repo}/contents/{path}": regexp.MustCompile(`^\/api\/v1\/repos\/[^\/\s]+\/[^\/\s]+\/contents\/\S+$`),
+
+		// Get a commit (Gitea).
+		"/api/v1/repos/{owner}/{repo}/git/commits/{ref}": regexp.MustCompile(`^\/api\/v1\/repos\/[^\/\s]+\/[^\/\s]+\/git\/commits\/\S+$`),
+
+		// Get a repository (Bitbucket).
+		// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-repositories/#api-repositories-workspace-repo-slug-get
+		"/2.0/repositories/{workspace}/{repo}": regexp.MustCompile(`^\/2\.0\/repositories\/[^\/\s]+\/[^\/\s]+$`),
+
+		// Get file contents (Bitbucket).
+		// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-source/#api-repositories-workspace-repo-slug-src-commit-path-get
+		"/2.0/repositories/{workspace}/{repo}/src/{ref}/{path}": regexp.MustCompile(`^\/2\.0\/repositories\/[^\/\s]+\/[^\/\s]+\/src\/\S+\/\S+$`),
+
+		// Get a commit (Bitbucket).
+		// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-commits/#api-repositories-workspace-repo-slug-commit-commit-get
+		"/2.0/repositories/{workspace}/{repo}/commit/{ref}": regexp.MustCompile(`^\/2\.0\/repositories\/[^\/\s]+\/[^\/\s]+\/commit\/\S+$`),
+
+		// Refresh auth token (Bitbucket).
+		// https://developer.atlassian.com/cloud/bitbucket/oauth-2/
+		"/site/oauth2/access_token": regexp.MustCompile(`^\/site\/oauth2\/access_token$`),
 	}
 )
 
@@ -35,7 +69,7 @@ func InstrumentedHTTPClient(logger log.Logger, reg prometheus.Registerer) *http.
 		prometheus.HistogramOpts{
 			Namespace: "pyroscope",
 			Name:      "vcs_github_request_duration",
-			Help:      "Duration of GitHub API requests in seconds",
+			Help:      "Duration of VCS provider API requests in seconds",
 			Buckets:   prometheus.ExponentialBucketsRange(0.1, 10, 8),
 		},
 		[]string{"method", "route", "status_code"},
@@ -49,8 +83,8 @@ func InstrumentedHTTPClient(logger log.Logger, reg prometheus.Registerer) *http.
 	return client
 }
 
-// withGitHubMetricsTransport wraps a transport with a client to track GitHub
-// API usage.
+// withGitHubMetricsTransport wraps a transport with a client to track VCS
+// provider API usage.
 func withGitHubMetricsTransport(logger log.Logger, hv *prometheus.HistogramVec) util.RoundTripperInstrumentFunc {
 	return func(next http.RoundTripper) http.RoundTripper {
 		return util.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
@@ -64,7 +98,7 @@ func withGitHubMetricsTransport(logger log.Logger, hv *prometheus.HistogramVec)
 			}
 
 			if route == "unknown_route" {
-				level.Warn(logger).Log("path", req.URL.Path, "msg", "unknown GitHub API route")
+				level.Warn(logger).Log("path", req.URL.Path, "msg", "unknown VCS API route")
 			}
 			hv.WithLabelValues(req.Method, route, statusCode).Observe(time.Since(start).Seconds())
 
@@ -57,6 +57,56 @@ func Test_matchGitHubAPIRoute(t *testing.T) {
 			Path: "/login/oauth/access_token",
 			Want: "/login/oauth/access_token",
 		},
+		{
+			Name: "GitLab GetFile",
+			Path: "/api/v4/projects/grafana/pyroscope/repository/files/pkg/querier/querier.go",
+			Want: "/api/v4/projects/{id}/repository/files/{path}",
+		},
+		{
+			Name: "GitLab GetCommit",
+			Path: "/api/v4/projects/group/subgroup/pyroscope/repository/commits/HEAD",
+			Want: "/api/v4/projects/{id}/repository/commits/{ref}",
+		},
+		{
+			Name: "GitLab Refresh",
+			Path: "/oauth/token",
+			Want: "/oauth/token",
+		},
+		{
+			Name: "Gitea GetContents",
+			Path: "/api/v1/repos/grafana/pyroscope/contents/pkg/querier/querier.go",
+			Want: "/api/v1/repos/{owner}/{repo}/contents/{path}",
+		},
+		{
+			Name: "Gitea GetCommit",
+			Path: "/api/v1/repos/grafana/pyroscope/git/commits/abcdef1234567890",
+			Want: "/api/v1/repos/{owner}/{repo}/git/commits/{ref}",
+		},
+		{
+			Name: "Bitbucket GetRepository",
+			Path: "/2.0/repositories/grafana/pyroscope",
+			Want: "/2.0/repositories/{workspace}/{repo}",
+		},
+		{
+			Name: "Bitbucket GetFile",
+			Path: "/2.0/repositories/grafana/pyroscope/src/main/pkg/querier/querier.go",
+			Want: "/2.0/repositories/{workspace}/{repo}/src/{ref}/{path}",
+		},
+		{
+			Name: "Bitbucket GetFile in commit directory",
+			Path: "/2.0/repositories/grafana/pyroscope/src/main/commit/querier.go",
+			Want: "/2.0/repositories/{workspace}/{repo}/src/{ref}/{path}",
+		},
+		{
+			Name: "Bitbucket GetCommit",
+			Path: "/2.0/repositories/grafana/pyroscope/commit/abcdef1234567890",
+			Want: "/2.0/repositories/{workspace}/{repo}/commit/{ref}",
+		},
+		{
+			Name: "Bitbucket Refresh",
+			Path: "/site/oauth2/access_token",
+			Want: "/site/oauth2/access_token",
+		},
 		{
 			Name: "empty path",
 			Path: "",
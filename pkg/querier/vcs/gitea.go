@@ -0,0 +1,41 @@
+package vcs
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"strings"
+
+	"golang.org/x/oauth2"
+
+	vcsv1 "github.com/grafana/pyroscope/api/gen/proto/go/vcs/v1"
+	"github.com/grafana/pyroscope/pkg/querier/vcs/client"
+)
+
+var (
+	giteaURL             = os.Getenv("GITEA_URL")
+	giteaAppClientID     = os.Getenv("GITEA_CLIENT_ID")
+	giteaAppClientSecret = os.Getenv("GITEA_CLIENT_SECRET")
+)
+
+// newGiteaProvider returns the provider of the Gitea instance set
+// with GITEA_URL. Gitea is always self-hosted: if the URL is not set,
+// no repository is served by the provider.
+func newGiteaProvider() *provider {
+	baseURL := strings.TrimSuffix(giteaURL, "/")
+	return &provider{
+		kind:         vcsv1.Provider_PROVIDER_GITEA,
+		name:         "Gitea",
+		envPrefix:    "GITEA",
+		baseURL:      baseURL,
+		clientID:     giteaAppClientID,
+		clientSecret: giteaAppClientSecret,
+		endpoint: oauth2.Endpoint{
+			AuthURL:  baseURL + "/login/oauth/authorize",
+			TokenURL: baseURL + "/login/oauth/access_token",
+		},
+		newClient: func(ctx context.Context, token *oauth2.Token, c *http.Client) (vcsClient, error) {
+			return client.GiteaClient(ctx, token, baseURL, c)
+		},
+	}
+}
@@ -2,7 +2,6 @@ package vcs
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -12,6 +11,9 @@ import (
 
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/endpoints"
+
+	vcsv1 "github.com/grafana/pyroscope/api/gen/proto/go/vcs/v1"
+	"github.com/grafana/pyroscope/pkg/querier/vcs/client"
 )
 
 const (
@@ -49,19 +51,34 @@ func (t githubAuthToken) toOAuthToken() *oauth2.Token {
 	}
 }
 
-// githubOAuthConfig creates a GitHub OAuth config.
-func githubOAuthConfig() (*oauth2.Config, error) {
-	if githubAppClientID == "" {
-		return nil, fmt.Errorf("missing GITHUB_CLIENT_ID environment variable")
+func newGithubProvider() *provider {
+	return &provider{
+		kind:         vcsv1.Provider_PROVIDER_GITHUB,
+		name:         "GitHub",
+		envPrefix:    "GITHUB",
+		baseURL:      "https://github.com",
+		clientID:     githubAppClientID,
+		clientSecret: githubAppClientSecret,
+		endpoint:     endpoints.GitHub,
+		refresh:      refreshGithubOAuthToken,
+		newClient: func(ctx context.Context, token *oauth2.Token, c *http.Client) (vcsClient, error) {
+			return client.GithubClient(ctx, token, c)
+		},
 	}
-	if githubAppClientSecret == "" {
-		return nil, fmt.Errorf("missing GITHUB_CLIENT_SECRET environment variable")
+}
+
+// refreshGithubOAuthToken refreshes the token with the GitHub API: the
+// refresh token expiry is not returned in the standard token response.
+func refreshGithubOAuthToken(ctx context.Context, token *oauth2.Token, client *http.Client) (*oauth2.Token, error) {
+	req, err := buildGithubRefreshRequest(ctx, token)
+	if err != nil {
+		return nil, err
 	}
-	return &oauth2.Config{
-		ClientID:     githubAppClientID,
-		ClientSecret: githubAppClientSecret,
-		Endpoint:     endpoints.GitHub,
-	}, nil
+	githubToken, err := refreshGithubToken(req, client)
+	if err != nil {
+		return nil, err
+	}
+	return githubToken.toOAuthToken(), nil
 }
 
 // refreshGithubToken sends a request configured for the GitHub API and marshals
@@ -149,21 +166,3 @@ func githubAuthTokenFromFormURLEncoded(values url.Values) (*githubAuthToken, err
 
 	return token, nil
 }
-
-func isGitHubIntegrationConfigured() error {
-	var errs []error
-
-	if githubAppClientID == "" {
-		errs = append(errs, fmt.Errorf("missing GITHUB_CLIENT_ID environment variable"))
-	}
-
-	if githubAppClientSecret == "" {
-		errs = append(errs, fmt.Errorf("missing GITHUB_CLIENT_SECRET environment variable"))
-	}
-
-	if len(githubSessionSecret) == 0 {
-		errs = append(errs, fmt.Errorf("missing GITHUB_SESSION_SECRET environment variable"))
-	}
-
-	return errors.Join(errs...)
-}
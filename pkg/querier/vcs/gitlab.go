@@ -0,0 +1,46 @@
+package vcs
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"strings"
+
+	"golang.org/x/oauth2"
+
+	vcsv1 "github.com/grafana/pyroscope/api/gen/proto/go/vcs/v1"
+	"github.com/grafana/pyroscope/pkg/querier/vcs/client"
+)
+
+const defaultGitlabURL = "https://gitlab.com"
+
+var (
+	gitlabURL             = os.Getenv("GITLAB_URL")
+	gitlabAppClientID     = os.Getenv("GITLAB_CLIENT_ID")
+	gitlabAppClientSecret = os.Getenv("GITLAB_CLIENT_SECRET")
+)
+
+// newGitlabProvider returns the provider of gitlab.com, or of the
+// self-hosted instance, if GITLAB_URL is set.
+func newGitlabProvider() *provider {
+	baseURL := strings.TrimSuffix(gitlabURL, "/")
+	if baseURL == "" {
+		baseURL = defaultGitlabURL
+	}
+	return &provider{
+		kind:         vcsv1.Provider_PROVIDER_GITLAB,
+		name:         "GitLab",
+		envPrefix:    "GITLAB",
+		baseURL:      baseURL,
+		clientID:     gitlabAppClientID,
+		clientSecret: gitlabAppClientSecret,
+		endpoint: oauth2.Endpoint{
+			AuthURL:  baseURL + "/oauth/authorize",
+			TokenURL: baseURL + "/oauth/token",
+		},
+		subgroups: true,
+		newClient: func(ctx context.Context, token *oauth2.Token, c *http.Client) (vcsClient, error) {
+			return client.GitlabClient(ctx, token, baseURL, c)
+		},
+	}
+}
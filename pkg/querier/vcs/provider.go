@@ -0,0 +1,207 @@
+package vcs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"connectrpc.com/connect"
+	"golang.org/x/oauth2"
+
+	vcsv1 "github.com/grafana/pyroscope/api/gen/proto/go/vcs/v1"
+	"github.com/grafana/pyroscope/pkg/querier/vcs/source"
+)
+
+// vcsClient is the API client of a provider.
+type vcsClient interface {
+	source.VCSClient
+	GetCommit(ctx context.Context, owner, repo, ref string) (*vcsv1.GetCommitResponse, error)
+}
+
+// provider is a VCS provider hosting repositories, e.g. GitHub or a
+// self-hosted GitLab instance. Users authorize access to the provider
+// with the OAuth 2.0 authorization code flow.
+type provider struct {
+	kind vcsv1.Provider
+	name string
+	// envPrefix is the prefix of the environment variables
+	// the provider is configured with, e.g. GITHUB.
+	envPrefix string
+	// baseURL is the URL of the provider instance. Repositories hosted
+	// on the instance are served by the provider.
+	baseURL      string
+	clientID     string
+	clientSecret string
+	endpoint     oauth2.Endpoint
+	// subgroups indicates that the repository owner may include
+	// nested groups, e.g. gitlab.com/group/subgroup/repo.
+	subgroups bool
+
+	// refresh refreshes the OAuth token. If not set, the standard
+	// refresh token grant is used.
+	refresh   func(ctx context.Context, token *oauth2.Token, client *http.Client) (*oauth2.Token, error)
+	newClient func(ctx context.Context, token *oauth2.Token, client *http.Client) (vcsClient, error)
+}
+
+func (p *provider) String() string { return p.name }
+
+// host returns the host name of the provider instance.
+func (p *provider) host() string {
+	u, err := url.Parse(p.baseURL)
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(u.Hostname())
+}
+
+// cookieName returns the name of the session cookie of the provider.
+// The GitHub session is stored in the default cookie for compatibility.
+func (p *provider) cookieName() string {
+	if p.kind == vcsv1.Provider_PROVIDER_GITHUB {
+		return sessionCookieName
+	}
+	return sessionCookieName + "-" + strings.ToLower(p.envPrefix)
+}
+
+// isConfigured returns an error if the provider integration is not configured.
+func (p *provider) isConfigured() error {
+	var errs []error
+
+	if p.baseURL == "" {
+		errs = append(errs, fmt.Errorf("missing %s_URL environment variable", p.envPrefix))
+	}
+
+	if p.clientID == "" {
+		errs = append(errs, fmt.Errorf("missing %s_CLIENT_ID environment variable", p.envPrefix))
+	}
+
+	if p.clientSecret == "" {
+		errs = append(errs, fmt.Errorf("missing %s_CLIENT_SECRET environment variable", p.envPrefix))
+	}
+
+	if len(sessionSecret) == 0 {
+		errs = append(errs, fmt.Errorf("missing %s environment variable", envVarSessionSecret))
+	}
+
+	return errors.Join(errs...)
+}
+
+// oauthConfig creates the OAuth config of the provider.
+func (p *provider) oauthConfig(redirectURI string) (*oauth2.Config, error) {
+	if err := p.isConfigured(); err != nil {
+		return nil, err
+	}
+	return &oauth2.Config{
+		ClientID:     p.clientID,
+		ClientSecret: p.clientSecret,
+		Endpoint:     p.endpoint,
+		RedirectURL:  redirectURI,
+	}, nil
+}
+
+// refreshToken exchanges the refresh token for a new token.
+func (p *provider) refreshToken(ctx context.Context, token *oauth2.Token, client *http.Client) (*oauth2.Token, error) {
+	if p.refresh != nil {
+		return p.refresh(ctx, token, client)
+	}
+	cfg, err := p.oauthConfig("")
+	if err != nil {
+		return nil, err
+	}
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, client)
+	// The token source only refreshes expired tokens.
+	return cfg.TokenSource(ctx, &oauth2.Token{RefreshToken: token.RefreshToken}).Token()
+}
+
+// repository is a repository hosted by a provider.
+type repository struct {
+	host, owner, name string
+}
+
+func (r *repository) GetHostName() string  { return r.host }
+func (r *repository) GetOwnerName() string { return r.owner }
+func (r *repository) GetRepoName() string  { return r.name }
+
+// parseRepositoryURL parses the repository URL and selects the provider
+// hosting the repository by the URL host. Both the HTTP URLs, such as
+// https://github.com/grafana/pyroscope, and the SSH URLs, such as
+// git@github.com:grafana/pyroscope.git, are supported.
+func parseRepositoryURL(providers []*provider, rawURL string) (*repository, *provider, error) {
+	host, path, err := splitRepositoryURL(rawURL)
+	if err != nil {
+		return nil, nil, err
+	}
+	var p *provider
+	for _, c := range providers {
+		if h := c.host(); h != "" && h == host {
+			p = c
+			break
+		}
+	}
+	if p == nil {
+		return nil, nil, fmt.Errorf("repository host %q is not supported", host)
+	}
+
+	segments := strings.FieldsFunc(path, func(r rune) bool { return r == '/' })
+	n := 2
+	if p.subgroups {
+		// GitLab separates the repository path from the
+		// resource path, e.g. /-/blob/main/README.md.
+		n = len(segments)
+		for i, s := range segments {
+			if s == "-" {
+				n = i
+				break
+			}
+		}
+	}
+	if n < 2 || len(segments) < n {
+		return nil, nil, fmt.Errorf("expecting <owner>/<repo> in repository URL, received: %q", rawURL)
+	}
+	return &repository{
+		host:  host,
+		owner: strings.Join(segments[:n-1], "/"),
+		name:  strings.TrimSuffix(segments[n-1], ".git"),
+	}, p, nil
+}
+
+func splitRepositoryURL(rawURL string) (host, path string, err error) {
+	if !strings.Contains(rawURL, "://") {
+		// SCP-like SSH URL: [user@]host:path.
+		if at := strings.Index(rawURL, "@"); at >= 0 {
+			rawURL = rawURL[at+1:]
+		}
+		host, path, ok := strings.Cut(rawURL, ":")
+		if !ok || host == "" {
+			return "", "", fmt.Errorf("invalid repository URL %q", rawURL)
+		}
+		return strings.ToLower(host), path, nil
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", "", err
+	}
+	return strings.ToLower(u.Hostname()), u.Path, nil
+}
+
+// providerByKind returns the provider of the given kind.
+func providerByKind(providers []*provider, kind vcsv1.Provider) *provider {
+	for _, p := range providers {
+		if p.kind == kind {
+			return p
+		}
+	}
+	return nil
+}
+
+// repositoryFromRequest parses the repository URL of the request.
+func (q *Service) repositoryFromRequest(rawURL string) (*repository, *provider, error) {
+	repo, p, err := parseRepositoryURL(q.providers, rawURL)
+	if err != nil {
+		return nil, nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return repo, p, nil
+}
@@ -0,0 +1,96 @@
+package vcs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	vcsv1 "github.com/grafana/pyroscope/api/gen/proto/go/vcs/v1"
+)
+
+func Test_parseRepositoryURL(t *testing.T) {
+	providers := []*provider{
+		{kind: vcsv1.Provider_PROVIDER_GITHUB, baseURL: "https://github.com"},
+		{kind: vcsv1.Provider_PROVIDER_GITLAB, baseURL: "https://gitlab.example.com", subgroups: true},
+		{kind: vcsv1.Provider_PROVIDER_GITEA, baseURL: ""},
+		{kind: vcsv1.Provider_PROVIDER_BITBUCKET, baseURL: "https://bitbucket.org"},
+	}
+
+	tests := []struct {
+		Name     string
+		URL      string
+		Provider vcsv1.Provider
+		Want     *repository
+		WantErr  bool
+	}{
+		{
+			Name:     "GitHub HTTPS",
+			URL:      "https://github.com/grafana/pyroscope",
+			Provider: vcsv1.Provider_PROVIDER_GITHUB,
+			Want:     &repository{host: "github.com", owner: "grafana", name: "pyroscope"},
+		},
+		{
+			Name:     "GitHub SSH",
+			URL:      "git@github.com:grafana/pyroscope.git",
+			Provider: vcsv1.Provider_PROVIDER_GITHUB,
+			Want:     &repository{host: "github.com", owner: "grafana", name: "pyroscope"},
+		},
+		{
+			Name:     "GitHub with resource path",
+			URL:      "https://github.com/grafana/pyroscope/blob/main/go.mod",
+			Provider: vcsv1.Provider_PROVIDER_GITHUB,
+			Want:     &repository{host: "github.com", owner: "grafana", name: "pyroscope"},
+		},
+		{
+			Name:     "GitLab subgroups",
+			URL:      "https://gitlab.example.com/group/subgroup/repo.git",
+			Provider: vcsv1.Provider_PROVIDER_GITLAB,
+			Want:     &repository{host: "gitlab.example.com", owner: "group/subgroup", name: "repo"},
+		},
+		{
+			Name:     "GitLab with resource path",
+			URL:      "https://gitlab.example.com/group/subgroup/repo/-/blob/main/README.md",
+			Provider: vcsv1.Provider_PROVIDER_GITLAB,
+			Want:     &repository{host: "gitlab.example.com", owner: "group/subgroup", name: "repo"},
+		},
+		{
+			Name:     "Bitbucket SSH",
+			URL:      "git@bitbucket.org:workspace/repo.git",
+			Provider: vcsv1.Provider_PROVIDER_BITBUCKET,
+			Want:     &repository{host: "bitbucket.org", owner: "workspace", name: "repo"},
+		},
+		{
+			Name:    "unknown host",
+			URL:     "https://example.com/grafana/pyroscope",
+			WantErr: true,
+		},
+		{
+			Name:    "missing repository",
+			URL:     "https://github.com/grafana",
+			WantErr: true,
+		},
+		{
+			Name:    "invalid SSH URL",
+			URL:     "github.com/grafana/pyroscope",
+			WantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			repo, p, err := parseRepositoryURL(providers, tt.URL)
+			if tt.WantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.Provider, p.kind)
+			require.Equal(t, tt.Want, repo)
+		})
+	}
+}
+
+func Test_provider_cookieName(t *testing.T) {
+	require.Equal(t, sessionCookieName, newGithubProvider().cookieName())
+	require.Equal(t, sessionCookieName+"-gitlab", newGitlabProvider().cookieName())
+}
@@ -5,12 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"os"
+	"strings"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/go-kit/log"
-	giturl "github.com/kubescape/go-git-url"
-	"github.com/kubescape/go-git-url/apis"
 	"github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/oauth2"
 
@@ -22,9 +22,15 @@ import (
 
 var _ vcsv1connect.VCSServiceHandler = (*Service)(nil)
 
+// javaSourceRoots is a comma separated list of the repository directories
+// Java source files are searched in, e.g. "src/main/java,service/src/main/java".
+var javaSourceRoots = os.Getenv("JAVA_SOURCE_ROOTS")
+
 type Service struct {
-	logger     log.Logger
-	httpClient *http.Client
+	logger          log.Logger
+	httpClient      *http.Client
+	providers       []*provider
+	javaSourceRoots []string
 }
 
 func New(logger log.Logger, reg prometheus.Registerer) *Service {
@@ -33,126 +39,173 @@ func New(logger log.Logger, reg prometheus.Registerer) *Service {
 	return &Service{
 		logger:     logger,
 		httpClient: httpClient,
+		providers: []*provider{
+			newGithubProvider(),
+			newGitlabProvider(),
+			newGiteaProvider(),
+			newBitbucketProvider(),
+		},
+		javaSourceRoots: splitSourceRoots(javaSourceRoots),
 	}
 }
 
-func (q *Service) GithubApp(ctx context.Context, req *connect.Request[vcsv1.GithubAppRequest]) (*connect.Response[vcsv1.GithubAppResponse], error) {
-	err := isGitHubIntegrationConfigured()
-	if err != nil {
-		q.logger.Log("err", err, "msg", "GitHub integration is not configured")
-		return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("GitHub integration is not configured"))
+func splitSourceRoots(s string) []string {
+	var roots []string
+	for _, root := range strings.Split(s, ",") {
+		if root = strings.TrimSpace(root); root != "" {
+			roots = append(roots, root)
+		}
 	}
-
-	return connect.NewResponse(&vcsv1.GithubAppResponse{
-		ClientID: githubAppClientID,
-	}), nil
+	return roots
 }
 
-func (q *Service) GithubLogin(ctx context.Context, req *connect.Request[vcsv1.GithubLoginRequest]) (*connect.Response[vcsv1.GithubLoginResponse], error) {
-	cfg, err := githubOAuthConfig()
+func (q *Service) App(ctx context.Context, req *connect.Request[vcsv1.AppRequest]) (*connect.Response[vcsv1.AppResponse], error) {
+	_, p, err := q.repositoryFromRequest(req.Msg.RepositoryURL)
+	if err != nil {
+		return nil, err
+	}
+	res, err := q.app(p)
 	if err != nil {
-		q.logger.Log("err", err, "msg", "failed to get GitHub OAuth config")
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to authorize with GitHub"))
+		return nil, err
 	}
+	return connect.NewResponse(res), nil
+}
 
-	encryptionKey, err := deriveEncryptionKeyForContext(ctx)
+func (q *Service) Login(ctx context.Context, req *connect.Request[vcsv1.LoginRequest]) (*connect.Response[vcsv1.LoginResponse], error) {
+	_, p, err := q.repositoryFromRequest(req.Msg.RepositoryURL)
 	if err != nil {
-		q.logger.Log("err", err, "msg", "failed to derive encryption key")
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to authorize with GitHub"))
+		return nil, err
 	}
+	cookie, err := q.login(ctx, p, req.Msg.AuthorizationCode, req.Msg.RedirectURI)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&vcsv1.LoginResponse{Cookie: cookie}), nil
+}
 
-	token, err := cfg.Exchange(ctx, req.Msg.AuthorizationCode)
+func (q *Service) Refresh(ctx context.Context, req *connect.Request[vcsv1.RefreshRequest]) (*connect.Response[vcsv1.RefreshResponse], error) {
+	_, p, err := q.repositoryFromRequest(req.Msg.RepositoryURL)
 	if err != nil {
-		q.logger.Log("err", err, "msg", "failed to exchange authorization code with GitHub")
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize with GitHub"))
+		return nil, err
 	}
+	cookie, err := q.refresh(ctx, req, p)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&vcsv1.RefreshResponse{Cookie: cookie}), nil
+}
 
-	cookie, err := encodeToken(token, encryptionKey)
+func (q *Service) GithubApp(ctx context.Context, req *connect.Request[vcsv1.GithubAppRequest]) (*connect.Response[vcsv1.GithubAppResponse], error) {
+	res, err := q.app(providerByKind(q.providers, vcsv1.Provider_PROVIDER_GITHUB))
 	if err != nil {
-		q.logger.Log("err", err, "msg", "failed to encode GitHub OAuth token")
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to authorize with GitHub"))
+		return nil, err
 	}
+	return connect.NewResponse(&vcsv1.GithubAppResponse{
+		ClientID: res.ClientID,
+	}), nil
+}
 
-	res := &vcsv1.GithubLoginResponse{
-		Cookie: cookie.String(),
+func (q *Service) GithubLogin(ctx context.Context, req *connect.Request[vcsv1.GithubLoginRequest]) (*connect.Response[vcsv1.GithubLoginResponse], error) {
+	cookie, err := q.login(ctx, providerByKind(q.providers, vcsv1.Provider_PROVIDER_GITHUB), req.Msg.AuthorizationCode, "")
+	if err != nil {
+		return nil, err
 	}
-	return connect.NewResponse(res), nil
+	return connect.NewResponse(&vcsv1.GithubLoginResponse{Cookie: cookie}), nil
 }
 
 func (q *Service) GithubRefresh(ctx context.Context, req *connect.Request[vcsv1.GithubRefreshRequest]) (*connect.Response[vcsv1.GithubRefreshResponse], error) {
-	token, err := tokenFromRequest(ctx, req)
+	cookie, err := q.refresh(ctx, req, providerByKind(q.providers, vcsv1.Provider_PROVIDER_GITHUB))
 	if err != nil {
-		q.logger.Log("err", err, "msg", "failed to extract token from request")
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("invalid token"))
+		return nil, err
 	}
+	return connect.NewResponse(&vcsv1.GithubRefreshResponse{Cookie: cookie}), nil
+}
 
-	githubRequest, err := buildGithubRefreshRequest(ctx, token)
+func (q *Service) app(p *provider) (*vcsv1.AppResponse, error) {
+	err := p.isConfigured()
 	if err != nil {
-		q.logger.Log("err", err, "msg", "failed to extract token from request")
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to refresh token"))
+		q.logger.Log("err", err, "msg", fmt.Sprintf("%s integration is not configured", p))
+		return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("%s integration is not configured", p))
 	}
 
-	githubToken, err := refreshGithubToken(githubRequest, q.httpClient)
+	return &vcsv1.AppResponse{
+		Provider:     p.kind,
+		ClientID:     p.clientID,
+		AuthorizeURL: p.endpoint.AuthURL,
+		CookieName:   p.cookieName(),
+	}, nil
+}
+
+func (q *Service) login(ctx context.Context, p *provider, code, redirectURI string) (string, error) {
+	cfg, err := p.oauthConfig(redirectURI)
 	if err != nil {
-		q.logger.Log("err", err, "msg", "failed to refresh token with GitHub")
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to refresh token"))
+		q.logger.Log("err", err, "msg", fmt.Sprintf("failed to get %s OAuth config", p))
+		return "", connect.NewError(connect.CodeInternal, fmt.Errorf("failed to authorize with %s", p))
 	}
 
-	newToken := githubToken.toOAuthToken()
-
-	derivedKey, err := deriveEncryptionKeyForContext(ctx)
+	encryptionKey, err := deriveEncryptionKeyForContext(ctx)
 	if err != nil {
 		q.logger.Log("err", err, "msg", "failed to derive encryption key")
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to process token"))
+		return "", connect.NewError(connect.CodeInternal, fmt.Errorf("failed to authorize with %s", p))
 	}
 
-	cookie, err := encodeToken(newToken, derivedKey)
+	token, err := cfg.Exchange(context.WithValue(ctx, oauth2.HTTPClient, q.httpClient), code)
 	if err != nil {
-		q.logger.Log("err", err, "msg", "failed to encode GitHub OAuth token")
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to refresh token"))
+		q.logger.Log("err", err, "msg", fmt.Sprintf("failed to exchange authorization code with %s", p))
+		return "", connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to authorize with %s", p))
 	}
 
-	res := &vcsv1.GithubRefreshResponse{
-		Cookie: cookie.String(),
+	cookie, err := encodeTokenCookie(p.cookieName(), token, encryptionKey)
+	if err != nil {
+		q.logger.Log("err", err, "msg", fmt.Sprintf("failed to encode %s OAuth token", p))
+		return "", connect.NewError(connect.CodeInternal, fmt.Errorf("failed to authorize with %s", p))
 	}
-	return connect.NewResponse(res), nil
+
+	return cookie.String(), nil
 }
 
-func (q *Service) GetFile(ctx context.Context, req *connect.Request[vcsv1.GetFileRequest]) (*connect.Response[vcsv1.GetFileResponse], error) {
-	token, err := tokenFromRequest(ctx, req)
+func (q *Service) refresh(ctx context.Context, req connect.AnyRequest, p *provider) (string, error) {
+	token, err := tokenFromCookie(ctx, req, p.cookieName())
 	if err != nil {
 		q.logger.Log("err", err, "msg", "failed to extract token from request")
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("invalid token"))
+		return "", connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("invalid token"))
 	}
 
-	err = rejectExpiredToken(token)
+	newToken, err := p.refreshToken(ctx, token, q.httpClient)
 	if err != nil {
-		return nil, err
+		q.logger.Log("err", err, "msg", fmt.Sprintf("failed to refresh token with %s", p))
+		return "", connect.NewError(connect.CodeInternal, fmt.Errorf("failed to refresh token"))
 	}
 
-	// initialize and parse the git repo URL
-	gitURL, err := giturl.NewGitURL(req.Msg.RepositoryURL)
+	derivedKey, err := deriveEncryptionKeyForContext(ctx)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		q.logger.Log("err", err, "msg", "failed to derive encryption key")
+		return "", connect.NewError(connect.CodeInternal, fmt.Errorf("failed to process token"))
 	}
 
-	if gitURL.GetProvider() != apis.ProviderGitHub.String() {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("only GitHub repositories are supported"))
+	cookie, err := encodeTokenCookie(p.cookieName(), newToken, derivedKey)
+	if err != nil {
+		q.logger.Log("err", err, "msg", fmt.Sprintf("failed to encode %s OAuth token", p))
+		return "", connect.NewError(connect.CodeInternal, fmt.Errorf("failed to refresh token"))
 	}
 
-	// todo: we can support multiple provider: bitbucket, gitlab, etc.
-	ghClient, err := client.GithubClient(ctx, token, q.httpClient)
+	return cookie.String(), nil
+}
+
+func (q *Service) GetFile(ctx context.Context, req *connect.Request[vcsv1.GetFileRequest]) (*connect.Response[vcsv1.GetFileResponse], error) {
+	repo, vcsClient, err := q.clientFromRequest(ctx, req, req.Msg.RepositoryURL)
 	if err != nil {
 		return nil, err
 	}
 
 	file, err := source.NewFileFinder(
-		ghClient,
-		gitURL,
+		vcsClient,
+		repo,
 		req.Msg.LocalPath,
 		req.Msg.Ref,
 		http.DefaultClient,
-		log.With(q.logger, "repo", gitURL.GetRepoName()),
+		log.With(q.logger, "repo", repo.GetRepoName()),
+		source.WithJavaSourceRoots(q.javaSourceRoots),
 	).Find(ctx)
 	if err != nil {
 		if errors.Is(err, client.ErrNotFound) {
@@ -164,36 +217,43 @@ func (q *Service) GetFile(ctx context.Context, req *connect.Request[vcsv1.GetFil
 }
 
 func (q *Service) GetCommit(ctx context.Context, req *connect.Request[vcsv1.GetCommitRequest]) (*connect.Response[vcsv1.GetCommitResponse], error) {
-	token, err := tokenFromRequest(ctx, req)
+	repo, vcsClient, err := q.clientFromRequest(ctx, req, req.Msg.RepositoryURL)
 	if err != nil {
-		q.logger.Log("err", err, "msg", "failed to extract token from request")
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("invalid token"))
+		return nil, err
 	}
 
-	err = rejectExpiredToken(token)
+	commit, err := vcsClient.GetCommit(ctx, repo.GetOwnerName(), repo.GetRepoName(), req.Msg.Ref)
 	if err != nil {
 		return nil, err
 	}
+	return connect.NewResponse(commit), nil
+}
 
-	gitURL, err := giturl.NewGitURL(req.Msg.RepositoryURL)
+// clientFromRequest returns the repository and the API client of the
+// provider hosting it, authorized with the session token of the request.
+func (q *Service) clientFromRequest(ctx context.Context, req connect.AnyRequest, repositoryURL string) (*repository, vcsClient, error) {
+	// initialize and parse the git repo URL
+	repo, p, err := q.repositoryFromRequest(repositoryURL)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, nil, err
 	}
 
-	if gitURL.GetProvider() != apis.ProviderGitHub.String() {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("only GitHub repositories are supported"))
+	token, err := tokenFromCookie(ctx, req, p.cookieName())
+	if err != nil {
+		q.logger.Log("err", err, "msg", "failed to extract token from request")
+		return nil, nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("invalid token"))
 	}
 
-	ghClient, err := client.GithubClient(ctx, token, q.httpClient)
+	err = rejectExpiredToken(token)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	commit, err := ghClient.GetCommit(ctx, gitURL.GetOwnerName(), gitURL.GetRepoName(), req.Msg.Ref)
+	vcsClient, err := p.newClient(ctx, token, q.httpClient)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return connect.NewResponse(commit), nil
+	return repo, vcsClient, nil
 }
 
 func rejectExpiredToken(token *oauth2.Token) error {
@@ -3,10 +3,12 @@ package source
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"path/filepath"
+	"strings"
 
 	"connectrpc.com/connect"
 	"github.com/go-kit/log"
@@ -14,28 +16,51 @@ import (
 
 	vcsv1 "github.com/grafana/pyroscope/api/gen/proto/go/vcs/v1"
 	"github.com/grafana/pyroscope/pkg/querier/vcs/client"
+	"github.com/grafana/pyroscope/pkg/querier/vcs/source/java"
 )
 
 type VCSClient interface {
 	GetFile(ctx context.Context, req client.FileRequest) (client.File, error)
 }
 
+// Repository identifies a repository hosted by a VCS provider.
+type Repository interface {
+	GetHostName() string
+	GetOwnerName() string
+	GetRepoName() string
+}
+
+const githubHost = "github.com"
+
 // FileFinder finds a file in a vcs repository.
 type FileFinder struct {
 	path, ref string
-	repo      giturl.IGitURL
+	repo      Repository
 
 	client     VCSClient
 	httpClient *http.Client
 	logger     log.Logger
+
+	javaSourceRoots []string
+}
+
+type FileFinderOption func(*FileFinder)
+
+// WithJavaSourceRoots sets the directories of the repository Java source
+// files are searched in, e.g. src/main/java. If not set, the default
+// Maven and Gradle layouts are searched.
+func WithJavaSourceRoots(roots []string) FileFinderOption {
+	return func(ff *FileFinder) {
+		ff.javaSourceRoots = roots
+	}
 }
 
 // NewFileFinder returns a new FileFinder.
-func NewFileFinder(client VCSClient, repo giturl.IGitURL, path, ref string, httpClient *http.Client, logger log.Logger) *FileFinder {
+func NewFileFinder(client VCSClient, repo Repository, path, ref string, httpClient *http.Client, logger log.Logger, opts ...FileFinderOption) *FileFinder {
 	if ref == "" {
 		ref = "HEAD"
 	}
-	return &FileFinder{
+	ff := &FileFinder{
 		client:     client,
 		logger:     logger,
 		repo:       repo,
@@ -43,6 +68,10 @@ func NewFileFinder(client VCSClient, repo giturl.IGitURL, path, ref string, http
 		ref:        ref,
 		httpClient: httpClient,
 	}
+	for _, opt := range opts {
+		opt(ff)
+	}
+	return ff
 }
 
 // Find returns the file content and URL.
@@ -50,8 +79,17 @@ func (ff FileFinder) Find(ctx context.Context) (*vcsv1.GetFileResponse, error) {
 	switch filepath.Ext(ff.path) {
 	case ExtGo:
 		return ff.findGoFile(ctx)
-	// todo: add more languages support
+	case ExtPython:
+		return ff.findPythonFile(ctx)
+	case ExtJS, ExtMJS, ExtCJS, ExtTS:
+		return ff.findNodeFile(ctx)
+	case java.ExtJava, java.ExtKotlin:
+		return ff.findJavaFile(ctx)
 	default:
+		// Java frames refer to classes rather than files.
+		if java.IsClassFrame(ff.path) {
+			return ff.findJavaFile(ctx)
+		}
 		// by default we return the file content at the given path without any processing.
 		content, err := ff.fetchRepoFile(ctx, ff.path, ff.ref)
 		if err != nil {
@@ -75,6 +113,102 @@ func (arg FileFinder) fetchRepoFile(ctx context.Context, path, ref string) (*vcs
 	return newFileResponse(content.Content, content.URL)
 }
 
+// fetchDependencyFile fetches the file of a dependency hosted in the
+// repository with the given URL. The refs and the paths are tried in
+// order, until the file is found.
+func (ff FileFinder) fetchDependencyFile(ctx context.Context, repositoryURL string, refs, paths []string) (*vcsv1.GetFileResponse, error) {
+	repo, err := giturl.NewGitURL(repositoryURL)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("unsupported repository %s: %w", repositoryURL, err))
+	}
+	for _, ref := range refs {
+		for _, path := range paths {
+			file, err := ff.fetchRepositoryFile(ctx, repo, path, ref)
+			if isNotFound(err) {
+				continue
+			}
+			return file, err
+		}
+	}
+	return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("file %s not found in %s", ff.path, repositoryURL))
+}
+
+// dependencyRefs returns the refs the files of the given version of a
+// dependency may be found at: releases are usually tagged with the version,
+// with or without the "v" prefix. If the version is unknown, or not tagged,
+// the files are fetched from the default branch, and may not match the
+// installed version.
+func dependencyRefs(version string) []string {
+	if version == "" {
+		return []string{"HEAD"}
+	}
+	return []string{"v" + version, version, "HEAD"}
+}
+
+// fetchRepositoryFile fetches the file from the given repository. Repositories
+// of the provider of the configured repository are accessed with the VCS client,
+// while public GitHub repositories are accessed anonymously.
+func (ff FileFinder) fetchRepositoryFile(ctx context.Context, repo Repository, path, ref string) (*vcsv1.GetFileResponse, error) {
+	if strings.EqualFold(repo.GetHostName(), ff.repo.GetHostName()) {
+		content, err := ff.client.GetFile(ctx, client.FileRequest{
+			Owner: repo.GetOwnerName(),
+			Repo:  repo.GetRepoName(),
+			Path:  path,
+			Ref:   ref,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return newFileResponse(content.Content, content.URL)
+	}
+	if repo.GetHostName() == githubHost {
+		return ff.fetchURL(ctx, fmt.Sprintf("https://raw.githubusercontent.com/%s/%s/%s/%s", repo.GetOwnerName(), repo.GetRepoName(), ref, path), false)
+	}
+	return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("unsupported repository host %s", repo.GetHostName()))
+}
+
+// tryFindFile tries to find the file in the repo.
+// It tries to find the file in the repo by removing path segment after path segment.
+// maxAttempts is the maximum number of attempts to try to find the file in case the file path is very long.
+// For example, if the path is "github.com/grafana/grafana/pkg/infra/log/log.go", it will try to find the file at:
+// - github.com/grafana/grafana/pkg/infra/log/log.go
+// - grafana/grafana/pkg/infra/log/log.go
+// - pkg/infra/log/log.go
+// - infra/log/log.go
+// - log/log.go
+// - log.go
+func (ff FileFinder) tryFindFile(ctx context.Context, maxAttempts int) (*vcsv1.GetFileResponse, error) {
+	if maxAttempts <= 0 {
+		return nil, errors.New("invalid max attempts")
+	}
+	// Try to find the file in the repo.
+	path := strings.TrimPrefix(ff.path, strings.Join([]string{ff.repo.GetHostName(), ff.repo.GetOwnerName(), ff.repo.GetRepoName()}, "/"))
+	path = strings.TrimLeft(path, "/")
+	attempts := 0
+	for {
+		content, err := ff.client.GetFile(ctx, client.FileRequest{
+			Owner: ff.repo.GetOwnerName(),
+			Repo:  ff.repo.GetRepoName(),
+			Path:  path,
+			Ref:   ff.ref,
+		})
+		attempts++
+		if err != nil && errors.Is(err, client.ErrNotFound) && attempts < maxAttempts {
+			i := strings.Index(path, "/")
+			if i < 0 {
+				return nil, err
+			}
+			// remove the first path segment
+			path = path[i+1:]
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return newFileResponse(content.Content, content.URL)
+	}
+}
+
 // fetchURL fetches the file content from the given URL.
 func (ff FileFinder) fetchURL(ctx context.Context, url string, decodeBase64 bool) (*vcsv1.GetFileResponse, error) {
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
@@ -103,6 +237,10 @@ func (ff FileFinder) fetchURL(ctx context.Context, url string, decodeBase64 bool
 	return newFileResponse(string(decoded), url)
 }
 
+func isNotFound(err error) bool {
+	return errors.Is(err, client.ErrNotFound) || connect.CodeOf(err) == connect.CodeNotFound
+}
+
 func newFileResponse(content, url string) (*vcsv1.GetFileResponse, error) {
 	return &vcsv1.GetFileResponse{
 		Content: base64.StdEncoding.EncodeToString([]byte(content)),
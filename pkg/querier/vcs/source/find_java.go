@@ -0,0 +1,24 @@
+package source
+
+import (
+	"context"
+
+	vcsv1 "github.com/grafana/pyroscope/api/gen/proto/go/vcs/v1"
+	"github.com/grafana/pyroscope/pkg/querier/vcs/source/java"
+)
+
+// findJavaFile finds the source file of a Java or Kotlin class in a vcs
+// repository. The file is searched in each of the source roots, falling
+// back to the path as is.
+func (ff FileFinder) findJavaFile(ctx context.Context) (*vcsv1.GetFileResponse, error) {
+	if sourcePaths, ok := java.SourcePaths(ff.path); ok {
+		for _, path := range java.CandidatePaths(sourcePaths, ff.javaSourceRoots) {
+			file, err := ff.fetchRepoFile(ctx, path, ff.ref)
+			if isNotFound(err) {
+				continue
+			}
+			return file, err
+		}
+	}
+	return ff.fetchRepoFile(ctx, ff.path, ff.ref)
+}
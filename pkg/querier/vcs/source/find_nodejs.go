@@ -0,0 +1,32 @@
+package source
+
+import (
+	"context"
+
+	vcsv1 "github.com/grafana/pyroscope/api/gen/proto/go/vcs/v1"
+	"github.com/grafana/pyroscope/pkg/querier/vcs/source/nodejs"
+)
+
+const (
+	ExtJS  = ".js"
+	ExtMJS = ".mjs"
+	ExtCJS = ".cjs"
+	ExtTS  = ".ts"
+)
+
+// findNodeFile finds a JavaScript or TypeScript file in a vcs repository.
+// Files of the installed packages are fetched from the source repositories
+// of the packages, at the installed version, if the path includes it:
+// otherwise, the files are fetched from the default branch. See
+// ParseNodeModulesPath.
+func (ff FileFinder) findNodeFile(ctx context.Context) (*vcsv1.GetFileResponse, error) {
+	pkg, ok := nodejs.ParseNodeModulesPath(ff.path)
+	if !ok {
+		return ff.tryFindFile(ctx, 30)
+	}
+	repo, err := pkg.Repository(ctx, ff.httpClient)
+	if err != nil {
+		return nil, err
+	}
+	return ff.fetchDependencyFile(ctx, repo.URL, dependencyRefs(pkg.Version), []string{repo.FilePath(pkg)})
+}
@@ -0,0 +1,28 @@
+package source
+
+import (
+	"context"
+
+	vcsv1 "github.com/grafana/pyroscope/api/gen/proto/go/vcs/v1"
+	"github.com/grafana/pyroscope/pkg/querier/vcs/source/python"
+)
+
+const (
+	ExtPython = ".py"
+)
+
+// findPythonFile finds a python file in a vcs repository. Files of the
+// installed packages are fetched from the source repositories of the
+// packages, at the installed version, if the path includes it: otherwise,
+// the files are fetched from the default branch. See ParseSitePackagesPath.
+func (ff FileFinder) findPythonFile(ctx context.Context) (*vcsv1.GetFileResponse, error) {
+	pkg, ok := python.ParseSitePackagesPath(ff.path)
+	if !ok {
+		return ff.tryFindFile(ctx, 30)
+	}
+	repositoryURL, err := pkg.RepositoryURL(ctx, ff.httpClient)
+	if err != nil {
+		return nil, err
+	}
+	return ff.fetchDependencyFile(ctx, repositoryURL, dependencyRefs(pkg.Version), pkg.CandidatePaths())
+}
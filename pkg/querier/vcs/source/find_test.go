@@ -0,0 +1,114 @@
+package source
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/pyroscope/pkg/querier/vcs/client"
+)
+
+type repositoryMock struct{}
+
+func (repositoryMock) GetHostName() string  { return "github.com" }
+func (repositoryMock) GetOwnerName() string { return "grafana" }
+func (repositoryMock) GetRepoName() string  { return "app" }
+
+// vcsClientMock serves the files keyed by owner/repo/path, or by
+// owner/repo/path@ref, if the file is only found at the ref.
+type vcsClientMock map[string]string
+
+func (c vcsClientMock) GetFile(_ context.Context, req client.FileRequest) (client.File, error) {
+	key := req.Owner + "/" + req.Repo + "/" + req.Path
+	if content, ok := c[key+"@"+req.Ref]; ok {
+		return client.File{Content: content, URL: "https://github.com/" + key + "@" + req.Ref}, nil
+	}
+	content, ok := c[key]
+	if !ok {
+		return client.File{}, client.ErrNotFound
+	}
+	return client.File{Content: content, URL: "https://github.com/" + key}, nil
+}
+
+type registryMock map[string]string
+
+func (r registryMock) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, ok := r[req.URL.String()]
+	if !ok {
+		return &http.Response{StatusCode: 404, Status: "404 Not Found", Body: io.NopCloser(strings.NewReader(""))}, nil
+	}
+	return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(body))}, nil
+}
+
+func TestFileFinder_Find(t *testing.T) {
+	vcsClient := vcsClientMock{
+		"grafana/app/service/src/main/java/com/example/Handler.java": "class Handler {}",
+		"grafana/app/src/main/kotlin/com/example/Worker.kt":          "class Worker",
+		"grafana/app/lib/Makefile":                                   "all:",
+		"grafana/app/service/handlers.py":                            "def handle(): pass",
+		"psf/requests/src/requests/sessions.py":                      "class Session: pass",
+		"grpc/grpc-node/packages/grpc-js/build/src/server.js":        "class Server {}",
+		"debug-js/debug/src/node.js@4.3.4":                           "module.exports = {}",
+	}
+	httpClient := &http.Client{Transport: registryMock{
+		"https://pypi.org/pypi/requests/json":               `{"info":{"project_urls":{"Source":"https://github.com/psf/requests"}}}`,
+		"https://registry.npmjs.org/@grpc%2Fgrpc-js/latest": `{"repository":{"url":"git+https://github.com/grpc/grpc-node.git","directory":"packages/grpc-js"}}`,
+		"https://registry.npmjs.org/debug/4.3.4":            `{"repository":"debug-js/debug"}`,
+	}}
+
+	for _, tt := range []struct {
+		path        string
+		expectedURL string
+	}{
+		{
+			path:        "com/example/Handler.handle",
+			expectedURL: "https://github.com/grafana/app/service/src/main/java/com/example/Handler.java",
+		},
+		{
+			path:        "com.example.Worker.run",
+			expectedURL: "https://github.com/grafana/app/src/main/kotlin/com/example/Worker.kt",
+		},
+		{
+			path:        "lib/Makefile",
+			expectedURL: "https://github.com/grafana/app/lib/Makefile",
+		},
+		{
+			path:        "/app/service/handlers.py",
+			expectedURL: "https://github.com/grafana/app/service/handlers.py",
+		},
+		{
+			path:        "/usr/lib/python3.11/site-packages/requests/sessions.py",
+			expectedURL: "https://github.com/psf/requests/src/requests/sessions.py",
+		},
+		{
+			path:        "/app/node_modules/@grpc/grpc-js/build/src/server.js",
+			expectedURL: "https://github.com/grpc/grpc-node/packages/grpc-js/build/src/server.js",
+		},
+		{
+			path:        "/app/node_modules/.pnpm/debug@4.3.4/node_modules/debug/src/node.js",
+			expectedURL: "https://github.com/debug-js/debug/src/node.js@4.3.4",
+		},
+	} {
+		t.Run(tt.path, func(t *testing.T) {
+			file, err := NewFileFinder(vcsClient, repositoryMock{}, tt.path, "", httpClient, log.NewNopLogger(),
+				WithJavaSourceRoots([]string{"src/main/java", "src/main/kotlin", "service/src/main/java"}),
+			).Find(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedURL, file.URL)
+			content, err := base64.StdEncoding.DecodeString(file.Content)
+			require.NoError(t, err)
+			require.NotEmpty(t, content)
+		})
+	}
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := NewFileFinder(vcsClient, repositoryMock{}, "com/example/Missing.handle", "", httpClient, log.NewNopLogger()).Find(context.Background())
+		require.ErrorIs(t, err, client.ErrNotFound)
+	})
+}
@@ -0,0 +1,75 @@
+package java
+
+import (
+	"path"
+	"strings"
+
+	"github.com/grafana/regexp"
+)
+
+const (
+	ExtJava   = ".java"
+	ExtKotlin = ".kt"
+)
+
+// DefaultSourceRoots are the directories searched for the source files
+// of classes when no source roots are configured.
+var DefaultSourceRoots = []string{"src/main/java", "src/main/kotlin", "src", ""}
+
+// frameRegex matches the fully qualified class name of a frame, optionally
+// followed by the method name, e.g. com/example/Foo$Bar.run or
+// com.example.Foo.lambda$main$0.
+var frameRegex = regexp.MustCompile(`^(?P<package>(?:[a-z_$][\w$]*[./])+)(?P<class>[A-Z][\w$]*)(?:\.(?P<method>[\w$<>]+))?$`)
+
+// IsClassFrame reports whether the frame refers to a class rather than to
+// a file. Paths of files without an extension look like classes, e.g.
+// lib/Makefile, therefore the package of the class must be separated with
+// dots, or the frame must include the method: com.example.Foo or
+// com/example/Foo.run.
+func IsClassFrame(frame string) bool {
+	matches := frameRegex.FindStringSubmatch(strings.TrimLeft(frame, "/"))
+	if matches == nil {
+		return false
+	}
+	return strings.Contains(matches[frameRegex.SubexpIndex("package")], ".") ||
+		matches[frameRegex.SubexpIndex("method")] != ""
+}
+
+// SourcePaths returns the paths the source file of the class from the given
+// frame may have, relative to the source root, for example:
+// com/example/Foo$Bar.run -> com/example/Foo.java, com/example/Foo.kt
+// Paths of Java and Kotlin source files are returned as is.
+func SourcePaths(frame string) ([]string, bool) {
+	frame = strings.TrimLeft(frame, "/")
+	switch path.Ext(frame) {
+	case ExtJava, ExtKotlin:
+		return []string{frame}, true
+	}
+	if !IsClassFrame(frame) {
+		return nil, false
+	}
+	matches := frameRegex.FindStringSubmatch(frame)
+	pkg := strings.ReplaceAll(matches[frameRegex.SubexpIndex("package")], ".", "/")
+	class := matches[frameRegex.SubexpIndex("class")]
+	// Nested and anonymous classes are declared in the file of the
+	// outermost class.
+	if i := strings.Index(class, "$"); i > 0 {
+		class = class[:i]
+	}
+	return []string{pkg + class + ExtJava, pkg + class + ExtKotlin}, true
+}
+
+// CandidatePaths returns the paths the source file may be found at in
+// the repository, for each of the source roots and source paths.
+func CandidatePaths(sourcePaths []string, roots []string) []string {
+	if len(roots) == 0 {
+		roots = DefaultSourceRoots
+	}
+	paths := make([]string, 0, len(roots)*len(sourcePaths))
+	for _, root := range roots {
+		for _, sourcePath := range sourcePaths {
+			paths = append(paths, path.Join(root, sourcePath))
+		}
+	}
+	return paths
+}
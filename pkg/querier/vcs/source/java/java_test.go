@@ -0,0 +1,79 @@
+package java
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSourcePaths(t *testing.T) {
+	for _, tt := range []struct {
+		input      string
+		expected   []string
+		expectedOk bool
+	}{
+		{
+			input:      "com/example/service/Handler.handle",
+			expected:   []string{"com/example/service/Handler.java", "com/example/service/Handler.kt"},
+			expectedOk: true,
+		},
+		{
+			input:      "com.example.service.Handler$Worker.lambda$run$0",
+			expected:   []string{"com/example/service/Handler.java", "com/example/service/Handler.kt"},
+			expectedOk: true,
+		},
+		{
+			input:      "com/example/service/Handler.<init>",
+			expected:   []string{"com/example/service/Handler.java", "com/example/service/Handler.kt"},
+			expectedOk: true,
+		},
+		{
+			input:      "com.example.service.Handler",
+			expected:   []string{"com/example/service/Handler.java", "com/example/service/Handler.kt"},
+			expectedOk: true,
+		},
+		{
+			input:      "com/example/service/Handler.kt",
+			expected:   []string{"com/example/service/Handler.kt"},
+			expectedOk: true,
+		},
+		{
+			input:      "com/example/service/Handler",
+			expectedOk: false,
+		},
+		{
+			input:      "lib/Makefile",
+			expectedOk: false,
+		},
+		{
+			input:      "Handler.handle",
+			expectedOk: false,
+		},
+		{
+			input:      "[unknown]",
+			expectedOk: false,
+		},
+	} {
+		t.Run(tt.input, func(t *testing.T) {
+			paths, ok := SourcePaths(tt.input)
+			require.Equal(t, tt.expectedOk, ok)
+			require.Equal(t, tt.expected, paths)
+		})
+	}
+}
+
+func TestCandidatePaths(t *testing.T) {
+	require.Equal(t,
+		[]string{
+			"src/main/java/com/example/Foo.java", "src/main/java/com/example/Foo.kt",
+			"src/main/kotlin/com/example/Foo.java", "src/main/kotlin/com/example/Foo.kt",
+			"src/com/example/Foo.java", "src/com/example/Foo.kt",
+			"com/example/Foo.java", "com/example/Foo.kt",
+		},
+		CandidatePaths([]string{"com/example/Foo.java", "com/example/Foo.kt"}, nil),
+	)
+	require.Equal(t,
+		[]string{"service/src/main/java/com/example/Foo.java", "com/example/Foo.java"},
+		CandidatePaths([]string{"com/example/Foo.java"}, []string{"service/src/main/java", "."}),
+	)
+}
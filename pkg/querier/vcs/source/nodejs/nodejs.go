@@ -0,0 +1,182 @@
+package nodejs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"connectrpc.com/connect"
+)
+
+const (
+	npmRegistryURL  = "https://registry.npmjs.org"
+	nodeModulesPath = "/node_modules/"
+	pnpmStorePath   = "/.pnpm/"
+)
+
+// Package represents an installed npm package with a file path in that package.
+type Package struct {
+	// Name is the name of the package, e.g. express or @grpc/grpc-js.
+	Name string
+	// FilePath is the path of the file relative to the package directory.
+	FilePath string
+	// Version is the installed version of the package, if the path
+	// includes it, e.g. 4.3.4.
+	Version string
+}
+
+// ParseNodeModulesPath parses the package from the given path if the file
+// is installed in node_modules, for example:
+// /app/node_modules/@grpc/grpc-js/build/src/server.js -> @grpc/grpc-js, build/src/server.js
+// The innermost node_modules directory is used, which also covers nested
+// dependencies and the pnpm store layout.
+//
+// The version of the package is only known if the path includes it, which
+// is the case for the pnpm store:
+// /app/node_modules/.pnpm/debug@4.3.4/node_modules/debug/src/node.js -> debug 4.3.4
+// Otherwise, the version is recorded in the package.json next to the file,
+// which is not part of the profile, and is left empty.
+func ParseNodeModulesPath(p string) (Package, bool) {
+	idx := strings.LastIndex(p, nodeModulesPath)
+	if idx < 0 {
+		return Package{}, false
+	}
+	segments := strings.Split(p[idx+len(nodeModulesPath):], "/")
+	n := 1
+	if strings.HasPrefix(segments[0], "@") {
+		// scoped package
+		n = 2
+	}
+	if len(segments) <= n || segments[0] == "" || segments[n-1] == "" {
+		return Package{}, false
+	}
+	pkg := Package{
+		Name:     strings.Join(segments[:n], "/"),
+		FilePath: strings.Join(segments[n:], "/"),
+	}
+	if i := strings.LastIndex(p[:idx+1], pnpmStorePath); i >= 0 {
+		pkg.Version = pnpmVersion(p[i+len(pnpmStorePath):idx], pkg.Name)
+	}
+	return pkg, true
+}
+
+// pnpmVersion returns the version of the package from the name of its
+// directory in the pnpm store, e.g. @grpc+grpc-js@1.9.0_supports-color@8.1.1,
+// or an empty string, if the directory is not of the package.
+func pnpmVersion(dir, name string) string {
+	prefix := strings.ReplaceAll(name, "/", "+") + "@"
+	if !strings.HasPrefix(dir, prefix) {
+		return ""
+	}
+	version := strings.TrimPrefix(dir, prefix)
+	// The peer dependencies of the package are appended to the version.
+	if i := strings.IndexAny(version, "_("); i >= 0 {
+		version = version[:i]
+	}
+	return version
+}
+
+type HttpClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Repository is the source repository of a package.
+type Repository struct {
+	// URL is the URL of the repository.
+	URL string
+	// Directory is the directory of the package in the repository,
+	// if the repository is a monorepo.
+	Directory string
+}
+
+// FilePath returns the path of the package file in the repository.
+func (r Repository) FilePath(pkg Package) string {
+	if r.Directory == "" {
+		return pkg.FilePath
+	}
+	return path.Join(r.Directory, pkg.FilePath)
+}
+
+type npmPackage struct {
+	Repository json.RawMessage `json:"repository"`
+}
+
+// Repository returns the source repository of the package, as declared
+// in the package.json published to the npm registry. The package.json of
+// the installed version is used if it is known, and of the latest release
+// otherwise.
+func (pkg Package) Repository(ctx context.Context, httpClient HttpClient) (Repository, error) {
+	version := "latest"
+	if pkg.Version != "" {
+		version = pkg.Version
+	}
+	// Scoped packages must keep the scope separator escaped.
+	u := fmt.Sprintf("%s/%s/%s", npmRegistryURL, url.PathEscape(pkg.Name), url.PathEscape(version))
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return Repository{}, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return Repository{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return Repository{}, connect.NewError(connect.CodeNotFound, fmt.Errorf("failed to fetch package %s: %s", pkg.Name, resp.Status))
+	}
+	var p npmPackage
+	if err := json.NewDecoder(resp.Body).Decode(&p); err != nil {
+		return Repository{}, err
+	}
+	repo, ok := parseRepository(p.Repository)
+	if !ok {
+		return Repository{}, connect.NewError(connect.CodeNotFound, fmt.Errorf("no source repository found for package %s", pkg.Name))
+	}
+	return repo, nil
+}
+
+// parseRepository parses the repository field of package.json, which is
+// either a string or an object with the url and the directory.
+// See https://docs.npmjs.com/cli/configuring-npm/package-json#repository
+func parseRepository(raw json.RawMessage) (Repository, bool) {
+	var repo Repository
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		repo.URL = s
+	} else {
+		var obj struct {
+			URL       string `json:"url"`
+			Directory string `json:"directory"`
+		}
+		if err := json.Unmarshal(raw, &obj); err != nil {
+			return Repository{}, false
+		}
+		repo.URL, repo.Directory = obj.URL, obj.Directory
+	}
+	repo.URL = normalizeRepositoryURL(repo.URL)
+	return repo, repo.URL != ""
+}
+
+// normalizeRepositoryURL converts the repository URL to a URL that can be
+// parsed as a git URL, expanding the shorthand forms, such as github:user/repo
+// or user/repo.
+func normalizeRepositoryURL(u string) string {
+	u = strings.TrimPrefix(u, "git+")
+	for prefix, host := range map[string]string{
+		"github:":    "https://github.com/",
+		"gitlab:":    "https://gitlab.com/",
+		"bitbucket:": "https://bitbucket.org/",
+	} {
+		if strings.HasPrefix(u, prefix) {
+			return host + strings.TrimPrefix(u, prefix)
+		}
+	}
+	if !strings.Contains(u, ":") && strings.Count(u, "/") == 1 {
+		return "https://github.com/" + u
+	}
+	return u
+}
@@ -0,0 +1,137 @@
+package nodejs
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNodeModulesPath(t *testing.T) {
+	for _, tt := range []struct {
+		input      string
+		expected   Package
+		expectedOk bool
+	}{
+		{
+			input:      "/app/node_modules/express/lib/router/index.js",
+			expected:   Package{Name: "express", FilePath: "lib/router/index.js"},
+			expectedOk: true,
+		},
+		{
+			input:      "/app/node_modules/@grpc/grpc-js/build/src/server.js",
+			expected:   Package{Name: "@grpc/grpc-js", FilePath: "build/src/server.js"},
+			expectedOk: true,
+		},
+		{
+			input:      "/app/node_modules/.pnpm/debug@4.3.4/node_modules/debug/src/node.js",
+			expected:   Package{Name: "debug", FilePath: "src/node.js", Version: "4.3.4"},
+			expectedOk: true,
+		},
+		{
+			input:      "/app/node_modules/.pnpm/@grpc+grpc-js@1.9.0_supports-color@8.1.1/node_modules/@grpc/grpc-js/build/src/server.js",
+			expected:   Package{Name: "@grpc/grpc-js", FilePath: "build/src/server.js", Version: "1.9.0"},
+			expectedOk: true,
+		},
+		{
+			input:      "/app/node_modules/.pnpm/express@4.18.2/node_modules/express/node_modules/debug/src/node.js",
+			expected:   Package{Name: "debug", FilePath: "src/node.js"},
+			expectedOk: true,
+		},
+		{
+			input:      "/app/node_modules/@grpc/index.js",
+			expectedOk: false,
+		},
+		{
+			input:      "/app/src/server.js",
+			expectedOk: false,
+		},
+	} {
+		t.Run(tt.input, func(t *testing.T) {
+			pkg, ok := ParseNodeModulesPath(tt.input)
+			require.Equal(t, tt.expectedOk, ok)
+			require.Equal(t, tt.expected, pkg)
+		})
+	}
+}
+
+func TestParseRepository(t *testing.T) {
+	for _, tt := range []struct {
+		input      string
+		expected   Repository
+		expectedOk bool
+	}{
+		{
+			input:      `"github:expressjs/express"`,
+			expected:   Repository{URL: "https://github.com/expressjs/express"},
+			expectedOk: true,
+		},
+		{
+			input:      `"debug-js/debug"`,
+			expected:   Repository{URL: "https://github.com/debug-js/debug"},
+			expectedOk: true,
+		},
+		{
+			input:      `{"type":"git","url":"git+https://github.com/grpc/grpc-node.git","directory":"packages/grpc-js"}`,
+			expected:   Repository{URL: "https://github.com/grpc/grpc-node.git", Directory: "packages/grpc-js"},
+			expectedOk: true,
+		},
+		{
+			input:      `{"type":"git","url":""}`,
+			expectedOk: false,
+		},
+		{
+			input:      `null`,
+			expectedOk: false,
+		},
+	} {
+		t.Run(tt.input, func(t *testing.T) {
+			repo, ok := parseRepository(json.RawMessage(tt.input))
+			require.Equal(t, tt.expectedOk, ok)
+			if ok {
+				require.Equal(t, tt.expected, repo)
+			}
+		})
+	}
+}
+
+type ClientMock struct{}
+
+func (c *ClientMock) Do(req *http.Request) (*http.Response, error) {
+	switch req.URL.String() {
+	case "https://registry.npmjs.org/@grpc%2Fgrpc-js/latest":
+		return &http.Response{
+			StatusCode: 200,
+			Body: io.NopCloser(strings.NewReader(`{"name":"@grpc/grpc-js","repository":{
+				"type":"git","url":"https://github.com/grpc/grpc-node/tree/master/packages/grpc-js","directory":"packages/grpc-js"
+			}}`)),
+		}, nil
+	case "https://registry.npmjs.org/@grpc%2Fgrpc-js/1.9.0":
+		return &http.Response{
+			StatusCode: 200,
+			Body: io.NopCloser(strings.NewReader(`{"name":"@grpc/grpc-js","version":"1.9.0","repository":{
+				"type":"git","url":"https://github.com/grpc/grpc-node.git","directory":"packages/grpc-js"
+			}}`)),
+		}, nil
+	}
+	return &http.Response{StatusCode: 404, Status: "404 Not Found", Body: io.NopCloser(strings.NewReader(""))}, nil
+}
+
+func TestPackage_Repository(t *testing.T) {
+	pkg := Package{Name: "@grpc/grpc-js", FilePath: "build/src/server.js"}
+	repo, err := pkg.Repository(context.Background(), &ClientMock{})
+	require.NoError(t, err)
+	require.Equal(t, "packages/grpc-js/build/src/server.js", repo.FilePath(pkg))
+
+	pkg.Version = "1.9.0"
+	repo, err = pkg.Repository(context.Background(), &ClientMock{})
+	require.NoError(t, err)
+	require.Equal(t, "https://github.com/grpc/grpc-node.git", repo.URL)
+
+	_, err = Package{Name: "unknown"}.Repository(context.Background(), &ClientMock{})
+	require.Error(t, err)
+}
@@ -0,0 +1,156 @@
+package python
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/grafana/regexp"
+)
+
+const pypiURL = "https://pypi.org/pypi"
+
+var sitePackagesDirs = []string{"/site-packages/", "/dist-packages/"}
+
+// eggRegex matches the directory of a package installed as an egg,
+// e.g. requests-2.31.0-py3.11.egg.
+var eggRegex = regexp.MustCompile(`^(?P<name>[^-/]+)-(?P<version>[^-/]+)(?:-[^/]*)?\.egg$`)
+
+// importNames maps the top-level import names of popular packages
+// to their distribution names, when they differ.
+var importNames = map[string]string{
+	"attr":     "attrs",
+	"bs4":      "beautifulsoup4",
+	"cv2":      "opencv-python",
+	"dateutil": "python-dateutil",
+	"dns":      "dnspython",
+	"dotenv":   "python-dotenv",
+	"jwt":      "PyJWT",
+	"OpenSSL":  "pyOpenSSL",
+	"PIL":      "Pillow",
+	"sklearn":  "scikit-learn",
+	"yaml":     "PyYAML",
+}
+
+// projectURLKeys are the project URL labels pointing to the source code,
+// in the order of preference.
+var projectURLKeys = []string{"Source", "Source Code", "Code", "Repository", "GitHub", "Homepage"}
+
+// Package represents an installed python package with a file path in
+// that package.
+type Package struct {
+	// Name is the distribution name of the package, e.g. requests.
+	Name string
+	// FilePath is the path of the file relative to site-packages,
+	// e.g. requests/sessions.py.
+	FilePath string
+	// Version is the installed version of the package, if the path
+	// includes it, e.g. 2.31.0.
+	Version string
+}
+
+// ParseSitePackagesPath parses the package from the given path if the file
+// is installed in site-packages, for example:
+// /usr/lib/python3.11/site-packages/requests/sessions.py -> requests, requests/sessions.py
+//
+// The version of the package is only known if the path includes it, which
+// is the case for eggs:
+// site-packages/requests-2.31.0-py3.11.egg/requests/sessions.py -> requests 2.31.0
+// Otherwise, the version is recorded in the *.dist-info directory next to
+// the package, which is not part of the profile, and is left empty.
+func ParseSitePackagesPath(path string) (Package, bool) {
+	var filePath string
+	for _, dir := range sitePackagesDirs {
+		if idx := strings.LastIndex(path, dir); idx >= 0 {
+			filePath = path[idx+len(dir):]
+			break
+		}
+	}
+	if filePath == "" {
+		return Package{}, false
+	}
+	var version string
+	if dir, rest, ok := strings.Cut(filePath, "/"); ok {
+		if matches := eggRegex.FindStringSubmatch(dir); matches != nil {
+			version = matches[eggRegex.SubexpIndex("version")]
+			filePath = rest
+		}
+	}
+	name, _, _ := strings.Cut(filePath, "/")
+	name = strings.TrimSuffix(name, ".py")
+	if name == "" || strings.HasSuffix(name, ".dist-info") || strings.HasSuffix(name, ".egg-info") {
+		return Package{}, false
+	}
+	if n, ok := importNames[name]; ok {
+		name = n
+	}
+	return Package{Name: name, FilePath: filePath, Version: version}, true
+}
+
+// CandidatePaths returns the paths the file may be found at in the
+// repository of the package: either at the root, or in the src directory.
+func (p Package) CandidatePaths() []string {
+	return []string{p.FilePath, "src/" + p.FilePath}
+}
+
+type HttpClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+type pypiProject struct {
+	Info struct {
+		HomePage    string            `json:"home_page"`
+		ProjectURLs map[string]string `json:"project_urls"`
+	} `json:"info"`
+}
+
+// RepositoryURL returns the URL of the source repository of the package,
+// as declared in the package metadata published to PyPI. The metadata of
+// the installed version is used if it is known, and of the latest release
+// otherwise.
+func (p Package) RepositoryURL(ctx context.Context, httpClient HttpClient) (string, error) {
+	u := fmt.Sprintf("%s/%s/json", pypiURL, url.PathEscape(p.Name))
+	if p.Version != "" {
+		u = fmt.Sprintf("%s/%s/%s/json", pypiURL, url.PathEscape(p.Name), url.PathEscape(p.Version))
+	}
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", connect.NewError(connect.CodeNotFound, fmt.Errorf("failed to fetch package %s: %s", p.Name, resp.Status))
+	}
+	var project pypiProject
+	if err := json.NewDecoder(resp.Body).Decode(&project); err != nil {
+		return "", err
+	}
+	for _, key := range projectURLKeys {
+		for k, u := range project.Info.ProjectURLs {
+			if strings.EqualFold(k, key) && isRepositoryURL(u) {
+				return u, nil
+			}
+		}
+	}
+	if isRepositoryURL(project.Info.HomePage) {
+		return project.Info.HomePage, nil
+	}
+	return "", connect.NewError(connect.CodeNotFound, fmt.Errorf("no source repository found for package %s", p.Name))
+}
+
+func isRepositoryURL(u string) bool {
+	for _, host := range []string{"github.com/", "gitlab.com/", "bitbucket.org/"} {
+		if strings.Contains(u, host) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,110 @@
+package python
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSitePackagesPath(t *testing.T) {
+	for _, tt := range []struct {
+		input      string
+		expected   Package
+		expectedOk bool
+	}{
+		{
+			input:      "/usr/lib/python3.11/site-packages/requests/sessions.py",
+			expected:   Package{Name: "requests", FilePath: "requests/sessions.py"},
+			expectedOk: true,
+		},
+		{
+			input:      "/app/.venv/lib/python3.12/site-packages/yaml/loader.py",
+			expected:   Package{Name: "PyYAML", FilePath: "yaml/loader.py"},
+			expectedOk: true,
+		},
+		{
+			input:      "/usr/lib/python3.11/site-packages/requests-2.31.0-py3.11.egg/requests/sessions.py",
+			expected:   Package{Name: "requests", FilePath: "requests/sessions.py", Version: "2.31.0"},
+			expectedOk: true,
+		},
+		{
+			input:      "/usr/lib/python3/dist-packages/six.py",
+			expected:   Package{Name: "six", FilePath: "six.py"},
+			expectedOk: true,
+		},
+		{
+			input:      "/usr/lib/python3.11/site-packages/requests-2.31.0.dist-info/METADATA",
+			expectedOk: false,
+		},
+		{
+			input:      "/app/service/handlers.py",
+			expectedOk: false,
+		},
+	} {
+		t.Run(tt.input, func(t *testing.T) {
+			pkg, ok := ParseSitePackagesPath(tt.input)
+			require.Equal(t, tt.expectedOk, ok)
+			require.Equal(t, tt.expected, pkg)
+		})
+	}
+}
+
+type ClientMock struct{}
+
+func (c *ClientMock) Do(req *http.Request) (*http.Response, error) {
+	switch req.URL.String() {
+	case "https://pypi.org/pypi/requests/json":
+		return &http.Response{
+			StatusCode: 200,
+			Body: io.NopCloser(strings.NewReader(`{"info":{
+				"home_page":"https://requests.readthedocs.io",
+				"project_urls":{"Documentation":"https://requests.readthedocs.io","Source":"https://github.com/psf/requests"}
+			}}`)),
+		}, nil
+	case "https://pypi.org/pypi/requests/2.31.0/json":
+		return &http.Response{
+			StatusCode: 200,
+			Body:       io.NopCloser(strings.NewReader(`{"info":{"project_urls":{"Source":"https://github.com/psf/requests"}}}`)),
+		}, nil
+	case "https://pypi.org/pypi/six/json":
+		return &http.Response{
+			StatusCode: 200,
+			Body:       io.NopCloser(strings.NewReader(`{"info":{"home_page":"https://github.com/benjaminp/six","project_urls":null}}`)),
+		}, nil
+	case "https://pypi.org/pypi/private/json":
+		return &http.Response{
+			StatusCode: 200,
+			Body:       io.NopCloser(strings.NewReader(`{"info":{"home_page":"https://example.com"}}`)),
+		}, nil
+	}
+	return &http.Response{StatusCode: 404, Status: "404 Not Found", Body: io.NopCloser(strings.NewReader(""))}, nil
+}
+
+func TestPackage_RepositoryURL(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		version     string
+		expected    string
+		expectedErr bool
+	}{
+		{name: "requests", expected: "https://github.com/psf/requests"},
+		{name: "requests", version: "2.31.0", expected: "https://github.com/psf/requests"},
+		{name: "six", expected: "https://github.com/benjaminp/six"},
+		{name: "private", expectedErr: true},
+		{name: "unknown", expectedErr: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := Package{Name: tt.name, Version: tt.version}.RepositoryURL(context.Background(), &ClientMock{})
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, u)
+		})
+	}
+}
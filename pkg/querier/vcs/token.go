@@ -28,9 +28,22 @@ type gitSessionTokenCookie struct {
 	ExpiryTimestamp int64  `json:"expiry"`
 }
 
-const envVarGithubSessionSecret = "GITHUB_SESSION_SECRET"
+const (
+	envVarSessionSecret = "VCS_SESSION_SECRET"
+	// envVarGithubSessionSecret is used if VCS_SESSION_SECRET is not set,
+	// as the session secret was specific to GitHub at first.
+	envVarGithubSessionSecret = "GITHUB_SESSION_SECRET"
+)
+
+var sessionSecret = []byte(getSessionSecret())
 
-var githubSessionSecret = []byte(os.Getenv(envVarGithubSessionSecret))
+// getSessionSecret returns the secret the sessions of all the providers are encrypted with.
+func getSessionSecret() string {
+	if secret := os.Getenv(envVarSessionSecret); secret != "" {
+		return secret
+	}
+	return os.Getenv(envVarGithubSessionSecret)
+}
 
 // derives a per tenant key from the global session secret using sha256
 func deriveEncryptionKeyForContext(ctx context.Context) ([]byte, error) {
@@ -42,11 +55,11 @@ func deriveEncryptionKeyForContext(ctx context.Context) ([]byte, error) {
 		return nil, errors.New("tenantID is empty")
 	}
 
-	if len(githubSessionSecret) == 0 {
-		return nil, errors.New(envVarGithubSessionSecret + " is empty")
+	if len(sessionSecret) == 0 {
+		return nil, errors.New(envVarSessionSecret + " is empty")
 	}
 	h := sha256.New()
-	h.Write(githubSessionSecret)
+	h.Write(sessionSecret)
 	h.Write([]byte{':'})
 	h.Write([]byte(tenantID))
 	return h.Sum(nil), nil
@@ -83,11 +96,18 @@ func getDurationValueFrom(values url.Values, key string, scalar time.Duration) (
 	return time.Duration(n) * scalar, nil
 }
 
-// tokenFromRequest decodes an OAuth token from a request.
+// tokenFromRequest decodes an OAuth token from the default session
+// cookie of a request.
 func tokenFromRequest(ctx context.Context, req connect.AnyRequest) (*oauth2.Token, error) {
-	cookie, err := (&http.Request{Header: req.Header()}).Cookie(sessionCookieName)
+	return tokenFromCookie(ctx, req, sessionCookieName)
+}
+
+// tokenFromCookie decodes an OAuth token from the named session cookie
+// of a request.
+func tokenFromCookie(ctx context.Context, req connect.AnyRequest, name string) (*oauth2.Token, error) {
+	cookie, err := (&http.Request{Header: req.Header()}).Cookie(name)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read cookie %s: %w", sessionCookieName, err)
+		return nil, fmt.Errorf("failed to read cookie %s: %w", name, err)
 	}
 
 	derivedKey, err := deriveEncryptionKeyForContext(ctx)
@@ -102,8 +122,15 @@ func tokenFromRequest(ctx context.Context, req connect.AnyRequest) (*oauth2.Toke
 	return token, nil
 }
 
-// encodeToken encrypts then base64 encodes an OAuth token.
+// encodeToken encrypts then base64 encodes an OAuth token into the
+// default session cookie.
 func encodeToken(token *oauth2.Token, key []byte) (*http.Cookie, error) {
+	return encodeTokenCookie(sessionCookieName, token, key)
+}
+
+// encodeTokenCookie encrypts then base64 encodes an OAuth token into
+// the named session cookie.
+func encodeTokenCookie(name string, token *oauth2.Token, key []byte) (*http.Cookie, error) {
 	encrypted, err := encryptToken(token, key)
 	if err != nil {
 		return nil, err
@@ -119,7 +146,7 @@ func encodeToken(token *oauth2.Token, key []byte) (*http.Cookie, error) {
 
 	encoded := base64.StdEncoding.EncodeToString(bytes)
 	cookie := &http.Cookie{
-		Name:     sessionCookieName,
+		Name:     name,
 		Value:    encoded,
 		Expires:  time.Now().Add(githubRefreshExpiryDuration),
 		HttpOnly: false,
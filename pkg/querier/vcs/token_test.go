@@ -138,7 +138,7 @@ func Test_tokenFromRequest(t *testing.T) {
 	ctx := newTestContext()
 
 	t.Run("token exists in request", func(t *testing.T) {
-		githubSessionSecret = []byte("16_byte_key_XXXX")
+		sessionSecret = []byte("16_byte_key_XXXX")
 
 		derivedKey, err := deriveEncryptionKeyForContext(ctx)
 		require.NoError(t, err)
@@ -160,7 +160,7 @@ func Test_tokenFromRequest(t *testing.T) {
 	})
 
 	t.Run("token does not exist in request", func(t *testing.T) {
-		githubSessionSecret = []byte("16_byte_key_XXXX")
+		sessionSecret = []byte("16_byte_key_XXXX")
 		wantErr := "failed to read cookie GitSession: http: named cookie not present"
 
 		// The type of request here doesn't matter.
@@ -173,7 +173,7 @@ func Test_tokenFromRequest(t *testing.T) {
 }
 
 func Test_encodeToken(t *testing.T) {
-	githubSessionSecret = []byte("16_byte_key_XXXX")
+	sessionSecret = []byte("16_byte_key_XXXX")
 	ctx := newTestContext()
 
 	derivedKey, err := deriveEncryptionKeyForContext(ctx)
@@ -196,7 +196,7 @@ func Test_encodeToken(t *testing.T) {
 }
 
 func Test_decodeToken(t *testing.T) {
-	githubSessionSecret = []byte("16_byte_key_XXXX")
+	sessionSecret = []byte("16_byte_key_XXXX")
 
 	ctx := newTestContext()
 	derivedKey, err := deriveEncryptionKeyForContext(ctx)
@@ -248,7 +248,7 @@ func Test_decodeToken(t *testing.T) {
 }
 
 func Test_tenantIsolation(t *testing.T) {
-	githubSessionSecret = []byte("16_byte_key_XXXX")
+	sessionSecret = []byte("16_byte_key_XXXX")
 
 	var (
 		ctxA = newTestContextWithTenantID("tenant_a")
@@ -276,7 +276,7 @@ func Test_tenantIsolation(t *testing.T) {
 }
 
 func Test_StillCompatbile(t *testing.T) {
-	githubSessionSecret = []byte("16_byte_key_XXXX")
+	sessionSecret = []byte("16_byte_key_XXXX")
 
 	ctx := newTestContextWithTenantID("tenant_a")
 	req := connect.NewRequest(&vcsv1.GetFileRequest{})
@@ -287,6 +287,15 @@ func Test_StillCompatbile(t *testing.T) {
 	require.Equal(t, "so_secret", realToken.AccessToken)
 }
 
+func Test_getSessionSecret(t *testing.T) {
+	t.Setenv(envVarSessionSecret, "")
+	t.Setenv(envVarGithubSessionSecret, "github_secret")
+	require.Equal(t, "github_secret", getSessionSecret())
+
+	t.Setenv(envVarSessionSecret, "vcs_secret")
+	require.Equal(t, "vcs_secret", getSessionSecret())
+}
+
 func newTestContext() context.Context {
 	return newTestContextWithTenantID("test_tenant_id")
 }
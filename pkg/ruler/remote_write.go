@@ -0,0 +1,58 @@
+package ruler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/grafana/dskit/user"
+	"github.com/klauspost/compress/snappy"
+	"github.com/prometheus/prometheus/prompb"
+)
+
+// RemoteWriteClient sends samples to a Prometheus-compatible
+// endpoint, using the remote write protocol.
+type RemoteWriteClient struct {
+	url    string
+	client *http.Client
+}
+
+func NewRemoteWriteClient(url string, timeout time.Duration) *RemoteWriteClient {
+	return &RemoteWriteClient{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Write sends the time series on behalf of the tenant: the tenant
+// ID is propagated in the X-Scope-OrgID header.
+func (c *RemoteWriteClient) Write(ctx context.Context, tenantID string, series []prompb.TimeSeries) error {
+	if len(series) == 0 {
+		return nil
+	}
+	data, err := (&prompb.WriteRequest{Timeseries: series}).Marshal()
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(snappy.Encode(nil, data)))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Encoding", "snappy")
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("X-Prometheus-Remote-Write-Version", "0.1.0")
+	req.Header.Set(user.OrgIDHeaderName, tenantID)
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("remote write: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("remote write: unexpected status %s: %s", resp.Status, bytes.TrimSpace(body))
+	}
+	return nil
+}
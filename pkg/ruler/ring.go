@@ -0,0 +1,99 @@
+package ruler
+
+import (
+	"fmt"
+	"hash/fnv"
+
+	"github.com/go-kit/log"
+	"github.com/grafana/dskit/kv"
+	"github.com/grafana/dskit/ring"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/grafana/pyroscope/pkg/util"
+)
+
+const (
+	// ringKey is the key under which the ruler ring is stored in the KV store.
+	ringKey = "ruler"
+
+	// ringNumTokens is how many tokens each ruler has in the ring: rule
+	// groups are sharded by their tokens, which spread the groups evenly.
+	ringNumTokens = 128
+
+	// ringAutoForgetUnhealthyPeriods is how many consecutive timeout periods an
+	// unhealthy instance in the ring will be automatically removed after.
+	ringAutoForgetUnhealthyPeriods = 4
+)
+
+// RingOp is the operation used to find the ruler owning a rule group.
+var RingOp = ring.NewOp([]ring.InstanceState{ring.ACTIVE}, nil)
+
+func toBasicLifecyclerConfig(cfg util.CommonRingConfig, logger log.Logger) (ring.BasicLifecyclerConfig, error) {
+	instanceAddr, err := ring.GetInstanceAddr(cfg.InstanceAddr, cfg.InstanceInterfaceNames, logger, cfg.EnableIPv6)
+	if err != nil {
+		return ring.BasicLifecyclerConfig{}, err
+	}
+
+	instancePort := ring.GetInstancePort(cfg.InstancePort, cfg.ListenPort)
+
+	return ring.BasicLifecyclerConfig{
+		ID:                              cfg.InstanceID,
+		Addr:                            fmt.Sprintf("%s:%d", instanceAddr, instancePort),
+		HeartbeatPeriod:                 cfg.HeartbeatPeriod,
+		HeartbeatTimeout:                cfg.HeartbeatTimeout,
+		TokensObservePeriod:             0,
+		NumTokens:                       ringNumTokens,
+		KeepInstanceInTheRingOnShutdown: false,
+	}, nil
+}
+
+func newRingAndLifecycler(cfg util.CommonRingConfig, logger log.Logger, reg prometheus.Registerer) (*ring.Ring, *ring.BasicLifecycler, error) {
+	reg = prometheus.WrapRegistererWithPrefix("pyroscope_", reg)
+	kvStore, err := kv.NewClient(cfg.KVStore, ring.GetCodec(), kv.RegistererWithKVName(reg, "ruler-lifecycler"), logger)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to initialize rulers' KV store")
+	}
+
+	lifecyclerCfg, err := toBasicLifecyclerConfig(cfg, logger)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to build rulers' lifecycler config")
+	}
+
+	var delegate ring.BasicLifecyclerDelegate
+	delegate = ring.NewInstanceRegisterDelegate(ring.ACTIVE, lifecyclerCfg.NumTokens)
+	delegate = ring.NewLeaveOnStoppingDelegate(delegate, logger)
+	delegate = ring.NewAutoForgetDelegate(ringAutoForgetUnhealthyPeriods*lifecyclerCfg.HeartbeatTimeout, delegate, logger)
+
+	rulersLifecycler, err := ring.NewBasicLifecycler(lifecyclerCfg, "ruler", ringKey, kvStore, delegate, logger, reg)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to initialize rulers' lifecycler")
+	}
+
+	ringCfg := cfg.ToRingConfig()
+	ringCfg.ReplicationFactor = 1
+	rulersRing, err := ring.New(ringCfg, "ruler", ringKey, logger, reg)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to initialize rulers' ring client")
+	}
+
+	return rulersRing, rulersLifecycler, nil
+}
+
+// ownsRuleGroup reports whether the ruler instance owns the
+// token of the rule group: only the owner evaluates the group.
+func ownsRuleGroup(r ring.ReadRing, instanceAddr string, tenantID, group string) (bool, error) {
+	hasher := fnv.New32a()
+	_, _ = hasher.Write([]byte(tenantID))
+	_, _ = hasher.Write([]byte{'/'})
+	_, _ = hasher.Write([]byte(group))
+
+	rs, err := r.Get(hasher.Sum32(), RingOp, nil, nil, nil)
+	if err != nil {
+		return false, err
+	}
+	if len(rs.Instances) != 1 {
+		return false, fmt.Errorf("unexpected number of rulers in the shard (expected 1, got %d)", len(rs.Instances))
+	}
+	return rs.Instances[0].Addr == instanceAddr, nil
+}
@@ -0,0 +1,396 @@
+package ruler
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/gorilla/mux"
+	"github.com/grafana/dskit/ring"
+	"github.com/grafana/dskit/services"
+	"github.com/grafana/dskit/user"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/prometheus/prompb"
+	"gopkg.in/yaml.v3"
+
+	"github.com/grafana/pyroscope/api/gen/proto/go/querier/v1/querierv1connect"
+	"github.com/grafana/pyroscope/pkg/objstore"
+	"github.com/grafana/pyroscope/pkg/tenant"
+	"github.com/grafana/pyroscope/pkg/util"
+	httputil "github.com/grafana/pyroscope/pkg/util/http"
+)
+
+type Config struct {
+	EvaluationInterval time.Duration         `yaml:"evaluation_interval"`
+	EvaluationDelay    time.Duration         `yaml:"evaluation_delay"`
+	PollInterval       time.Duration         `yaml:"poll_interval" category:"advanced"`
+	QueryAddress       string                `yaml:"query_address"`
+	QueryTimeout       time.Duration         `yaml:"query_timeout" category:"advanced"`
+	RemoteWriteURL     string                `yaml:"remote_write_url"`
+	RemoteWriteTimeout time.Duration         `yaml:"remote_write_timeout" category:"advanced"`
+	Ring               util.CommonRingConfig `yaml:"ring" doc:"description=The hash ring the rule groups are sharded with across the rulers."`
+}
+
+func (cfg *Config) RegisterFlags(f *flag.FlagSet, logger log.Logger) {
+	f.DurationVar(&cfg.EvaluationInterval, "ruler.evaluation-interval", time.Minute, "Default evaluation interval of the rule groups.")
+	f.DurationVar(&cfg.EvaluationDelay, "ruler.evaluation-delay", time.Minute, "How far behind the current time the rules are evaluated, to allow for the delay of the profile ingestion. The recorded samples are timestamped accordingly.")
+	f.DurationVar(&cfg.PollInterval, "ruler.poll-interval", time.Minute, "How frequently the rule groups are reloaded from the object storage.")
+	f.StringVar(&cfg.QueryAddress, "ruler.query-address", "", "Address of the query frontend or querier the rules are evaluated with, e.g. 'http://query-frontend:4040'. Required, unless the ruler runs in single-binary mode, where it defaults to the local server.")
+	f.DurationVar(&cfg.QueryTimeout, "ruler.query-timeout", 30*time.Second, "Timeout of the queries the rules are evaluated with.")
+	f.StringVar(&cfg.RemoteWriteURL, "ruler.remote-write-url", "", "URL of the Prometheus remote write endpoint the recorded metrics are sent to, e.g. 'http://mimir:8080/api/v1/push'. The tenant ID is sent in the X-Scope-OrgID header.")
+	f.DurationVar(&cfg.RemoteWriteTimeout, "ruler.remote-write-timeout", 30*time.Second, "Timeout of the remote write requests.")
+	cfg.Ring.RegisterFlags("ruler.ring.", "collectors/", "rulers", f, logger)
+}
+
+func (cfg *Config) Validate() error {
+	if cfg.RemoteWriteURL == "" {
+		return errors.New("ruler remote write URL is required")
+	}
+	if cfg.EvaluationInterval <= 0 || cfg.PollInterval <= 0 {
+		return errors.New("ruler evaluation and poll intervals must be positive")
+	}
+	if cfg.EvaluationDelay < 0 {
+		return errors.New("ruler evaluation delay must not be negative")
+	}
+	if cfg.QueryTimeout <= 0 {
+		return errors.New("ruler query timeout must be positive")
+	}
+	return nil
+}
+
+// Ruler periodically evaluates recording rules of the tenants: the
+// results of the profile queries are remote-written as metrics.
+//
+// Rule groups are stored in the object storage and managed via the
+// HTTP API. Changes are picked up within the poll interval.
+//
+// Rule groups are sharded across the rulers with the ring: each group
+// is only evaluated by the ruler that owns its token. Ring changes are
+// picked up within the poll interval as well.
+type Ruler struct {
+	services.Service
+
+	cfg         Config
+	logger      log.Logger
+	store       *Store
+	querier     querierv1connect.QuerierServiceClient
+	remoteWrite *RemoteWriteClient
+
+	ring               *ring.Ring
+	ringLifecycler     *ring.BasicLifecycler
+	subservices        *services.Manager
+	subservicesWatcher *services.FailureWatcher
+
+	mu sync.Mutex
+	// Tenant/group => evaluation loop.
+	groups map[string]*groupEvaluator
+	wg     sync.WaitGroup
+
+	evaluations        prometheus.Counter
+	evaluationFailures prometheus.Counter
+	remoteWriteErrors  prometheus.Counter
+	samplesWritten     prometheus.Counter
+}
+
+type groupEvaluator struct {
+	group  *RuleGroup
+	cancel context.CancelFunc
+}
+
+func New(cfg Config, bucket objstore.Bucket, querier querierv1connect.QuerierServiceClient, logger log.Logger, reg prometheus.Registerer) (*Ruler, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	r := &Ruler{
+		cfg:         cfg,
+		logger:      logger,
+		store:       NewStore(bucket),
+		querier:     querier,
+		remoteWrite: NewRemoteWriteClient(cfg.RemoteWriteURL, cfg.RemoteWriteTimeout),
+		groups:      make(map[string]*groupEvaluator),
+
+		evaluations: promauto.With(reg).NewCounter(prometheus.CounterOpts{
+			Name: "pyroscope_ruler_evaluations_total",
+			Help: "Total number of rule evaluations.",
+		}),
+		evaluationFailures: promauto.With(reg).NewCounter(prometheus.CounterOpts{
+			Name: "pyroscope_ruler_evaluation_failures_total",
+			Help: "Total number of failed rule evaluations.",
+		}),
+		remoteWriteErrors: promauto.With(reg).NewCounter(prometheus.CounterOpts{
+			Name: "pyroscope_ruler_remote_write_failures_total",
+			Help: "Total number of failed remote write requests.",
+		}),
+		samplesWritten: promauto.With(reg).NewCounter(prometheus.CounterOpts{
+			Name: "pyroscope_ruler_samples_written_total",
+			Help: "Total number of samples remote-written.",
+		}),
+	}
+	var err error
+	r.ring, r.ringLifecycler, err = newRingAndLifecycler(cfg.Ring, logger, reg)
+	if err != nil {
+		return nil, err
+	}
+	r.subservices, err = services.NewManager(r.ringLifecycler, r.ring)
+	if err != nil {
+		return nil, err
+	}
+	r.subservicesWatcher = services.NewFailureWatcher()
+	r.subservicesWatcher.WatchManager(r.subservices)
+	r.Service = services.NewBasicService(r.starting, r.running, r.stopping)
+	return r, nil
+}
+
+func (r *Ruler) starting(ctx context.Context) error {
+	if err := services.StartManagerAndAwaitHealthy(ctx, r.subservices); err != nil {
+		return fmt.Errorf("unable to start ruler subservices: %w", err)
+	}
+	level.Info(r.logger).Log("msg", "waiting until ruler is ACTIVE in the ring")
+	if err := ring.WaitInstanceState(ctx, r.ring, r.ringLifecycler.GetInstanceID(), ring.ACTIVE); err != nil {
+		return fmt.Errorf("ruler failed to become ACTIVE in the ring: %w", err)
+	}
+	level.Info(r.logger).Log("msg", "ruler is ACTIVE in the ring")
+	return nil
+}
+
+func (r *Ruler) running(ctx context.Context) error {
+	t := time.NewTicker(r.cfg.PollInterval)
+	defer t.Stop()
+	for {
+		if err := r.sync(ctx); err != nil {
+			level.Error(r.logger).Log("msg", "failed to load rule groups", "err", err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-r.subservicesWatcher.Chan():
+			return fmt.Errorf("ruler subservice failed: %w", err)
+		case <-t.C:
+		}
+	}
+}
+
+func (r *Ruler) stopping(_ error) error {
+	r.mu.Lock()
+	for key, e := range r.groups {
+		e.cancel()
+		delete(r.groups, key)
+	}
+	r.mu.Unlock()
+	r.wg.Wait()
+	return services.StopManagerAndAwaitStopped(context.Background(), r.subservices)
+}
+
+// sync starts evaluation of the new rule groups owned by the ruler,
+// restarts evaluation of the modified ones, and stops evaluation of
+// the deleted ones and the ones no longer owned.
+func (r *Ruler) sync(ctx context.Context) error {
+	tenants, err := r.store.Tenants(ctx)
+	if err != nil {
+		return err
+	}
+	groups := make(map[string]*RuleGroup)
+	tenantIDs := make(map[string]string)
+	for _, tenantID := range tenants {
+		tenantGroups, err := r.store.List(ctx, tenantID)
+		if err != nil {
+			return err
+		}
+		for _, g := range tenantGroups {
+			owned, err := ownsRuleGroup(r.ring, r.ringLifecycler.GetInstanceAddr(), tenantID, g.Name)
+			if err != nil {
+				return err
+			}
+			if !owned {
+				continue
+			}
+			key := tenantID + "/" + g.Name
+			groups[key] = g
+			tenantIDs[key] = tenantID
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for key, e := range r.groups {
+		if g, ok := groups[key]; !ok || !sameRuleGroup(g, e.group) {
+			e.cancel()
+			delete(r.groups, key)
+		}
+	}
+	for key, g := range groups {
+		if _, ok := r.groups[key]; ok {
+			continue
+		}
+		groupCtx, cancel := context.WithCancel(ctx)
+		r.groups[key] = &groupEvaluator{group: g, cancel: cancel}
+		r.wg.Add(1)
+		go func(tenantID string, g *RuleGroup) {
+			defer r.wg.Done()
+			r.runGroup(groupCtx, tenantID, g)
+		}(tenantIDs[key], g)
+	}
+	return nil
+}
+
+func sameRuleGroup(a, b *RuleGroup) bool {
+	x, errA := yaml.Marshal(a)
+	y, errB := yaml.Marshal(b)
+	return errA == nil && errB == nil && string(x) == string(y)
+}
+
+func (r *Ruler) runGroup(ctx context.Context, tenantID string, g *RuleGroup) {
+	t := time.NewTicker(g.evaluationInterval(r.cfg.EvaluationInterval))
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-t.C:
+			r.evaluateGroup(ctx, tenantID, g, now)
+		}
+	}
+}
+
+// evaluateGroup evaluates the rules of the group and sends the
+// results in a single remote write request. Rules that fail are
+// skipped: their results are missing, which is reflected in the
+// recorded metrics as a gap.
+//
+// The rules are evaluated over the interval ending the evaluation
+// delay before the time given, the samples are timestamped with the
+// end of the interval.
+func (r *Ruler) evaluateGroup(ctx context.Context, tenantID string, g *RuleGroup, now time.Time) {
+	interval := g.evaluationInterval(r.cfg.EvaluationInterval)
+	now = now.Add(-r.cfg.EvaluationDelay)
+	logger := log.With(r.logger, "tenant", tenantID, "group", g.Name)
+	queryCtx := user.InjectOrgID(ctx, tenantID)
+	var series []prompb.TimeSeries
+	for i := range g.Rules {
+		rule := &g.Rules[i]
+		r.evaluations.Inc()
+		resp, err := r.querier.SelectSeries(queryCtx, connect.NewRequest(rule.selectSeriesRequest(now, interval)))
+		if err != nil {
+			r.evaluationFailures.Inc()
+			level.Warn(logger).Log("msg", "failed to evaluate rule", "record", rule.Record, "err", err)
+			continue
+		}
+		series = append(series, rule.timeSeries(resp.Msg.Series, now)...)
+	}
+	if err := r.remoteWrite.Write(ctx, tenantID, series); err != nil {
+		r.remoteWriteErrors.Inc()
+		level.Warn(logger).Log("msg", "failed to write recorded metrics", "err", err)
+		return
+	}
+	r.samplesWritten.Add(float64(len(series)))
+}
+
+// RingHandler serves the ring status page.
+func (r *Ruler) RingHandler(w http.ResponseWriter, req *http.Request) {
+	r.ring.ServeHTTP(w, req)
+}
+
+// ListRuleGroupsHandler returns the rule groups of the tenant.
+func (r *Ruler) ListRuleGroupsHandler(w http.ResponseWriter, req *http.Request) {
+	tenantID, err := tenant.ExtractTenantIDFromContext(req.Context())
+	if err != nil {
+		httputil.ErrorWithStatus(w, err, http.StatusBadRequest)
+		return
+	}
+	groups, err := r.store.List(req.Context(), tenantID)
+	if err != nil {
+		httputil.Error(w, err)
+		return
+	}
+	writeYAML(w, struct {
+		Groups []*RuleGroup `yaml:"groups"`
+	}{Groups: groups})
+}
+
+// GetRuleGroupHandler returns the rule group.
+func (r *Ruler) GetRuleGroupHandler(w http.ResponseWriter, req *http.Request) {
+	tenantID, err := tenant.ExtractTenantIDFromContext(req.Context())
+	if err != nil {
+		httputil.ErrorWithStatus(w, err, http.StatusBadRequest)
+		return
+	}
+	g, err := r.store.Get(req.Context(), tenantID, mux.Vars(req)["group"])
+	if err != nil {
+		writeStoreError(w, err)
+		return
+	}
+	writeYAML(w, g)
+}
+
+// SetRuleGroupHandler creates or replaces the rule group
+// sent in the request body in the YAML format.
+func (r *Ruler) SetRuleGroupHandler(w http.ResponseWriter, req *http.Request) {
+	tenantID, err := tenant.ExtractTenantIDFromContext(req.Context())
+	if err != nil {
+		httputil.ErrorWithStatus(w, err, http.StatusBadRequest)
+		return
+	}
+	data, err := io.ReadAll(io.LimitReader(req.Body, maxRuleGroupSize+1))
+	if err != nil {
+		httputil.ErrorWithStatus(w, err, http.StatusBadRequest)
+		return
+	}
+	if len(data) > maxRuleGroupSize {
+		httputil.ErrorWithStatus(w, errors.New("rule group is too large"), http.StatusRequestEntityTooLarge)
+		return
+	}
+	g, err := ParseRuleGroup(data)
+	if err != nil {
+		httputil.ErrorWithStatus(w, err, http.StatusBadRequest)
+		return
+	}
+	if err = r.store.Set(req.Context(), tenantID, g); err != nil {
+		level.Error(r.logger).Log("msg", "failed to store rule group", "tenant", tenantID, "group", g.Name, "err", err)
+		httputil.Error(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// DeleteRuleGroupHandler deletes the rule group.
+func (r *Ruler) DeleteRuleGroupHandler(w http.ResponseWriter, req *http.Request) {
+	tenantID, err := tenant.ExtractTenantIDFromContext(req.Context())
+	if err != nil {
+		httputil.ErrorWithStatus(w, err, http.StatusBadRequest)
+		return
+	}
+	if err = r.store.Delete(req.Context(), tenantID, mux.Vars(req)["group"]); err != nil {
+		writeStoreError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+const maxRuleGroupSize = 1 << 20
+
+func writeStoreError(w http.ResponseWriter, err error) {
+	if errors.Is(err, ErrRuleGroupNotFound) {
+		httputil.ErrorWithStatus(w, err, http.StatusNotFound)
+		return
+	}
+	httputil.Error(w, err)
+}
+
+func writeYAML(w http.ResponseWriter, v any) {
+	data, err := yaml.Marshal(v)
+	if err != nil {
+		httputil.Error(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/yaml")
+	_, _ = w.Write(data)
+}
@@ -0,0 +1,263 @@
+package ruler
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/go-kit/log"
+	"github.com/gorilla/mux"
+	"github.com/grafana/dskit/kv"
+	"github.com/grafana/dskit/kv/consul"
+	"github.com/grafana/dskit/ring"
+	"github.com/grafana/dskit/tenant"
+	"github.com/grafana/dskit/user"
+	"github.com/klauspost/compress/snappy"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
+	"github.com/grafana/pyroscope/api/gen/proto/go/querier/v1/querierv1connect"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	"github.com/grafana/pyroscope/pkg/objstore"
+	"github.com/grafana/pyroscope/pkg/objstore/testutil"
+)
+
+type fakeQuerier struct {
+	querierv1connect.QuerierServiceClient
+
+	mu       sync.Mutex
+	requests []*querierv1.SelectSeriesRequest
+	tenants  []string
+}
+
+func (q *fakeQuerier) SelectSeries(ctx context.Context, req *connect.Request[querierv1.SelectSeriesRequest]) (*connect.Response[querierv1.SelectSeriesResponse], error) {
+	tenantID, err := tenant.TenantID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	q.mu.Lock()
+	q.requests = append(q.requests, req.Msg)
+	q.tenants = append(q.tenants, tenantID)
+	q.mu.Unlock()
+	if req.Msg.LabelSelector == `{service_name="broken"}` {
+		return nil, connect.NewError(connect.CodeInternal, io.ErrUnexpectedEOF)
+	}
+	return connect.NewResponse(&querierv1.SelectSeriesResponse{Series: []*typesv1.Series{{
+		Labels: phlaremodel.LabelsFromStrings("service_name", "checkout"),
+		Points: []*typesv1.Point{{Timestamp: req.Msg.End, Value: 42}},
+	}}}), nil
+}
+
+type remoteWriteServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests []*prompb.WriteRequest
+	tenants  []string
+}
+
+func newRemoteWriteServer(t *testing.T) *remoteWriteServer {
+	s := new(remoteWriteServer)
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		compressed, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		data, err := snappy.Decode(nil, compressed)
+		require.NoError(t, err)
+		var req prompb.WriteRequest
+		require.NoError(t, req.Unmarshal(data))
+		s.mu.Lock()
+		s.requests = append(s.requests, &req)
+		s.tenants = append(s.tenants, r.Header.Get(user.OrgIDHeaderName))
+		s.mu.Unlock()
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func newTestRuler(t *testing.T, bucket objstore.Bucket, querier querierv1connect.QuerierServiceClient, remoteWriteURL string) *Ruler {
+	kvStore, closer := consul.NewInMemoryClient(ring.GetCodec(), log.NewNopLogger(), nil)
+	t.Cleanup(func() { assert.NoError(t, closer.Close()) })
+	return newTestRulerWithKV(t, bucket, querier, remoteWriteURL, kvStore, "ruler-1")
+}
+
+// newTestRulerWithKV returns a ruler that is ACTIVE in the ring
+// stored in the KV store given. The rule groups are not evaluated
+// until the ruler is synced.
+func newTestRulerWithKV(t *testing.T, bucket objstore.Bucket, querier querierv1connect.QuerierServiceClient, remoteWriteURL string, kvStore kv.Client, instanceID string) *Ruler {
+	cfg := Config{
+		EvaluationInterval: time.Minute,
+		PollInterval:       time.Minute,
+		QueryTimeout:       time.Second,
+		RemoteWriteURL:     remoteWriteURL,
+		RemoteWriteTimeout: time.Second,
+	}
+	cfg.Ring.KVStore.Mock = kvStore
+	cfg.Ring.InstanceID = instanceID
+	cfg.Ring.InstanceAddr = instanceID
+	cfg.Ring.HeartbeatPeriod = time.Second
+	cfg.Ring.HeartbeatTimeout = time.Minute
+	r, err := New(cfg, bucket, querier, log.NewNopLogger(), prometheus.NewRegistry())
+	require.NoError(t, err)
+	require.NoError(t, r.starting(context.Background()))
+	t.Cleanup(func() { require.NoError(t, r.stopping(nil)) })
+	return r
+}
+
+func Test_Ruler_evaluateGroup(t *testing.T) {
+	bucket, _ := testutil.NewFilesystemBucket(t, context.Background(), t.TempDir())
+	querier := new(fakeQuerier)
+	rw := newRemoteWriteServer(t)
+	r := newTestRuler(t, bucket, querier, rw.URL)
+	r.cfg.EvaluationDelay = time.Second
+
+	g, err := ParseRuleGroup([]byte(`
+name: checkout
+rules:
+  - record: checkout_cpu
+    profile_type: process_cpu:cpu:nanoseconds:cpu:nanoseconds
+    selector: '{service_name="checkout"}'
+  - record: broken_cpu
+    profile_type: process_cpu:cpu:nanoseconds:cpu:nanoseconds
+    selector: '{service_name="broken"}'
+`))
+	require.NoError(t, err)
+	now := time.UnixMilli(1_001_000)
+	r.evaluateGroup(context.Background(), "tenant-a", g, now)
+
+	// The rules are evaluated the evaluation delay behind.
+	require.Len(t, querier.requests, 2)
+	assert.Equal(t, []string{"tenant-a", "tenant-a"}, querier.tenants)
+	assert.Equal(t, int64(940_000), querier.requests[0].Start)
+	assert.Equal(t, int64(1_000_000), querier.requests[0].End)
+
+	// The failed rule is skipped.
+	require.Len(t, rw.requests, 1)
+	assert.Equal(t, []string{"tenant-a"}, rw.tenants)
+	assert.Equal(t, []prompb.TimeSeries{{
+		Labels: []prompb.Label{
+			{Name: "__name__", Value: "checkout_cpu"},
+			{Name: "service_name", Value: "checkout"},
+		},
+		Samples: []prompb.Sample{{Value: 42, Timestamp: 1_000_000}},
+	}}, rw.requests[0].Timeseries)
+}
+
+func Test_Ruler_sync(t *testing.T) {
+	bucket, _ := testutil.NewFilesystemBucket(t, context.Background(), t.TempDir())
+	rw := newRemoteWriteServer(t)
+	r := newTestRuler(t, bucket, new(fakeQuerier), rw.URL)
+	ctx := context.Background()
+
+	g, err := ParseRuleGroup([]byte(testRuleGroup))
+	require.NoError(t, err)
+	require.NoError(t, r.store.Set(ctx, "tenant-a", g))
+	require.NoError(t, r.store.Set(ctx, "tenant-b", g))
+	require.NoError(t, r.sync(ctx))
+	assert.Len(t, r.groups, 2)
+	evaluator := r.groups["tenant-a/gc"]
+	require.NotNil(t, evaluator)
+
+	// Unchanged groups keep running.
+	require.NoError(t, r.sync(ctx))
+	assert.Same(t, evaluator, r.groups["tenant-a/gc"])
+
+	// Modified groups are restarted, deleted groups are stopped.
+	g.Interval = 0
+	require.NoError(t, r.store.Set(ctx, "tenant-a", g))
+	require.NoError(t, r.store.Delete(ctx, "tenant-b", "gc"))
+	require.NoError(t, r.sync(ctx))
+	assert.Len(t, r.groups, 1)
+	assert.NotSame(t, evaluator, r.groups["tenant-a/gc"])
+}
+
+func Test_Ruler_sync_Sharding(t *testing.T) {
+	bucket, _ := testutil.NewFilesystemBucket(t, context.Background(), t.TempDir())
+	rw := newRemoteWriteServer(t)
+	kvStore, closer := consul.NewInMemoryClient(ring.GetCodec(), log.NewNopLogger(), nil)
+	t.Cleanup(func() { assert.NoError(t, closer.Close()) })
+	r1 := newTestRulerWithKV(t, bucket, new(fakeQuerier), rw.URL, kvStore, "ruler-1")
+	r2 := newTestRulerWithKV(t, bucket, new(fakeQuerier), rw.URL, kvStore, "ruler-2")
+	for _, r := range []*Ruler{r1, r2} {
+		require.Eventually(t, func() bool {
+			return r.ring.InstancesCount() == 2
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+
+	ctx := context.Background()
+	g, err := ParseRuleGroup([]byte(testRuleGroup))
+	require.NoError(t, err)
+	const n = 32
+	for i := 0; i < n; i++ {
+		g.Name = fmt.Sprintf("group-%d", i)
+		require.NoError(t, r1.store.Set(ctx, "tenant-a", g))
+	}
+	require.NoError(t, r1.sync(ctx))
+	require.NoError(t, r2.sync(ctx))
+
+	// Each group is evaluated by exactly one ruler.
+	assert.NotEmpty(t, r1.groups)
+	assert.NotEmpty(t, r2.groups)
+	assert.Len(t, r1.groups, n-len(r2.groups))
+	for key := range r1.groups {
+		assert.NotContains(t, r2.groups, key)
+	}
+}
+
+func Test_Ruler_API(t *testing.T) {
+	bucket, _ := testutil.NewFilesystemBucket(t, context.Background(), t.TempDir())
+	r := newTestRuler(t, bucket, new(fakeQuerier), "http://localhost")
+
+	router := mux.NewRouter()
+	router.Path("/ruler/rules").Methods(http.MethodGet).HandlerFunc(r.ListRuleGroupsHandler)
+	router.Path("/ruler/rules").Methods(http.MethodPost).HandlerFunc(r.SetRuleGroupHandler)
+	router.Path("/ruler/rules/{group}").Methods(http.MethodGet).HandlerFunc(r.GetRuleGroupHandler)
+	router.Path("/ruler/rules/{group}").Methods(http.MethodDelete).HandlerFunc(r.DeleteRuleGroupHandler)
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		router.ServeHTTP(w, req.WithContext(user.InjectOrgID(req.Context(), "tenant-a")))
+	}))
+	defer s.Close()
+
+	do := func(method, path, body string) (int, string) {
+		req, err := http.NewRequest(method, s.URL+path, strings.NewReader(body))
+		require.NoError(t, err)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		data, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, string(data)
+	}
+
+	status, _ := do(http.MethodGet, "/ruler/rules/gc", "")
+	assert.Equal(t, http.StatusNotFound, status)
+	status, _ = do(http.MethodPost, "/ruler/rules", "name: gc")
+	assert.Equal(t, http.StatusBadRequest, status)
+	status, _ = do(http.MethodPost, "/ruler/rules", testRuleGroup)
+	assert.Equal(t, http.StatusAccepted, status)
+
+	status, body := do(http.MethodGet, "/ruler/rules/gc", "")
+	assert.Equal(t, http.StatusOK, status)
+	g, err := ParseRuleGroup([]byte(body))
+	require.NoError(t, err)
+	assert.Equal(t, "gc_cpu_nanoseconds", g.Rules[0].Record)
+
+	status, body = do(http.MethodGet, "/ruler/rules", "")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Contains(t, body, "groups:\n    - name: gc\n")
+
+	status, _ = do(http.MethodDelete, "/ruler/rules/gc", "")
+	assert.Equal(t, http.StatusAccepted, status)
+	status, _ = do(http.MethodDelete, "/ruler/rules/gc", "")
+	assert.Equal(t, http.StatusNotFound, status)
+}
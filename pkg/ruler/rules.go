@@ -0,0 +1,228 @@
+package ruler
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/prometheus/prometheus/promql/parser"
+	"gopkg.in/yaml.v3"
+
+	querierv1 "github.com/grafana/pyroscope/api/gen/proto/go/querier/v1"
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+)
+
+// RuleGroup is a set of recording rules evaluated together,
+// at the same interval.
+type RuleGroup struct {
+	Name string `yaml:"name"`
+	// Evaluation interval of the group. If zero, the
+	// default evaluation interval of the ruler is used.
+	Interval model.Duration `yaml:"interval,omitempty"`
+	Rules    []Rule         `yaml:"rules"`
+}
+
+// Rule records the result of a SelectSeries query as a metric.
+// Each series of the query result makes a time series of the
+// metric, labeled with the series labels and the rule labels.
+type Rule struct {
+	Record        string            `yaml:"record"`
+	ProfileType   string            `yaml:"profile_type"`
+	LabelSelector string            `yaml:"selector"`
+	GroupBy       []string          `yaml:"group_by,omitempty"`
+	Aggregation   string            `yaml:"aggregation,omitempty"`
+	Labels        map[string]string `yaml:"labels,omitempty"`
+
+	// Stack trace selector: only the samples of the matching stack
+	// traces are accounted. CallSite is ignored if Function is set.
+	CallSite []string          `yaml:"call_site,omitempty"`
+	Function *FunctionSelector `yaml:"function,omitempty"`
+}
+
+type FunctionSelector struct {
+	Name  string `yaml:"name"`
+	Regex bool   `yaml:"regex,omitempty"`
+	Self  bool   `yaml:"self,omitempty"`
+}
+
+var (
+	ErrInvalidRuleGroup = errors.New("invalid rule group")
+
+	groupNameRE = regexp.MustCompile(`^[a-zA-Z0-9_.-]{1,128}$`)
+
+	aggregations = map[string]typesv1.TimeSeriesAggregationType{
+		"sum":   typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_SUM,
+		"avg":   typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_AVERAGE,
+		"min":   typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_MIN,
+		"max":   typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_MAX,
+		"count": typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_COUNT,
+		"p50":   typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_P50,
+		"p90":   typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_P90,
+		"p99":   typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_P99,
+	}
+)
+
+// ParseRuleGroup decodes and validates the YAML rule group.
+func ParseRuleGroup(data []byte) (*RuleGroup, error) {
+	var g RuleGroup
+	if err := yaml.Unmarshal(data, &g); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRuleGroup, err)
+	}
+	if err := g.Validate(); err != nil {
+		return nil, err
+	}
+	return &g, nil
+}
+
+func (g *RuleGroup) Validate() error {
+	if !groupNameRE.MatchString(g.Name) {
+		return fmt.Errorf("%w: group name %q must consist of up to 128 alphanumeric, '_', '.' and '-' characters", ErrInvalidRuleGroup, g.Name)
+	}
+	if g.Interval < 0 {
+		return fmt.Errorf("%w: negative evaluation interval", ErrInvalidRuleGroup)
+	}
+	if len(g.Rules) == 0 {
+		return fmt.Errorf("%w: group %s has no rules", ErrInvalidRuleGroup, g.Name)
+	}
+	for i := range g.Rules {
+		if err := g.Rules[i].validate(); err != nil {
+			return fmt.Errorf("%w: group %s, rule %d: %v", ErrInvalidRuleGroup, g.Name, i, err)
+		}
+	}
+	return nil
+}
+
+func (r *Rule) validate() error {
+	if !model.IsValidMetricName(model.LabelValue(r.Record)) {
+		return fmt.Errorf("invalid metric name %q", r.Record)
+	}
+	if _, err := phlaremodel.ParseProfileTypeSelector(r.ProfileType); err != nil {
+		return err
+	}
+	if _, err := parser.ParseMetricSelector(r.LabelSelector); err != nil {
+		return fmt.Errorf("invalid selector: %w", err)
+	}
+	for _, name := range r.GroupBy {
+		if !model.LabelName(name).IsValid() {
+			return fmt.Errorf("invalid group by label name %q", name)
+		}
+	}
+	if _, ok := aggregations[r.Aggregation]; r.Aggregation != "" && !ok {
+		return fmt.Errorf("unknown aggregation %q", r.Aggregation)
+	}
+	for name := range r.Labels {
+		if !model.LabelName(name).IsValid() || name == model.MetricNameLabel {
+			return fmt.Errorf("invalid label name %q", name)
+		}
+	}
+	if r.Function != nil && r.Function.Name == "" {
+		return errors.New("function name is required")
+	}
+	if r.Function != nil && r.Function.Regex {
+		if _, err := regexp.Compile(r.Function.Name); err != nil {
+			return fmt.Errorf("invalid function name regular expression: %w", err)
+		}
+	}
+	return nil
+}
+
+func (g *RuleGroup) evaluationInterval(defaultInterval time.Duration) time.Duration {
+	if g.Interval > 0 {
+		return time.Duration(g.Interval)
+	}
+	return defaultInterval
+}
+
+func (r *Rule) aggregation() typesv1.TimeSeriesAggregationType {
+	return aggregations[r.Aggregation]
+}
+
+// selectSeriesRequest returns the query of the rule evaluation: a single
+// step that spans the evaluation interval ending at the time given.
+func (r *Rule) selectSeriesRequest(now time.Time, interval time.Duration) *querierv1.SelectSeriesRequest {
+	aggregation := r.aggregation()
+	req := &querierv1.SelectSeriesRequest{
+		ProfileTypeID: r.ProfileType,
+		LabelSelector: r.LabelSelector,
+		Start:         now.Add(-interval).UnixMilli(),
+		End:           now.UnixMilli(),
+		GroupBy:       r.GroupBy,
+		Step:          interval.Seconds(),
+		Aggregation:   &aggregation,
+	}
+	switch {
+	case r.Function != nil:
+		req.StackTraceSelector = &typesv1.StackTraceSelector{
+			Function: &typesv1.FunctionSelector{
+				Name:  r.Function.Name,
+				Regex: r.Function.Regex,
+				Self:  r.Function.Self,
+			},
+		}
+	case len(r.CallSite) > 0:
+		callSite := make([]*typesv1.Location, len(r.CallSite))
+		for i, name := range r.CallSite {
+			callSite[i] = &typesv1.Location{Name: name}
+		}
+		req.StackTraceSelector = &typesv1.StackTraceSelector{CallSite: callSite}
+	}
+	return req
+}
+
+// timeSeries converts the query result into samples of the recorded
+// metric. The series of the query result may have more than one point,
+// if profiles fall on the step boundary: the points are then combined
+// according to the aggregation.
+func (r *Rule) timeSeries(series []*typesv1.Series, now time.Time) []prompb.TimeSeries {
+	ts := make([]prompb.TimeSeries, 0, len(series))
+	for _, s := range series {
+		if len(s.Points) == 0 {
+			continue
+		}
+		labels := make([]prompb.Label, 0, len(s.Labels)+len(r.Labels)+1)
+		labels = append(labels, prompb.Label{Name: model.MetricNameLabel, Value: r.Record})
+		for _, l := range s.Labels {
+			if _, ok := r.Labels[l.Name]; !ok {
+				labels = append(labels, prompb.Label{Name: l.Name, Value: l.Value})
+			}
+		}
+		for name, value := range r.Labels {
+			labels = append(labels, prompb.Label{Name: name, Value: value})
+		}
+		sort.Slice(labels, func(i, j int) bool {
+			return labels[i].Name < labels[j].Name
+		})
+		ts = append(ts, prompb.TimeSeries{
+			Labels: labels,
+			Samples: []prompb.Sample{{
+				Value:     combinePoints(s.Points, r.aggregation()),
+				Timestamp: now.UnixMilli(),
+			}},
+		})
+	}
+	return ts
+}
+
+func combinePoints(points []*typesv1.Point, aggregation typesv1.TimeSeriesAggregationType) float64 {
+	v := points[len(points)-1].Value
+	for _, p := range points[:len(points)-1] {
+		switch aggregation {
+		case typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_SUM,
+			typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_COUNT:
+			v += p.Value
+		case typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_MIN:
+			v = math.Min(v, p.Value)
+		case typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_MAX:
+			v = math.Max(v, p.Value)
+		}
+		// Averages and percentiles can't be combined:
+		// the latest point is taken.
+	}
+	return v
+}
@@ -0,0 +1,109 @@
+package ruler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	typesv1 "github.com/grafana/pyroscope/api/gen/proto/go/types/v1"
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+)
+
+const testRuleGroup = `
+name: gc
+interval: 30s
+rules:
+  - record: gc_cpu_nanoseconds
+    profile_type: process_cpu:cpu:nanoseconds:cpu:nanoseconds
+    selector: '{service_name="checkout"}'
+    group_by: [service_name]
+    function:
+      name: runtime.gcBgMarkWorker
+    labels:
+      team: platform
+  - record: cpu_nanoseconds
+    profile_type: process_cpu:cpu:nanoseconds:cpu:nanoseconds
+    selector: '{service_name="checkout"}'
+    aggregation: max
+    call_site: [main, handler]
+`
+
+func Test_ParseRuleGroup(t *testing.T) {
+	g, err := ParseRuleGroup([]byte(testRuleGroup))
+	require.NoError(t, err)
+	assert.Equal(t, "gc", g.Name)
+	assert.Equal(t, 30*time.Second, g.evaluationInterval(time.Minute))
+	require.Len(t, g.Rules, 2)
+
+	now := time.UnixMilli(1_000_000)
+	req := g.Rules[0].selectSeriesRequest(now, 30*time.Second)
+	assert.Equal(t, int64(970_000), req.Start)
+	assert.Equal(t, int64(1_000_000), req.End)
+	assert.Equal(t, float64(30), req.Step)
+	assert.Equal(t, typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_SUM, req.GetAggregation())
+	assert.Equal(t, "runtime.gcBgMarkWorker", req.StackTraceSelector.GetFunction().GetName())
+
+	req = g.Rules[1].selectSeriesRequest(now, 30*time.Second)
+	assert.Equal(t, typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_MAX, req.GetAggregation())
+	require.Len(t, req.StackTraceSelector.GetCallSite(), 2)
+	assert.Equal(t, "handler", req.StackTraceSelector.CallSite[1].Name)
+}
+
+func Test_ParseRuleGroup_Invalid(t *testing.T) {
+	for name, group := range map[string]string{
+		"invalid yaml":   `name: [`,
+		"no name":        `rules: [{record: x, profile_type: "a:b:c:d:e", selector: "{}"}]`,
+		"invalid name":   `{name: "../x", rules: [{record: x, profile_type: "a:b:c:d:e", selector: "{}"}]}`,
+		"no rules":       `name: x`,
+		"invalid record": `{name: x, rules: [{record: "x-y", profile_type: "a:b:c:d:e", selector: "{}"}]}`,
+		"profile type":   `{name: x, rules: [{record: x, profile_type: "a", selector: "{}"}]}`,
+		"selector":       `{name: x, rules: [{record: x, profile_type: "a:b:c:d:e", selector: "{"}]}`,
+		"aggregation":    `{name: x, rules: [{record: x, profile_type: "a:b:c:d:e", selector: "{}", aggregation: median}]}`,
+		"label name":     `{name: x, rules: [{record: x, profile_type: "a:b:c:d:e", selector: "{}", labels: {__name__: y}}]}`,
+		"function regex": `{name: x, rules: [{record: x, profile_type: "a:b:c:d:e", selector: "{}", function: {name: "(", regex: true}}]}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseRuleGroup([]byte(group))
+			require.ErrorIs(t, err, ErrInvalidRuleGroup)
+		})
+	}
+}
+
+func Test_Rule_timeSeries(t *testing.T) {
+	rule := &Rule{
+		Record:      "cpu",
+		Aggregation: "sum",
+		Labels:      map[string]string{"team": "platform", "service_name": "override"},
+	}
+	now := time.UnixMilli(1_000_000)
+	series := []*typesv1.Series{
+		{
+			Labels: phlaremodel.LabelsFromStrings("service_name", "checkout", "pod", "a"),
+			Points: []*typesv1.Point{{Timestamp: 1, Value: 1}, {Timestamp: 2, Value: 2}},
+		},
+		{
+			Labels: phlaremodel.LabelsFromStrings("service_name", "cart"),
+		},
+	}
+	assert.Equal(t, []prompb.TimeSeries{{
+		Labels: []prompb.Label{
+			{Name: "__name__", Value: "cpu"},
+			{Name: "pod", Value: "a"},
+			{Name: "service_name", Value: "override"},
+			{Name: "team", Value: "platform"},
+		},
+		Samples: []prompb.Sample{{Value: 3, Timestamp: 1_000_000}},
+	}}, rule.timeSeries(series, now))
+}
+
+func Test_combinePoints(t *testing.T) {
+	points := []*typesv1.Point{{Value: 4}, {Value: 1}, {Value: 2}}
+	assert.Equal(t, float64(7), combinePoints(points, typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_SUM))
+	assert.Equal(t, float64(7), combinePoints(points, typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_COUNT))
+	assert.Equal(t, float64(1), combinePoints(points, typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_MIN))
+	assert.Equal(t, float64(4), combinePoints(points, typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_MAX))
+	assert.Equal(t, float64(2), combinePoints(points, typesv1.TimeSeriesAggregationType_TIME_SERIES_AGGREGATION_TYPE_AVERAGE))
+}
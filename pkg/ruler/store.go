@@ -0,0 +1,119 @@
+package ruler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/grafana/pyroscope/pkg/objstore"
+	"github.com/grafana/pyroscope/pkg/phlaredb/bucket"
+)
+
+var ErrRuleGroupNotFound = errors.New("rule group not found")
+
+const ruleGroupExt = ".yaml"
+
+// Store keeps rule groups in the object storage,
+// one object per group, under the tenant prefix.
+type Store struct {
+	bucket objstore.Bucket
+}
+
+func NewStore(bucket objstore.Bucket) *Store {
+	return &Store{bucket: bucket}
+}
+
+func (s *Store) tenantBucket(tenantID string) objstore.Bucket {
+	return objstore.NewPrefixedBucket(s.bucket, tenantID+"/ruler")
+}
+
+// Tenants returns the tenants found in the bucket. Not every
+// tenant returned necessarily has rule groups.
+func (s *Store) Tenants(ctx context.Context) ([]string, error) {
+	return bucket.ListUsers(ctx, s.bucket)
+}
+
+// List returns the rule groups of the tenant, ordered by name.
+func (s *Store) List(ctx context.Context, tenantID string) ([]*RuleGroup, error) {
+	b := s.tenantBucket(tenantID)
+	var names []string
+	err := b.Iter(ctx, "", func(name string) error {
+		if strings.HasSuffix(name, ruleGroupExt) {
+			names = append(names, strings.TrimSuffix(name, ruleGroupExt))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing rule groups: %w", err)
+	}
+	groups := make([]*RuleGroup, 0, len(names))
+	for _, name := range names {
+		g, err := s.Get(ctx, tenantID, name)
+		if err != nil {
+			if errors.Is(err, ErrRuleGroupNotFound) {
+				// Deleted concurrently.
+				continue
+			}
+			return nil, err
+		}
+		groups = append(groups, g)
+	}
+	return groups, nil
+}
+
+func (s *Store) Get(ctx context.Context, tenantID, name string) (*RuleGroup, error) {
+	if !groupNameRE.MatchString(name) {
+		return nil, ErrRuleGroupNotFound
+	}
+	b := s.tenantBucket(tenantID)
+	r, err := b.Get(ctx, name+ruleGroupExt)
+	if err != nil {
+		if b.IsObjNotFoundErr(err) {
+			return nil, ErrRuleGroupNotFound
+		}
+		return nil, fmt.Errorf("fetching rule group %s: %w", name, err)
+	}
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading rule group %s: %w", name, err)
+	}
+	return ParseRuleGroup(data)
+}
+
+// Set creates or replaces the rule group.
+func (s *Store) Set(ctx context.Context, tenantID string, g *RuleGroup) error {
+	if err := g.Validate(); err != nil {
+		return err
+	}
+	data, err := yaml.Marshal(g)
+	if err != nil {
+		return err
+	}
+	return s.tenantBucket(tenantID).Upload(ctx, g.Name+ruleGroupExt, bytes.NewReader(data))
+}
+
+func (s *Store) Delete(ctx context.Context, tenantID, name string) error {
+	if !groupNameRE.MatchString(name) {
+		return ErrRuleGroupNotFound
+	}
+	b := s.tenantBucket(tenantID)
+	// Not every bucket implementation reports deletion
+	// of a missing object as an error.
+	exists, err := b.Exists(ctx, name+ruleGroupExt)
+	if err != nil {
+		return fmt.Errorf("deleting rule group %s: %w", name, err)
+	}
+	if !exists {
+		return ErrRuleGroupNotFound
+	}
+	if err = b.Delete(ctx, name+ruleGroupExt); err != nil && !b.IsObjNotFoundErr(err) {
+		return fmt.Errorf("deleting rule group %s: %w", name, err)
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-kit/log"
@@ -19,6 +20,8 @@ import (
 	phlareobj "github.com/grafana/pyroscope/pkg/objstore"
 	"github.com/grafana/pyroscope/pkg/phlaredb"
 	"github.com/grafana/pyroscope/pkg/phlaredb/block"
+	"github.com/grafana/pyroscope/pkg/phlaredb/symdb"
+	"github.com/grafana/pyroscope/pkg/phlaredb/tombstones"
 )
 
 // TODO move this to a config.
@@ -35,7 +38,11 @@ type BucketStore struct {
 
 	tenantID, syncDir string
 
-	logger log.Logger
+	symbolizer symdb.Symbolizer
+	logger     log.Logger
+
+	deleteRequests *tombstones.Store
+	tombstones     atomic.Pointer[tombstones.Tombstones]
 
 	blocksMx sync.RWMutex
 	blocks   map[ulid.ULID]*Block
@@ -45,15 +52,18 @@ type BucketStore struct {
 	stats   BucketStoreStats
 }
 
-func NewBucketStore(bucket phlareobj.Bucket, fetcher block.MetadataFetcher, tenantID string, syncDir string, logger log.Logger, reg prometheus.Registerer) (*BucketStore, error) {
+func NewBucketStore(bucket phlareobj.Bucket, fetcher block.MetadataFetcher, tenantID string, syncDir string, symbolizer symdb.Symbolizer, logger log.Logger, reg prometheus.Registerer) (*BucketStore, error) {
 	s := &BucketStore{
-		fetcher:  fetcher,
-		bucket:   phlareobj.NewTenantBucketClient(tenantID, bucket, nil),
-		tenantID: tenantID,
-		syncDir:  syncDir,
-		logger:   log.With(logger, "tenant", tenantID),
-		blockSet: newBucketBlockSet(),
-		blocks:   map[ulid.ULID]*Block{},
+		fetcher:    fetcher,
+		bucket:     phlareobj.NewTenantBucketClient(tenantID, bucket, nil),
+		tenantID:   tenantID,
+		syncDir:    syncDir,
+		symbolizer: symbolizer,
+		logger:     log.With(logger, "tenant", tenantID),
+
+		deleteRequests: tombstones.NewStore(bucket),
+		blockSet:       newBucketBlockSet(),
+		blocks:         map[ulid.ULID]*Block{},
 		metrics: NewBucketStoreMetrics(prometheus.WrapRegistererWith(
 			prometheus.Labels{"tenant": tenantID},
 			reg,
@@ -103,7 +113,24 @@ func (s *BucketStore) getBlock(id ulid.ULID) *Block {
 	return s.blocks[id]
 }
 
+// Tombstones returns the tombstones of the tenant delete requests:
+// the deleted profiles are filtered out at query time.
+func (s *BucketStore) Tombstones() *tombstones.Tombstones {
+	return s.tombstones.Load()
+}
+
+func (s *BucketStore) syncTombstones(ctx context.Context) {
+	t, err := s.deleteRequests.Tombstones(ctx, s.tenantID)
+	if err != nil {
+		// Keep the previously loaded tombstones.
+		level.Warn(s.logger).Log("msg", "failed to load delete requests", "err", err)
+		return
+	}
+	s.tombstones.Store(t)
+}
+
 func (s *BucketStore) SyncBlocks(ctx context.Context) error {
+	s.syncTombstones(ctx)
 	// TODO sounds like we should get the meta this is just a list of ids
 	metas, _, metaFetchErr := s.fetcher.Fetch(ctx)
 	// For partial view allow adding new blocks at least.
@@ -181,6 +208,10 @@ func (bs *BucketStore) addBlock(ctx context.Context, meta *block.Meta) (err erro
 		bs.blocksMx.Lock()
 		defer bs.blocksMx.Unlock()
 		ctx = phlaredb.ContextWithBlockMetrics(ctx, bs.metrics.blockMetrics)
+		if bs.symbolizer != nil {
+			ctx = phlaredb.ContextWithSymbolizer(ctx, bs.symbolizer)
+		}
+		ctx = phlaredb.ContextWithTombstones(ctx, bs)
 		b, err := bs.createBlock(ctx, meta)
 		if err != nil {
 			return nil, errors.Wrap(err, "load block from disk")
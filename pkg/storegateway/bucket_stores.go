@@ -19,6 +19,7 @@ import (
 	phlareobj "github.com/grafana/pyroscope/pkg/objstore"
 	"github.com/grafana/pyroscope/pkg/phlaredb/block"
 	"github.com/grafana/pyroscope/pkg/phlaredb/bucket"
+	"github.com/grafana/pyroscope/pkg/phlaredb/symdb"
 	"github.com/grafana/pyroscope/pkg/util"
 )
 
@@ -31,6 +32,8 @@ type BucketStoreConfig struct {
 	IgnoreBlocksWithin       time.Duration `yaml:"ignore_blocks_within" category:"advanced"`
 	MetaSyncConcurrency      int           `yaml:"meta_sync_concurrency" category:"advanced"`
 	IgnoreDeletionMarksDelay time.Duration `yaml:"ignore_deletion_mark_delay" category:"advanced"`
+
+	BucketCache phlareobj.CachingBucketConfig `yaml:"bucket_cache" doc:"description=Configures the cache of the object store reads: block metadata files, attributes and headers of the block files."`
 }
 
 // RegisterFlags registers the BucketStore flags
@@ -41,6 +44,8 @@ func (cfg *BucketStoreConfig) RegisterFlags(f *flag.FlagSet, logger log.Logger)
 	// cfg.BucketIndex.RegisterFlagsWithPrefix(f, "blocks-storage.bucket-store.bucket-index.")
 	// cfg.IndexHeader.RegisterFlagsWithPrefix(f, "blocks-storage.bucket-store.index-header.")
 
+	cfg.BucketCache.RegisterFlagsWithPrefix("blocks-storage.bucket-store.bucket-cache.", f)
+
 	f.StringVar(&cfg.SyncDir, "blocks-storage.bucket-store.sync-dir", "./data/pyroscope-sync/", "Directory to store synchronized pyroscope block headers. This directory is not required to be persisted between restarts, but it's highly recommended in order to improve the store-gateway startup time.")
 	f.DurationVar(&cfg.SyncInterval, "blocks-storage.bucket-store.sync-interval", 15*time.Minute, "How frequently to scan the bucket, or to refresh the bucket index (if enabled), in order to look for changes (new blocks shipped by ingesters and blocks deleted by retention or compaction).")
 	f.IntVar(&cfg.TenantSyncConcurrency, "blocks-storage.bucket-store.tenant-sync-concurrency", 10, "Maximum number of concurrent tenants synching blocks.")
@@ -85,6 +90,9 @@ func (cfg *BucketStoreConfig) Validate(logger log.Logger) error {
 	// if !util.StringsContain(validSeriesSelectionStrategies, cfg.SeriesSelectionStrategyName) {
 	// 	return errors.New("invalid series-selection-strategy, set one of " + strings.Join(validSeriesSelectionStrategies, ", "))
 	// }
+	if err := cfg.BucketCache.Validate(); err != nil {
+		return errors.Wrap(err, "bucket-cache configuration")
+	}
 	return nil
 }
 
@@ -107,6 +115,7 @@ type BucketStores struct {
 	syncBackoffConfig backoff.Config
 	shardingStrategy  ShardingStrategy
 	limits            Limits
+	symbolizer        symdb.Symbolizer
 	reg               prometheus.Registerer
 	// Keeps a bucket store for each tenant.
 	storesMu sync.RWMutex
@@ -120,9 +129,10 @@ type BucketStores struct {
 	blocksLoaded      prometheus.GaugeFunc
 }
 
-func NewBucketStores(cfg BucketStoreConfig, shardingStrategy ShardingStrategy, storageBucket phlareobj.Bucket, limits Limits, logger log.Logger, reg prometheus.Registerer) (*BucketStores, error) {
+func NewBucketStores(cfg BucketStoreConfig, shardingStrategy ShardingStrategy, storageBucket phlareobj.Bucket, limits Limits, symbolizer symdb.Symbolizer, logger log.Logger, reg prometheus.Registerer) (*BucketStores, error) {
 	bs := &BucketStores{
 		storageBucket: storageBucket,
+		symbolizer:    symbolizer,
 		logger:        logger,
 		cfg:           cfg,
 		syncBackoffConfig: backoff.Config{
@@ -341,6 +351,7 @@ func (bs *BucketStores) getOrCreateStore(userID string) (*BucketStore, error) {
 		fetcher,
 		userID,
 		bs.syncDirForUser(userID),
+		bs.symbolizer,
 		userLogger,
 		bs.reg,
 	)
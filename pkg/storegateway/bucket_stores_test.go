@@ -48,7 +48,7 @@ func TestBucketStores_BlockMetricsRegistration(t *testing.T) {
 		MetaSyncConcurrency:   1,
 	}
 
-	stores, err := NewBucketStores(config, sharding, bucket, limits, logger, reg)
+	stores, err := NewBucketStores(config, sharding, bucket, limits, nil, logger, reg)
 	require.NoError(t, err)
 	require.NoError(t, stores.SyncBlocks(ctx))
 
@@ -66,7 +66,7 @@ func TestBucketStores_BlockMetricsRegistration(t *testing.T) {
 		},
 		Start: 0,
 		End:   time.Now().UnixMilli(),
-	}, 0)
+	}, 0, nil)
 	require.NoError(t, err)
 	require.NotNil(t, r)
 
@@ -15,6 +15,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 
 	phlareobj "github.com/grafana/pyroscope/pkg/objstore"
+	"github.com/grafana/pyroscope/pkg/phlaredb/symdb"
 	"github.com/grafana/pyroscope/pkg/util"
 	"github.com/grafana/pyroscope/pkg/validation"
 )
@@ -83,7 +84,7 @@ func (c *Config) Validate(limits validation.Limits) error {
 	return nil
 }
 
-func NewStoreGateway(gatewayCfg Config, storageBucket phlareobj.Bucket, limits Limits, logger log.Logger, reg prometheus.Registerer) (*StoreGateway, error) {
+func NewStoreGateway(gatewayCfg Config, storageBucket phlareobj.Bucket, limits Limits, symbolizer symdb.Symbolizer, logger log.Logger, reg prometheus.Registerer) (*StoreGateway, error) {
 	ringStore, err := kv.NewClient(
 		gatewayCfg.ShardingRing.Ring.KVStore,
 		ring.GetCodec(),
@@ -94,10 +95,15 @@ func NewStoreGateway(gatewayCfg Config, storageBucket phlareobj.Bucket, limits L
 		return nil, errors.Wrap(err, "create KV store client")
 	}
 
-	return newStoreGateway(gatewayCfg, storageBucket, ringStore, limits, logger, reg)
+	storageBucket, err = phlareobj.NewCachingBucketFromConfig(gatewayCfg.BucketStoreConfig.BucketCache, storageBucket, "store-gateway-bucket-cache", logger, reg)
+	if err != nil {
+		return nil, errors.Wrap(err, "create caching bucket")
+	}
+
+	return newStoreGateway(gatewayCfg, storageBucket, ringStore, limits, symbolizer, logger, reg)
 }
 
-func newStoreGateway(gatewayCfg Config, storageBucket phlareobj.Bucket, ringStore kv.Client, limits Limits, logger log.Logger, reg prometheus.Registerer) (*StoreGateway, error) {
+func newStoreGateway(gatewayCfg Config, storageBucket phlareobj.Bucket, ringStore kv.Client, limits Limits, symbolizer symdb.Symbolizer, logger log.Logger, reg prometheus.Registerer) (*StoreGateway, error) {
 	var err error
 
 	g := &StoreGateway{
@@ -142,7 +148,7 @@ func newStoreGateway(gatewayCfg Config, storageBucket phlareobj.Bucket, ringStor
 
 	shardingStrategy = NewShuffleShardingStrategy(g.ring, lifecyclerCfg.ID, lifecyclerCfg.Addr, limits, logger)
 
-	g.stores, err = NewBucketStores(gatewayCfg.BucketStoreConfig, shardingStrategy, storageBucket, limits, logger, prometheus.WrapRegistererWith(prometheus.Labels{"component": "store-gateway"}, reg))
+	g.stores, err = NewBucketStores(gatewayCfg.BucketStoreConfig, shardingStrategy, storageBucket, limits, symbolizer, logger, prometheus.WrapRegistererWith(prometheus.Labels{"component": "store-gateway"}, reg))
 	if err != nil {
 		return nil, errors.Wrap(err, "create bucket stores")
 	}
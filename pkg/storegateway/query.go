@@ -124,6 +124,39 @@ func (s *StoreGateway) MergeSpanProfile(ctx context.Context, stream *connect.Bid
 	return terminateStream(stream)
 }
 
+func (s *StoreGateway) MergeProfilesTopFunctions(ctx context.Context, stream *connect.BidiStream[ingestv1.MergeProfilesTopFunctionsRequest, ingestv1.MergeProfilesTopFunctionsResponse]) error {
+	found, err := s.forBucketStore(ctx, func(bs *BucketStore) error {
+		return bs.MergeProfilesTopFunctions(ctx, stream)
+	})
+	if err != nil || found {
+		return err
+	}
+	return terminateStream(stream)
+}
+
+func (s *StoreGateway) MergeProfilesValues(ctx context.Context, stream *connect.BidiStream[ingestv1.MergeProfilesValuesRequest, ingestv1.MergeProfilesValuesResponse]) error {
+	found, err := s.forBucketStore(ctx, func(bs *BucketStore) error {
+		return bs.MergeProfilesValues(ctx, stream)
+	})
+	if err != nil || found {
+		return err
+	}
+	return terminateStream(stream)
+}
+
+func (s *StoreGateway) GetProfileByID(ctx context.Context, req *connect.Request[ingestv1.GetProfileByIDRequest]) (*connect.Response[ingestv1.GetProfileByIDResponse], error) {
+	res := &ingestv1.GetProfileByIDResponse{}
+	_, err := s.forBucketStore(ctx, func(bs *BucketStore) error {
+		var err error
+		res, err = phlaredb.GetProfileByID(ctx, req.Msg, bs.openBlocksForReading)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
 func (s *StoreGateway) BlockMetadata(ctx context.Context, req *connect.Request[ingestv1.BlockMetadataRequest]) (*connect.Response[ingestv1.BlockMetadataResponse], error) {
 	var res *ingestv1.BlockMetadataResponse
 	found, err := s.forBucketStore(ctx, func(bs *BucketStore) error {
@@ -218,6 +251,14 @@ func (store *BucketStore) MergeSpanProfile(ctx context.Context, stream *connect.
 	return phlaredb.MergeSpanProfile(ctx, stream, store.openBlocksForReading)
 }
 
+func (store *BucketStore) MergeProfilesTopFunctions(ctx context.Context, stream *connect.BidiStream[ingestv1.MergeProfilesTopFunctionsRequest, ingestv1.MergeProfilesTopFunctionsResponse]) error {
+	return phlaredb.MergeProfilesTopFunctions(ctx, stream, store.openBlocksForReading)
+}
+
+func (store *BucketStore) MergeProfilesValues(ctx context.Context, stream *connect.BidiStream[ingestv1.MergeProfilesValuesRequest, ingestv1.MergeProfilesValuesResponse]) error {
+	return phlaredb.MergeProfilesValues(ctx, stream, store.openBlocksForReading)
+}
+
 func (s *BucketStore) BlockMetadata(ctx context.Context, req *ingestv1.BlockMetadataRequest) (*ingestv1.BlockMetadataResponse, error) {
 	set := s.blockSet.getFor(model.Time(req.Start), model.Time(req.End))
 	result := &ingestv1.BlockMetadataResponse{
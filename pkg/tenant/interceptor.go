@@ -86,6 +86,8 @@ func (i *authInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc
 var defaultResolver tenant.Resolver = tenant.NewMultiResolver()
 
 // ExtractTenantIDFromHeaders extracts a single TenantID from http headers.
+// The org ID is injected into the context even if it does not resolve to
+// a single tenant: queries may span multiple tenants.
 func ExtractTenantIDFromHeaders(ctx context.Context, headers http.Header) (string, context.Context, error) {
 	orgID := headers.Get(user.OrgIDHeaderName)
 	if orgID == "" {
@@ -95,7 +97,7 @@ func ExtractTenantIDFromHeaders(ctx context.Context, headers http.Header) (strin
 
 	tenantID, err := defaultResolver.TenantID(ctx)
 	if err != nil {
-		return "", nil, err
+		return "", ctx, err
 	}
 
 	return tenantID, ctx, nil
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"connectrpc.com/connect"
+	"github.com/grafana/dskit/tenant"
 	"github.com/stretchr/testify/require"
 )
 
@@ -59,6 +60,19 @@ func Test_AuthInterceptor(t *testing.T) {
 			require.NoError(t, err)
 			require.Nil(t, resp)
 		},
+		"server: enable, forward multiple tenants": func(t *testing.T) {
+			i := NewAuthInterceptor(true)
+			req := newFakeReq(false)
+			req.Header().Set("X-Scope-OrgID", "foo|bar")
+			resp, err := i.WrapUnary(func(ctx context.Context, ar connect.AnyRequest) (connect.AnyResponse, error) {
+				tenantIDs, err := tenant.TenantIDs(ctx)
+				require.NoError(t, err)
+				require.Equal(t, []string{"bar", "foo"}, tenantIDs)
+				return nil, nil
+			})(context.Background(), req)
+			require.NoError(t, err)
+			require.Nil(t, resp)
+		},
 		"streaming client should forward from context": func(t *testing.T) {
 			i := NewAuthInterceptor(false)
 			inConn := newFakeClientStreamingConn()
@@ -82,20 +82,25 @@ type Limits struct {
 	MaxFlameGraphNodesDefault int `yaml:"max_flamegraph_nodes_default" json:"max_flamegraph_nodes_default"`
 	MaxFlameGraphNodesMax     int `yaml:"max_flamegraph_nodes_max" json:"max_flamegraph_nodes_max"`
 
+	// Top functions enforced limits.
+	MaxTopFunctions int `yaml:"max_top_functions" json:"max_top_functions"`
+
 	// Store-gateway.
 	StoreGatewayTenantShardSize int `yaml:"store_gateway_tenant_shard_size" json:"store_gateway_tenant_shard_size"`
 
 	// Query frontend.
 	QuerySplitDuration model.Duration `yaml:"split_queries_by_interval" json:"split_queries_by_interval"`
+	QueryShards        int            `yaml:"query_shards" json:"query_shards"`
 
 	// Compactor.
-	CompactorBlocksRetentionPeriod     model.Duration `yaml:"compactor_blocks_retention_period" json:"compactor_blocks_retention_period"`
-	CompactorSplitAndMergeShards       int            `yaml:"compactor_split_and_merge_shards" json:"compactor_split_and_merge_shards"`
-	CompactorSplitAndMergeStageSize    int            `yaml:"compactor_split_and_merge_stage_size" json:"compactor_split_and_merge_stage_size"`
-	CompactorSplitGroups               int            `yaml:"compactor_split_groups" json:"compactor_split_groups"`
-	CompactorTenantShardSize           int            `yaml:"compactor_tenant_shard_size" json:"compactor_tenant_shard_size"`
-	CompactorPartialBlockDeletionDelay model.Duration `yaml:"compactor_partial_block_deletion_delay" json:"compactor_partial_block_deletion_delay"`
-	CompactorDownsamplerEnabled        bool           `yaml:"compactor_downsampler_enabled" json:"compactor_downsampler_enabled"`
+	CompactorBlocksRetentionPeriod     model.Duration  `yaml:"compactor_blocks_retention_period" json:"compactor_blocks_retention_period"`
+	CompactorSplitAndMergeShards       int             `yaml:"compactor_split_and_merge_shards" json:"compactor_split_and_merge_shards"`
+	CompactorSplitAndMergeStageSize    int             `yaml:"compactor_split_and_merge_stage_size" json:"compactor_split_and_merge_stage_size"`
+	CompactorSplitGroups               int             `yaml:"compactor_split_groups" json:"compactor_split_groups"`
+	CompactorTenantShardSize           int             `yaml:"compactor_tenant_shard_size" json:"compactor_tenant_shard_size"`
+	CompactorPartialBlockDeletionDelay model.Duration  `yaml:"compactor_partial_block_deletion_delay" json:"compactor_partial_block_deletion_delay"`
+	CompactorDownsamplerEnabled        bool            `yaml:"compactor_downsampler_enabled" json:"compactor_downsampler_enabled"`
+	CompactorRetentionRules            []RetentionRule `yaml:"compactor_retention_rules" json:"compactor_retention_rules" doc:"nocli|description=Retention rules for the profiles of the series matching label selectors. The compactor rewrites the blocks to delete the profiles older than the period of the first rule the series matches. Blocks are still deleted after the blocks retention period."`
 
 	// This config doesn't have a CLI flag registered here because they're registered in
 	// their own original config struct.
@@ -141,6 +146,7 @@ func (l *Limits) RegisterFlags(f *flag.FlagSet) {
 
 	_ = l.QuerySplitDuration.Set("0s")
 	f.Var(&l.QuerySplitDuration, "querier.split-queries-by-interval", "Split queries by a time interval and execute in parallel. The value 0 disables splitting by time")
+	f.IntVar(&l.QueryShards, "querier.query-shards", 0, "Number of shards merge and series queries are split into by series, in addition to the split by time. Must be a power of 2. The value 0 disables splitting by series")
 
 	f.IntVar(&l.MaxQueryParallelism, "querier.max-query-parallelism", 0, "Maximum number of queries that will be scheduled in parallel by the frontend.")
 
@@ -155,6 +161,7 @@ func (l *Limits) RegisterFlags(f *flag.FlagSet) {
 
 	f.IntVar(&l.MaxFlameGraphNodesDefault, "querier.max-flamegraph-nodes-default", 8<<10, "Maximum number of flame graph nodes by default. 0 to disable.")
 	f.IntVar(&l.MaxFlameGraphNodesMax, "querier.max-flamegraph-nodes-max", 0, "Maximum number of flame graph nodes allowed. 0 to disable.")
+	f.IntVar(&l.MaxTopFunctions, "querier.max-top-functions", 10000, "Maximum number of functions returned by a top functions query. 0 to disable.")
 
 	f.Var(&l.DistributorAggregationWindow, "distributor.aggregation-window", "Duration of the distributor aggregation window. Requires aggregation period to be specified. 0 to disable.")
 	f.Var(&l.DistributorAggregationPeriod, "distributor.aggregation-period", "Duration of the distributor aggregation period. Requires aggregation window to be specified. 0 to disable.")
@@ -205,6 +212,14 @@ func (l *Limits) Validate() error {
 		return fmt.Errorf("invalid ingestion_relabeling_default_rules_position: %s", l.IngestionRelabelingDefaultRulesPosition)
 	}
 
+	if l.QueryShards < 0 || l.QueryShards&(l.QueryShards-1) != 0 {
+		return fmt.Errorf("invalid query_shards: %d, must be a power of 2", l.QueryShards)
+	}
+
+	if err := validateRetentionRules(l.CompactorRetentionRules); err != nil {
+		return fmt.Errorf("invalid compactor_retention_rules: %w", err)
+	}
+
 	return nil
 }
 
@@ -362,6 +377,11 @@ func (o *Overrides) MaxFlameGraphNodesMax(tenantID string) int {
 	return o.getOverridesForTenant(tenantID).MaxFlameGraphNodesMax
 }
 
+// MaxTopFunctions returns the max number of functions returned by a top functions query.
+func (o *Overrides) MaxTopFunctions(tenantID string) int {
+	return o.getOverridesForTenant(tenantID).MaxTopFunctions
+}
+
 // StoreGatewayTenantShardSize returns the store-gateway shard size for a given user.
 func (o *Overrides) StoreGatewayTenantShardSize(userID string) int {
 	return o.getOverridesForTenant(userID).StoreGatewayTenantShardSize
@@ -372,6 +392,11 @@ func (o *Overrides) QuerySplitDuration(tenantID string) time.Duration {
 	return time.Duration(o.getOverridesForTenant(tenantID).QuerySplitDuration)
 }
 
+// QueryShards returns the number of shards queries are split into by series in the query frontend.
+func (o *Overrides) QueryShards(tenantID string) int {
+	return o.getOverridesForTenant(tenantID).QueryShards
+}
+
 // CompactorTenantShardSize returns number of compactors that this user can use. 0 = all compactors.
 func (o *Overrides) CompactorTenantShardSize(userID string) int {
 	return o.getOverridesForTenant(userID).CompactorTenantShardSize
@@ -416,6 +441,11 @@ func (o *Overrides) CompactorDownsamplerEnabled(userId string) bool {
 	return o.getOverridesForTenant(userId).CompactorDownsamplerEnabled
 }
 
+// CompactorRetentionRules returns the retention rules for a given user.
+func (o *Overrides) CompactorRetentionRules(userID string) []RetentionRule {
+	return o.getOverridesForTenant(userID).CompactorRetentionRules
+}
+
 // S3SSEType returns the per-tenant S3 SSE type.
 func (o *Overrides) S3SSEType(user string) string {
 	return o.getOverridesForTenant(user).S3SSEType
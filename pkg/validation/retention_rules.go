@@ -0,0 +1,50 @@
+package validation
+
+import (
+	"fmt"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+// Maximum number of retention rules that can be configured (per tenant).
+const maxRetentionRules = 50
+
+// RetentionRule defines the retention period of the profiles of the series
+// matching the selector. A series is retained according to the first rule
+// it matches; series not matching any rule are subject to the tenant blocks
+// retention period only.
+type RetentionRule struct {
+	Name     string         `yaml:"name" json:"name" doc:"description=Name of the rule, used as the rule label of the compactor retention metrics."`
+	Selector string         `yaml:"selector" json:"selector" doc:"description=Label selector of the series the rule applies to, for example '{env=\"ci\"}'."`
+	Period   model.Duration `yaml:"period" json:"period" doc:"description=Profiles of the matching series older than the period are deleted."`
+}
+
+// Matchers returns the label matchers of the rule selector.
+func (r *RetentionRule) Matchers() ([]*labels.Matcher, error) {
+	return parser.ParseMetricSelector(r.Selector)
+}
+
+func validateRetentionRules(rules []RetentionRule) error {
+	if len(rules) > maxRetentionRules {
+		return fmt.Errorf("maximum number of retention rules is %d, got %d", maxRetentionRules, len(rules))
+	}
+	names := make(map[string]struct{}, len(rules))
+	for _, r := range rules {
+		if r.Name == "" {
+			return fmt.Errorf("retention rule name must not be empty")
+		}
+		if _, ok := names[r.Name]; ok {
+			return fmt.Errorf("duplicate retention rule name %q", r.Name)
+		}
+		names[r.Name] = struct{}{}
+		if _, err := r.Matchers(); err != nil {
+			return fmt.Errorf("failed to parse retention rule %q selector: %w", r.Name, err)
+		}
+		if r.Period <= 0 {
+			return fmt.Errorf("retention rule %q period must be positive", r.Name)
+		}
+	}
+	return nil
+}
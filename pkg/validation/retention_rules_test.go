@@ -0,0 +1,40 @@
+package validation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestLimits_RetentionRules(t *testing.T) {
+	var l Limits
+	require.NoError(t, yaml.Unmarshal([]byte(`
+compactor_retention_rules:
+  - name: ci
+    selector: '{env="ci"}'
+    period: 3d
+  - name: production
+    selector: '{env="prod"}'
+    period: 90d
+`), &l))
+	require.NoError(t, l.Validate())
+	require.Equal(t, []RetentionRule{
+		{Name: "ci", Selector: `{env="ci"}`, Period: model.Duration(3 * 24 * time.Hour)},
+		{Name: "production", Selector: `{env="prod"}`, Period: model.Duration(90 * 24 * time.Hour)},
+	}, l.CompactorRetentionRules)
+
+	for name, rules := range map[string][]RetentionRule{
+		"empty name":       {{Selector: `{env="ci"}`, Period: model.Duration(time.Hour)}},
+		"duplicate name":   {{Name: "a", Selector: `{env="ci"}`, Period: model.Duration(time.Hour)}, {Name: "a", Selector: `{env="dev"}`, Period: model.Duration(time.Hour)}},
+		"invalid selector": {{Name: "a", Selector: `{env=}`, Period: model.Duration(time.Hour)}},
+		"zero period":      {{Name: "a", Selector: `{env="ci"}`}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			l := Limits{CompactorRetentionRules: rules}
+			require.Error(t, l.Validate())
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 type MockLimits struct {
 	QuerySplitDurationValue         time.Duration
+	QueryShardsValue                int
 	MaxQueryParallelismValue        int
 	MaxQueryLengthValue             time.Duration
 	MaxQueryLookbackValue           time.Duration
@@ -18,6 +19,8 @@ type MockLimits struct {
 	MaxFlameGraphNodesDefaultValue int
 	MaxFlameGraphNodesMaxValue     int
 
+	MaxTopFunctionsValue int
+
 	DistributorAggregationWindowValue time.Duration
 	DistributorAggregationPeriodValue time.Duration
 
@@ -34,6 +37,7 @@ type MockLimits struct {
 }
 
 func (m MockLimits) QuerySplitDuration(string) time.Duration        { return m.QuerySplitDurationValue }
+func (m MockLimits) QueryShards(string) int                         { return m.QueryShardsValue }
 func (m MockLimits) MaxQueryParallelism(string) int                 { return m.MaxQueryParallelismValue }
 func (m MockLimits) MaxQueryLength(tenantID string) time.Duration   { return m.MaxQueryLengthValue }
 func (m MockLimits) MaxQueryLookback(tenantID string) time.Duration { return m.MaxQueryLookbackValue }
@@ -44,6 +48,7 @@ func (m MockLimits) QueryAnalysisSeriesEnabled(tenantID string) bool {
 
 func (m MockLimits) MaxFlameGraphNodesDefault(string) int { return m.MaxFlameGraphNodesDefaultValue }
 func (m MockLimits) MaxFlameGraphNodesMax(string) int     { return m.MaxFlameGraphNodesMaxValue }
+func (m MockLimits) MaxTopFunctions(string) int           { return m.MaxTopFunctionsValue }
 
 func (m MockLimits) MaxLabelNameLength(userID string) int     { return m.MaxLabelNameLengthValue }
 func (m MockLimits) MaxLabelValueLength(userID string) int    { return m.MaxLabelValueLengthValue }
@@ -104,6 +104,11 @@ type UsageGroupMatch struct {
 	names    []string
 }
 
+// Names returns the names of the matching usage groups.
+func (m UsageGroupMatch) Names() []string {
+	return m.names
+}
+
 func (m UsageGroupMatch) CountReceivedBytes(profileType string, n int64) {
 	if len(m.names) == 0 {
 		usageGroupReceivedDecompressedBytes.WithLabelValues(profileType, m.tenantID, noMatchName).Add(float64(n))